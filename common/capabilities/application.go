@@ -21,14 +21,18 @@ const (
 
 	// ApplicationResourcesTreeExperimental is the capabilties string for private data using the experimental feature of collections/sideDB.
 	ApplicationResourcesTreeExperimental = "V1_1_RESOURCETREE_EXPERIMENTAL"
+
+	// ApplicationChaincodeCallsExperimental is the capabilties string for the experimental validation of chaincode-to-chaincode calls.
+	ApplicationChaincodeCallsExperimental = "V1_1_CC2CC_EXPERIMENTAL"
 )
 
 // ApplicationProvider provides capabilities information for application level config.
 type ApplicationProvider struct {
 	*registry
-	v11                          bool
-	v11PvtDataExperimental       bool
-	v11ResourcesTreeExperimental bool
+	v11                           bool
+	v11PvtDataExperimental        bool
+	v11ResourcesTreeExperimental  bool
+	v11ChaincodeCallsExperimental bool
 }
 
 // NewApplicationProvider creates a application capabilities provider.
@@ -38,6 +42,7 @@ func NewApplicationProvider(capabilities map[string]*cb.Capability) *Application
 	_, ap.v11 = capabilities[ApplicationV1_1]
 	_, ap.v11PvtDataExperimental = capabilities[ApplicationPvtDataExperimental]
 	_, ap.v11ResourcesTreeExperimental = capabilities[ApplicationResourcesTreeExperimental]
+	_, ap.v11ChaincodeCallsExperimental = capabilities[ApplicationChaincodeCallsExperimental]
 	return ap
 }
 
@@ -67,3 +72,11 @@ func (ap *ApplicationProvider) PrivateChannelData() bool {
 func (ap *ApplicationProvider) V1_1Validation() bool {
 	return ap.v11
 }
+
+// ChaincodeCallsValidation returns true if the chaincode-to-chaincode calls recorded in a transaction
+// should be validated, that is, if the endorsement policies of the invoked chaincodes should be enforced
+// and the reads performed on other channels should be verified. Such reads can only be verified by
+// the peers that have joined the invoked channels.
+func (ap *ApplicationProvider) ChaincodeCallsValidation() bool {
+	return ap.v11ChaincodeCallsExperimental
+}
@@ -18,6 +18,8 @@ func (ap *ApplicationProvider) HasCapability(capability string) bool {
 		return true
 	case ApplicationResourcesTreeExperimental:
 		return true
+	case ApplicationChaincodeCallsExperimental:
+		return true
 	default:
 		return false
 	}
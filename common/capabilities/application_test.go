@@ -34,3 +34,11 @@ func TestApplicationPvtDataExperimental(t *testing.T) {
 	})
 	assert.True(t, op.PrivateChannelData())
 }
+
+func TestApplicationChaincodeCallsExperimental(t *testing.T) {
+	op := NewApplicationProvider(map[string]*cb.Capability{
+		ApplicationChaincodeCallsExperimental: {},
+	})
+	assert.True(t, op.ChaincodeCallsValidation())
+	assert.False(t, op.V1_1Validation())
+}
@@ -129,6 +129,10 @@ type ApplicationCapabilities interface {
 	// V1_1Validation returns true is this channel is configured to perform stricter validation
 	// of transactions (as introduced in v1.1).
 	V1_1Validation() bool
+
+	// ChaincodeCallsValidation returns true if the chaincode-to-chaincode calls recorded in
+	// transactions should be validated against the policies and ledgers of the invoked chaincodes.
+	ChaincodeCallsValidation() bool
 }
 
 // OrdererCapabilities defines the capabilities for the orderer portion of a channel
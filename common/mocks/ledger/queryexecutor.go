@@ -43,8 +43,15 @@ func (m *MockQueryExecutor) GetState(namespace string, key string) ([]byte, erro
 }
 
 func (m *MockQueryExecutor) GetStateMultipleKeys(namespace string, keys []string) ([][]byte, error) {
-	return nil, nil
-
+	res := make([][]byte, len(keys))
+	for i, key := range keys {
+		val, err := m.GetState(namespace, key)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = val
+	}
+	return res, nil
 }
 
 func (m *MockQueryExecutor) GetStateRangeScanIterator(namespace string, startKey string, endKey string) (ledger.ResultsIterator, error) {
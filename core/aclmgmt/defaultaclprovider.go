@@ -74,6 +74,8 @@ func (d *defaultACLProvider) initialize() {
 	d.cResourcePolicyMap[resources.QSCC_GetBlockByHash] = CHANNELREADERS
 	d.cResourcePolicyMap[resources.QSCC_GetTransactionByID] = CHANNELREADERS
 	d.cResourcePolicyMap[resources.QSCC_GetBlockByTxID] = CHANNELREADERS
+	d.cResourcePolicyMap[resources.QSCC_GetMVCCConflicts] = CHANNELREADERS
+	d.cResourcePolicyMap[resources.QSCC_VerifyPvtData] = CHANNELREADERS
 
 	//--------------- CSCC resources -----------
 	//p resources (implemented by the chaincode currently)
@@ -29,6 +29,8 @@ const (
 	QSCC_GetBlockByHash     = "QSCC.GetBlockByHash"
 	QSCC_GetTransactionByID = "QSCC.GetTransactionByID"
 	QSCC_GetBlockByTxID     = "QSCC.GetBlockByTxID"
+	QSCC_GetMVCCConflicts   = "QSCC.GetMVCCConflicts"
+	QSCC_VerifyPvtData      = "QSCC.VerifyPvtData"
 
 	//CSCC resources
 	CSCC_JoinChain                = "CSCC.JoinChain"
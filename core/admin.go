@@ -17,22 +17,100 @@ limitations under the License.
 package core
 
 import (
+	"encoding/json"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/peer"
+	"github.com/hyperledger/fabric/gossip/api"
+	gcomm "github.com/hyperledger/fabric/gossip/comm"
+	gcommon "github.com/hyperledger/fabric/gossip/common"
+	"github.com/hyperledger/fabric/gossip/discovery"
+	mspmgmt "github.com/hyperledger/fabric/msp/mgmt"
+	gproto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/hyperledger/fabric/protos/msp"
 	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 )
 
 var logger = flogging.MustGetLogger("server")
 
-// NewAdminServer creates and returns a Admin service instance.
-func NewAdminServer() *ServerAdmin {
-	s := new(ServerAdmin)
+// DivergenceChecker checks whether the endorsers of a proposal simulated it alike
+type DivergenceChecker interface {
+	// CheckDivergence reports the keys that the simulations of
+	// a proposal didn't read or write alike
+	CheckDivergence(ctx context.Context, request *pb.DivergenceRequest) (*pb.DivergenceReport, error)
+}
+
+// GossipInspector exposes the state of the gossip component of the peer
+type GossipInspector interface {
+	// Peers returns the NetworkMembers considered alive
+	Peers() []discovery.NetworkMember
+
+	// DeadPeers returns the NetworkMembers considered dead
+	DeadPeers() []discovery.NetworkMember
+
+	// PeersOfChannel returns the NetworkMembers considered alive
+	// and also subscribed to the channel given
+	PeersOfChannel(gcommon.ChainID) []discovery.NetworkMember
+
+	// SelfChannelInfo returns the StateInfo message the peer
+	// publishes about the given channel, or nil if there is none
+	SelfChannelInfo(chainID gcommon.ChainID) *gproto.SignedGossipMessage
+
+	// PeerIdentity returns the identity of the peer with the given PKI-ID
+	PeerIdentity(pkiID gcommon.PKIidType) (api.PeerIdentityType, error)
+
+	// Connections returns the connections to remote peers,
+	// along with the bytes sent and received over them
+	Connections() []gcomm.ConnectionStats
+
+	// IdentityDigests returns the PKI-IDs of the peers whose identities
+	// are available to other peers through pull
+	IdentityDigests() []gcommon.PKIidType
+
+	// BlockDigests returns the sequence numbers of the blocks of the given
+	// channel that are available to other peers through pull
+	BlockDigests(chainID gcommon.ChainID) []uint64
+}
+
+// NewAdminServer creates and returns a Admin service instance, which
+// checks the divergence of endorsements with the given checker, and
+// lists the given handlers as the ones loaded by the peer.
+func NewAdminServer(checker DivergenceChecker, handlers ...*pb.HandlerInfo) *ServerAdmin {
+	s := &ServerAdmin{checker: checker, handlers: handlers, getLedger: peer.GetLedger, getChannels: peer.GetChannelsInfo, localMSPID: localMSPID}
 	return s
 }
 
 // ServerAdmin implementation of the Admin service for the Peer
 type ServerAdmin struct {
+	checker     DivergenceChecker
+	handlers    []*pb.HandlerInfo
+	gossip      GossipInspector
+	getLedger   func(cid string) ledger.PeerLedger
+	getChannels func() []*pb.ChannelInfo
+	localMSPID  func() string
+}
+
+// localMSPID returns the ID of the local MSP, or an empty string if it has none
+func localMSPID() string {
+	id, err := mspmgmt.GetLocalMSP().GetIdentifier()
+	if err != nil {
+		logger.Warningf("Failed getting the ID of the local MSP: %s", err)
+		return ""
+	}
+	return id
+}
+
+// SetGossip sets the gossip component whose state is reported.
+// It must be called before the Admin service is served, as the
+// gossip component is initialized after the service is created.
+func (s *ServerAdmin) SetGossip(gossip GossipInspector) {
+	s.gossip = gossip
 }
 
 // GetStatus reports the status of the server
@@ -71,3 +149,167 @@ func (*ServerAdmin) RevertLogLevels(context.Context, *empty.Empty) (*empty.Empty
 
 	return &empty.Empty{}, err
 }
+
+// redactedValue replaces the values of the configuration parameters of handlers
+const redactedValue = "REDACTED"
+
+// GetHandlers returns the handlers loaded by the peer, along with the names of
+// their configuration parameters. The values of the parameters are redacted, as
+// they may well be secrets.
+func (s *ServerAdmin) GetHandlers(context.Context, *empty.Empty) (*pb.Handlers, error) {
+	handlers := &pb.Handlers{}
+	for _, handler := range s.handlers {
+		h := proto.Clone(handler).(*pb.HandlerInfo)
+		h.Config = redactConfig(h.Config)
+		handlers.Handlers = append(handlers.Handlers, h)
+	}
+	return handlers, nil
+}
+
+// redactConfig replaces the values of the parameters of the given JSON
+// configuration, nested parameters included, with redactedValue
+func redactConfig(config string) string {
+	if config == "" {
+		return ""
+	}
+	var params interface{}
+	if err := json.Unmarshal([]byte(config), &params); err != nil {
+		return redactedValue
+	}
+	redacted, err := json.Marshal(redactParams(params))
+	if err != nil {
+		return redactedValue
+	}
+	return string(redacted)
+}
+
+func redactParams(params interface{}) interface{} {
+	switch p := params.(type) {
+	case map[string]interface{}:
+		for name, value := range p {
+			p[name] = redactParams(value)
+		}
+		return p
+	case []interface{}:
+		for i, value := range p {
+			p[i] = redactParams(value)
+		}
+		return p
+	default:
+		return redactedValue
+	}
+}
+
+// GetHotKeys returns the keys of a channel with the most MVCC and phantom read conflicts
+func (s *ServerAdmin) GetHotKeys(ctx context.Context, request *pb.HotKeysRequest) (*pb.HotKeys, error) {
+	l := s.getLedger(request.ChannelId)
+	if l == nil {
+		return nil, errors.Errorf("channel %s doesn't exist", request.ChannelId)
+	}
+	return &pb.HotKeys{HotKeys: l.GetHotKeys(request.Namespace)}, nil
+}
+
+// CheckEndorsementDivergence diffs the read-write sets of the responses of endorsers
+// to a proposal, optionally along with those of a local simulation of the proposal
+func (s *ServerAdmin) CheckEndorsementDivergence(ctx context.Context, request *pb.DivergenceRequest) (*pb.DivergenceReport, error) {
+	if s.checker == nil {
+		return nil, errors.New("endorsement divergence checks are not supported")
+	}
+	return s.checker.CheckDivergence(ctx, request)
+}
+
+// GetGossipMembership returns the alive and dead members of the gossip membership,
+// and the peers whose identities are available to other peers through pull
+func (s *ServerAdmin) GetGossipMembership(context.Context, *empty.Empty) (*pb.GossipMembership, error) {
+	if s.gossip == nil {
+		return nil, errors.New("gossip is not available")
+	}
+	membership := &pb.GossipMembership{
+		Alive: s.gossipPeers(s.gossip.Peers()),
+		Dead:  s.gossipPeers(s.gossip.DeadPeers()),
+	}
+	for _, pkiID := range s.gossip.IdentityDigests() {
+		membership.IdentityDigests = append(membership.IdentityDigests, pkiID)
+	}
+	return membership, nil
+}
+
+// GetGossipChannels returns the peers, the leadership and the blocks available to
+// other peers through pull of a channel, or of all the channels the peer joined
+func (s *ServerAdmin) GetGossipChannels(ctx context.Context, request *pb.GossipChannelsRequest) (*pb.GossipChannels, error) {
+	if s.gossip == nil {
+		return nil, errors.New("gossip is not available")
+	}
+	var channelIDs []string
+	for _, channel := range s.getChannels() {
+		if request.ChannelId == "" || request.ChannelId == channel.ChannelId {
+			channelIDs = append(channelIDs, channel.ChannelId)
+		}
+	}
+	if request.ChannelId != "" && len(channelIDs) == 0 {
+		return nil, errors.Errorf("channel %s doesn't exist", request.ChannelId)
+	}
+	sort.Strings(channelIDs)
+	channels := &pb.GossipChannels{}
+	for _, channelID := range channelIDs {
+		chainID := gcommon.ChainID(channelID)
+		channel := &pb.GossipChannel{
+			ChannelId:    channelID,
+			Peers:        s.gossipPeers(s.gossip.PeersOfChannel(chainID)),
+			BlockDigests: s.gossip.BlockDigests(chainID),
+		}
+		if self := s.gossip.SelfChannelInfo(chainID); self != nil && self.IsStateInfoMsg() {
+			channel.Leader = self.GetStateInfo().GetProperties().GetLeader()
+		}
+		channels.Channels = append(channels.Channels, channel)
+	}
+	return channels, nil
+}
+
+// GetGossipConnections returns the gossip connections to remote peers,
+// along with the bytes sent and received over them
+func (s *ServerAdmin) GetGossipConnections(context.Context, *empty.Empty) (*pb.GossipConnections, error) {
+	if s.gossip == nil {
+		return nil, errors.New("gossip is not available")
+	}
+	connections := &pb.GossipConnections{}
+	for _, conn := range s.gossip.Connections() {
+		connections.Connections = append(connections.Connections, &pb.GossipConnection{
+			Endpoint:      conn.Endpoint,
+			PkiId:         conn.PKIID,
+			Outbound:      conn.Outbound,
+			BytesSent:     conn.BytesSent,
+			BytesReceived: conn.BytesReceived,
+		})
+	}
+	return connections, nil
+}
+
+// gossipPeers describes the given members. The internal endpoints of peers are
+// only disclosed for the peers of the organization of the local MSP, as they are
+// not meant to be known outside of it.
+func (s *ServerAdmin) gossipPeers(members []discovery.NetworkMember) []*pb.GossipPeer {
+	selfMSPID := s.localMSPID()
+	var peers []*pb.GossipPeer
+	for _, member := range members {
+		p := &pb.GossipPeer{
+			Endpoint: member.Endpoint,
+			PkiId:    member.PKIid,
+		}
+		if identity, err := s.gossip.PeerIdentity(member.PKIid); err == nil {
+			sID := &msp.SerializedIdentity{}
+			if err := proto.Unmarshal(identity, sID); err == nil {
+				p.Mspid = sID.Mspid
+			}
+		}
+		if p.Mspid != "" && p.Mspid == selfMSPID {
+			p.InternalEndpoint = member.InternalEndpoint
+		}
+		if member.Properties != nil {
+			p.LedgerHeight = member.Properties.LedgerHeight
+			p.Leader = member.Properties.Leader
+		}
+		peers = append(peers, p)
+	}
+	return peers
+}
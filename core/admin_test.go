@@ -22,15 +22,25 @@ import (
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/testutil"
+	"github.com/hyperledger/fabric/gossip/api"
+	gcomm "github.com/hyperledger/fabric/gossip/comm"
+	gcommon "github.com/hyperledger/fabric/gossip/common"
+	"github.com/hyperledger/fabric/gossip/discovery"
+	gproto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/hyperledger/fabric/protos/msp"
 	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	netcontext "golang.org/x/net/context"
 )
 
 var adminServer *ServerAdmin
 
 func init() {
-	adminServer = NewAdminServer()
+	adminServer = NewAdminServer(nil)
 	testutil.SetupTestConfig()
 }
 
@@ -68,3 +78,226 @@ func TestLoggingCalls(t *testing.T) {
 	assert.Equal(t, flogging.DefaultLevel(), logResponse.LogLevel, "logger level should have been the default")
 	assert.Nil(t, err, "Error should have been nil")
 }
+
+func TestGetHandlers(t *testing.T) {
+	response, err := adminServer.GetHandlers(context.Background(), &empty.Empty{})
+	assert.NoError(t, err)
+	assert.Empty(t, response.Handlers)
+
+	// the values of the configuration parameters are redacted
+	handlers := []*pb.HandlerInfo{
+		{Type: "auth", Name: "RateLimit", Config: `{"perClient":{"rate":10,"burst":20}}`},
+		{Type: "auth", Name: "MSPAccess", Config: `{"default":{"deny":[{"mspID":"Org3MSP"}]}}`},
+		{Type: "auth", Name: "DefaultAuth"},
+		{Type: "endorsement", Key: "custom", Library: "/opt/lib/endorsement.so", Config: `barf`},
+	}
+	response, err = NewAdminServer(nil, handlers...).GetHandlers(context.Background(), &empty.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, []*pb.HandlerInfo{
+		{Type: "auth", Name: "RateLimit", Config: `{"perClient":{"burst":"REDACTED","rate":"REDACTED"}}`},
+		{Type: "auth", Name: "MSPAccess", Config: `{"default":{"deny":[{"mspID":"REDACTED"}]}}`},
+		{Type: "auth", Name: "DefaultAuth"},
+		{Type: "endorsement", Key: "custom", Library: "/opt/lib/endorsement.so", Config: "REDACTED"},
+	}, response.Handlers)
+	// the handlers of the server are left untouched
+	assert.Equal(t, `{"perClient":{"rate":10,"burst":20}}`, handlers[0].Config)
+}
+
+type mockLedger struct {
+	ledger.PeerLedger
+	hotKeys []*pb.HotKey
+}
+
+func (m *mockLedger) GetHotKeys(namespace string) []*pb.HotKey {
+	var hotKeys []*pb.HotKey
+	for _, hotKey := range m.hotKeys {
+		if namespace == "" || hotKey.Namespace == namespace {
+			hotKeys = append(hotKeys, hotKey)
+		}
+	}
+	return hotKeys
+}
+
+func TestGetHotKeys(t *testing.T) {
+	hotKeys := []*pb.HotKey{
+		{Namespace: "mycc", Key: "key1", Conflicts: 5},
+		{Namespace: "othercc", Key: "key2", Conflicts: 2},
+	}
+	server := NewAdminServer(nil)
+	server.getLedger = func(cid string) ledger.PeerLedger {
+		if cid != "mychannel" {
+			return nil
+		}
+		return &mockLedger{hotKeys: hotKeys}
+	}
+
+	response, err := server.GetHotKeys(context.Background(), &pb.HotKeysRequest{ChannelId: "mychannel"})
+	assert.NoError(t, err)
+	assert.Equal(t, hotKeys, response.HotKeys)
+
+	response, err = server.GetHotKeys(context.Background(), &pb.HotKeysRequest{ChannelId: "mychannel", Namespace: "mycc"})
+	assert.NoError(t, err)
+	assert.Equal(t, hotKeys[:1], response.HotKeys)
+
+	_, err = server.GetHotKeys(context.Background(), &pb.HotKeysRequest{ChannelId: "otherchannel"})
+	assert.EqualError(t, err, "channel otherchannel doesn't exist")
+}
+
+type mockDivergenceChecker struct {
+	report *pb.DivergenceReport
+}
+
+func (c *mockDivergenceChecker) CheckDivergence(ctx netcontext.Context, request *pb.DivergenceRequest) (*pb.DivergenceReport, error) {
+	return c.report, nil
+}
+
+func TestCheckEndorsementDivergence(t *testing.T) {
+	_, err := NewAdminServer(nil).CheckEndorsementDivergence(context.Background(), &pb.DivergenceRequest{})
+	assert.EqualError(t, err, "endorsement divergence checks are not supported")
+
+	report := &pb.DivergenceReport{Endorsers: []string{"Org1MSP", "Org2MSP"}, ResponsesDiverge: true}
+	server := NewAdminServer(&mockDivergenceChecker{report: report})
+	response, err := server.CheckEndorsementDivergence(context.Background(), &pb.DivergenceRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, report, response)
+}
+
+type mockGossipInspector struct {
+	alive, dead []discovery.NetworkMember
+	identities  map[string]api.PeerIdentityType
+}
+
+func (m *mockGossipInspector) Peers() []discovery.NetworkMember {
+	return m.alive
+}
+
+func (m *mockGossipInspector) DeadPeers() []discovery.NetworkMember {
+	return m.dead
+}
+
+func (m *mockGossipInspector) PeersOfChannel(chainID gcommon.ChainID) []discovery.NetworkMember {
+	if string(chainID) != "mychannel" {
+		return nil
+	}
+	return m.alive
+}
+
+func (m *mockGossipInspector) SelfChannelInfo(chainID gcommon.ChainID) *gproto.SignedGossipMessage {
+	if string(chainID) != "mychannel" {
+		return nil
+	}
+	return &gproto.SignedGossipMessage{
+		GossipMessage: &gproto.GossipMessage{
+			Content: &gproto.GossipMessage_StateInfo{
+				StateInfo: &gproto.StateInfo{Properties: &gproto.Properties{Leader: true}},
+			},
+		},
+	}
+}
+
+func (m *mockGossipInspector) PeerIdentity(pkiID gcommon.PKIidType) (api.PeerIdentityType, error) {
+	identity, exists := m.identities[string(pkiID)]
+	if !exists {
+		return nil, errors.New("identity not found")
+	}
+	return identity, nil
+}
+
+func (m *mockGossipInspector) Connections() []gcomm.ConnectionStats {
+	return []gcomm.ConnectionStats{{
+		RemotePeer:    gcomm.RemotePeer{Endpoint: "peer1:7051", PKIID: gcommon.PKIidType("p1")},
+		Outbound:      true,
+		BytesSent:     100,
+		BytesReceived: 200,
+	}}
+}
+
+func (m *mockGossipInspector) IdentityDigests() []gcommon.PKIidType {
+	return []gcommon.PKIidType{gcommon.PKIidType("p1")}
+}
+
+func (m *mockGossipInspector) BlockDigests(chainID gcommon.ChainID) []uint64 {
+	if string(chainID) != "mychannel" {
+		return nil
+	}
+	return []uint64{5, 6}
+}
+
+func newMockGossipInspector() *mockGossipInspector {
+	return &mockGossipInspector{
+		alive: []discovery.NetworkMember{{
+			Endpoint:         "peer1:7051",
+			InternalEndpoint: "peer1.org1:7051",
+			PKIid:            gcommon.PKIidType("p1"),
+			Properties:       &gproto.Properties{LedgerHeight: 10, Leader: true},
+		}, {
+			Endpoint:         "peer3:7051",
+			InternalEndpoint: "peer3.org2:7051",
+			PKIid:            gcommon.PKIidType("p3"),
+		}},
+		dead: []discovery.NetworkMember{{Endpoint: "peer2:7051", InternalEndpoint: "peer2.org1:7051", PKIid: gcommon.PKIidType("p2")}},
+		identities: map[string]api.PeerIdentityType{
+			"p1": api.PeerIdentityType(utils.MarshalOrPanic(&msp.SerializedIdentity{Mspid: "Org1MSP"})),
+			"p3": api.PeerIdentityType(utils.MarshalOrPanic(&msp.SerializedIdentity{Mspid: "Org2MSP"})),
+		},
+	}
+}
+
+func TestGetGossipMembership(t *testing.T) {
+	server := NewAdminServer(nil)
+	_, err := server.GetGossipMembership(context.Background(), &empty.Empty{})
+	assert.EqualError(t, err, "gossip is not available")
+
+	// the internal endpoints of the peers of other organizations, or of
+	// unknown ones, are not disclosed
+	server.SetGossip(newMockGossipInspector())
+	server.localMSPID = func() string { return "Org1MSP" }
+	membership, err := server.GetGossipMembership(context.Background(), &empty.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, []*pb.GossipPeer{
+		{Endpoint: "peer1:7051", InternalEndpoint: "peer1.org1:7051", PkiId: []byte("p1"), Mspid: "Org1MSP", LedgerHeight: 10, Leader: true},
+		{Endpoint: "peer3:7051", PkiId: []byte("p3"), Mspid: "Org2MSP"},
+	}, membership.Alive)
+	assert.Equal(t, []*pb.GossipPeer{{Endpoint: "peer2:7051", PkiId: []byte("p2")}}, membership.Dead)
+	assert.Equal(t, [][]byte{[]byte("p1")}, membership.IdentityDigests)
+}
+
+func TestGetGossipChannels(t *testing.T) {
+	server := NewAdminServer(nil)
+	server.getChannels = func() []*pb.ChannelInfo {
+		return []*pb.ChannelInfo{{ChannelId: "otherchannel"}, {ChannelId: "mychannel"}}
+	}
+	_, err := server.GetGossipChannels(context.Background(), &pb.GossipChannelsRequest{})
+	assert.EqualError(t, err, "gossip is not available")
+
+	server.SetGossip(newMockGossipInspector())
+	channels, err := server.GetGossipChannels(context.Background(), &pb.GossipChannelsRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, channels.Channels, 2)
+	assert.Equal(t, "mychannel", channels.Channels[0].ChannelId)
+	assert.True(t, channels.Channels[0].Leader)
+	assert.Equal(t, []uint64{5, 6}, channels.Channels[0].BlockDigests)
+	assert.Len(t, channels.Channels[0].Peers, 2)
+	assert.Equal(t, "otherchannel", channels.Channels[1].ChannelId)
+	assert.False(t, channels.Channels[1].Leader)
+	assert.Empty(t, channels.Channels[1].Peers)
+
+	channels, err = server.GetGossipChannels(context.Background(), &pb.GossipChannelsRequest{ChannelId: "otherchannel"})
+	assert.NoError(t, err)
+	assert.Len(t, channels.Channels, 1)
+	assert.Equal(t, "otherchannel", channels.Channels[0].ChannelId)
+
+	_, err = server.GetGossipChannels(context.Background(), &pb.GossipChannelsRequest{ChannelId: "nochannel"})
+	assert.EqualError(t, err, "channel nochannel doesn't exist")
+}
+
+func TestGetGossipConnections(t *testing.T) {
+	server := NewAdminServer(nil)
+	_, err := server.GetGossipConnections(context.Background(), &empty.Empty{})
+	assert.EqualError(t, err, "gossip is not available")
+
+	server.SetGossip(newMockGossipInspector())
+	connections, err := server.GetGossipConnections(context.Background(), &empty.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, []*pb.GossipConnection{{Endpoint: "peer1:7051", PkiId: []byte("p1"), Outbound: true, BytesSent: 100, BytesReceived: 200}}, connections.Connections)
+}
@@ -0,0 +1,97 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package chaincode
+
+import (
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// ChaincodeCallRecorder collects the chaincode-to-chaincode invocations
+// performed while simulating a proposal. The endorser attaches a recorder
+// to the context passed to the chaincode support (see ChaincodeCallRecorderKey)
+// and hands the recorded calls over to ESCC, so that they end up in the
+// ChaincodeAction of the proposal response.
+type ChaincodeCallRecorder struct {
+	sync.Mutex
+	calls []*pb.ChaincodeCall
+}
+
+// NewChaincodeCallRecorder returns an empty ChaincodeCallRecorder
+func NewChaincodeCallRecorder() *ChaincodeCallRecorder {
+	return &ChaincodeCallRecorder{}
+}
+
+// Record appends the supplied call to the list of recorded calls
+func (r *ChaincodeCallRecorder) Record(call *pb.ChaincodeCall) {
+	r.Lock()
+	defer r.Unlock()
+	r.calls = append(r.calls, call)
+}
+
+// Calls returns the calls recorded so far, in invocation order
+func (r *ChaincodeCallRecorder) Calls() []*pb.ChaincodeCall {
+	r.Lock()
+	defer r.Unlock()
+	calls := make([]*pb.ChaincodeCall, len(r.calls))
+	copy(calls, r.calls)
+	return calls
+}
+
+//use this to record chaincode-to-chaincode invocations, if the caller asked for it
+func getChaincodeCallRecorder(context context.Context) *ChaincodeCallRecorder {
+	if recorder, ok := context.Value(ChaincodeCallRecorderKey).(*ChaincodeCallRecorder); ok {
+		return recorder
+	}
+	return nil
+}
+
+// readOnlyResults strips the writes from the public simulation results of
+// a cross-channel invocation: those writes are discarded and only the reads
+// are meaningful to the committers that verify the invocation. Range query
+// info and private data hashes are dropped as well, as they are not verified.
+// The returned boolean reports whether any write has been stripped.
+func readOnlyResults(pubSimRes *rwset.TxReadWriteSet) ([]byte, bool, error) {
+	if pubSimRes == nil {
+		return nil, false, nil
+	}
+
+	discardedWrites := false
+	reads := &rwset.TxReadWriteSet{DataModel: pubSimRes.DataModel}
+	for _, nsRWSet := range pubSimRes.NsRwset {
+		kvRWSet := &kvrwset.KVRWSet{}
+		if err := proto.Unmarshal(nsRWSet.Rwset, kvRWSet); err != nil {
+			return nil, false, errors.Wrapf(err, "failed unmarshalling read-write set of namespace %s", nsRWSet.Namespace)
+		}
+		discardedWrites = discardedWrites || len(kvRWSet.Writes) > 0
+		if len(kvRWSet.Reads) == 0 {
+			continue
+		}
+
+		kvReads, err := proto.Marshal(&kvrwset.KVRWSet{Reads: kvRWSet.Reads})
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "failed marshalling reads of namespace %s", nsRWSet.Namespace)
+		}
+		reads.NsRwset = append(reads.NsRwset, &rwset.NsReadWriteSet{Namespace: nsRWSet.Namespace, Rwset: kvReads})
+	}
+
+	if len(reads.NsRwset) == 0 {
+		return nil, discardedWrites, nil
+	}
+
+	readsBytes, err := proto.Marshal(reads)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed marshalling reads")
+	}
+	return readsBytes, discardedWrites, nil
+}
@@ -0,0 +1,78 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package chaincode
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
+	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+func TestChaincodeCallRecorder(t *testing.T) {
+	assert.Nil(t, getChaincodeCallRecorder(context.Background()))
+
+	recorder := NewChaincodeCallRecorder()
+	ctxt := context.WithValue(context.Background(), ChaincodeCallRecorderKey, recorder)
+	assert.Equal(t, recorder, getChaincodeCallRecorder(ctxt))
+	assert.Empty(t, recorder.Calls())
+
+	call1 := &pb.ChaincodeCall{ChaincodeId: &pb.ChaincodeID{Name: "cc1", Version: "1"}, ChannelId: "ch1"}
+	call2 := &pb.ChaincodeCall{ChaincodeId: &pb.ChaincodeID{Name: "cc2", Version: "2"}, ChannelId: "ch2", BlockHeight: 3}
+	recorder.Record(call1)
+	recorder.Record(call2)
+	assert.Equal(t, []*pb.ChaincodeCall{call1, call2}, recorder.Calls())
+}
+
+func TestReadOnlyResults(t *testing.T) {
+	reads, discardedWrites, err := readOnlyResults(nil)
+	assert.NoError(t, err)
+	assert.False(t, discardedWrites)
+	assert.Nil(t, reads)
+
+	// reads and writes on two namespaces, writes only on a third one
+	builder := rwsetutil.NewRWSetBuilder()
+	builder.AddToReadSet("ns1", "key1", version.NewHeight(1, 1))
+	builder.AddToWriteSet("ns1", "key1", []byte("value"))
+	builder.AddToReadSet("ns2", "key2", nil)
+	builder.AddToWriteSet("ns3", "key3", []byte("value"))
+	simRes, err := builder.GetTxSimulationResults()
+	assert.NoError(t, err)
+
+	reads, discardedWrites, err = readOnlyResults(simRes.PubSimulationResults)
+	assert.NoError(t, err)
+	assert.True(t, discardedWrites)
+
+	txRWSet := &rwsetutil.TxRwSet{}
+	assert.NoError(t, txRWSet.FromProtoBytes(reads))
+	assert.Len(t, txRWSet.NsRwSets, 2)
+	assert.Equal(t, "ns1", txRWSet.NsRwSets[0].NameSpace)
+	assert.Empty(t, txRWSet.NsRwSets[0].KvRwSet.Writes)
+	assert.True(t, proto.Equal(rwsetutil.NewKVRead("key1", version.NewHeight(1, 1)), txRWSet.NsRwSets[0].KvRwSet.Reads[0]))
+	assert.Equal(t, "ns2", txRWSet.NsRwSets[1].NameSpace)
+	assert.Equal(t, "key2", txRWSet.NsRwSets[1].KvRwSet.Reads[0].Key)
+
+	// only writes
+	builder = rwsetutil.NewRWSetBuilder()
+	builder.AddToWriteSet("ns1", "key1", []byte("value"))
+	simRes, err = builder.GetTxSimulationResults()
+	assert.NoError(t, err)
+	reads, discardedWrites, err = readOnlyResults(simRes.PubSimulationResults)
+	assert.NoError(t, err)
+	assert.True(t, discardedWrites)
+	assert.Nil(t, reads)
+
+	// bogus read-write set
+	_, _, err = readOnlyResults(&rwset.TxReadWriteSet{NsRwset: []*rwset.NsReadWriteSet{{Namespace: "ns", Rwset: []byte("barf")}}})
+	assert.Error(t, err)
+}
+
@@ -32,11 +32,16 @@ import (
 	"github.com/hyperledger/fabric/core/chaincode/platforms"
 	"github.com/hyperledger/fabric/core/chaincode/shim"
 	"github.com/hyperledger/fabric/core/common/ccprovider"
+	"github.com/hyperledger/fabric/core/common/privdata"
 	"github.com/hyperledger/fabric/core/config"
 	"github.com/hyperledger/fabric/core/container"
 	"github.com/hyperledger/fabric/core/container/api"
 	"github.com/hyperledger/fabric/core/container/ccintf"
 	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/peer"
+	"github.com/hyperledger/fabric/msp"
+	mspmgmt "github.com/hyperledger/fabric/msp/mgmt"
+	"github.com/hyperledger/fabric/protos/common"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	logging "github.com/op/go-logging"
 	"github.com/pkg/errors"
@@ -58,6 +63,9 @@ const (
 	//HistoryQueryExecutorKey is used to attach ledger history query executor context
 	HistoryQueryExecutorKey key = "historyqueryexecutorkey"
 
+	//ChaincodeCallRecorderKey is used to attach a recorder of chaincode-to-chaincode invocations
+	ChaincodeCallRecorderKey key = "chaincodecallrecorderkey"
+
 	// Mutual TLS auth client key and cert paths in the chaincode container
 	TLSClientKeyPath      string = "/etc/hyperledger/fabric/client.key"
 	TLSClientCertPath     string = "/etc/hyperledger/fabric/client.crt"
@@ -107,6 +115,26 @@ type runningChaincodes struct {
 	launchStarted map[string]bool
 }
 
+// chaincodeExits holds the reasons reported by the container runtime for
+// chaincode containers exiting, keyed by canonical chaincode name
+type chaincodeExits struct {
+	sync.Mutex
+	reasons map[string]chan string
+}
+
+//call this under lock
+func (exits *chaincodeExits) get(chaincode string) chan string {
+	if exits.reasons == nil {
+		exits.reasons = make(map[string]chan string)
+	}
+	reason, ok := exits.reasons[chaincode]
+	if !ok {
+		reason = make(chan string, 1)
+		exits.reasons[chaincode] = reason
+	}
+	return reason
+}
+
 //GetChain returns the chaincode framework support object
 func GetChain() *ChaincodeSupport {
 	return theChaincodeSupport
@@ -115,6 +143,10 @@ func GetChain() *ChaincodeSupport {
 func (chaincodeSupport *ChaincodeSupport) preLaunchSetup(chaincode string, notfy chan bool) {
 	chaincodeSupport.runningChaincodes.Lock()
 	defer chaincodeSupport.runningChaincodes.Unlock()
+	//forget why a previous instance of this chaincode exited
+	chaincodeSupport.chaincodeExits.Lock()
+	delete(chaincodeSupport.chaincodeExits.reasons, chaincode)
+	chaincodeSupport.chaincodeExits.Unlock()
 	//register placeholder Handler. This will be transferred in registerHandler
 	//NOTE: from this point, existence of handler for this chaincode means the chaincode
 	//is in the process of getting started (or has been started)
@@ -147,6 +179,7 @@ func NewChaincodeSupport(ccEndpoint string, userrunsCC bool, ccstartuptimeout ti
 			chaincodeMap:  make(map[string]*chaincodeRTEnv),
 			launchStarted: make(map[string]bool),
 		}, peerNetworkID: pnid, peerID: pid,
+		collectionStore: privdata.NewSimpleCollectionStore(&collectionSupport{}),
 	}
 
 	theChaincodeSupport.auth = accesscontrol.NewAuthenticator(theChaincodeSupport, ca)
@@ -186,6 +219,7 @@ func NewChaincodeSupport(ccEndpoint string, userrunsCC bool, ccstartuptimeout ti
 	}
 
 	theChaincodeSupport.executetimeout = execto
+	theChaincodeSupport.executetimeouts = getExecuteTimeoutOverrides()
 
 	viper.SetEnvPrefix("CORE")
 	viper.AutomaticEnv()
@@ -199,6 +233,27 @@ func NewChaincodeSupport(ccEndpoint string, userrunsCC bool, ccstartuptimeout ti
 	return theChaincodeSupport.auth
 }
 
+// getExecuteTimeoutOverrides reads the per-chaincode execute timeouts from
+// chaincode.overrides. Viper lowercases the keys it reads from the config
+// file, so the timeouts are keyed by the lowercased chaincode names
+func getExecuteTimeoutOverrides() map[string]time.Duration {
+	timeouts := make(map[string]time.Duration)
+	for name := range viper.GetStringMap("chaincode.overrides") {
+		name = strings.ToLower(name)
+		key := "chaincode.overrides." + name + ".executetimeout"
+		if !viper.IsSet(key) {
+			continue
+		}
+		if eto := viper.GetDuration(key); eto <= time.Duration(1)*time.Second {
+			chaincodeLogger.Errorf("Invalid execute timeout value %s for chaincode %s (should be at least 1s); using the default", eto, name)
+		} else {
+			chaincodeLogger.Debugf("Setting execute timeout value for chaincode %s to %s", name, eto)
+			timeouts[name] = eto
+		}
+	}
+	return timeouts
+}
+
 // getLogLevelFromViper gets the chaincode container log levels from viper
 func getLogLevelFromViper(module string) string {
 	levelString := viper.GetString("chaincode.logging." + module)
@@ -227,8 +282,51 @@ type ChaincodeSupport struct {
 	shimLogLevel      string
 	logFormat         string
 	executetimeout    time.Duration
+	executetimeouts   map[string]time.Duration
+	chaincodeExits    chaincodeExits
 	userRunsCC        bool
 	peerTLS           bool
+	collectionStore   privdata.CollectionStore
+}
+
+// GetExecuteTimeout returns the execute timeout for the named chaincode,
+// which is its chaincode.overrides entry if it has one and the
+// chaincode.executetimeout otherwise
+func (chaincodeSupport *ChaincodeSupport) GetExecuteTimeout(ccName string) time.Duration {
+	if eto, ok := chaincodeSupport.executetimeouts[strings.ToLower(ccName)]; ok {
+		return eto
+	}
+	return chaincodeSupport.executetimeout
+}
+
+// HandleChaincodeExit records why the container of a chaincode exited, so
+// that transactions still pending on it can be failed with that reason
+func (chaincodeSupport *ChaincodeSupport) HandleChaincodeExit(ccid ccintf.CCID, reason string) {
+	canName := ccid.ChaincodeSpec.ChaincodeId.Name + ":" + ccid.Version
+	chaincodeLogger.Warningf("chaincode %s %s", canName, reason)
+
+	chaincodeSupport.chaincodeExits.Lock()
+	defer chaincodeSupport.chaincodeExits.Unlock()
+	select {
+	case chaincodeSupport.chaincodeExits.get(canName) <- reason:
+	default:
+		//an earlier reason has not been consumed yet, keep it
+	}
+}
+
+// waitForChaincodeExit waits up to the timeout for the container runtime to
+// report why the chaincode exited and returns the reason, if any
+func (chaincodeSupport *ChaincodeSupport) waitForChaincodeExit(canName string, timeout time.Duration) string {
+	chaincodeSupport.chaincodeExits.Lock()
+	reason := chaincodeSupport.chaincodeExits.get(canName)
+	chaincodeSupport.chaincodeExits.Unlock()
+
+	select {
+	case r := <-reason:
+		return r
+	case <-time.After(timeout):
+		return ""
+	}
 }
 
 // DuplicateChaincodeHandlerError returned if attempt to register same chaincodeID while a stream already exists.
@@ -810,7 +908,7 @@ func (chaincodeSupport *ChaincodeSupport) Execute(ctxt context.Context, cccid *c
 		//response is sent to user or calling chaincode. ChaincodeMessage_ERROR
 		//are typically treated as error
 	case <-time.After(timeout):
-		err = errors.New("timeout expired while executing transaction")
+		err = errors.Errorf("timeout expired while executing transaction: chaincode %s exceeded its execute timeout of %s", canName, timeout)
 	}
 
 	//our responsibility to delete transaction context if sendExecuteMessage succeeded
@@ -825,3 +923,24 @@ func IsDevMode() bool {
 
 	return mode == DevModeUserRunsChaincode
 }
+
+// collectionSupport gives the collection store access to the
+// collection configurations of the chaincodes on the peer's channels
+type collectionSupport struct {
+}
+
+func (*collectionSupport) GetQueryExecutorForLedger(cid string) (ledger.QueryExecutor, error) {
+	lgr := peer.GetLedger(cid)
+	if lgr == nil {
+		return nil, errors.Errorf("ledger for channel %s not found", cid)
+	}
+	return lgr.NewQueryExecutor()
+}
+
+func (*collectionSupport) GetCollectionKVSKey(cc common.CollectionCriteria) string {
+	return privdata.BuildCollectionKVSKey(cc.Namespace)
+}
+
+func (*collectionSupport) GetIdentityDeserializer(chainID string) msp.IdentityDeserializer {
+	return mspmgmt.GetManagerForChain(chainID)
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/hyperledger/fabric/core/common/ccprovider"
 	"github.com/hyperledger/fabric/core/config"
 	"github.com/hyperledger/fabric/core/container"
+	"github.com/hyperledger/fabric/core/container/ccintf"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/ledgermgmt"
 	cmp "github.com/hyperledger/fabric/core/mocks/peer"
@@ -44,6 +45,8 @@ import (
 	plgr "github.com/hyperledger/fabric/protos/ledger/queryresult"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	putils "github.com/hyperledger/fabric/protos/utils"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
 )
 
@@ -478,6 +481,34 @@ func invokeCC(t *testing.T, chainID, ccname string, ccSide *mockpeer.MockCCComm)
 	return nil
 }
 
+func invokeMultipleKeysCC(t *testing.T, chainID, ccname string, ccSide *mockpeer.MockCCComm) error {
+	done := setuperror()
+
+	errorFunc := func(ind int, err error) {
+		done <- err
+	}
+
+	chaincodeID := &pb.ChaincodeID{Name: ccname, Version: "0"}
+	ci := &pb.ChaincodeInput{Args: [][]byte{[]byte("invoke"), []byte("A"), []byte("B"), []byte("10")}, Decorations: nil}
+	cis := &pb.ChaincodeInvocationSpec{ChaincodeSpec: &pb.ChaincodeSpec{Type: pb.ChaincodeSpec_Type(pb.ChaincodeSpec_Type_value["GOLANG"]), ChaincodeId: chaincodeID, Input: ci}}
+	txid := util.GenerateUUID()
+	ctxt, txsim, sprop, prop := startTx(t, chainID, cis, txid)
+
+	respSet := &mockpeer.MockResponseSet{errorFunc, nil, []*mockpeer.MockResponse{
+		{&pb.ChaincodeMessage{Type: pb.ChaincodeMessage_TRANSACTION}, &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_GET_STATE_MULTIPLE, Payload: putils.MarshalOrPanic(&pb.GetStateMultiple{Keys: []string{"A", "B"}}), Txid: txid, ChannelId: chainID}},
+		{&pb.ChaincodeMessage{Type: pb.ChaincodeMessage_RESPONSE}, &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_PUT_STATE_MULTIPLE, Payload: putils.MarshalOrPanic(&pb.PutStateMultiple{Kvs: map[string][]byte{"A": []byte("80"), "B": []byte("220")}}), Txid: txid, ChannelId: chainID}},
+		{&pb.ChaincodeMessage{Type: pb.ChaincodeMessage_RESPONSE}, &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_PUT_STATE_MULTIPLE, Payload: putils.MarshalOrPanic(&pb.PutStateMultiple{Collection: "c1", Kvs: map[string][]byte{"A": []byte("80")}}), Txid: txid, ChannelId: chainID}},
+		{&pb.ChaincodeMessage{Type: pb.ChaincodeMessage_RESPONSE}, &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_GET_STATE_MULTIPLE, Payload: putils.MarshalOrPanic(&pb.GetStateMultiple{Collection: "c1", Keys: []string{"A"}}), Txid: txid, ChannelId: chainID}},
+		{&pb.ChaincodeMessage{Type: pb.ChaincodeMessage_ERROR}, &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_COMPLETED, Payload: putils.MarshalOrPanic(&pb.Response{Status: shim.ERROR, Message: "private data read after write"}), Txid: txid, ChannelId: chainID}}}}
+
+	cccid := ccprovider.NewCCContext(chainID, ccname, "0", txid, false, sprop, prop)
+	execCC(t, ctxt, ccSide, cccid, false, true, done, cis, respSet)
+
+	endTx(t, cccid, txsim, cis)
+
+	return nil
+}
+
 func invokePrivateDataGetPutDelCC(t *testing.T, chainID, ccname string, ccSide *mockpeer.MockCCComm) error {
 	done := setuperror()
 
@@ -928,6 +959,88 @@ func TestLaunchAndWaitLaunchError(t *testing.T) {
 	}
 }
 
+func TestGetExecuteTimeout(t *testing.T) {
+	overrides := map[string]interface{}{
+		"sleeper": map[string]interface{}{"executetimeout": "90s"},
+		"typo":    map[string]interface{}{"executetimeout": "1ms"},
+		"limited": map[string]interface{}{},
+	}
+	viper.Set("chaincode.overrides", overrides)
+	viper.Set("chaincode.overrides.sleeper.executetimeout", "90s")
+	viper.Set("chaincode.overrides.typo.executetimeout", "1ms")
+	defer func() {
+		viper.Set("chaincode.overrides", nil)
+		viper.Set("chaincode.overrides.sleeper.executetimeout", nil)
+		viper.Set("chaincode.overrides.typo.executetimeout", nil)
+	}()
+
+	newCCSupport := &ChaincodeSupport{executetimeout: 30 * time.Second, executetimeouts: getExecuteTimeoutOverrides()}
+	assert.Equal(t, 90*time.Second, newCCSupport.GetExecuteTimeout("sleeper"))
+	// invalid and missing overrides fall back to chaincode.executetimeout
+	assert.Equal(t, 30*time.Second, newCCSupport.GetExecuteTimeout("typo"))
+	assert.Equal(t, 30*time.Second, newCCSupport.GetExecuteTimeout("limited"))
+	assert.Equal(t, 30*time.Second, newCCSupport.GetExecuteTimeout("mycc"))
+}
+
+func TestGetExecuteTimeoutMixedCase(t *testing.T) {
+	// viper lowercases the keys it reads from core.yaml, hence an override
+	// of the MixedCaseCC chaincode is read as an override of mixedcasecc
+	overrides := map[string]interface{}{
+		"mixedcasecc": map[string]interface{}{"executetimeout": "90s"},
+	}
+	viper.Set("chaincode.overrides", overrides)
+	viper.Set("chaincode.overrides.mixedcasecc.executetimeout", "90s")
+	defer func() {
+		viper.Set("chaincode.overrides", nil)
+		viper.Set("chaincode.overrides.mixedcasecc.executetimeout", nil)
+	}()
+
+	// chaincode names are matched regardless of their case
+	newCCSupport := &ChaincodeSupport{executetimeout: 30 * time.Second, executetimeouts: getExecuteTimeoutOverrides()}
+	assert.Equal(t, 90*time.Second, newCCSupport.GetExecuteTimeout("MixedCaseCC"))
+	assert.Equal(t, 90*time.Second, newCCSupport.GetExecuteTimeout("mixedcasecc"))
+	assert.Equal(t, 30*time.Second, newCCSupport.GetExecuteTimeout("othercc"))
+}
+
+func TestFailPendingTransactions(t *testing.T) {
+	newCCSupport := &ChaincodeSupport{runningChaincodes: &runningChaincodes{chaincodeMap: make(map[string]*chaincodeRTEnv), launchStarted: make(map[string]bool)}}
+	newHandler := func() (*Handler, chan *pb.ChaincodeMessage) {
+		newCCSupport.preLaunchSetup("testcc:0", make(chan bool, 1))
+		notfy := make(chan *pb.ChaincodeMessage, 1)
+		h := &Handler{
+			ChaincodeID:      &pb.ChaincodeID{Name: "testcc:0"},
+			chaincodeSupport: newCCSupport,
+			registered:       true,
+			txCtxs:           map[string]*transactionContext{"testchanneltx1": {chainID: "testchannel", responseNotifier: notfy}},
+		}
+		return h, notfy
+	}
+
+	// the container runtime reports why the chaincode exited
+	h, notfy := newHandler()
+	ccid := ccintf.CCID{ChaincodeSpec: &pb.ChaincodeSpec{ChaincodeId: &pb.ChaincodeID{Name: "testcc"}}, Version: "0"}
+	newCCSupport.HandleChaincodeExit(ccid, "was killed for exceeding its memory limit of 1024 bytes")
+	h.deregister()
+	msg := <-notfy
+	assert.Equal(t, pb.ChaincodeMessage_ERROR, msg.Type)
+	assert.Equal(t, "testchannel", msg.ChannelId)
+	assert.Equal(t, "chaincode testcc:0 terminated while executing transaction: container was killed for exceeding its memory limit of 1024 bytes", string(msg.Payload))
+
+	// chaincodes run by the user have no container to report on
+	newCCSupport.userRunsCC = true
+	h, notfy = newHandler()
+	h.deregister()
+	msg = <-notfy
+	assert.Equal(t, "chaincode testcc:0 terminated while executing transaction", string(msg.Payload))
+
+	// a response that is already there is not replaced
+	h, notfy = newHandler()
+	notfy <- &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_COMPLETED}
+	h.deregister()
+	msg = <-notfy
+	assert.Equal(t, pb.ChaincodeMessage_COMPLETED, msg.Type)
+}
+
 func TestGetTxContextFromHandler(t *testing.T) {
 	h := Handler{txCtxs: map[string]*transactionContext{}}
 
@@ -1148,6 +1261,9 @@ func TestCCFramework(t *testing.T) {
 	//call's invoke and do some GET
 	invokeCC(t, chainID, ccname, ccSide)
 
+	//call's invoke and do some multiple keys GET/PUT
+	invokeMultipleKeysCC(t, chainID, ccname, ccSide)
+
 	//call's invoke and do some GET/PUT/DEL on private data
 	invokePrivateDataGetPutDelCC(t, chainID, ccname, ccSide)
 
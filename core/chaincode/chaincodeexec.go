@@ -75,19 +75,19 @@ func GetChaincodeDefinition(ctxt context.Context, txid string, signedProp *pb.Si
 }
 
 // ExecuteChaincode executes a given chaincode given chaincode name and arguments
-func ExecuteChaincode(ctxt context.Context, cccid *ccprovider.CCContext, args [][]byte) (*pb.Response, *pb.ChaincodeEvent, error) {
+func ExecuteChaincode(ctxt context.Context, cccid *ccprovider.CCContext, args [][]byte) (*pb.Response, []*pb.ChaincodeEvent, error) {
 	var spec *pb.ChaincodeInvocationSpec
 	var err error
 	var res *pb.Response
-	var ccevent *pb.ChaincodeEvent
+	var ccevents []*pb.ChaincodeEvent
 
 	spec, err = createCIS(cccid.Name, args)
-	res, ccevent, err = Execute(ctxt, cccid, spec)
+	res, ccevents, err = Execute(ctxt, cccid, spec)
 	if err != nil {
 		err = errors.WithMessage(err, "error executing chaincode")
 		chaincodeLogger.Errorf("%+v", err)
 		return nil, nil, err
 	}
 
-	return res, ccevent, err
+	return res, ccevents, err
 }
@@ -28,7 +28,7 @@ import (
 )
 
 //Execute - execute proposal, return original response of chaincode
-func Execute(ctxt context.Context, cccid *ccprovider.CCContext, spec interface{}) (*pb.Response, *pb.ChaincodeEvent, error) {
+func Execute(ctxt context.Context, cccid *ccprovider.CCContext, spec interface{}) (*pb.Response, []*pb.ChaincodeEvent, error) {
 	var err error
 	var cds *pb.ChaincodeDeploymentSpec
 	var ci *pb.ChaincodeInvocationSpec
@@ -55,7 +55,7 @@ func Execute(ctxt context.Context, cccid *ccprovider.CCContext, spec interface{}
 		return nil, nil, errors.WithMessage(err, "failed to create chaincode message")
 	}
 
-	resp, err := theChaincodeSupport.Execute(ctxt, cccid, ccMsg, theChaincodeSupport.executetimeout)
+	resp, err := theChaincodeSupport.Execute(ctxt, cccid, ccMsg, theChaincodeSupport.GetExecuteTimeout(cccid.Name))
 	if err != nil {
 		// Rollback transaction
 		return nil, nil, errors.WithMessage(err, "failed to execute transaction")
@@ -64,9 +64,10 @@ func Execute(ctxt context.Context, cccid *ccprovider.CCContext, spec interface{}
 		return nil, nil, errors.Errorf("failed to receive a response for txid (%s)", cccid.TxID)
 	}
 
-	if resp.ChaincodeEvent != nil {
-		resp.ChaincodeEvent.ChaincodeId = cccid.Name
-		resp.ChaincodeEvent.TxId = cccid.TxID
+	ccevents := getChaincodeEvents(resp)
+	for _, ccevent := range ccevents {
+		ccevent.ChaincodeId = cccid.Name
+		ccevent.TxId = cccid.TxID
 	}
 
 	if resp.Type == pb.ChaincodeMessage_COMPLETED {
@@ -77,10 +78,10 @@ func Execute(ctxt context.Context, cccid *ccprovider.CCContext, spec interface{}
 		}
 
 		// Success
-		return res, resp.ChaincodeEvent, nil
+		return res, ccevents, nil
 	} else if resp.Type == pb.ChaincodeMessage_ERROR {
 		// Rollback transaction
-		return nil, resp.ChaincodeEvent, errors.Errorf("transaction returned with failure: %s", string(resp.Payload))
+		return nil, ccevents, errors.Errorf("transaction returned with failure: %s", string(resp.Payload))
 	}
 
 	//TODO - this should never happen ... a panic is more appropriate but will save that for future
@@ -89,8 +90,8 @@ func Execute(ctxt context.Context, cccid *ccprovider.CCContext, spec interface{}
 
 // ExecuteWithErrorFilter is similar to Execute, but filters error contained in chaincode response and returns Payload of response only.
 // Mostly used by unit-test.
-func ExecuteWithErrorFilter(ctxt context.Context, cccid *ccprovider.CCContext, spec interface{}) ([]byte, *pb.ChaincodeEvent, error) {
-	res, event, err := Execute(ctxt, cccid, spec)
+func ExecuteWithErrorFilter(ctxt context.Context, cccid *ccprovider.CCContext, spec interface{}) ([]byte, []*pb.ChaincodeEvent, error) {
+	res, events, err := Execute(ctxt, cccid, spec)
 	if err != nil {
 		chaincodeLogger.Errorf("ExecuteWithErrorFilter %s error: %+v", cccid.Name, err)
 		return nil, nil, err
@@ -105,5 +106,18 @@ func ExecuteWithErrorFilter(ctxt context.Context, cccid *ccprovider.CCContext, s
 		return nil, nil, errors.New(res.Message)
 	}
 
-	return res.Payload, event, nil
+	return res.Payload, events, nil
+}
+
+// getChaincodeEvents returns the events set by a chaincode in its response
+// message; chaincodes built with a shim that does not support multiple
+// events only set the single ChaincodeEvent of the message
+func getChaincodeEvents(msg *pb.ChaincodeMessage) []*pb.ChaincodeEvent {
+	if len(msg.ChaincodeEvents) > 0 {
+		return msg.ChaincodeEvents
+	}
+	if msg.ChaincodeEvent != nil {
+		return []*pb.ChaincodeEvent{msg.ChaincodeEvent}
+	}
+	return nil
 }
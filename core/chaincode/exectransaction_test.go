@@ -49,6 +49,7 @@ import (
 	pb "github.com/hyperledger/fabric/protos/peer"
 	putils "github.com/hyperledger/fabric/protos/utils"
 	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -383,12 +384,12 @@ func deploy2(ctx context.Context, cccid *ccprovider.CCContext, chaincodeDeployme
 }
 
 // Invoke a chaincode.
-func invoke(ctx context.Context, chainID string, spec *pb.ChaincodeSpec, blockNumber uint64, creator []byte) (ccevt *pb.ChaincodeEvent, uuid string, retval []byte, err error) {
+func invoke(ctx context.Context, chainID string, spec *pb.ChaincodeSpec, blockNumber uint64, creator []byte) (ccevts []*pb.ChaincodeEvent, uuid string, retval []byte, err error) {
 	return invokeWithVersion(ctx, chainID, spec.GetChaincodeId().Version, spec, blockNumber, creator)
 }
 
 // Invoke a chaincode with version (needed for upgrade)
-func invokeWithVersion(ctx context.Context, chainID string, version string, spec *pb.ChaincodeSpec, blockNumber uint64, creator []byte) (ccevt *pb.ChaincodeEvent, uuid string, retval []byte, err error) {
+func invokeWithVersion(ctx context.Context, chainID string, version string, spec *pb.ChaincodeSpec, blockNumber uint64, creator []byte) (ccevts []*pb.ChaincodeEvent, uuid string, retval []byte, err error) {
 	cdInvocationSpec := &pb.ChaincodeInvocationSpec{ChaincodeSpec: spec}
 
 	// Now create the Transactions message and send to Peer.
@@ -416,12 +417,12 @@ func invokeWithVersion(ctx context.Context, chainID string, version string, spec
 	}
 	sprop, prop := putils.MockSignedEndorserProposalOrPanic(chainID, spec, creator, []byte("msg1"))
 	cccid := ccprovider.NewCCContext(chainID, cdInvocationSpec.ChaincodeSpec.ChaincodeId.Name, version, uuid, false, sprop, prop)
-	retval, ccevt, err = ExecuteWithErrorFilter(ctx, cccid, cdInvocationSpec)
+	retval, ccevts, err = ExecuteWithErrorFilter(ctx, cccid, cdInvocationSpec)
 	if err != nil {
 		return nil, uuid, nil, fmt.Errorf("Error invoking chaincode: %s", err)
 	}
 
-	return ccevt, uuid, retval, err
+	return ccevts, uuid, retval, err
 }
 
 func closeListenerAndSleep(l net.Listener) {
@@ -1473,8 +1474,8 @@ func TestGetEvent(t *testing.T) {
 
 			spec = &pb.ChaincodeSpec{Type: 1, ChaincodeId: cID, Input: &pb.ChaincodeInput{Args: args}}
 
-			var ccevt *pb.ChaincodeEvent
-			ccevt, _, _, err = invoke(ctxt, chainID, spec, nextBlockNumber, nil)
+			var ccevts []*pb.ChaincodeEvent
+			ccevts, _, _, err = invoke(ctxt, chainID, spec, nextBlockNumber, nil)
 			nextBlockNumber++
 
 			if err != nil {
@@ -1482,10 +1483,10 @@ func TestGetEvent(t *testing.T) {
 				t.Fail()
 			}
 
-			if ccevt == nil {
-				t.Logf("Error ccevt is nil %s(%s)", ccID, err)
-				t.Fail()
+			if len(ccevts) != 1 {
+				t.Fatalf("Error expected one event, got %d %s(%s)", len(ccevts), ccID, err)
 			}
+			ccevt := ccevts[0]
 
 			if ccevt.ChaincodeId != ccID {
 				t.Logf("Error ccevt id(%s) != cid(%s)", ccevt.ChaincodeId, ccID)
@@ -1669,9 +1670,9 @@ func TestChaincodeInvokesForbiddenSystemChaincode(t *testing.T) {
 
 	time.Sleep(time.Second)
 
-	// send an invoke to pass thru to invoke "escc" system chaincode
+	// send an invoke to pass thru to invoke "vscc" system chaincode
 	// this should fail
-	args = util.ToChaincodeArgs("escc/"+chainID, "getid", chainID, "pthru")
+	args = util.ToChaincodeArgs("vscc/"+chainID, "getid", chainID, "pthru")
 
 	spec = &pb.ChaincodeSpec{Type: 1, ChaincodeId: cID, Input: &pb.ChaincodeInput{Args: args}}
 	// Invoke chaincode
@@ -1909,3 +1910,16 @@ func (f *mockPolicyCheckerFactory) NewPolicyChecker() policy.PolicyChecker {
 		&mocks.MockMSPPrincipalGetter{Principal: []byte("Admin")},
 	)
 }
+
+func TestGetChaincodeEvents(t *testing.T) {
+	// no events
+	assert.Empty(t, getChaincodeEvents(&pb.ChaincodeMessage{}))
+
+	// a shim that only sets a single event
+	event := &pb.ChaincodeEvent{EventName: "event"}
+	assert.Equal(t, []*pb.ChaincodeEvent{event}, getChaincodeEvents(&pb.ChaincodeMessage{ChaincodeEvent: event}))
+
+	// a shim that sets all the events
+	events := []*pb.ChaincodeEvent{{EventName: "first"}, {EventName: "second"}}
+	assert.Equal(t, events, getChaincodeEvents(&pb.ChaincodeMessage{ChaincodeEvent: events[1], ChaincodeEvents: events}))
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/hyperledger/fabric/core/container/ccintf"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/peer"
+	"github.com/hyperledger/fabric/protos/common"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/looplab/fsm"
 	"github.com/pkg/errors"
@@ -38,6 +39,10 @@ const (
 
 )
 
+// exitReasonTimeout bounds how long transactions pending on a terminated
+// chaincode wait for the container runtime to report why it exited
+const exitReasonTimeout = 2 * time.Second
+
 var chaincodeLogger = flogging.MustGetLogger("chaincode")
 
 type transactionContext struct {
@@ -52,6 +57,9 @@ type transactionContext struct {
 
 	txsimulator          ledger.TxSimulator
 	historyQueryExecutor ledger.HistoryQueryExecutor
+
+	// records chaincode-to-chaincode invocations, if requested
+	callRecorder *ChaincodeCallRecorder
 }
 
 type pendingQueryResult struct {
@@ -194,6 +202,7 @@ func (handler *Handler) createTxContext(ctxt context.Context, chainID string, tx
 	handler.txCtxs[txCtxID] = txctx
 	txctx.txsimulator = getTxSimulator(ctxt)
 	txctx.historyQueryExecutor = getHistoryQueryExecutor(ctxt)
+	txctx.callRecorder = getChaincodeCallRecorder(ctxt)
 
 	return txctx, nil
 }
@@ -267,10 +276,45 @@ func (handler *Handler) checkACL(signedProp *pb.SignedProposal, proposal *pb.Pro
 func (handler *Handler) deregister() error {
 	if handler.registered {
 		handler.chaincodeSupport.deregisterHandler(handler)
+		handler.failPendingTransactions()
 	}
 	return nil
 }
 
+// failPendingTransactions sends an error to every transaction still waiting
+// on the chaincode once its stream is gone, instead of leaving them to time
+// out. When the chaincode runs in a container, the error carries the reason
+// the container exited (such as exceeding its memory limit) if the container
+// runtime reports it in time.
+func (handler *Handler) failPendingTransactions() {
+	handler.Lock()
+	pending := len(handler.txCtxs)
+	handler.Unlock()
+	if pending == 0 {
+		return
+	}
+
+	canName := handler.ChaincodeID.Name
+	errMsg := fmt.Sprintf("chaincode %s terminated while executing transaction", canName)
+	if !handler.chaincodeSupport.userRunsCC {
+		if reason := handler.chaincodeSupport.waitForChaincodeExit(canName, exitReasonTimeout); reason != "" {
+			errMsg = fmt.Sprintf("%s: container %s", errMsg, reason)
+		}
+	}
+	chaincodeLogger.Errorf("%s, failing %d pending transaction(s)", errMsg, pending)
+
+	handler.Lock()
+	defer handler.Unlock()
+	for _, tctx := range handler.txCtxs {
+		msg := &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_ERROR, Payload: []byte(errMsg), ChannelId: tctx.chainID}
+		select {
+		case tctx.responseNotifier <- msg:
+		default:
+			//a response has already been delivered
+		}
+	}
+}
+
 func (handler *Handler) triggerNextState(msg *pb.ChaincodeMessage, send bool) {
 	//this will send Async
 	handler.nextState <- &nextStateInfo{msg: msg, sendToCC: send, sendSync: false}
@@ -420,9 +464,11 @@ func newChaincodeSupportHandler(chaincodeSupport *ChaincodeSupport, peerChatStre
 			{Name: pb.ChaincodeMessage_READY.String(), Src: []string{establishedstate}, Dst: readystate},
 			{Name: pb.ChaincodeMessage_PUT_STATE.String(), Src: []string{readystate}, Dst: readystate},
 			{Name: pb.ChaincodeMessage_DEL_STATE.String(), Src: []string{readystate}, Dst: readystate},
+			{Name: pb.ChaincodeMessage_PUT_STATE_MULTIPLE.String(), Src: []string{readystate}, Dst: readystate},
 			{Name: pb.ChaincodeMessage_INVOKE_CHAINCODE.String(), Src: []string{readystate}, Dst: readystate},
 			{Name: pb.ChaincodeMessage_COMPLETED.String(), Src: []string{readystate}, Dst: readystate},
 			{Name: pb.ChaincodeMessage_GET_STATE.String(), Src: []string{readystate}, Dst: readystate},
+			{Name: pb.ChaincodeMessage_GET_STATE_MULTIPLE.String(), Src: []string{readystate}, Dst: readystate},
 			{Name: pb.ChaincodeMessage_GET_STATE_BY_RANGE.String(), Src: []string{readystate}, Dst: readystate},
 			{Name: pb.ChaincodeMessage_GET_QUERY_RESULT.String(), Src: []string{readystate}, Dst: readystate},
 			{Name: pb.ChaincodeMessage_GET_HISTORY_FOR_KEY.String(), Src: []string{readystate}, Dst: readystate},
@@ -437,6 +483,7 @@ func newChaincodeSupportHandler(chaincodeSupport *ChaincodeSupport, peerChatStre
 			"before_" + pb.ChaincodeMessage_REGISTER.String():           func(e *fsm.Event) { v.beforeRegisterEvent(e, v.FSM.Current()) },
 			"before_" + pb.ChaincodeMessage_COMPLETED.String():          func(e *fsm.Event) { v.beforeCompletedEvent(e, v.FSM.Current()) },
 			"after_" + pb.ChaincodeMessage_GET_STATE.String():           func(e *fsm.Event) { v.afterGetState(e, v.FSM.Current()) },
+			"after_" + pb.ChaincodeMessage_GET_STATE_MULTIPLE.String():  func(e *fsm.Event) { v.afterGetStateMultiple(e, v.FSM.Current()) },
 			"after_" + pb.ChaincodeMessage_GET_STATE_BY_RANGE.String():  func(e *fsm.Event) { v.afterGetStateByRange(e, v.FSM.Current()) },
 			"after_" + pb.ChaincodeMessage_GET_QUERY_RESULT.String():    func(e *fsm.Event) { v.afterGetQueryResult(e, v.FSM.Current()) },
 			"after_" + pb.ChaincodeMessage_GET_HISTORY_FOR_KEY.String(): func(e *fsm.Event) { v.afterGetHistoryForKey(e, v.FSM.Current()) },
@@ -444,6 +491,7 @@ func newChaincodeSupportHandler(chaincodeSupport *ChaincodeSupport, peerChatStre
 			"after_" + pb.ChaincodeMessage_QUERY_STATE_CLOSE.String():   func(e *fsm.Event) { v.afterQueryStateClose(e, v.FSM.Current()) },
 			"after_" + pb.ChaincodeMessage_PUT_STATE.String():           func(e *fsm.Event) { v.enterBusyState(e, v.FSM.Current()) },
 			"after_" + pb.ChaincodeMessage_DEL_STATE.String():           func(e *fsm.Event) { v.enterBusyState(e, v.FSM.Current()) },
+			"after_" + pb.ChaincodeMessage_PUT_STATE_MULTIPLE.String():  func(e *fsm.Event) { v.enterBusyState(e, v.FSM.Current()) },
 			"after_" + pb.ChaincodeMessage_INVOKE_CHAINCODE.String():    func(e *fsm.Event) { v.enterBusyState(e, v.FSM.Current()) },
 			"enter_" + establishedstate:                                 func(e *fsm.Event) { v.enterEstablishedState(e, v.FSM.Current()) },
 			"enter_" + readystate:                                       func(e *fsm.Event) { v.enterReadyState(e, v.FSM.Current()) },
@@ -640,7 +688,9 @@ func (handler *Handler) handleGetState(msg *pb.ChaincodeMessage) {
 		var res []byte
 		var err error
 		if isCollectionSet(getState.Collection) {
-			res, err = txContext.txsimulator.GetPrivateData(chaincodeID, getState.Collection, getState.Key)
+			if err = handler.checkCollectionAccess(txContext, chaincodeID, getState.Collection, false); err == nil {
+				res, err = txContext.txsimulator.GetPrivateData(chaincodeID, getState.Collection, getState.Key)
+			}
 		} else {
 			res, err = txContext.txsimulator.GetState(chaincodeID, getState.Key)
 		}
@@ -665,6 +715,87 @@ func (handler *Handler) handleGetState(msg *pb.ChaincodeMessage) {
 	}()
 }
 
+// afterGetStateMultiple handles a GET_STATE_MULTIPLE request from the chaincode.
+func (handler *Handler) afterGetStateMultiple(e *fsm.Event, state string) {
+	msg, ok := e.Args[0].(*pb.ChaincodeMessage)
+	if !ok {
+		e.Cancel(errors.New("received unexpected message type"))
+		return
+	}
+	chaincodeLogger.Debugf("[%s]Received %s, invoking get state multiple from ledger", shorttxid(msg.Txid), pb.ChaincodeMessage_GET_STATE_MULTIPLE)
+
+	// Query ledger for state
+	handler.handleGetStateMultiple(msg)
+}
+
+// Handles query to ledger to get the state of several keys at once
+func (handler *Handler) handleGetStateMultiple(msg *pb.ChaincodeMessage) {
+	// The defer followed by triggering a go routine dance is needed to ensure that the previous state transition
+	// is completed before the next one is triggered. The previous state transition is deemed complete only when
+	// the afterGetStateMultiple function is exited.
+	go func() {
+		// Check if this is the unique state request from this chaincode txid
+		uniqueReq := handler.createTXIDEntry(msg.ChannelId, msg.Txid)
+		if !uniqueReq {
+			// Drop this request
+			chaincodeLogger.Error("Another state request pending for this Txid. Cannot process.")
+			return
+		}
+
+		var serialSendMsg *pb.ChaincodeMessage
+		var txContext *transactionContext
+		txContext, serialSendMsg = handler.isValidTxSim(msg.ChannelId, msg.Txid,
+			"[%s]No ledger context for GetStateMultiple. Sending %s", shorttxid(msg.Txid), pb.ChaincodeMessage_ERROR)
+
+		defer func() {
+			handler.deleteTXIDEntry(msg.ChannelId, msg.Txid)
+			chaincodeLogger.Debugf("[%s]handleGetStateMultiple serial send %s",
+				shorttxid(serialSendMsg.Txid), serialSendMsg.Type)
+			handler.serialSendAsync(serialSendMsg, nil)
+		}()
+
+		if txContext == nil {
+			return
+		}
+
+		getStateMultiple := &pb.GetStateMultiple{}
+		unmarshalErr := proto.Unmarshal(msg.Payload, getStateMultiple)
+		if unmarshalErr != nil {
+			serialSendMsg = &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_ERROR, Payload: []byte(unmarshalErr.Error()), Txid: msg.Txid, ChannelId: msg.ChannelId}
+			return
+		}
+		chaincodeID := handler.getCCRootName()
+		chaincodeLogger.Debugf("[%s] getting state for chaincode %s, %d keys, channel %s",
+			shorttxid(msg.Txid), chaincodeID, len(getStateMultiple.Keys), txContext.chainID)
+
+		var values [][]byte
+		var err error
+		if isCollectionSet(getStateMultiple.Collection) {
+			if err = handler.checkCollectionAccess(txContext, chaincodeID, getStateMultiple.Collection, false); err == nil {
+				values, err = txContext.txsimulator.GetPrivateDataMultipleKeys(chaincodeID, getStateMultiple.Collection, getStateMultiple.Keys)
+			}
+		} else {
+			values, err = txContext.txsimulator.GetStateMultipleKeys(chaincodeID, getStateMultiple.Keys)
+		}
+
+		var payload []byte
+		if err == nil {
+			payload, err = proto.Marshal(&pb.GetStateMultipleResult{Values: values})
+		}
+		if err != nil {
+			// Send error msg back to chaincode. GetStateMultiple will not trigger event
+			chaincodeLogger.Errorf("[%s]Failed to get chaincode state(%s). Sending %s",
+				shorttxid(msg.Txid), err, pb.ChaincodeMessage_ERROR)
+			serialSendMsg = &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_ERROR, Payload: []byte(err.Error()), Txid: msg.Txid, ChannelId: msg.ChannelId}
+			return
+		}
+
+		// Send response msg back to chaincode. GetStateMultiple will not trigger event
+		chaincodeLogger.Debugf("[%s]Got state. Sending %s", shorttxid(msg.Txid), pb.ChaincodeMessage_RESPONSE)
+		serialSendMsg = &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_RESPONSE, Payload: payload, Txid: msg.Txid, ChannelId: msg.ChannelId}
+	}()
+}
+
 // afterGetStateByRange handles a GET_STATE_BY_RANGE request from the chaincode.
 func (handler *Handler) afterGetStateByRange(e *fsm.Event, state string) {
 	msg, ok := e.Args[0].(*pb.ChaincodeMessage)
@@ -732,7 +863,9 @@ func (handler *Handler) handleGetStateByRange(msg *pb.ChaincodeMessage) {
 		var err error
 
 		if isCollectionSet(getStateByRange.Collection) {
-			rangeIter, err = txContext.txsimulator.GetPrivateDataRangeScanIterator(chaincodeID, getStateByRange.Collection, getStateByRange.StartKey, getStateByRange.EndKey)
+			if err = handler.checkCollectionAccess(txContext, chaincodeID, getStateByRange.Collection, false); err == nil {
+				rangeIter, err = txContext.txsimulator.GetPrivateDataRangeScanIterator(chaincodeID, getStateByRange.Collection, getStateByRange.StartKey, getStateByRange.EndKey)
+			}
 		} else {
 			rangeIter, err = txContext.txsimulator.GetStateRangeScanIterator(chaincodeID, getStateByRange.StartKey, getStateByRange.EndKey)
 		}
@@ -1037,7 +1170,9 @@ func (handler *Handler) handleGetQueryResult(msg *pb.ChaincodeMessage) {
 		var err error
 		var executeIter commonledger.ResultsIterator
 		if isCollectionSet(getQueryResult.Collection) {
-			executeIter, err = txContext.txsimulator.ExecuteQueryOnPrivateData(chaincodeID, getQueryResult.Collection, getQueryResult.Query)
+			if err = handler.checkCollectionAccess(txContext, chaincodeID, getQueryResult.Collection, false); err == nil {
+				executeIter, err = txContext.txsimulator.ExecuteQueryOnPrivateData(chaincodeID, getQueryResult.Collection, getQueryResult.Query)
+			}
 		} else {
 			executeIter, err = txContext.txsimulator.ExecuteQuery(chaincodeID, getQueryResult.Query)
 		}
@@ -1167,6 +1302,36 @@ func isCollectionSet(collection string) bool {
 	return true
 }
 
+// checkCollectionAccess verifies that the creator of the transaction's
+// proposal may read (or write, if write is set) the private data of the
+// given collection, as restricted by its member-only-read and
+// member-only-write flags
+func (handler *Handler) checkCollectionAccess(txContext *transactionContext, chaincodeID string, collection string, write bool) error {
+	if handler.chaincodeSupport == nil || handler.chaincodeSupport.collectionStore == nil {
+		return nil
+	}
+	cc := common.CollectionCriteria{
+		Channel:    txContext.chainID,
+		Namespace:  chaincodeID,
+		Collection: collection,
+	}
+	// the collection configuration is read through the transaction's own
+	// simulator, as it holds the ledger's read lock until it is done; the
+	// read isn't recorded, so that the results of the simulation don't
+	// depend on whether the collection restricts access
+	canRead, canWrite, err := handler.chaincodeSupport.collectionStore.RetrieveReadWritePermission(cc, txContext.signedProp, txContext.txsimulator)
+	if err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("failed retrieving the access permissions of collection %s of chaincode %s", collection, chaincodeID))
+	}
+	if write && !canWrite {
+		return errors.Errorf("tx creator does not have write access permission on privatedata in chaincodeName:%s collectionName: %s", chaincodeID, collection)
+	}
+	if !write && !canRead {
+		return errors.Errorf("tx creator does not have read access permission on privatedata in chaincodeName:%s collectionName: %s", chaincodeID, collection)
+	}
+	return nil
+}
+
 func (handler *Handler) getTxContextForMessage(channelID string, txid string, msgType string, payload []byte, fmtStr string, args ...interface{}) (*transactionContext, *pb.ChaincodeMessage) {
 	//if we have a channelID, just get the txsim from isValidTxSim
 	//if this is NOT an INVOKE_CHAINCODE, then let isValidTxSim handle retrieving the txContext
@@ -1261,11 +1426,29 @@ func (handler *Handler) enterBusyState(e *fsm.Event, state string) {
 			}
 
 			if isCollectionSet(putState.Collection) {
-				err = txContext.txsimulator.SetPrivateData(chaincodeID, putState.Collection, putState.Key, putState.Value)
+				if err = handler.checkCollectionAccess(txContext, chaincodeID, putState.Collection, true); err == nil {
+					err = txContext.txsimulator.SetPrivateData(chaincodeID, putState.Collection, putState.Key, putState.Value)
+				}
 			} else {
 				err = txContext.txsimulator.SetState(chaincodeID, putState.Key, putState.Value)
 			}
 
+		} else if msg.Type.String() == pb.ChaincodeMessage_PUT_STATE_MULTIPLE.String() {
+			putStateMultiple := &pb.PutStateMultiple{}
+			unmarshalErr := proto.Unmarshal(msg.Payload, putStateMultiple)
+			if unmarshalErr != nil {
+				errHandler([]byte(unmarshalErr.Error()), "[%s]Unable to decipher payload. Sending %s", shorttxid(msg.Txid), pb.ChaincodeMessage_ERROR)
+				return
+			}
+
+			if isCollectionSet(putStateMultiple.Collection) {
+				if err = handler.checkCollectionAccess(txContext, chaincodeID, putStateMultiple.Collection, true); err == nil {
+					err = txContext.txsimulator.SetPrivateDataMultipleKeys(chaincodeID, putStateMultiple.Collection, putStateMultiple.Kvs)
+				}
+			} else {
+				err = txContext.txsimulator.SetStateMultipleKeys(chaincodeID, putStateMultiple.Kvs)
+			}
+
 		} else if msg.Type.String() == pb.ChaincodeMessage_DEL_STATE.String() {
 			// Invoke ledger to delete state
 			delState := &pb.DelState{}
@@ -1276,7 +1459,9 @@ func (handler *Handler) enterBusyState(e *fsm.Event, state string) {
 			}
 
 			if isCollectionSet(delState.Collection) {
-				err = txContext.txsimulator.DeletePrivateData(chaincodeID, delState.Collection, delState.Key)
+				if err = handler.checkCollectionAccess(txContext, chaincodeID, delState.Collection, true); err == nil {
+					err = txContext.txsimulator.DeletePrivateData(chaincodeID, delState.Collection, delState.Key)
+				}
 			} else {
 				err = txContext.txsimulator.DeleteState(chaincodeID, delState.Key)
 			}
@@ -1309,10 +1494,15 @@ func (handler *Handler) enterBusyState(e *fsm.Event, state string) {
 
 			// Set up a new context for the called chaincode if on a different channel
 			// We grab the called channel's ledger simulator to hold the new state
+			// Writes performed on a different channel are discarded with the simulator,
+			// whereas the reads are recorded along with the height of the called channel's
+			// ledger, so that committers can verify them (see ChaincodeCall)
 			ctxt := context.Background()
 			txsim := txContext.txsimulator
 			historyQueryExecutor := txContext.historyQueryExecutor
-			if calledCcIns.ChainID != txContext.chainID {
+			crossChannel := calledCcIns.ChainID != txContext.chainID
+			var blockHeight uint64
+			if crossChannel {
 				lgr := peer.GetLedger(calledCcIns.ChainID)
 				if lgr == nil {
 					payload := "Failed to find ledger for called channel " + calledCcIns.ChainID
@@ -1328,9 +1518,22 @@ func (handler *Handler) enterBusyState(e *fsm.Event, state string) {
 				}
 				defer txsim2.Done()
 				txsim = txsim2
+
+				// the height is retrieved once the simulator is obtained, so
+				// that no state update can occur between the two operations
+				bcInfo, err2 := lgr.GetBlockchainInfo()
+				if err2 != nil {
+					triggerNextStateMsg = &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_ERROR,
+						Payload: []byte(err2.Error()), Txid: msg.Txid, ChannelId: msg.ChannelId}
+					return
+				}
+				blockHeight = bcInfo.Height
 			}
 			ctxt = context.WithValue(ctxt, TXSimulatorKey, txsim)
 			ctxt = context.WithValue(ctxt, HistoryQueryExecutorKey, historyQueryExecutor)
+			if txContext.callRecorder != nil {
+				ctxt = context.WithValue(ctxt, ChaincodeCallRecorderKey, txContext.callRecorder)
+			}
 
 			chaincodeLogger.Debugf("[%s] getting chaincode data for %s on channel %s",
 				shorttxid(msg.Txid), calledCcIns.ChaincodeName, calledCcIns.ChainID)
@@ -1371,8 +1574,7 @@ func (handler *Handler) enterBusyState(e *fsm.Event, state string) {
 				return
 			}
 
-			// TODO: Need to handle timeout correctly
-			timeout := time.Duration(30000) * time.Millisecond
+			timeout := handler.chaincodeSupport.GetExecuteTimeout(calledCcIns.ChaincodeName)
 
 			ccMsg, _ := createCCMessage(pb.ChaincodeMessage_TRANSACTION, calledCcIns.ChainID, msg.Txid, chaincodeInput)
 
@@ -1387,6 +1589,20 @@ func (handler *Handler) enterBusyState(e *fsm.Event, state string) {
 			} else {
 				res, err = proto.Marshal(response)
 			}
+
+			if err == nil && txContext.callRecorder != nil {
+				call := &pb.ChaincodeCall{
+					ChaincodeId: &pb.ChaincodeID{Name: calledCcIns.ChaincodeName, Version: version},
+					ChannelId:   calledCcIns.ChainID,
+				}
+				if crossChannel {
+					call.BlockHeight = blockHeight
+					call.Results, err = handler.crossChannelReads(txsim, calledCcIns.ChainID)
+				}
+				if err == nil {
+					txContext.callRecorder.Record(call)
+				}
+			}
 		}
 
 		if err != nil {
@@ -1400,6 +1616,26 @@ func (handler *Handler) enterBusyState(e *fsm.Event, state string) {
 	}()
 }
 
+// crossChannelReads returns the reads performed on channel chainID by a
+// chaincode invoked from a different channel
+func (handler *Handler) crossChannelReads(txsim ledger.TxSimulator, chainID string) ([]byte, error) {
+	simRes, err := txsim.GetTxSimulationResults()
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("failed to get simulation results on channel %s", chainID))
+	}
+	if len(simRes.PvtSimulationResults.GetNsPvtRwset()) > 0 {
+		chaincodeLogger.Warningf("Private data accessed on channel %s by a cross-channel invocation is not recorded", chainID)
+	}
+	reads, discardedWrites, err := readOnlyResults(simRes.PubSimulationResults)
+	if err != nil {
+		return nil, err
+	}
+	if discardedWrites {
+		chaincodeLogger.Warningf("Writes performed on channel %s by a cross-channel invocation are discarded", chainID)
+	}
+	return reads, nil
+}
+
 func (handler *Handler) enterEstablishedState(e *fsm.Event, state string) {
 	handler.notifyDuringStartup(true)
 }
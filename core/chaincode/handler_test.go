@@ -7,12 +7,17 @@ SPDX-License-Identifier: Apache-2.0
 package chaincode
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"testing"
 
 	"github.com/hyperledger/fabric/common/ledger"
+	"github.com/hyperledger/fabric/core/common/privdata"
+	coreledger "github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/ledger/queryresult"
+	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -107,3 +112,40 @@ func (m *MockResultsIterator) Next() (ledger.QueryResult, error) {
 func (m *MockResultsIterator) Close() {
 	m.Called()
 }
+
+type mockCollectionStore struct {
+	privdata.CollectionStore
+	canRead, canWrite bool
+	err               error
+	cc                common.CollectionCriteria
+}
+
+func (cs *mockCollectionStore) RetrieveReadWritePermission(cc common.CollectionCriteria, _ *pb.SignedProposal, _ coreledger.QueryExecutor) (bool, bool, error) {
+	cs.cc = cc
+	return cs.canRead, cs.canWrite, cs.err
+}
+
+func TestCheckCollectionAccess(t *testing.T) {
+	txContext := &transactionContext{chainID: "testchannel"}
+
+	// handlers without a collection store don't restrict access
+	handler := &Handler{chaincodeSupport: &ChaincodeSupport{}}
+	assert.NoError(t, handler.checkCollectionAccess(txContext, "testcc", "coll", false))
+	assert.NoError(t, handler.checkCollectionAccess(txContext, "testcc", "coll", true))
+
+	store := &mockCollectionStore{canRead: true}
+	handler.chaincodeSupport.collectionStore = store
+	assert.NoError(t, handler.checkCollectionAccess(txContext, "testcc", "coll", false))
+	assert.Equal(t, common.CollectionCriteria{Channel: "testchannel", Namespace: "testcc", Collection: "coll"}, store.cc)
+	assert.EqualError(t, handler.checkCollectionAccess(txContext, "testcc", "coll", true),
+		"tx creator does not have write access permission on privatedata in chaincodeName:testcc collectionName: coll")
+
+	store.canRead, store.canWrite = false, true
+	assert.NoError(t, handler.checkCollectionAccess(txContext, "testcc", "coll", true))
+	assert.EqualError(t, handler.checkCollectionAccess(txContext, "testcc", "coll", false),
+		"tx creator does not have read access permission on privatedata in chaincodeName:testcc collectionName: coll")
+
+	store.err = errors.New("ledger error")
+	assert.EqualError(t, handler.checkCollectionAccess(txContext, "testcc", "coll", false),
+		"failed retrieving the access permissions of collection coll of chaincode testcc: ledger error")
+}
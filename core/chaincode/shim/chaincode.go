@@ -51,13 +51,13 @@ const (
 // ChaincodeStub is an object passed to chaincode for shim side handling of
 // APIs.
 type ChaincodeStub struct {
-	TxID           string
-	ChannelId      string
-	chaincodeEvent *pb.ChaincodeEvent
-	args           [][]byte
-	handler        *Handler
-	signedProposal *pb.SignedProposal
-	proposal       *pb.Proposal
+	TxID            string
+	ChannelId       string
+	chaincodeEvents []*pb.ChaincodeEvent
+	args            [][]byte
+	handler         *Handler
+	signedProposal  *pb.SignedProposal
+	proposal        *pb.Proposal
 
 	// Additional fields extracted from the signedProposal
 	creator   []byte
@@ -433,6 +433,31 @@ func (stub *ChaincodeStub) PutState(key string, value []byte) error {
 	return stub.handler.handlePutState(collection, key, value, stub.ChannelId, stub.TxID)
 }
 
+// GetStateMultipleKeys documentation can be found in interfaces.go
+func (stub *ChaincodeStub) GetStateMultipleKeys(keys []string) ([][]byte, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	// Access public data by setting the collection to empty string
+	collection := ""
+	return stub.handler.handleGetStateMultiple(collection, keys, stub.ChannelId, stub.TxID)
+}
+
+// PutStateMultipleKeys documentation can be found in interfaces.go
+func (stub *ChaincodeStub) PutStateMultipleKeys(kvs map[string][]byte) error {
+	for key := range kvs {
+		if key == "" {
+			return errors.New("key must not be an empty string")
+		}
+	}
+	if len(kvs) == 0 {
+		return nil
+	}
+	// Access public data by setting the collection to empty string
+	collection := ""
+	return stub.handler.handlePutStateMultiple(collection, kvs, stub.ChannelId, stub.TxID)
+}
+
 // GetQueryResult documentation can be found in interfaces.go
 func (stub *ChaincodeStub) GetQueryResult(query string) (StateQueryIteratorInterface, error) {
 	// Access public data by setting the collection to empty string
@@ -757,10 +782,20 @@ func (stub *ChaincodeStub) SetEvent(name string, payload []byte) error {
 	if name == "" {
 		return errors.New("event name can not be nil string")
 	}
-	stub.chaincodeEvent = &pb.ChaincodeEvent{EventName: name, Payload: payload}
+	stub.chaincodeEvents = append(stub.chaincodeEvents, &pb.ChaincodeEvent{EventName: name, Payload: payload})
 	return nil
 }
 
+// lastChaincodeEvent returns the last of the supplied events, which is
+// sent as the single event of a transaction for compatibility with peers
+// that do not support multiple events
+func lastChaincodeEvent(events []*pb.ChaincodeEvent) *pb.ChaincodeEvent {
+	if len(events) == 0 {
+		return nil
+	}
+	return events[len(events)-1]
+}
+
 // ------------- Logging Control and Chaincode Loggers ---------------
 
 // As independent programs, Go language chaincodes can use any logging
@@ -33,6 +33,33 @@ func (stub *ChaincodeStub) PutPrivateData(collection string, key string, value [
 	return stub.handler.handlePutState(collection, key, value, stub.ChannelId, stub.TxID)
 }
 
+// GetPrivateDataMultipleKeys documentation can be found in interfaces.go
+func (stub *ChaincodeStub) GetPrivateDataMultipleKeys(collection string, keys []string) ([][]byte, error) {
+	if collection == "" {
+		return nil, fmt.Errorf("collection must not be an empty string")
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	return stub.handler.handleGetStateMultiple(collection, keys, stub.ChannelId, stub.TxID)
+}
+
+// PutPrivateDataMultipleKeys documentation can be found in interfaces.go
+func (stub *ChaincodeStub) PutPrivateDataMultipleKeys(collection string, kvs map[string][]byte) error {
+	if collection == "" {
+		return fmt.Errorf("collection must not be an empty string")
+	}
+	for key := range kvs {
+		if key == "" {
+			return fmt.Errorf("key must not be an empty string")
+		}
+	}
+	if len(kvs) == 0 {
+		return nil
+	}
+	return stub.handler.handlePutStateMultiple(collection, kvs, stub.ChannelId, stub.TxID)
+}
+
 // DelPrivateData documentation can be found in interfaces.go
 func (stub *ChaincodeStub) DelPrivateData(collection string, key string) error {
 	if collection == "" {
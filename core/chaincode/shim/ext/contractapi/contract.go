@@ -0,0 +1,137 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package contractapi provides a high-level API to write chaincodes as sets of
+// contracts, whose exported methods are invoked by name with typed arguments.
+package contractapi
+
+import (
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+)
+
+// TransactionContextInterface is the interface of the transaction context
+// that is passed to the functions of a contract as their first argument.
+// Functions may declare this interface, or the concrete type of the
+// transaction context of their contract, as the type of that argument
+type TransactionContextInterface interface {
+	// GetStub returns the stub of the transaction being executed
+	GetStub() shim.ChaincodeStubInterface
+}
+
+// SettableTransactionContextInterface is a transaction context whose stub
+// can be set; a new instance is created for each transaction
+type SettableTransactionContextInterface interface {
+	TransactionContextInterface
+
+	// SetStub sets the stub of the transaction being executed
+	SetStub(stub shim.ChaincodeStubInterface)
+}
+
+// TransactionContext is the default transaction context
+type TransactionContext struct {
+	stub shim.ChaincodeStubInterface
+}
+
+// GetStub returns the stub of the transaction being executed
+func (ctx *TransactionContext) GetStub() shim.ChaincodeStubInterface {
+	return ctx.stub
+}
+
+// SetStub sets the stub of the transaction being executed
+func (ctx *TransactionContext) SetStub(stub shim.ChaincodeStubInterface) {
+	ctx.stub = stub
+}
+
+// BeforeTransactionHook is invoked before any function of a contract;
+// if it returns an error, the function is not invoked and the
+// transaction fails with that error
+type BeforeTransactionHook func(ctx TransactionContextInterface) error
+
+// AfterTransactionHook is invoked after a function of a contract completed
+// successfully, with the value returned by the function (nil if none); if it
+// returns an error, the transaction fails with that error
+type AfterTransactionHook func(ctx TransactionContextInterface, result interface{}) error
+
+// UnknownTransactionHook is invoked in place of a function that a contract does
+// not define; the transaction fails if it returns an error, it succeeds with an
+// empty payload otherwise
+type UnknownTransactionHook func(ctx TransactionContextInterface) error
+
+// ContractInterface is the interface that contracts must implement in order
+// to be registered with a ContractChaincode. The exported methods of a contract,
+// except for those of this interface, are the functions the contract provides.
+// Contracts normally embed Contract, which implements this interface
+type ContractInterface interface {
+	// GetName returns the name of the contract, which is used to
+	// qualify its functions (as in "name:function")
+	GetName() string
+
+	// GetTransactionContextHandler returns a prototype of the transaction
+	// context to be passed to the functions of the contract; it must be
+	// a pointer to a struct
+	GetTransactionContextHandler() SettableTransactionContextInterface
+
+	// GetBeforeTransaction returns the hook to be invoked before the
+	// functions of the contract, or nil
+	GetBeforeTransaction() BeforeTransactionHook
+
+	// GetAfterTransaction returns the hook to be invoked after the
+	// functions of the contract, or nil
+	GetAfterTransaction() AfterTransactionHook
+
+	// GetUnknownTransaction returns the hook to be invoked when an
+	// unknown function of the contract is requested, or nil
+	GetUnknownTransaction() UnknownTransactionHook
+}
+
+// Contract provides a default implementation of ContractInterface,
+// meant to be embedded in the structs implementing the contracts
+type Contract struct {
+	// Name of the contract; if empty, the name of the
+	// struct embedding Contract is used instead
+	Name string
+
+	// TransactionContextHandler is the prototype of the transaction
+	// context of the contract; TransactionContext is used if nil
+	TransactionContextHandler SettableTransactionContextInterface
+
+	// BeforeTransaction is invoked before the functions of the contract
+	BeforeTransaction BeforeTransactionHook
+
+	// AfterTransaction is invoked after the functions of the contract
+	AfterTransaction AfterTransactionHook
+
+	// UnknownTransaction is invoked in place of unknown functions
+	UnknownTransaction UnknownTransactionHook
+}
+
+// GetName returns the name of the contract
+func (c *Contract) GetName() string {
+	return c.Name
+}
+
+// GetTransactionContextHandler returns the prototype of the transaction context
+func (c *Contract) GetTransactionContextHandler() SettableTransactionContextInterface {
+	if c.TransactionContextHandler == nil {
+		return &TransactionContext{}
+	}
+	return c.TransactionContextHandler
+}
+
+// GetBeforeTransaction returns the hook invoked before the functions of the contract
+func (c *Contract) GetBeforeTransaction() BeforeTransactionHook {
+	return c.BeforeTransaction
+}
+
+// GetAfterTransaction returns the hook invoked after the functions of the contract
+func (c *Contract) GetAfterTransaction() AfterTransactionHook {
+	return c.AfterTransaction
+}
+
+// GetUnknownTransaction returns the hook invoked in place of unknown functions
+func (c *Contract) GetUnknownTransaction() UnknownTransactionHook {
+	return c.UnknownTransaction
+}
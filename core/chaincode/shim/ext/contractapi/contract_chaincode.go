@@ -0,0 +1,304 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package contractapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+)
+
+const (
+	// SystemContractName is the name of the contract, reserved to
+	// ContractChaincode, that provides the metadata of a chaincode
+	SystemContractName = "org.hyperledger.fabric"
+
+	// MetadataFunction is the fully qualified name of the function that
+	// returns the JSON-encoded ContractChaincodeMetadata of a chaincode
+	MetadataFunction = SystemContractName + ":GetMetadata"
+
+	// separates the name of a contract from the name of its functions
+	nameSeparator = ":"
+)
+
+var contractInterfaceType = reflect.TypeOf((*ContractInterface)(nil)).Elem()
+var transactionContextInterfaceType = reflect.TypeOf((*TransactionContextInterface)(nil)).Elem()
+
+// contractFunction is a function provided by a contract
+type contractFunction struct {
+	method reflect.Value
+	// whether the function expects the transaction context as first argument
+	takesContext bool
+	params       []reflect.Type
+	// whether the function returns a value and an error, respectively
+	returnsValue bool
+	returnsError bool
+}
+
+// registeredContract is a contract registered with a ContractChaincode
+type registeredContract struct {
+	name        string
+	contract    ContractInterface
+	contextType reflect.Type
+	functions   map[string]*contractFunction
+}
+
+// ContractChaincode is a shim.Chaincode that routes the invocations to the
+// functions of the contracts it has been created with. The function to invoke
+// is named "contract:function" in the first argument of an invocation, while
+// the following arguments are passed to the function; the contract name may be
+// omitted for the functions of the default contract, that is, the first one.
+type ContractChaincode struct {
+	defaultContract string
+	contracts       map[string]*registeredContract
+	metadata        []byte
+}
+
+// NewChaincode creates a ContractChaincode for the supplied contracts; it
+// returns an error if the contracts, or the functions they provide, are not
+// compliant with the contract API
+func NewChaincode(contracts ...ContractInterface) (*ContractChaincode, error) {
+	if len(contracts) == 0 {
+		return nil, errors.New("at least one contract is required")
+	}
+
+	cc := &ContractChaincode{contracts: make(map[string]*registeredContract)}
+	for _, contract := range contracts {
+		rc, err := newRegisteredContract(contract)
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := cc.contracts[rc.name]; exists {
+			return nil, errors.Errorf("multiple contracts named %s", rc.name)
+		}
+		cc.contracts[rc.name] = rc
+		if cc.defaultContract == "" {
+			cc.defaultContract = rc.name
+		}
+	}
+
+	metadata, err := json.Marshal(cc.getMetadata())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed marshalling chaincode metadata")
+	}
+	cc.metadata = metadata
+
+	return cc, nil
+}
+
+func newRegisteredContract(contract ContractInterface) (*registeredContract, error) {
+	if contract == nil {
+		return nil, errors.New("nil contract")
+	}
+
+	contractType := reflect.TypeOf(contract)
+	name := contract.GetName()
+	if name == "" {
+		name = reflect.Indirect(reflect.ValueOf(contract)).Type().Name()
+	}
+	if name == "" || strings.Contains(name, nameSeparator) {
+		return nil, errors.Errorf("invalid name [%s] for contract of type %s", name, contractType)
+	}
+	if name == SystemContractName {
+		return nil, errors.Errorf("contract name %s is reserved", name)
+	}
+
+	ctxHandler := contract.GetTransactionContextHandler()
+	if ctxHandler == nil {
+		return nil, errors.Errorf("nil transaction context handler for contract %s", name)
+	}
+	contextType := reflect.TypeOf(ctxHandler)
+	if contextType.Kind() != reflect.Ptr || contextType.Elem().Kind() != reflect.Struct {
+		return nil, errors.Errorf("transaction context handler of contract %s must be a pointer to a struct, got %s", name, contextType)
+	}
+
+	rc := &registeredContract{
+		name:        name,
+		contract:    contract,
+		contextType: contextType,
+		functions:   make(map[string]*contractFunction),
+	}
+
+	contractValue := reflect.ValueOf(contract)
+	for i := 0; i < contractType.NumMethod(); i++ {
+		method := contractType.Method(i)
+		if _, isContractMethod := contractInterfaceType.MethodByName(method.Name); isContractMethod {
+			continue
+		}
+
+		fn, err := newContractFunction(contractValue.Method(i), contextType)
+		if err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("function %s of contract %s", method.Name, name))
+		}
+		rc.functions[method.Name] = fn
+	}
+
+	if len(rc.functions) == 0 {
+		return nil, errors.Errorf("contract %s has no functions", name)
+	}
+
+	return rc, nil
+}
+
+func newContractFunction(method reflect.Value, contextType reflect.Type) (*contractFunction, error) {
+	methodType := method.Type()
+	fn := &contractFunction{method: method}
+
+	for i := 0; i < methodType.NumIn(); i++ {
+		paramType := methodType.In(i)
+		if i == 0 && isContextParam(paramType, contextType) {
+			fn.takesContext = true
+			continue
+		}
+		if err := checkType(paramType); err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("parameter %d", i))
+		}
+		fn.params = append(fn.params, paramType)
+	}
+
+	switch methodType.NumOut() {
+	case 0:
+	case 1:
+		if methodType.Out(0) == errorType {
+			fn.returnsError = true
+		} else {
+			fn.returnsValue = true
+		}
+	case 2:
+		if methodType.Out(1) != errorType {
+			return nil, errors.New("the second return value must be an error")
+		}
+		fn.returnsValue = true
+		fn.returnsError = true
+	default:
+		return nil, errors.New("at most a value and an error can be returned")
+	}
+
+	if fn.returnsValue {
+		if err := checkType(methodType.Out(0)); err != nil {
+			return nil, errors.WithMessage(err, "return value")
+		}
+	}
+
+	return fn, nil
+}
+
+// isContextParam returns whether a parameter of the supplied
+// type accepts transaction contexts of type contextType
+func isContextParam(paramType, contextType reflect.Type) bool {
+	if paramType.Kind() == reflect.Interface {
+		return paramType.Implements(transactionContextInterfaceType) && contextType.Implements(paramType)
+	}
+	return paramType == contextType
+}
+
+// Init routes the invocation to the function named in the first argument,
+// if any; it does nothing otherwise
+func (cc *ContractChaincode) Init(stub shim.ChaincodeStubInterface) pb.Response {
+	if len(stub.GetArgs()) == 0 {
+		return shim.Success(nil)
+	}
+	return cc.Invoke(stub)
+}
+
+// Invoke routes the invocation to the function named in the first argument
+func (cc *ContractChaincode) Invoke(stub shim.ChaincodeStubInterface) pb.Response {
+	args := stub.GetArgs()
+	if len(args) == 0 {
+		return shim.Error("no function name provided")
+	}
+
+	qualifiedName := string(args[0])
+	if qualifiedName == MetadataFunction {
+		return shim.Success(cc.metadata)
+	}
+
+	contractName, fnName := cc.defaultContract, qualifiedName
+	if i := strings.LastIndex(qualifiedName, nameSeparator); i >= 0 {
+		contractName, fnName = qualifiedName[:i], qualifiedName[i+1:]
+	}
+	rc, exists := cc.contracts[contractName]
+	if !exists {
+		return shim.Error(fmt.Sprintf("contract %s not found", contractName))
+	}
+
+	ctx := reflect.New(rc.contextType.Elem()).Interface().(SettableTransactionContextInterface)
+	ctx.SetStub(stub)
+
+	fn, exists := rc.functions[fnName]
+	if !exists {
+		unknownTx := rc.contract.GetUnknownTransaction()
+		if unknownTx == nil {
+			return shim.Error(fmt.Sprintf("function %s not found in contract %s", fnName, contractName))
+		}
+		if err := unknownTx(ctx); err != nil {
+			return shim.Error(err.Error())
+		}
+		return shim.Success(nil)
+	}
+
+	if beforeTx := rc.contract.GetBeforeTransaction(); beforeTx != nil {
+		if err := beforeTx(ctx); err != nil {
+			return shim.Error(err.Error())
+		}
+	}
+
+	result, payload, err := fn.call(ctx, args[1:])
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	if afterTx := rc.contract.GetAfterTransaction(); afterTx != nil {
+		if err := afterTx(ctx, result); err != nil {
+			return shim.Error(err.Error())
+		}
+	}
+
+	return shim.Success(payload)
+}
+
+// call invokes the function with the supplied arguments and returns the
+// value it returned (if any), along with its serialized form
+func (fn *contractFunction) call(ctx TransactionContextInterface, args [][]byte) (interface{}, []byte, error) {
+	if len(args) != len(fn.params) {
+		return nil, nil, errors.Errorf("incorrect number of arguments (expected %d, provided %d)", len(fn.params), len(args))
+	}
+
+	var in []reflect.Value
+	if fn.takesContext {
+		in = append(in, reflect.ValueOf(ctx))
+	}
+	for i, paramType := range fn.params {
+		v, err := fromArg(args[i], paramType)
+		if err != nil {
+			return nil, nil, errors.WithMessage(err, fmt.Sprintf("argument %d", i))
+		}
+		in = append(in, v)
+	}
+
+	out := fn.method.Call(in)
+
+	if fn.returnsError {
+		if err, _ := out[len(out)-1].Interface().(error); err != nil {
+			return nil, nil, err
+		}
+	}
+	if !fn.returnsValue {
+		return nil, nil, nil
+	}
+
+	payload, err := toPayload(out[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	return out[0].Interface(), payload, nil
+}
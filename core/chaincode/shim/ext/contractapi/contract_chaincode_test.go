@@ -0,0 +1,343 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package contractapi
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/stretchr/testify/assert"
+)
+
+type asset struct {
+	ID    string `json:"id"`
+	Value int    `json:"value"`
+	Owner *owner `json:"owner,omitempty"`
+	notes string
+}
+
+type owner struct {
+	Name string
+}
+
+type assetContract struct {
+	Contract
+}
+
+func (c *assetContract) Create(ctx TransactionContextInterface, id string, value int) error {
+	a := &asset{ID: id, Value: value}
+	assetBytes, _ := json.Marshal(a)
+	return ctx.GetStub().PutState(id, assetBytes)
+}
+
+func (c *assetContract) Read(ctx TransactionContextInterface, id string) (*asset, error) {
+	assetBytes, err := ctx.GetStub().GetState(id)
+	if err != nil {
+		return nil, err
+	}
+	if assetBytes == nil {
+		return nil, errors.New("asset " + id + " not found")
+	}
+	a := &asset{}
+	err = json.Unmarshal(assetBytes, a)
+	return a, err
+}
+
+func (c *assetContract) Update(ctx TransactionContextInterface, a asset) error {
+	assetBytes, _ := json.Marshal(a)
+	return ctx.GetStub().PutState(a.ID, assetBytes)
+}
+
+type customContext struct {
+	TransactionContext
+	calls []string
+}
+
+type mathContract struct {
+	Contract
+}
+
+func (c *mathContract) Add(a, b int64) int64 {
+	return a + b
+}
+
+func (c *mathContract) Divide(ctx *customContext, a, b float64) (float64, error) {
+	ctx.calls = append(ctx.calls, "Divide")
+	if b == 0 {
+		return 0, errors.New("division by zero")
+	}
+	return a / b, nil
+}
+
+func (c *mathContract) Not(b bool) bool {
+	return !b
+}
+
+func (c *mathContract) Sum(values []int) int {
+	sum := 0
+	for _, v := range values {
+		sum += v
+	}
+	return sum
+}
+
+func (c *mathContract) Echo(b []byte) []byte {
+	return b
+}
+
+func (c *mathContract) Nothing() {}
+
+func TestContractChaincode(t *testing.T) {
+	cc, err := NewChaincode(&assetContract{}, &mathContract{Contract{Name: "math", TransactionContextHandler: &customContext{}}})
+	assert.NoError(t, err)
+
+	stub := shim.NewMockStub("contracts", cc)
+
+	// Init with no function
+	res := stub.MockInit("1", nil)
+	assert.Equal(t, int32(shim.OK), res.Status, res.Message)
+
+	// Init routes to functions too
+	res = stub.MockInit("2", [][]byte{[]byte("Create"), []byte("asset1"), []byte("10")})
+	assert.Equal(t, int32(shim.OK), res.Status, res.Message)
+
+	// default contract
+	res = stub.MockInvoke("3", [][]byte{[]byte("Read"), []byte("asset1")})
+	assert.Equal(t, int32(shim.OK), res.Status, res.Message)
+	assert.JSONEq(t, `{"id":"asset1","value":10}`, string(res.Payload))
+
+	// qualified name
+	res = stub.MockInvoke("4", [][]byte{[]byte("assetContract:Update"), []byte(`{"id":"asset1","value":20,"owner":{"Name":"alice"}}`)})
+	assert.Equal(t, int32(shim.OK), res.Status, res.Message)
+	res = stub.MockInvoke("5", [][]byte{[]byte("assetContract:Read"), []byte("asset1")})
+	assert.Equal(t, int32(shim.OK), res.Status, res.Message)
+	assert.JSONEq(t, `{"id":"asset1","value":20,"owner":{"Name":"alice"}}`, string(res.Payload))
+
+	// function returning an error
+	res = stub.MockInvoke("6", [][]byte{[]byte("Read"), []byte("asset2")})
+	assert.Equal(t, int32(shim.ERROR), res.Status)
+	assert.Equal(t, "asset asset2 not found", res.Message)
+
+	// basic types
+	for _, tc := range []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"math:Add", "2", "-3"}, "-1"},
+		{[]string{"math:Divide", "3", "2"}, "1.5"},
+		{[]string{"math:Not", "true"}, "false"},
+		{[]string{"math:Sum", "[1,2,3]"}, "6"},
+		{[]string{"math:Echo", "bytes"}, "bytes"},
+		{[]string{"math:Nothing"}, ""},
+	} {
+		var args [][]byte
+		for _, arg := range tc.args {
+			args = append(args, []byte(arg))
+		}
+		res = stub.MockInvoke("7", args)
+		assert.Equal(t, int32(shim.OK), res.Status, res.Message)
+		assert.Equal(t, tc.expected, string(res.Payload))
+	}
+
+	// bad invocations
+	for _, tc := range []struct {
+		args    []string
+		message string
+	}{
+		{[]string{}, "no function name provided"},
+		{[]string{"math:Divide", "3", "0"}, "division by zero"},
+		{[]string{"math:Add", "2"}, "incorrect number of arguments (expected 2, provided 1)"},
+		{[]string{"math:Add", "2", "two"}, "argument 1: value two is not valid for type int64"},
+		{[]string{"math:Sum", "[1.5]"}, "argument 0: value [1.5] is not valid JSON for type []int"},
+		{[]string{"math:Missing"}, "function Missing not found in contract math"},
+		{[]string{"missing:Add"}, "contract missing not found"},
+	} {
+		var args [][]byte
+		for _, arg := range tc.args {
+			args = append(args, []byte(arg))
+		}
+		res = stub.MockInvoke("8", args)
+		assert.Equal(t, int32(shim.ERROR), res.Status)
+		assert.Contains(t, res.Message, tc.message)
+	}
+}
+
+func TestContractChaincodeHooks(t *testing.T) {
+	var events []string
+	contract := &mathContract{Contract{
+		Name:                      "math",
+		TransactionContextHandler: &customContext{},
+		BeforeTransaction: func(ctx TransactionContextInterface) error {
+			assert.NotNil(t, ctx.GetStub())
+			events = append(events, "before")
+			if len(ctx.GetStub().GetArgs()) > 3 {
+				return errors.New("too many arguments")
+			}
+			return nil
+		},
+		AfterTransaction: func(ctx TransactionContextInterface, result interface{}) error {
+			events = append(events, "after")
+			assert.Equal(t, []string{"Divide"}, ctx.(*customContext).calls)
+			if result.(float64) > 10 {
+				return errors.New("result too big")
+			}
+			return nil
+		},
+		UnknownTransaction: func(ctx TransactionContextInterface) error {
+			events = append(events, "unknown")
+			return nil
+		},
+	}}
+	cc, err := NewChaincode(contract)
+	assert.NoError(t, err)
+	stub := shim.NewMockStub("contracts", cc)
+
+	res := stub.MockInvoke("1", [][]byte{[]byte("Divide"), []byte("4"), []byte("2")})
+	assert.Equal(t, int32(shim.OK), res.Status, res.Message)
+	assert.Equal(t, []string{"before", "after"}, events)
+
+	events = nil
+	res = stub.MockInvoke("2", [][]byte{[]byte("Divide"), []byte("40"), []byte("2")})
+	assert.Equal(t, int32(shim.ERROR), res.Status)
+	assert.Equal(t, "result too big", res.Message)
+	assert.Equal(t, []string{"before", "after"}, events)
+
+	events = nil
+	res = stub.MockInvoke("3", [][]byte{[]byte("Divide"), []byte("4"), []byte("2"), []byte("0")})
+	assert.Equal(t, int32(shim.ERROR), res.Status)
+	assert.Equal(t, "too many arguments", res.Message)
+	assert.Equal(t, []string{"before"}, events)
+
+	events = nil
+	res = stub.MockInvoke("4", [][]byte{[]byte("Multiply")})
+	assert.Equal(t, int32(shim.OK), res.Status, res.Message)
+	assert.Equal(t, []string{"unknown"}, events)
+
+	contract.UnknownTransaction = func(ctx TransactionContextInterface) error {
+		return errors.New("unknown function")
+	}
+	res = stub.MockInvoke("5", [][]byte{[]byte("Multiply")})
+	assert.Equal(t, int32(shim.ERROR), res.Status)
+	assert.Equal(t, "unknown function", res.Message)
+}
+
+type badParamContract struct {
+	Contract
+}
+
+func (c *badParamContract) Bad(ch chan int) {}
+
+type badReturnContract struct {
+	Contract
+}
+
+func (c *badReturnContract) Bad() (int, int) { return 0, 0 }
+
+type tooManyReturnsContract struct {
+	Contract
+}
+
+func (c *tooManyReturnsContract) Bad() (int, int, error) { return 0, 0, nil }
+
+type badReturnTypeContract struct {
+	Contract
+}
+
+func (c *badReturnTypeContract) Bad() func() { return nil }
+
+type emptyContract struct {
+	Contract
+}
+
+type badContext struct{}
+
+func (c badContext) GetStub() shim.ChaincodeStubInterface     { return nil }
+func (c badContext) SetStub(stub shim.ChaincodeStubInterface) {}
+
+func TestNewChaincodeErrors(t *testing.T) {
+	for _, tc := range []struct {
+		contracts []ContractInterface
+		message   string
+	}{
+		{nil, "at least one contract is required"},
+		{[]ContractInterface{nil}, "nil contract"},
+		{[]ContractInterface{&mathContract{Contract{Name: "a:b"}}}, "invalid name [a:b]"},
+		{[]ContractInterface{&mathContract{Contract{Name: SystemContractName}}}, "contract name org.hyperledger.fabric is reserved"},
+		{[]ContractInterface{&mathContract{}, &mathContract{}}, "multiple contracts named mathContract"},
+		{[]ContractInterface{&mathContract{Contract{TransactionContextHandler: badContext{}}}}, "must be a pointer to a struct"},
+		{[]ContractInterface{&badParamContract{}}, "parameter 0: type chan int is not supported"},
+		{[]ContractInterface{&badReturnContract{}}, "the second return value must be an error"},
+		{[]ContractInterface{&tooManyReturnsContract{}}, "at most a value and an error can be returned"},
+		{[]ContractInterface{&badReturnTypeContract{}}, "return value: type func() is not supported"},
+		{[]ContractInterface{&emptyContract{}}, "contract emptyContract has no functions"},
+	} {
+		_, err := NewChaincode(tc.contracts...)
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), tc.message)
+		}
+	}
+}
+
+func TestMetadata(t *testing.T) {
+	cc, err := NewChaincode(&assetContract{}, &mathContract{Contract{Name: "math", TransactionContextHandler: &customContext{}}})
+	assert.NoError(t, err)
+	stub := shim.NewMockStub("contracts", cc)
+
+	res := stub.MockInvoke("1", [][]byte{[]byte(MetadataFunction)})
+	assert.Equal(t, int32(shim.OK), res.Status, res.Message)
+
+	metadata := &ContractChaincodeMetadata{}
+	assert.NoError(t, json.Unmarshal(res.Payload, metadata))
+	assert.Equal(t, "assetContract", metadata.DefaultContract)
+	assert.Len(t, metadata.Contracts, 2)
+
+	assetMetadata := metadata.Contracts["assetContract"]
+	assert.Equal(t, "assetContract", assetMetadata.Name)
+	assert.Len(t, assetMetadata.Transactions, 3)
+	assert.Equal(t, TransactionMetadata{
+		Name: "Create",
+		Parameters: []ParameterMetadata{
+			{Name: "param0", Schema: &Schema{Type: "string"}},
+			{Name: "param1", Schema: &Schema{Type: "integer", Format: "int"}},
+		},
+	}, assetMetadata.Transactions[0])
+	assetSchema := &Schema{Type: "object", Properties: map[string]*Schema{
+		"id":    {Type: "string"},
+		"value": {Type: "integer", Format: "int"},
+		"owner": {Type: "object", Properties: map[string]*Schema{"Name": {Type: "string"}}},
+	}}
+	assert.Equal(t, "Read", assetMetadata.Transactions[1].Name)
+	assert.Equal(t, assetSchema, assetMetadata.Transactions[1].Returns)
+	assert.Equal(t, assetSchema, assetMetadata.Transactions[2].Parameters[0].Schema)
+
+	mathMetadata := metadata.Contracts["math"]
+	var names []string
+	for _, tx := range mathMetadata.Transactions {
+		names = append(names, tx.Name)
+	}
+	assert.Equal(t, []string{"Add", "Divide", "Echo", "Not", "Nothing", "Sum"}, names)
+	assert.Len(t, mathMetadata.Transactions[1].Parameters, 2)
+	assert.Equal(t, &Schema{Type: "number", Format: "float64"}, mathMetadata.Transactions[1].Returns)
+	assert.Equal(t, &Schema{Type: "string", Format: "byte"}, mathMetadata.Transactions[2].Returns)
+	assert.Nil(t, mathMetadata.Transactions[4].Returns)
+	assert.Equal(t, &Schema{Type: "array", Items: &Schema{Type: "integer", Format: "int"}}, mathMetadata.Transactions[5].Parameters[0].Schema)
+}
+
+type node struct {
+	Next *node  `json:"next"`
+	Skip string `json:"-"`
+}
+
+func TestRecursiveSchema(t *testing.T) {
+	schema := getSchema(reflect.TypeOf(node{}))
+	assert.Equal(t, &Schema{Type: "object", Properties: map[string]*Schema{
+		"next": {Type: "object"},
+	}}, schema)
+}
@@ -0,0 +1,76 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package contractapi
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ContractChaincodeMetadata describes the contracts of a ContractChaincode;
+// it is returned, JSON-encoded, by the MetadataFunction
+type ContractChaincodeMetadata struct {
+	DefaultContract string                      `json:"defaultContract"`
+	Contracts       map[string]ContractMetadata `json:"contracts"`
+}
+
+// ContractMetadata describes a contract and its functions
+type ContractMetadata struct {
+	Name         string                `json:"name"`
+	Transactions []TransactionMetadata `json:"transactions"`
+}
+
+// TransactionMetadata describes a function of a contract. Since
+// the names of the parameters are not available at runtime, they
+// are named after their position (param0, param1, ...)
+type TransactionMetadata struct {
+	Name       string              `json:"name"`
+	Parameters []ParameterMetadata `json:"parameters,omitempty"`
+	Returns    *Schema             `json:"returns,omitempty"`
+}
+
+// ParameterMetadata describes a parameter of a function
+type ParameterMetadata struct {
+	Name   string  `json:"name"`
+	Schema *Schema `json:"schema"`
+}
+
+func (cc *ContractChaincode) getMetadata() *ContractChaincodeMetadata {
+	metadata := &ContractChaincodeMetadata{
+		DefaultContract: cc.defaultContract,
+		Contracts:       make(map[string]ContractMetadata),
+	}
+
+	for name, rc := range cc.contracts {
+		contractMetadata := ContractMetadata{Name: name}
+
+		fnNames := make([]string, 0, len(rc.functions))
+		for fnName := range rc.functions {
+			fnNames = append(fnNames, fnName)
+		}
+		sort.Strings(fnNames)
+
+		for _, fnName := range fnNames {
+			fn := rc.functions[fnName]
+			txMetadata := TransactionMetadata{Name: fnName}
+			for i, paramType := range fn.params {
+				txMetadata.Parameters = append(txMetadata.Parameters, ParameterMetadata{
+					Name:   fmt.Sprintf("param%d", i),
+					Schema: getSchema(paramType),
+				})
+			}
+			if fn.returnsValue {
+				txMetadata.Returns = getSchema(fn.method.Type().Out(0))
+			}
+			contractMetadata.Transactions = append(contractMetadata.Transactions, txMetadata)
+		}
+
+		metadata.Contracts[name] = contractMetadata
+	}
+
+	return metadata
+}
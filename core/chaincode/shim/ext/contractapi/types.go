@@ -0,0 +1,167 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package contractapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+var bytesType = reflect.TypeOf([]byte{})
+
+// Arguments and return values of basic types (strings, booleans and numbers)
+// are passed as their textual representation, byte slices as they are, and
+// any other supported type (structs, slices, arrays, maps and pointers to
+// those) in JSON encoding
+
+// checkType returns an error if values of the supplied type cannot
+// be passed as argument to, or returned from, a contract function
+func checkType(t reflect.Type) error {
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64,
+		reflect.Struct, reflect.Slice, reflect.Array, reflect.Map:
+		return nil
+	case reflect.Ptr:
+		if t.Elem().Kind() == reflect.Ptr {
+			return errors.Errorf("type %s is not supported", t)
+		}
+		return checkType(t.Elem())
+	default:
+		return errors.Errorf("type %s is not supported", t)
+	}
+}
+
+// fromArg converts a function argument to a value of the supplied type
+func fromArg(arg []byte, t reflect.Type) (reflect.Value, error) {
+	var v interface{}
+	var err error
+	s := string(arg)
+
+	switch t.Kind() {
+	case reflect.String:
+		return reflect.ValueOf(s).Convert(t), nil
+	case reflect.Bool:
+		v, err = strconv.ParseBool(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err = strconv.ParseInt(s, 10, t.Bits())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, err = strconv.ParseUint(s, 10, t.Bits())
+	case reflect.Float32, reflect.Float64:
+		v, err = strconv.ParseFloat(s, t.Bits())
+	default:
+		if t == bytesType {
+			return reflect.ValueOf(arg), nil
+		}
+		ptr := reflect.New(t)
+		if err = json.Unmarshal(arg, ptr.Interface()); err != nil {
+			return reflect.Value{}, errors.Wrapf(err, "value %s is not valid JSON for type %s", s, t)
+		}
+		return ptr.Elem(), nil
+	}
+
+	if err != nil {
+		return reflect.Value{}, errors.Wrapf(err, "value %s is not valid for type %s", s, t)
+	}
+	return reflect.ValueOf(v).Convert(t), nil
+}
+
+// toPayload converts a value returned by a contract function to a payload
+func toPayload(v reflect.Value) ([]byte, error) {
+	switch v.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return []byte(fmt.Sprint(v.Interface())), nil
+	default:
+		if v.Type() == bytesType {
+			return v.Bytes(), nil
+		}
+		if v.Kind() == reflect.Ptr && v.IsNil() {
+			return nil, nil
+		}
+		payload, err := json.Marshal(v.Interface())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed marshalling value of type %s", v.Type())
+		}
+		return payload, nil
+	}
+}
+
+// Schema is a (simplified) JSON schema describing the
+// values of an argument or of a return value
+type Schema struct {
+	Type       string             `json:"type"`
+	Format     string             `json:"format,omitempty"`
+	Items      *Schema            `json:"items,omitempty"`
+	Properties map[string]*Schema `json:"properties,omitempty"`
+}
+
+// getSchema returns the schema of the values of the supplied type
+func getSchema(t reflect.Type) *Schema {
+	return getSchemaWithVisited(t, map[reflect.Type]bool{})
+}
+
+func getSchemaWithVisited(t reflect.Type, visited map[reflect.Type]bool) *Schema {
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Format: t.Kind().String()}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number", Format: t.Kind().String()}
+	case reflect.Ptr:
+		return getSchemaWithVisited(t.Elem(), visited)
+	case reflect.Slice, reflect.Array:
+		if t == bytesType {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: getSchemaWithVisited(t.Elem(), visited)}
+	case reflect.Struct:
+		// recursive types are not expanded further
+		if visited[t] {
+			return &Schema{Type: "object"}
+		}
+		visited[t] = true
+		defer delete(visited, t)
+
+		schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" {
+				// unexported field
+				continue
+			}
+			name := field.Name
+			if tag := field.Tag.Get("json"); tag != "" {
+				tagName := strings.Split(tag, ",")[0]
+				if tagName == "-" {
+					continue
+				}
+				if tagName != "" {
+					name = tagName
+				}
+			}
+			schema.Properties[name] = getSchemaWithVisited(field.Type, visited)
+		}
+		return schema
+	default:
+		return &Schema{Type: "object"}
+	}
+}
@@ -225,14 +225,14 @@ func (handler *Handler) handleInit(msg *pb.ChaincodeMessage) {
 			handler.triggerNextState(nextStateMsg, send)
 		}()
 
-		errFunc := func(err error, payload []byte, ce *pb.ChaincodeEvent, errFmt string, args ...interface{}) *pb.ChaincodeMessage {
+		errFunc := func(err error, payload []byte, ce []*pb.ChaincodeEvent, errFmt string, args ...interface{}) *pb.ChaincodeMessage {
 			if err != nil {
 				// Send ERROR message to chaincode support and change state
 				if payload == nil {
 					payload = []byte(err.Error())
 				}
 				chaincodeLogger.Errorf(errFmt, args...)
-				return &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_ERROR, Payload: payload, Txid: msg.Txid, ChaincodeEvent: ce, ChannelId: msg.ChannelId}
+				return &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_ERROR, Payload: payload, Txid: msg.Txid, ChaincodeEvent: lastChaincodeEvent(ce), ChaincodeEvents: ce, ChannelId: msg.ChannelId}
 			}
 			return nil
 		}
@@ -247,7 +247,7 @@ func (handler *Handler) handleInit(msg *pb.ChaincodeMessage) {
 		// Create the ChaincodeStub which the chaincode can use to callback
 		stub := new(ChaincodeStub)
 		err := stub.init(handler, msg.ChannelId, msg.Txid, input, msg.Proposal)
-		if nextStateMsg = errFunc(err, nil, stub.chaincodeEvent, "[%s]Init get error response. Sending %s", shorttxid(msg.Txid), pb.ChaincodeMessage_ERROR.String()); nextStateMsg != nil {
+		if nextStateMsg = errFunc(err, nil, stub.chaincodeEvents, "[%s]Init get error response. Sending %s", shorttxid(msg.Txid), pb.ChaincodeMessage_ERROR.String()); nextStateMsg != nil {
 			return
 		}
 
@@ -256,18 +256,18 @@ func (handler *Handler) handleInit(msg *pb.ChaincodeMessage) {
 
 		if res.Status >= ERROR {
 			err = errors.New(res.Message)
-			if nextStateMsg = errFunc(err, []byte(res.Message), stub.chaincodeEvent, "[%s]Init get error response. Sending %s", shorttxid(msg.Txid), pb.ChaincodeMessage_ERROR.String()); nextStateMsg != nil {
+			if nextStateMsg = errFunc(err, []byte(res.Message), stub.chaincodeEvents, "[%s]Init get error response. Sending %s", shorttxid(msg.Txid), pb.ChaincodeMessage_ERROR.String()); nextStateMsg != nil {
 				return
 			}
 		}
 
 		resBytes, err := proto.Marshal(&res)
-		if nextStateMsg = errFunc(err, nil, stub.chaincodeEvent, "[%s]Init marshal response error. Sending %s", shorttxid(msg.Txid), pb.ChaincodeMessage_ERROR.String()); nextStateMsg != nil {
+		if nextStateMsg = errFunc(err, nil, stub.chaincodeEvents, "[%s]Init marshal response error. Sending %s", shorttxid(msg.Txid), pb.ChaincodeMessage_ERROR.String()); nextStateMsg != nil {
 			return
 		}
 
 		// Send COMPLETED message to chaincode support and change state
-		nextStateMsg = &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_COMPLETED, Payload: resBytes, Txid: msg.Txid, ChaincodeEvent: stub.chaincodeEvent, ChannelId: stub.ChannelId}
+		nextStateMsg = &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_COMPLETED, Payload: resBytes, Txid: msg.Txid, ChaincodeEvent: lastChaincodeEvent(stub.chaincodeEvents), ChaincodeEvents: stub.chaincodeEvents, ChannelId: stub.ChannelId}
 		chaincodeLogger.Debugf("[%s]Init succeeded. Sending %s", shorttxid(msg.Txid), pb.ChaincodeMessage_COMPLETED)
 	}()
 }
@@ -302,11 +302,11 @@ func (handler *Handler) handleTransaction(msg *pb.ChaincodeMessage) {
 			handler.triggerNextState(nextStateMsg, send)
 		}()
 
-		errFunc := func(err error, ce *pb.ChaincodeEvent, errStr string, args ...interface{}) *pb.ChaincodeMessage {
+		errFunc := func(err error, ce []*pb.ChaincodeEvent, errStr string, args ...interface{}) *pb.ChaincodeMessage {
 			if err != nil {
 				payload := []byte(err.Error())
 				chaincodeLogger.Errorf(errStr, args...)
-				return &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_ERROR, Payload: payload, Txid: msg.Txid, ChaincodeEvent: ce, ChannelId: msg.ChannelId}
+				return &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_ERROR, Payload: payload, Txid: msg.Txid, ChaincodeEvent: lastChaincodeEvent(ce), ChaincodeEvents: ce, ChannelId: msg.ChannelId}
 			}
 			return nil
 		}
@@ -322,7 +322,7 @@ func (handler *Handler) handleTransaction(msg *pb.ChaincodeMessage) {
 		// Create the ChaincodeStub which the chaincode can use to callback
 		stub := new(ChaincodeStub)
 		err := stub.init(handler, msg.ChannelId, msg.Txid, input, msg.Proposal)
-		if nextStateMsg = errFunc(err, stub.chaincodeEvent, "[%s]Transaction execution failed. Sending %s", shorttxid(msg.Txid), pb.ChaincodeMessage_ERROR.String()); nextStateMsg != nil {
+		if nextStateMsg = errFunc(err, stub.chaincodeEvents, "[%s]Transaction execution failed. Sending %s", shorttxid(msg.Txid), pb.ChaincodeMessage_ERROR.String()); nextStateMsg != nil {
 			return
 		}
 
@@ -330,13 +330,13 @@ func (handler *Handler) handleTransaction(msg *pb.ChaincodeMessage) {
 
 		// Endorser will handle error contained in Response.
 		resBytes, err := proto.Marshal(&res)
-		if nextStateMsg = errFunc(err, stub.chaincodeEvent, "[%s]Transaction execution failed. Sending %s", shorttxid(msg.Txid), pb.ChaincodeMessage_ERROR.String()); nextStateMsg != nil {
+		if nextStateMsg = errFunc(err, stub.chaincodeEvents, "[%s]Transaction execution failed. Sending %s", shorttxid(msg.Txid), pb.ChaincodeMessage_ERROR.String()); nextStateMsg != nil {
 			return
 		}
 
 		// Send COMPLETED message to chaincode support and change state
 		chaincodeLogger.Debugf("[%s]Transaction completed. Sending %s", shorttxid(msg.Txid), pb.ChaincodeMessage_COMPLETED)
-		nextStateMsg = &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_COMPLETED, Payload: resBytes, Txid: msg.Txid, ChaincodeEvent: stub.chaincodeEvent, ChannelId: stub.ChannelId}
+		nextStateMsg = &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_COMPLETED, Payload: resBytes, Txid: msg.Txid, ChaincodeEvent: lastChaincodeEvent(stub.chaincodeEvents), ChaincodeEvents: stub.chaincodeEvents, ChannelId: stub.ChannelId}
 	}()
 }
 
@@ -401,7 +401,6 @@ func (handler *Handler) callPeerWithChaincodeMsg(msg *pb.ChaincodeMessage, chann
 	return handler.sendReceive(msg, respChan)
 }
 
-// TODO: Implement a method to get multiple keys at a time [FAB-1244]
 // handleGetState communicates with the peer to fetch the requested state information from the ledger.
 func (handler *Handler) handleGetState(collection string, key string, channelId string, txid string) ([]byte, error) {
 	// Construct payload for GET_STATE
@@ -430,7 +429,6 @@ func (handler *Handler) handleGetState(collection string, key string, channelId
 	return nil, errors.Errorf("[%s]incorrect chaincode message %s received. Expecting %s or %s", shorttxid(responseMsg.Txid), responseMsg.Type, pb.ChaincodeMessage_RESPONSE, pb.ChaincodeMessage_ERROR)
 }
 
-// TODO: Implement a method to set multiple keys at a time [FAB-1244]
 // handlePutState communicates with the peer to put state information into the ledger.
 func (handler *Handler) handlePutState(collection string, key string, value []byte, channelId string, txid string) error {
 	// Construct payload for PUT_STATE
@@ -461,6 +459,82 @@ func (handler *Handler) handlePutState(collection string, key string, value []by
 	return errors.Errorf("[%s]incorrect chaincode message %s received. Expecting %s or %s", shorttxid(responseMsg.Txid), responseMsg.Type, pb.ChaincodeMessage_RESPONSE, pb.ChaincodeMessage_ERROR)
 }
 
+// handleGetStateMultiple communicates with the peer to fetch the values of several keys
+// from the ledger in a single round-trip. The values are returned in the order of keys.
+func (handler *Handler) handleGetStateMultiple(collection string, keys []string, channelId string, txid string) ([][]byte, error) {
+	// Construct payload for GET_STATE_MULTIPLE
+	payloadBytes, _ := proto.Marshal(&pb.GetStateMultiple{Collection: collection, Keys: keys})
+
+	msg := &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_GET_STATE_MULTIPLE, Payload: payloadBytes, Txid: txid, ChannelId: channelId}
+	chaincodeLogger.Debugf("[%s]Sending %s", shorttxid(msg.Txid), pb.ChaincodeMessage_GET_STATE_MULTIPLE)
+
+	responseMsg, err := handler.callPeerWithChaincodeMsg(msg, channelId, txid)
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("[%s]error sending GET_STATE_MULTIPLE", shorttxid(txid)))
+	}
+
+	if responseMsg.Type.String() == pb.ChaincodeMessage_RESPONSE.String() {
+		// Success response
+		chaincodeLogger.Debugf("[%s]GetStateMultiple received payload %s", shorttxid(responseMsg.Txid), pb.ChaincodeMessage_RESPONSE)
+
+		result := &pb.GetStateMultipleResult{}
+		if err = proto.Unmarshal(responseMsg.Payload, result); err != nil {
+			return nil, errors.Errorf("[%s]GetStateMultipleResult unmarshall error", shorttxid(responseMsg.Txid))
+		}
+		if len(result.Values) != len(keys) {
+			return nil, errors.Errorf("[%s]GetStateMultipleResult returned %d values for %d keys", shorttxid(responseMsg.Txid), len(result.Values), len(keys))
+		}
+
+		// proto3 does not distinguish between nil and empty bytes; a missing
+		// key is reported as nil, consistently with GetState
+		for i, v := range result.Values {
+			if len(v) == 0 {
+				result.Values[i] = nil
+			}
+		}
+		return result.Values, nil
+	}
+	if responseMsg.Type.String() == pb.ChaincodeMessage_ERROR.String() {
+		// Error response
+		chaincodeLogger.Errorf("[%s]GetStateMultiple received error %s", shorttxid(responseMsg.Txid), pb.ChaincodeMessage_ERROR)
+		return nil, errors.New(string(responseMsg.Payload[:]))
+	}
+
+	// Incorrect chaincode message received
+	return nil, errors.Errorf("[%s]incorrect chaincode message %s received. Expecting %s or %s", shorttxid(responseMsg.Txid), responseMsg.Type, pb.ChaincodeMessage_RESPONSE, pb.ChaincodeMessage_ERROR)
+}
+
+// handlePutStateMultiple communicates with the peer to put several key/value pairs
+// into the ledger in a single round-trip.
+func (handler *Handler) handlePutStateMultiple(collection string, kvs map[string][]byte, channelId string, txid string) error {
+	// Construct payload for PUT_STATE_MULTIPLE
+	payloadBytes, _ := proto.Marshal(&pb.PutStateMultiple{Collection: collection, Kvs: kvs})
+
+	msg := &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_PUT_STATE_MULTIPLE, Payload: payloadBytes, Txid: txid, ChannelId: channelId}
+	chaincodeLogger.Debugf("[%s]Sending %s", shorttxid(msg.Txid), pb.ChaincodeMessage_PUT_STATE_MULTIPLE)
+
+	// Execute the request and get response
+	responseMsg, err := handler.callPeerWithChaincodeMsg(msg, channelId, txid)
+	if err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("[%s]error sending PUT_STATE_MULTIPLE", shorttxid(txid)))
+	}
+
+	if responseMsg.Type.String() == pb.ChaincodeMessage_RESPONSE.String() {
+		// Success response
+		chaincodeLogger.Debugf("[%s]Received %s. Successfully updated state", shorttxid(responseMsg.Txid), pb.ChaincodeMessage_RESPONSE)
+		return nil
+	}
+
+	if responseMsg.Type.String() == pb.ChaincodeMessage_ERROR.String() {
+		// Error response
+		chaincodeLogger.Errorf("[%s]Received %s. Payload: %s", shorttxid(responseMsg.Txid), pb.ChaincodeMessage_ERROR, responseMsg.Payload)
+		return errors.New(string(responseMsg.Payload[:]))
+	}
+
+	// Incorrect chaincode message received
+	return errors.Errorf("[%s]incorrect chaincode message %s received. Expecting %s or %s", shorttxid(responseMsg.Txid), responseMsg.Type, pb.ChaincodeMessage_RESPONSE, pb.ChaincodeMessage_ERROR)
+}
+
 // handleDelState communicates with the peer to delete a key from the state in the ledger.
 func (handler *Handler) handleDelState(collection string, key string, channelId string, txid string) error {
 	//payloadBytes, _ := proto.Marshal(&pb.GetState{Collection: collection, Key: key})
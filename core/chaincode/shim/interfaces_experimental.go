@@ -94,6 +94,18 @@ type ChaincodeStubInterface interface {
 	// key namespace.
 	PutState(key string, value []byte) error
 
+	// GetStateMultipleKeys returns the values of the specified `keys` from the
+	// ledger in a single round-trip to the peer. The values are returned in
+	// the same order as `keys`; a key that does not exist in the state database
+	// has a nil value. Like GetState, it doesn't consider data modified by
+	// PutState that has not been committed.
+	GetStateMultipleKeys(keys []string) ([][]byte, error)
+
+	// PutStateMultipleKeys puts the specified key/value pairs into the
+	// transaction's writeset in a single round-trip to the peer. The same
+	// restrictions on keys as for PutState apply.
+	PutStateMultipleKeys(kvs map[string][]byte) error
+
 	// DelState records the specified `key` to be deleted in the writeset of
 	// the transaction proposal. The `key` and its value will be deleted from
 	// the ledger when the transaction is validated and successfully committed.
@@ -180,6 +192,17 @@ type ChaincodeStubInterface interface {
 	// prefixed with 0x00 as composite key namespace.
 	PutPrivateData(collection string, key string, value []byte) error
 
+	// GetPrivateDataMultipleKeys returns the values of the specified `keys`
+	// from the specified `collection` in a single round-trip to the peer. The
+	// values are returned in the same order as `keys`; a key that does not
+	// exist has a nil value.
+	GetPrivateDataMultipleKeys(collection string, keys []string) ([][]byte, error)
+
+	// PutPrivateDataMultipleKeys puts the specified key/value pairs into the
+	// transaction's private writeset for `collection` in a single round-trip
+	// to the peer. The same restrictions on keys as for PutPrivateData apply.
+	PutPrivateDataMultipleKeys(collection string, kvs map[string][]byte) error
+
 	// DelState records the specified `key` to be deleted in the private writeset of
 	// the transaction. Note that only hash of the private writeset goes into the
 	// transaction proposal response (which is sent to the client who issued the
@@ -260,7 +283,9 @@ type ChaincodeStubInterface interface {
 	// SetEvent allows the chaincode to set an event on the response to the
 	// proposal to be included as part of a transaction. The event will be
 	// available within the transaction in the committed block regardless of the
-	// validity of the transaction.
+	// validity of the transaction. SetEvent may be called multiple times, in
+	// which case all the events are included in the transaction, in the order
+	// in which they were set.
 	SetEvent(name string, payload []byte) error
 }
 
@@ -153,6 +153,14 @@ func (stub *MockStub) PutPrivateData(collection string, key string, value []byte
 	return errors.New("Not Implemented")
 }
 
+func (stub *MockStub) GetPrivateDataMultipleKeys(collection string, keys []string) ([][]byte, error) {
+	return nil, errors.New("Not Implemented")
+}
+
+func (stub *MockStub) PutPrivateDataMultipleKeys(collection string, kvs map[string][]byte) error {
+	return errors.New("Not Implemented")
+}
+
 func (stub *MockStub) DelPrivateData(collection string, key string) error {
 	return errors.New("Not Implemented")
 }
@@ -223,6 +231,29 @@ func (stub *MockStub) PutState(key string, value []byte) error {
 	return nil
 }
 
+// GetStateMultipleKeys retrieves the values for the given keys from the ledger
+func (stub *MockStub) GetStateMultipleKeys(keys []string) ([][]byte, error) {
+	values := make([][]byte, len(keys))
+	for i, key := range keys {
+		value, err := stub.GetState(key)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// PutStateMultipleKeys writes the specified key/value pairs into the ledger.
+func (stub *MockStub) PutStateMultipleKeys(kvs map[string][]byte) error {
+	for key, value := range kvs {
+		if err := stub.PutState(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // DelState removes the specified `key` and its value from the ledger.
 func (stub *MockStub) DelState(key string) error {
 	mockLogger.Debug("MockStub", stub.Name, "Deleting", key, stub.State[key])
@@ -24,6 +24,7 @@ import (
 
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestMockStateRangeQueryIterator(t *testing.T) {
@@ -271,3 +272,15 @@ func TestMockMock(t *testing.T) {
 	getBytes("f", []string{"a", "b"})
 	getFuncArgs([][]byte{[]byte("a")})
 }
+
+func TestMockStateMultipleKeys(t *testing.T) {
+	stub := NewMockStub("multipleKeysTest", nil)
+	stub.MockTransactionStart("init")
+	err := stub.PutStateMultipleKeys(map[string][]byte{"a": []byte("1"), "b": []byte("2")})
+	assert.NoError(t, err)
+	stub.MockTransactionEnd("init")
+
+	vals, err := stub.GetStateMultipleKeys([]string{"b", "c", "a"})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("2"), nil, []byte("1")}, vals)
+}
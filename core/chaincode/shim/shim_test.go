@@ -94,6 +94,8 @@ func (t *shimTestCC) Invoke(stub ChaincodeStubInterface) pb.Response {
 		return t.historyq(stub, args)
 	} else if function == "richq" {
 		return t.richq(stub, args)
+	} else if function == "multi" {
+		return t.multi(stub, args)
 	}
 
 	return Error("Invalid invoke function name. Expecting \"invoke\" \"delete\" \"query\"")
@@ -155,6 +157,38 @@ func (t *shimTestCC) invoke(stub ChaincodeStubInterface, args []string) pb.Respo
 	return Success(nil)
 }
 
+// multi makes payment of X units from A to B using the multiple keys APIs
+func (t *shimTestCC) multi(stub ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) != 3 {
+		return Error("Incorrect number of arguments. Expecting 3")
+	}
+
+	vals, err := stub.GetStateMultipleKeys(args[:2])
+	if err != nil {
+		return Error("Failed to get state")
+	}
+	if vals[0] == nil || vals[1] == nil {
+		return Error("Entity not found")
+	}
+	Aval, _ := strconv.Atoi(string(vals[0]))
+	Bval, _ := strconv.Atoi(string(vals[1]))
+
+	X, err := strconv.Atoi(args[2])
+	if err != nil {
+		return Error("Invalid transaction amount, expecting a integer value")
+	}
+
+	err = stub.PutStateMultipleKeys(map[string][]byte{
+		args[0]: []byte(strconv.Itoa(Aval - X)),
+		args[1]: []byte(strconv.Itoa(Bval + X)),
+	})
+	if err != nil {
+		return Error(err.Error())
+	}
+
+	return Success(nil)
+}
+
 // Deletes an entity from state
 func (t *shimTestCC) delete(stub ChaincodeStubInterface, args []string) pb.Response {
 	if len(args) != 1 {
@@ -469,6 +503,19 @@ func TestNilEventName(t *testing.T) {
 
 }
 
+func TestSetMultipleEvents(t *testing.T) {
+	stub := ChaincodeStub{}
+	assert.Nil(t, lastChaincodeEvent(stub.chaincodeEvents))
+
+	assert.NoError(t, stub.SetEvent("first", []byte("payload1")))
+	assert.NoError(t, stub.SetEvent("second", []byte("payload2")))
+	assert.Equal(t, []*pb.ChaincodeEvent{
+		{EventName: "first", Payload: []byte("payload1")},
+		{EventName: "second", Payload: []byte("payload2")},
+	}, stub.chaincodeEvents)
+	assert.Equal(t, "second", lastChaincodeEvent(stub.chaincodeEvents).EventName)
+}
+
 type testCase struct {
 	name         string
 	ccLogLevel   string
@@ -644,6 +691,35 @@ func TestInvoke(t *testing.T) {
 	//wait for done
 	processDone(t, done, false)
 
+	//good multiple keys get and put
+	multiRes := utils.MarshalOrPanic(&pb.GetStateMultipleResult{Values: [][]byte{[]byte("100"), []byte("200")}})
+	respSet = &mockpeer.MockResponseSet{errorFunc, errorFunc, []*mockpeer.MockResponse{
+		{&pb.ChaincodeMessage{Type: pb.ChaincodeMessage_GET_STATE_MULTIPLE, Txid: "3c", ChannelId: channelId}, &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_RESPONSE, Payload: multiRes, Txid: "3c", ChannelId: channelId}},
+		{&pb.ChaincodeMessage{Type: pb.ChaincodeMessage_PUT_STATE_MULTIPLE, Txid: "3c", ChannelId: channelId}, &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_RESPONSE, Txid: "3c", ChannelId: channelId}},
+		{&pb.ChaincodeMessage{Type: pb.ChaincodeMessage_COMPLETED, Txid: "3c", ChannelId: channelId}, nil}}}
+	peerSide.SetResponses(respSet)
+
+	ci = &pb.ChaincodeInput{Args: [][]byte{[]byte("multi"), []byte("A"), []byte("B"), []byte("10")}, Decorations: nil}
+	payload = utils.MarshalOrPanic(ci)
+	peerSide.Send(&pb.ChaincodeMessage{Type: pb.ChaincodeMessage_TRANSACTION, Payload: payload, Txid: "3c", ChannelId: channelId})
+
+	//wait for done
+	processDone(t, done, false)
+
+	//multiple keys get with a mismatched number of values
+	multiRes = utils.MarshalOrPanic(&pb.GetStateMultipleResult{Values: [][]byte{[]byte("100")}})
+	respSet = &mockpeer.MockResponseSet{errorFunc, errorFunc, []*mockpeer.MockResponse{
+		{&pb.ChaincodeMessage{Type: pb.ChaincodeMessage_GET_STATE_MULTIPLE, Txid: "3d", ChannelId: channelId}, &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_RESPONSE, Payload: multiRes, Txid: "3d", ChannelId: channelId}},
+		{&pb.ChaincodeMessage{Type: pb.ChaincodeMessage_COMPLETED, Txid: "3d", ChannelId: channelId}, nil}}}
+	peerSide.SetResponses(respSet)
+
+	ci = &pb.ChaincodeInput{Args: [][]byte{[]byte("multi"), []byte("A"), []byte("B"), []byte("10")}, Decorations: nil}
+	payload = utils.MarshalOrPanic(ci)
+	peerSide.Send(&pb.ChaincodeMessage{Type: pb.ChaincodeMessage_TRANSACTION, Payload: payload, Txid: "3d", ChannelId: channelId})
+
+	//wait for done
+	processDone(t, done, false)
+
 	//bad delete
 	respSet = &mockpeer.MockResponseSet{errorFunc, errorFunc, []*mockpeer.MockResponse{
 		{&pb.ChaincodeMessage{Type: pb.ChaincodeMessage_DEL_STATE, Txid: "4", ChannelId: channelId}, &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_ERROR, Txid: "4", ChannelId: channelId}},
@@ -0,0 +1,323 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package committer
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/hyperledger/fabric/core/common/privdata"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+)
+
+// the namespace that holds the chaincode definitions and
+// collection configurations that transactions are validated with
+const lsccNamespace = "lscc"
+
+// BlockValidator validates the transactions of a block, and
+// records their validation codes in the metadata of the block
+type BlockValidator interface {
+	Validate(block *common.Block) error
+}
+
+// BlockPreparer assembles a validated block with its private data
+type BlockPreparer func(block *common.Block) (*ledger.BlockAndPvtData, error)
+
+// PipelinedCommitter validates and commits blocks in a pipeline: the
+// transactions of a block are validated while the blocks that precede it
+// are validated against the state (MVCC) and committed into the ledger.
+//
+// The validation of a block reads the definitions of the chaincodes it
+// invokes, and the identifiers of the transactions committed so far, from
+// the ledger. In order for it to yield the same result as if the blocks were
+// processed one after the other, a block is validated only once none of the
+// blocks still waiting to be committed writes a key of the definitions it
+// depends upon, or contains any of its transaction identifiers. Blocks with
+// transactions other than endorser transactions, such as configuration
+// blocks, are processed once all the blocks preceding them are committed,
+// and before any of the blocks that follow them is validated.
+type PipelinedCommitter struct {
+	committer Committer
+	validator BlockValidator
+	depth     int
+	queue     chan *pipelinedBlock
+
+	lock      sync.Mutex
+	committed *sync.Cond
+	inFlight  []*footprint
+	err       error
+	closed    bool
+	done      chan struct{}
+}
+
+type pipelinedBlock struct {
+	*ledger.BlockAndPvtData
+	onCommit func()
+}
+
+// NewPipelinedCommitter creates a new PipelinedCommitter that validates blocks
+// with the given validator and commits them with the given committer. The depth
+// is the number of validated blocks that may be waiting to be committed.
+func NewPipelinedCommitter(committer Committer, validator BlockValidator, depth int) *PipelinedCommitter {
+	if depth < 1 {
+		depth = 1
+	}
+	pc := &PipelinedCommitter{
+		committer: committer,
+		validator: validator,
+		depth:     depth,
+		queue:     make(chan *pipelinedBlock, depth),
+		done:      make(chan struct{}),
+	}
+	pc.committed = sync.NewCond(&pc.lock)
+	go pc.commitBlocks()
+	return pc
+}
+
+// Submit validates the given block once it no longer depends on the blocks
+// waiting to be committed, assembles it with its private data using the given
+// preparer, if any, and queues it for commit. The given callback, if any, is
+// invoked once the block has been committed. Submit returns once the block is
+// queued; since blocks are committed in the order they are submitted, it must
+// not be invoked concurrently. Once the commit of a block fails, the blocks
+// queued after it are discarded and all subsequent invocations of Submit
+// return the error.
+func (pc *PipelinedCommitter) Submit(block *common.Block, prepare BlockPreparer, onCommit func()) error {
+	fp := newFootprint(block)
+
+	pc.lock.Lock()
+	for pc.err == nil && !pc.closed && pc.dependsOnInFlight(fp) {
+		logger.Debugf("Block [%d] depends on blocks waiting to be committed, waiting for their commit before validating it", block.Header.Number)
+		pc.committed.Wait()
+	}
+	err := pc.status()
+	pc.lock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := pc.validator.Validate(block); err != nil {
+		return err
+	}
+
+	blockAndPvtData := &ledger.BlockAndPvtData{Block: block}
+	if prepare != nil {
+		if blockAndPvtData, err = prepare(block); err != nil {
+			return err
+		}
+	}
+
+	pc.lock.Lock()
+	for pc.err == nil && !pc.closed && len(pc.inFlight) >= pc.depth {
+		pc.committed.Wait()
+	}
+	if err := pc.status(); err != nil {
+		pc.lock.Unlock()
+		return err
+	}
+	pc.inFlight = append(pc.inFlight, fp)
+	// the queue has room for the block, as it only holds
+	// the blocks in flight besides the one being committed
+	pc.queue <- &pipelinedBlock{BlockAndPvtData: blockAndPvtData, onCommit: onCommit}
+	pc.lock.Unlock()
+	return nil
+}
+
+// Flush waits until all the submitted blocks are committed,
+// and returns the error that failed the commit of any of them
+func (pc *PipelinedCommitter) Flush() error {
+	pc.lock.Lock()
+	defer pc.lock.Unlock()
+	for pc.err == nil && len(pc.inFlight) > 0 {
+		pc.committed.Wait()
+	}
+	return pc.err
+}
+
+// Close commits the blocks that were submitted, and stops the pipeline.
+// It doesn't close the underlying committer.
+func (pc *PipelinedCommitter) Close() {
+	pc.lock.Lock()
+	if pc.closed {
+		pc.lock.Unlock()
+		return
+	}
+	pc.closed = true
+	pc.committed.Broadcast()
+	pc.lock.Unlock()
+
+	close(pc.queue)
+	<-pc.done
+}
+
+func (pc *PipelinedCommitter) status() error {
+	if pc.err != nil {
+		return pc.err
+	}
+	if pc.closed {
+		return errors.New("pipelined committer is closed")
+	}
+	return nil
+}
+
+// dependsOnInFlight returns whether the validation of a block with the
+// given footprint depends on any of the blocks waiting to be committed
+func (pc *PipelinedCommitter) dependsOnInFlight(fp *footprint) bool {
+	for _, inFlight := range pc.inFlight {
+		if fp.dependsOn(inFlight) {
+			return true
+		}
+	}
+	return false
+}
+
+func (pc *PipelinedCommitter) commitBlocks() {
+	defer close(pc.done)
+	for b := range pc.queue {
+		pc.lock.Lock()
+		failed := pc.err != nil
+		pc.lock.Unlock()
+
+		var err error
+		if failed {
+			logger.Warningf("Discarding block [%d] since the commit of a preceding block failed", b.Block.Header.Number)
+		} else if err = pc.committer.CommitWithPvtData(b.BlockAndPvtData); err != nil {
+			logger.Errorf("Failed committing block [%d]: %+v", b.Block.Header.Number, err)
+			err = errors.WithMessage(err, fmt.Sprintf("failed committing block %d", b.Block.Header.Number))
+		} else if b.onCommit != nil {
+			b.onCommit()
+		}
+
+		pc.lock.Lock()
+		pc.inFlight = pc.inFlight[1:]
+		if err != nil {
+			pc.err = err
+		}
+		pc.committed.Broadcast()
+		pc.lock.Unlock()
+	}
+}
+
+type stateKey struct {
+	namespace string
+	key       string
+}
+
+// footprint records the keys a block writes, and the keys and
+// transaction identifiers its validation depends upon
+type footprint struct {
+	// barrier is set for blocks that must be processed
+	// in isolation from the blocks that precede and follow them
+	barrier bool
+	txIDs   map[string]struct{}
+	writes  map[stateKey]struct{}
+	reads   map[stateKey]struct{}
+}
+
+// dependsOn returns whether the validation of the block with this
+// footprint depends on the commit of the block with the given footprint
+func (fp *footprint) dependsOn(preceding *footprint) bool {
+	if fp.barrier || preceding.barrier {
+		return true
+	}
+	for txID := range fp.txIDs {
+		if _, exists := preceding.txIDs[txID]; exists {
+			return true
+		}
+	}
+	for key := range fp.reads {
+		if _, exists := preceding.writes[key]; exists {
+			return true
+		}
+	}
+	return false
+}
+
+// newFootprint computes the footprint of the given block. Transactions that
+// cannot be parsed are left out, as they are invalidated regardless of the
+// state of the ledger.
+func newFootprint(block *common.Block) *footprint {
+	fp := &footprint{
+		txIDs:  make(map[string]struct{}),
+		writes: make(map[stateKey]struct{}),
+		reads:  make(map[stateKey]struct{}),
+	}
+	for _, envBytes := range block.Data.Data {
+		env, err := utils.GetEnvelopeFromBlock(envBytes)
+		if err != nil {
+			continue
+		}
+		payload, err := utils.GetPayload(env)
+		if err != nil || payload.Header == nil {
+			continue
+		}
+		chdr, err := utils.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+		if err != nil {
+			continue
+		}
+		if common.HeaderType(chdr.Type) != common.HeaderType_ENDORSER_TRANSACTION {
+			fp.barrier = true
+			continue
+		}
+		fp.txIDs[chdr.TxId] = struct{}{}
+		if hdrExt, err := utils.GetChaincodeHeaderExtension(payload.Header); err == nil && hdrExt.ChaincodeId != nil {
+			fp.addChaincode(hdrExt.ChaincodeId.Name)
+		}
+		fp.addTransaction(payload.Data)
+	}
+	return fp
+}
+
+func (fp *footprint) addTransaction(txBytes []byte) {
+	tx, err := utils.GetTransaction(txBytes)
+	if err != nil {
+		return
+	}
+	for _, action := range tx.Actions {
+		_, respPayload, err := utils.GetPayloads(action)
+		if err != nil {
+			continue
+		}
+		for _, call := range respPayload.Calls {
+			if call.ChaincodeId != nil {
+				fp.addChaincode(call.ChaincodeId.Name)
+			}
+		}
+		txRWSet := &rwsetutil.TxRwSet{}
+		if err := txRWSet.FromProtoBytes(respPayload.Results); err != nil {
+			continue
+		}
+		for _, ns := range txRWSet.NsRwSets {
+			fp.addChaincode(ns.NameSpace)
+			for _, write := range ns.KvRwSet.GetWrites() {
+				fp.writes[stateKey{namespace: ns.NameSpace, key: write.Key}] = struct{}{}
+			}
+			if ns.NameSpace != lsccNamespace {
+				continue
+			}
+			// transactions of lscc are validated against
+			// the definitions they read and replace
+			for _, read := range ns.KvRwSet.GetReads() {
+				fp.reads[stateKey{namespace: lsccNamespace, key: read.Key}] = struct{}{}
+			}
+			for _, write := range ns.KvRwSet.GetWrites() {
+				fp.reads[stateKey{namespace: lsccNamespace, key: write.Key}] = struct{}{}
+			}
+		}
+	}
+}
+
+// addChaincode records that the validation depends on the
+// definition and the collection configuration of the given chaincode
+func (fp *footprint) addChaincode(name string) {
+	fp.reads[stateKey{namespace: lsccNamespace, key: name}] = struct{}{}
+	fp.reads[stateKey{namespace: lsccNamespace, key: privdata.BuildCollectionKVSKey(name)}] = struct{}{}
+}
@@ -0,0 +1,372 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package committer
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/common/configtx/test"
+	"github.com/hyperledger/fabric/common/ledger/testutil"
+	mockconfig "github.com/hyperledger/fabric/common/mocks/config"
+	"github.com/hyperledger/fabric/common/mocks/scc"
+	"github.com/hyperledger/fabric/core/committer/txvalidator"
+	"github.com/hyperledger/fabric/core/common/ccprovider"
+	"github.com/hyperledger/fabric/core/common/sysccprovider"
+	"github.com/hyperledger/fabric/core/handlers/validation"
+	ledger2 "github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/core/ledger/ledgermgmt"
+	mocktxvalidator "github.com/hyperledger/fabric/core/mocks/txvalidator"
+	msptesttools "github.com/hyperledger/fabric/msp/mgmt/testtools"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sync/semaphore"
+)
+
+// constructs a block with a transaction of chaincode foo that
+// writes the given keys, given as pairs of namespace and key
+func blockWithWrites(t testing.TB, num uint64, txID string, keys ...string) *common.Block {
+	b := rwsetutil.NewRWSetBuilder()
+	for i := 0; i < len(keys); i += 2 {
+		b.AddToWriteSet(keys[i], keys[i+1], []byte("value"))
+	}
+	simRes, err := b.GetTxSimulationResults()
+	assert.NoError(t, err)
+	simResBytes, err := simRes.GetPubSimulationBytes()
+	assert.NoError(t, err)
+	env, _, err := testutil.ConstructTransaction(nil, simResBytes, txID, false)
+	assert.NoError(t, err)
+	return testutil.NewBlock([]*common.Envelope{env}, num, nil)
+}
+
+func TestFootprint(t *testing.T) {
+	invoke := newFootprint(blockWithWrites(t, 2, "tx2", "foo", "key"))
+	configBlock, _ := test.MakeGenesisBlock("mychannel")
+
+	for _, testCase := range []struct {
+		name      string
+		preceding *common.Block
+		depends   bool
+	}{
+		{"Upgrade of the invoked chaincode", blockWithWrites(t, 1, "tx1", "lscc", "foo"), true},
+		{"Collection configuration of the invoked chaincode", blockWithWrites(t, 1, "tx1", "lscc", "foo~collection"), true},
+		{"Upgrade of another chaincode", blockWithWrites(t, 1, "tx1", "lscc", "bar"), false},
+		{"Same key in the namespace of the chaincode", blockWithWrites(t, 1, "tx1", "foo", "key"), false},
+		{"Same transaction identifier", blockWithWrites(t, 1, "tx2", "bar", "key"), true},
+		{"Configuration block", configBlock, true},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.depends, invoke.dependsOn(newFootprint(testCase.preceding)))
+		})
+	}
+
+	// lscc transactions depend on the definitions they replace
+	deploy := newFootprint(blockWithWrites(t, 2, "tx2", "lscc", "bar"))
+	assert.True(t, deploy.dependsOn(newFootprint(blockWithWrites(t, 1, "tx1", "lscc", "bar"))))
+	assert.False(t, deploy.dependsOn(newFootprint(blockWithWrites(t, 1, "tx1", "lscc", "baz"))))
+
+	// blocks that follow a configuration block depend on it
+	assert.True(t, newFootprint(configBlock).dependsOn(invoke))
+
+	// transactions that cannot be parsed are left out
+	fp := newFootprint(&common.Block{Data: &common.BlockData{Data: [][]byte{[]byte("garbage")}}})
+	assert.False(t, fp.barrier)
+	assert.Empty(t, fp.txIDs)
+	assert.Empty(t, fp.reads)
+}
+
+type mockValidator struct {
+	sync.Mutex
+	validated []uint64
+	err       error
+}
+
+func (v *mockValidator) Validate(block *common.Block) error {
+	v.Lock()
+	defer v.Unlock()
+	v.validated = append(v.validated, block.Header.Number)
+	return v.err
+}
+
+func (v *mockValidator) validatedBlocks() []uint64 {
+	v.Lock()
+	defer v.Unlock()
+	return append([]uint64{}, v.validated...)
+}
+
+type mockCommitter struct {
+	sync.Mutex
+	Committer
+	release   chan struct{}
+	committed []uint64
+	failOn    uint64
+}
+
+func (c *mockCommitter) CommitWithPvtData(blockAndPvtData *ledger2.BlockAndPvtData) error {
+	if c.release != nil {
+		<-c.release
+	}
+	c.Lock()
+	defer c.Unlock()
+	if c.failOn != 0 && blockAndPvtData.Block.Header.Number == c.failOn {
+		return errors.New("disk full")
+	}
+	c.committed = append(c.committed, blockAndPvtData.Block.Header.Number)
+	return nil
+}
+
+func (c *mockCommitter) committedBlocks() []uint64 {
+	c.Lock()
+	defer c.Unlock()
+	return append([]uint64{}, c.committed...)
+}
+
+func TestPipelinedCommitter(t *testing.T) {
+	v := &mockValidator{}
+	c := &mockCommitter{release: make(chan struct{})}
+	pc := NewPipelinedCommitter(c, v, 2)
+	defer pc.Close()
+
+	var onCommit []uint64
+	var prepared []uint64
+	submit := func(block *common.Block) error {
+		return pc.Submit(block, func(block *common.Block) (*ledger2.BlockAndPvtData, error) {
+			prepared = append(prepared, block.Header.Number)
+			return &ledger2.BlockAndPvtData{Block: block}, nil
+		}, func() {
+			onCommit = append(onCommit, block.Header.Number)
+		})
+	}
+
+	// block 2 is validated while block 1 is being committed
+	assert.NoError(t, submit(blockWithWrites(t, 1, "tx1", "lscc", "bar")))
+	assert.NoError(t, submit(blockWithWrites(t, 2, "tx2", "foo", "key")))
+	assert.Equal(t, []uint64{1, 2}, v.validatedBlocks())
+	assert.Equal(t, []uint64{1, 2}, prepared)
+	assert.Empty(t, c.committedBlocks())
+
+	// block 3 invokes the chaincode that block 1 upgrades,
+	// so it is validated only once block 1 is committed
+	submitted := make(chan error)
+	go func() {
+		submitted <- submit(blockWithWrites(t, 3, "tx3", "lscc", "baz", "bar", "key"))
+	}()
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, []uint64{1, 2}, v.validatedBlocks())
+
+	c.release <- struct{}{}
+	assert.NoError(t, <-submitted)
+	assert.Equal(t, []uint64{1, 2, 3}, v.validatedBlocks())
+	assert.Equal(t, []uint64{1}, c.committedBlocks())
+
+	close(c.release)
+	assert.NoError(t, pc.Flush())
+	assert.Equal(t, []uint64{1, 2, 3}, c.committedBlocks())
+	assert.Equal(t, []uint64{1, 2, 3}, onCommit)
+}
+
+func TestPipelinedCommitterFailures(t *testing.T) {
+	t.Run("Validation failure", func(t *testing.T) {
+		v := &mockValidator{err: errors.New("bad block")}
+		c := &mockCommitter{}
+		pc := NewPipelinedCommitter(c, v, 1)
+		defer pc.Close()
+		assert.EqualError(t, pc.Submit(blockWithWrites(t, 1, "tx1"), nil, nil), "bad block")
+		assert.NoError(t, pc.Flush())
+		assert.Empty(t, c.committedBlocks())
+	})
+
+	t.Run("Preparation failure", func(t *testing.T) {
+		c := &mockCommitter{}
+		pc := NewPipelinedCommitter(c, &mockValidator{}, 1)
+		defer pc.Close()
+		err := pc.Submit(blockWithWrites(t, 1, "tx1"), func(*common.Block) (*ledger2.BlockAndPvtData, error) {
+			return nil, errors.New("private data unavailable")
+		}, nil)
+		assert.EqualError(t, err, "private data unavailable")
+		assert.NoError(t, pc.Flush())
+		assert.Empty(t, c.committedBlocks())
+	})
+
+	t.Run("Commit failure", func(t *testing.T) {
+		c := &mockCommitter{failOn: 1, release: make(chan struct{})}
+		pc := NewPipelinedCommitter(c, &mockValidator{}, 2)
+		defer pc.Close()
+		assert.NoError(t, pc.Submit(blockWithWrites(t, 1, "tx1"), nil, nil))
+		assert.NoError(t, pc.Submit(blockWithWrites(t, 2, "tx2"), nil, nil))
+		close(c.release)
+		assert.EqualError(t, pc.Flush(), "failed committing block 1: disk full")
+		err := pc.Submit(blockWithWrites(t, 3, "tx3"), nil, nil)
+		assert.EqualError(t, err, "failed committing block 1: disk full")
+		// the block queued after the failed one is discarded
+		assert.Empty(t, c.committedBlocks())
+	})
+
+	t.Run("Closed", func(t *testing.T) {
+		c := &mockCommitter{}
+		pc := NewPipelinedCommitter(c, &mockValidator{}, 1)
+		assert.NoError(t, pc.Submit(blockWithWrites(t, 1, "tx1"), nil, nil))
+		pc.Close()
+		pc.Close()
+		assert.Equal(t, []uint64{1}, c.committedBlocks())
+		err := pc.Submit(blockWithWrites(t, 2, "tx2"), nil, nil)
+		assert.EqualError(t, err, "pipelined committer is closed")
+	})
+}
+
+// the number of transactions in each block of the benchmarks
+const benchmarkBlockSize = 10
+
+// noopValidationPlugin accepts every transaction, so that the benchmarks
+// measure the validation and commit performed by the peer itself
+type noopValidationPlugin struct{}
+
+func (*noopValidationPlugin) New() validation.Plugin {
+	return &noopValidationPlugin{}
+}
+
+func (*noopValidationPlugin) Init(dependencies ...validation.Dependency) error {
+	return nil
+}
+
+func (*noopValidationPlugin) Validate(block *common.Block, namespace string, txPosition int, actionPosition int, contextData ...validation.ContextDatum) error {
+	return nil
+}
+
+// setupBenchmarkLedger creates a ledger in which chaincode foo is instantiated,
+// and returns it along with a committer and a transaction validator of it
+func setupBenchmarkLedger(b *testing.B) (ledger2.PeerLedger, Committer, txvalidator.Validator) {
+	if err := msptesttools.LoadMSPSetupForTesting(); err != nil {
+		b.Fatal(err)
+	}
+	sysccprovider.RegisterSystemChaincodeProviderFactory(&scc.MocksccProviderFactory{})
+	viper.Set("peer.fileSystemPath", "/tmp/fabric/committerbenchmark")
+	ledgermgmt.InitializeTestEnv()
+	gb, _ := test.MakeGenesisBlock("TestLedger")
+	lgr, err := ledgermgmt.CreateLedger(gb)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	cd := &ccprovider.ChaincodeData{
+		Name:    "foo",
+		Version: "v1",
+		Vscc:    "vscc",
+		Policy:  utils.MarshalOrPanic(cauthdsl.SignedByAnyMember([]string{"DEFAULT"})),
+	}
+	rwsetBuilder := rwsetutil.NewRWSetBuilder()
+	rwsetBuilder.AddToWriteSet("lscc", "foo", utils.MarshalOrPanic(cd))
+	simRes, err := rwsetBuilder.GetTxSimulationResults()
+	if err != nil {
+		b.Fatal(err)
+	}
+	simResBytes, err := simRes.GetPubSimulationBytes()
+	if err != nil {
+		b.Fatal(err)
+	}
+	env, _, err := testutil.ConstructTransaction(nil, simResBytes, "", true)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if err := lgr.CommitWithPvtData(&ledger2.BlockAndPvtData{Block: testutil.NewBlock([]*common.Envelope{env}, 1, gb.Header.Hash())}); err != nil {
+		b.Fatal(err)
+	}
+
+	support := struct {
+		*mocktxvalidator.Support
+		*semaphore.Weighted
+	}{&mocktxvalidator.Support{LedgerVal: lgr, ACVal: &mockconfig.MockApplicationCapabilities{}}, semaphore.NewWeighted(benchmarkBlockSize)}
+	v := txvalidator.NewTxValidator("TestLedger", support, txvalidator.MapBasedPluginMapper{"vscc": &noopValidationPlugin{}})
+	return lgr, NewLedgerCommitter(lgr), v
+}
+
+// benchmarkBlocks constructs b.N blocks of signed transactions of chaincode foo that follow
+// the block that instantiated it. If conflicting is set, every block also writes the
+// definition of foo, so each block can be validated only once its predecessor is committed
+func benchmarkBlocks(b *testing.B, lgr ledger2.PeerLedger, conflicting bool) []*common.Block {
+	bcInfo, err := lgr.GetBlockchainInfo()
+	if err != nil {
+		b.Fatal(err)
+	}
+	blocks := make([]*common.Block, b.N)
+	prevHash := bcInfo.CurrentBlockHash
+	for i := range blocks {
+		var envs []*common.Envelope
+		for j := 0; j < benchmarkBlockSize; j++ {
+			rwsetBuilder := rwsetutil.NewRWSetBuilder()
+			rwsetBuilder.AddToWriteSet("foo", fmt.Sprintf("key%d_%d", i, j), []byte("value"))
+			if conflicting && j == 0 {
+				rwsetBuilder.AddToWriteSet("lscc", "foo", []byte("definition"))
+			}
+			simRes, err := rwsetBuilder.GetTxSimulationResults()
+			if err != nil {
+				b.Fatal(err)
+			}
+			simResBytes, err := simRes.GetPubSimulationBytes()
+			if err != nil {
+				b.Fatal(err)
+			}
+			env, _, err := testutil.ConstructTransaction(nil, simResBytes, "", true)
+			if err != nil {
+				b.Fatal(err)
+			}
+			envs = append(envs, env)
+		}
+		blocks[i] = testutil.NewBlock(envs, bcInfo.Height+uint64(i), prevHash)
+		prevHash = blocks[i].Header.Hash()
+	}
+	return blocks
+}
+
+func BenchmarkSerialCommit(b *testing.B) {
+	lgr, c, v := setupBenchmarkLedger(b)
+	defer ledgermgmt.CleanupTestEnv()
+	defer lgr.Close()
+	blocks := benchmarkBlocks(b, lgr, false)
+	b.ResetTimer()
+	for _, block := range blocks {
+		if err := v.Validate(block); err != nil {
+			b.Fatal(err)
+		}
+		if err := c.CommitWithPvtData(&ledger2.BlockAndPvtData{Block: block}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchmarkPipelinedCommit(b *testing.B, conflicting bool) {
+	lgr, c, v := setupBenchmarkLedger(b)
+	defer ledgermgmt.CleanupTestEnv()
+	defer lgr.Close()
+	blocks := benchmarkBlocks(b, lgr, conflicting)
+	pc := NewPipelinedCommitter(c, v, 2)
+	defer pc.Close()
+	b.ResetTimer()
+	for _, block := range blocks {
+		if err := pc.Submit(block, nil, nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+	if err := pc.Flush(); err != nil {
+		b.Fatal(err)
+	}
+}
+
+func BenchmarkPipelinedCommit(b *testing.B) {
+	benchmarkPipelinedCommit(b, false)
+}
+
+func BenchmarkPipelinedCommitConflicting(b *testing.B) {
+	benchmarkPipelinedCommit(b, true)
+}
@@ -0,0 +1,255 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package txvalidator
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/core/handlers/validation"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/msp"
+	"github.com/hyperledger/fabric/protos/common"
+	mspproto "github.com/hyperledger/fabric/protos/msp"
+	"github.com/pkg/errors"
+)
+
+// PluginName defines the name of the plugin as it appears in the configuration
+type PluginName string
+
+// PluginMapper maps plugin names to their corresponding factories
+type PluginMapper interface {
+	PluginFactoryByName(name PluginName) validation.PluginFactory
+}
+
+// MapBasedPluginMapper maps plugin names to their corresponding factories
+type MapBasedPluginMapper map[string]validation.PluginFactory
+
+// PluginFactoryByName returns a plugin factory for the given plugin name, or nil if not found
+func (m MapBasedPluginMapper) PluginFactoryByName(name PluginName) validation.PluginFactory {
+	return m[string(name)]
+}
+
+// QueryExecutorCreator creates query executors
+type QueryExecutorCreator interface {
+	NewQueryExecutor() (ledger.QueryExecutor, error)
+}
+
+// Context defines information about a transaction
+// that is being validated
+type Context struct {
+	Seq       int
+	Envelope  []byte
+	TxID      string
+	Channel   string
+	VSCCName  string
+	Policy    []byte
+	Namespace string
+	Block     *common.Block
+}
+
+// String returns a string representation of this Context
+func (c Context) String() string {
+	return fmt.Sprintf("Tx %s, seq %d out of %d in block %d for channel %s with validation plugin %s", c.TxID, c.Seq, len(c.Block.Data.Data), c.Block.Header.Number, c.Channel, c.VSCCName)
+}
+
+// NewPluginValidator creates a new PluginValidator
+func NewPluginValidator(pm PluginMapper, qec QueryExecutorCreator, deserializer msp.IdentityDeserializer, capabilities validation.Capabilities) *PluginValidator {
+	return &PluginValidator{
+		capabilities:         capabilities,
+		pluginChannelMapping: make(map[PluginName]*pluginsByChannel),
+		PluginMapper:         pm,
+		QueryExecutorCreator: qec,
+		IdentityDeserializer: deserializer,
+	}
+}
+
+// PluginValidator validates transactions in-process with validation plugins,
+// creating an instance of each plugin for every channel it validates on
+type PluginValidator struct {
+	sync.Mutex
+	pluginChannelMapping map[PluginName]*pluginsByChannel
+	PluginMapper
+	QueryExecutorCreator
+	msp.IdentityDeserializer
+	capabilities validation.Capabilities
+}
+
+// ValidateWithPlugin validates the transaction described by the given context with
+// the plugin it names. Returns a *validation.ExecutionFailureError if the plugin
+// couldn't be used, or the error returned by the plugin itself
+func (pv *PluginValidator) ValidateWithPlugin(ctx *Context) error {
+	plugin, err := pv.getOrCreatePlugin(ctx)
+	if err != nil {
+		return &validation.ExecutionFailureError{
+			Reason: fmt.Sprintf("plugin with name %s couldn't be used: %v", ctx.VSCCName, err),
+		}
+	}
+	err = plugin.Validate(ctx.Block, ctx.Namespace, ctx.Seq, 0, SerializedPolicy(ctx.Policy))
+	validityStatus := "valid"
+	if err != nil {
+		validityStatus = fmt.Sprintf("invalid: %v", err)
+	}
+	logger.Debug("Transaction", ctx.TxID, "appears to be", validityStatus)
+	return err
+}
+
+func (pv *PluginValidator) getOrCreatePlugin(ctx *Context) (validation.Plugin, error) {
+	pluginFactory := pv.PluginFactoryByName(PluginName(ctx.VSCCName))
+	if pluginFactory == nil {
+		return nil, errors.Errorf("plugin with name %s wasn't found", ctx.VSCCName)
+	}
+
+	pluginsByChannel := pv.getOrCreatePluginChannelMapping(PluginName(ctx.VSCCName), pluginFactory)
+	return pluginsByChannel.createPluginIfAbsent(ctx.Channel)
+}
+
+func (pv *PluginValidator) getOrCreatePluginChannelMapping(plugin PluginName, pf validation.PluginFactory) *pluginsByChannel {
+	pv.Lock()
+	defer pv.Unlock()
+	channelMapping, exists := pv.pluginChannelMapping[plugin]
+	if !exists {
+		channelMapping = &pluginsByChannel{
+			pluginFactory:    pf,
+			channels2Plugins: make(map[string]validation.Plugin),
+			pv:               pv,
+		}
+		pv.pluginChannelMapping[plugin] = channelMapping
+	}
+	return channelMapping
+}
+
+type pluginsByChannel struct {
+	sync.RWMutex
+	pluginFactory    validation.PluginFactory
+	channels2Plugins map[string]validation.Plugin
+	pv               *PluginValidator
+}
+
+func (pbc *pluginsByChannel) createPluginIfAbsent(channel string) (validation.Plugin, error) {
+	pbc.RLock()
+	plugin, exists := pbc.channels2Plugins[channel]
+	pbc.RUnlock()
+	if exists {
+		return plugin, nil
+	}
+
+	pbc.Lock()
+	defer pbc.Unlock()
+	plugin, exists = pbc.channels2Plugins[channel]
+	if exists {
+		return plugin, nil
+	}
+
+	pluginInstance := pbc.pluginFactory.New()
+	pe := &PolicyEvaluator{IdentityDeserializer: pbc.pv.IdentityDeserializer}
+	sf := &StateFetcherImpl{QueryExecutorCreator: pbc.pv.QueryExecutorCreator}
+	if err := pluginInstance.Init(pe, sf, pbc.pv.capabilities); err != nil {
+		return nil, errors.Wrap(err, "failed initializing plugin")
+	}
+	pbc.channels2Plugins[channel] = pluginInstance
+	return pluginInstance, nil
+}
+
+// SerializedPolicy defines a marshaled policy
+type SerializedPolicy []byte
+
+// Bytes returns the bytes of the SerializedPolicy
+func (sp SerializedPolicy) Bytes() []byte {
+	return sp
+}
+
+// PolicyEvaluator evaluates policies against the identities
+// that the given IdentityDeserializer deserializes
+type PolicyEvaluator struct {
+	msp.IdentityDeserializer
+}
+
+// Evaluate takes a set of SignedData and evaluates whether this set of signatures satisfies
+// the policy with the given bytes
+func (pe *PolicyEvaluator) Evaluate(policyBytes []byte, signatureSet []*common.SignedData) error {
+	pp := cauthdsl.NewPolicyProvider(pe.IdentityDeserializer)
+	policy, _, err := pp.NewPolicy(policyBytes)
+	if err != nil {
+		return err
+	}
+	return policy.Evaluate(signatureSet)
+}
+
+// StateFetcherImpl fetches a read-only view of the
+// committed state out of new query executors
+type StateFetcherImpl struct {
+	QueryExecutorCreator
+}
+
+// FetchState fetches state
+func (sf *StateFetcherImpl) FetchState() (validation.State, error) {
+	qe, err := sf.NewQueryExecutor()
+	if err != nil {
+		return nil, err
+	}
+	return qe, nil
+}
+
+// dynamicDeserializer deserializes identities with the
+// current MSP manager of the channel
+type dynamicDeserializer struct {
+	support Support
+}
+
+func (ds *dynamicDeserializer) DeserializeIdentity(serializedIdentity []byte) (msp.Identity, error) {
+	return ds.support.MSPManager().DeserializeIdentity(serializedIdentity)
+}
+
+func (ds *dynamicDeserializer) IsWellFormed(identity *mspproto.SerializedIdentity) error {
+	return ds.support.MSPManager().IsWellFormed(identity)
+}
+
+// dynamicCapabilities reports the current
+// application capabilities of the channel
+type dynamicCapabilities struct {
+	support Support
+}
+
+func (ds *dynamicCapabilities) Supported() error {
+	return ds.support.Capabilities().Supported()
+}
+
+func (ds *dynamicCapabilities) ForbidDuplicateTXIdInBlock() bool {
+	return ds.support.Capabilities().ForbidDuplicateTXIdInBlock()
+}
+
+func (ds *dynamicCapabilities) ResourcesTree() bool {
+	return ds.support.Capabilities().ResourcesTree()
+}
+
+func (ds *dynamicCapabilities) PrivateChannelData() bool {
+	return ds.support.Capabilities().PrivateChannelData()
+}
+
+func (ds *dynamicCapabilities) V1_1Validation() bool {
+	return ds.support.Capabilities().V1_1Validation()
+}
+
+func (ds *dynamicCapabilities) ChaincodeCallsValidation() bool {
+	return ds.support.Capabilities().ChaincodeCallsValidation()
+}
+
+// ledgerQueryExecutorCreator creates query executors
+// out of the current ledger of the channel
+type ledgerQueryExecutorCreator struct {
+	support Support
+}
+
+func (qec *ledgerQueryExecutorCreator) NewQueryExecutor() (ledger.QueryExecutor, error) {
+	l := qec.support.Ledger()
+	if l == nil {
+		return nil, errors.New("nil ledger instance")
+	}
+	return l.NewQueryExecutor()
+}
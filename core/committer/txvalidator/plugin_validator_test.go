@@ -0,0 +1,175 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package txvalidator
+
+import (
+	"sync"
+	"testing"
+
+	mockconfig "github.com/hyperledger/fabric/common/mocks/config"
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/core/handlers/validation"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/msp/mgmt"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingPlugin struct {
+	dependencies []validation.Dependency
+	namespace    string
+	txPosition   int
+	policy       []byte
+}
+
+func (p *recordingPlugin) Validate(block *common.Block, namespace string, txPosition int, actionPosition int, contextData ...validation.ContextDatum) error {
+	p.namespace = namespace
+	p.txPosition = txPosition
+	p.policy = contextData[0].(validation.SerializedPolicy).Bytes()
+	if p.namespace == "invalid" {
+		return errors.New("invalid transaction")
+	}
+	return nil
+}
+
+func (p *recordingPlugin) Init(dependencies ...validation.Dependency) error {
+	p.dependencies = dependencies
+	return nil
+}
+
+type recordingPluginFactory struct {
+	sync.Mutex
+	plugins []*recordingPlugin
+	initErr error
+}
+
+func (pf *recordingPluginFactory) New() validation.Plugin {
+	pf.Lock()
+	defer pf.Unlock()
+	if pf.initErr != nil {
+		return &failingPlugin{err: pf.initErr}
+	}
+	p := &recordingPlugin{}
+	pf.plugins = append(pf.plugins, p)
+	return p
+}
+
+type failingPlugin struct {
+	err error
+}
+
+func (p *failingPlugin) Validate(block *common.Block, namespace string, txPosition int, actionPosition int, contextData ...validation.ContextDatum) error {
+	panic("should not be invoked")
+}
+
+func (p *failingPlugin) Init(dependencies ...validation.Dependency) error {
+	return p.err
+}
+
+func TestValidateWithPlugin(t *testing.T) {
+	block := &common.Block{
+		Header: &common.BlockHeader{Number: 5},
+		Data:   &common.BlockData{Data: [][]byte{[]byte("tx0"), []byte("tx1")}},
+	}
+	ctx := func(channel, vsccName, namespace string) *Context {
+		return &Context{
+			Seq:       1,
+			Envelope:  block.Data.Data[1],
+			Block:     block,
+			TxID:      "tx1",
+			Channel:   channel,
+			Namespace: namespace,
+			Policy:    []byte("policy"),
+			VSCCName:  vsccName,
+		}
+	}
+
+	pf := &recordingPluginFactory{}
+	failing := &recordingPluginFactory{initErr: errors.New("bad dependencies")}
+	capabilities := &mockconfig.MockApplicationCapabilities{}
+	pv := NewPluginValidator(MapBasedPluginMapper{"vscc": pf, "failing": failing}, nil, nil, capabilities)
+
+	t.Run("Plugin not found", func(t *testing.T) {
+		err := pv.ValidateWithPlugin(ctx("mychannel", "missing", "mycc"))
+		assert.IsType(t, &validation.ExecutionFailureError{}, err)
+		assert.Contains(t, err.Error(), "plugin with name missing couldn't be used: plugin with name missing wasn't found")
+	})
+
+	t.Run("Plugin initialization fails", func(t *testing.T) {
+		err := pv.ValidateWithPlugin(ctx("mychannel", "failing", "mycc"))
+		assert.IsType(t, &validation.ExecutionFailureError{}, err)
+		assert.Contains(t, err.Error(), "failed initializing plugin: bad dependencies")
+	})
+
+	t.Run("Valid transaction", func(t *testing.T) {
+		err := pv.ValidateWithPlugin(ctx("mychannel", "vscc", "mycc"))
+		assert.NoError(t, err)
+		assert.Len(t, pf.plugins, 1)
+		plugin := pf.plugins[0]
+		assert.Equal(t, "mycc", plugin.namespace)
+		assert.Equal(t, 1, plugin.txPosition)
+		assert.Equal(t, []byte("policy"), plugin.policy)
+		assert.Len(t, plugin.dependencies, 3)
+		assert.IsType(t, &PolicyEvaluator{}, plugin.dependencies[0])
+		assert.IsType(t, &StateFetcherImpl{}, plugin.dependencies[1])
+		assert.Equal(t, capabilities, plugin.dependencies[2])
+	})
+
+	t.Run("Invalid transaction", func(t *testing.T) {
+		err := pv.ValidateWithPlugin(ctx("mychannel", "vscc", "invalid"))
+		assert.EqualError(t, err, "invalid transaction")
+	})
+
+	t.Run("One instance per channel", func(t *testing.T) {
+		err := pv.ValidateWithPlugin(ctx("otherchannel", "vscc", "mycc"))
+		assert.NoError(t, err)
+		err = pv.ValidateWithPlugin(ctx("otherchannel", "vscc", "mycc"))
+		assert.NoError(t, err)
+		assert.Len(t, pf.plugins, 2)
+	})
+}
+
+func TestPolicyEvaluator(t *testing.T) {
+	pe := &PolicyEvaluator{IdentityDeserializer: mgmt.GetManagerForChain(util.GetTestChainID())}
+
+	msg := []byte("msg")
+	sig, err := signer.Sign(msg)
+	assert.NoError(t, err)
+	signatureSet := []*common.SignedData{{Data: msg, Identity: signerSerialized, Signature: sig}}
+
+	err = pe.Evaluate(signedByAnyMember([]string{"DEFAULT"}), signatureSet)
+	assert.NoError(t, err)
+
+	err = pe.Evaluate(signedByAnyMember([]string{"OTHER"}), signatureSet)
+	assert.Error(t, err)
+
+	err = pe.Evaluate([]byte("not a policy"), signatureSet)
+	assert.Error(t, err)
+}
+
+type mockQueryExecutorCreator struct {
+	qe  ledger.QueryExecutor
+	err error
+}
+
+func (qec *mockQueryExecutorCreator) NewQueryExecutor() (ledger.QueryExecutor, error) {
+	return qec.qe, qec.err
+}
+
+func TestStateFetcherImpl(t *testing.T) {
+	sf := &StateFetcherImpl{QueryExecutorCreator: &mockQueryExecutorCreator{err: errors.New("ledger closed")}}
+	state, err := sf.FetchState()
+	assert.EqualError(t, err, "ledger closed")
+	assert.Nil(t, state)
+
+	qe := &mockQueryExecutor{}
+	sf = &StateFetcherImpl{QueryExecutorCreator: &mockQueryExecutorCreator{qe: qe}}
+	state, err = sf.FetchState()
+	assert.NoError(t, err)
+	assert.Equal(t, qe, state)
+}
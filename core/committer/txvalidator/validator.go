@@ -19,10 +19,10 @@ import (
 	"github.com/hyperledger/fabric/common/ledger/blkstorage"
 	"github.com/hyperledger/fabric/common/resourcesconfig"
 	coreUtil "github.com/hyperledger/fabric/common/util"
-	"github.com/hyperledger/fabric/core/chaincode/shim"
 	"github.com/hyperledger/fabric/core/common/ccprovider"
 	"github.com/hyperledger/fabric/core/common/sysccprovider"
 	"github.com/hyperledger/fabric/core/common/validation"
+	hvalidation "github.com/hyperledger/fabric/core/handlers/validation"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
 	ledgerUtil "github.com/hyperledger/fabric/core/ledger/util"
@@ -79,15 +79,16 @@ type Validator interface {
 // and vscc execution, in order to increase
 // testability of txValidator
 type vsccValidator interface {
-	VSCCValidateTx(payload *common.Payload, envBytes []byte, env *common.Envelope) (error, peer.TxValidationCode)
+	VSCCValidateTx(seq int, payload *common.Payload, envBytes []byte, block *common.Block) (error, peer.TxValidationCode)
 }
 
-// vsccValidator implementation which used to call
-// vscc chaincode and validate block transactions
+// vsccValidator implementation which validates block
+// transactions with the validation plugins named by
+// the definitions of the chaincodes they write to
 type vsccValidatorImpl struct {
-	support     Support
-	ccprovider  ccprovider.ChaincodeProvider
-	sccprovider sysccprovider.SystemChaincodeProvider
+	support         Support
+	sccprovider     sysccprovider.SystemChaincodeProvider
+	pluginValidator *PluginValidator
 }
 
 // implementation of Validator interface, keeps
@@ -122,14 +123,17 @@ type blockValidationResult struct {
 	txid                 string
 }
 
-// NewTxValidator creates new transactions validator
-func NewTxValidator(chainID string, support Support) Validator {
+// NewTxValidator creates new transactions validator, which validates
+// transactions with the plugins that the given PluginMapper maps to
+func NewTxValidator(chainID string, support Support, pm PluginMapper) Validator {
 	// Encapsulates interface implementation
+	pluginValidator := NewPluginValidator(pm, &ledgerQueryExecutorCreator{support: support},
+		&dynamicDeserializer{support: support}, &dynamicCapabilities{support: support})
 	return &txValidator{chainID, support,
 		&vsccValidatorImpl{
-			support:     support,
-			ccprovider:  ccprovider.GetChaincodeProvider(),
-			sccprovider: sysccprovider.GetSystemChaincodeProvider()}}
+			support:         support,
+			sccprovider:     sysccprovider.GetSystemChaincodeProvider(),
+			pluginValidator: pluginValidator}}
 }
 
 func (v *txValidator) chainExists(chain string) bool {
@@ -366,7 +370,7 @@ func validateTx(req *blockValidationRequest, results chan<- *blockValidationResu
 
 			// Validate tx with vscc and policy
 			logger.Debug("Validating transaction vscc tx validate")
-			err, cde := v.vscc.VSCCValidateTx(payload, d, env)
+			err, cde := v.vscc.VSCCValidateTx(tIdx, payload, d, block)
 			if err != nil {
 				logger.Errorf("VSCCValidateTx for transaction txId = %s returned error: %s", txID, err)
 				switch err.(type) {
@@ -664,9 +668,9 @@ func (v *vsccValidatorImpl) txWritesToNamespace(ns *rwsetutil.NsRwSet) bool {
 	return false
 }
 
-func (v *vsccValidatorImpl) VSCCValidateTx(payload *common.Payload, envBytes []byte, env *common.Envelope) (error, peer.TxValidationCode) {
-	logger.Debugf("VSCCValidateTx starts for env %p envbytes %p", env, envBytes)
-	defer logger.Debugf("VSCCValidateTx completes for env %p envbytes %p", env, envBytes)
+func (v *vsccValidatorImpl) VSCCValidateTx(seq int, payload *common.Payload, envBytes []byte, block *common.Block) (error, peer.TxValidationCode) {
+	logger.Debugf("VSCCValidateTx starts for bytes %p", envBytes)
+	defer logger.Debugf("VSCCValidateTx completes for bytes %p", envBytes)
 
 	// get header extensions so we have the chaincode ID
 	hdrExt, err := utils.GetChaincodeHeaderExtension(payload.Header)
@@ -792,7 +796,17 @@ func (v *vsccValidatorImpl) VSCCValidateTx(payload *common.Payload, envBytes []b
 			}
 
 			// do VSCC validation
-			if err = v.VSCCValidateTxForCC(envBytes, chdr.TxId, chdr.ChannelId, vscc.ChaincodeName, vscc.ChaincodeVersion, policy); err != nil {
+			ctx := &Context{
+				Seq:       seq,
+				Envelope:  envBytes,
+				Block:     block,
+				TxID:      chdr.TxId,
+				Channel:   chdr.ChannelId,
+				Namespace: ns,
+				Policy:    policy,
+				VSCCName:  vscc.ChaincodeName,
+			}
+			if err = v.VSCCValidateTxForCC(ctx); err != nil {
 				switch err.(type) {
 				case *commonerrors.VSCCEndorsementPolicyError:
 					return err, peer.TxValidationCode_ENDORSEMENT_POLICY_FAILURE
@@ -801,6 +815,14 @@ func (v *vsccValidatorImpl) VSCCValidateTx(payload *common.Payload, envBytes []b
 				}
 			}
 		}
+
+		// validate the chaincode-to-chaincode calls performed by the
+		// invoked chaincode, if the channel is configured to do so
+		if v.support.Capabilities().ChaincodeCallsValidation() {
+			if err, code := v.validateChaincodeCalls(seq, envBytes, block, chdr, respPayload.Calls, wrNamespace); err != nil {
+				return err, code
+			}
+		}
 	} else {
 		// make sure that we can invoke this system chaincode - if the chaincode
 		// cannot be invoked through a proposal to this peer, we have to drop the
@@ -823,7 +845,17 @@ func (v *vsccValidatorImpl) VSCCValidateTx(payload *common.Payload, envBytes []b
 		// currently, VSCC does custom validation for LSCC only; if an hlf
 		// user creates a new system chaincode which is invokable from the outside
 		// they have to modify VSCC to provide appropriate validation
-		if err = v.VSCCValidateTxForCC(envBytes, chdr.TxId, vscc.ChainID, vscc.ChaincodeName, vscc.ChaincodeVersion, policy); err != nil {
+		ctx := &Context{
+			Seq:       seq,
+			Envelope:  envBytes,
+			Block:     block,
+			TxID:      chdr.TxId,
+			Channel:   vscc.ChainID,
+			Namespace: ccID,
+			Policy:    policy,
+			VSCCName:  vscc.ChaincodeName,
+		}
+		if err = v.VSCCValidateTxForCC(ctx); err != nil {
 			switch err.(type) {
 			case *commonerrors.VSCCEndorsementPolicyError:
 				return err, peer.TxValidationCode_ENDORSEMENT_POLICY_FAILURE
@@ -836,44 +868,136 @@ func (v *vsccValidatorImpl) VSCCValidateTx(payload *common.Payload, envBytes []b
 	return nil, peer.TxValidationCode_VALID
 }
 
-func (v *vsccValidatorImpl) VSCCValidateTxForCC(envBytes []byte, txid, chid, vsccName, vsccVer string, policy []byte) error {
-	logger.Debugf("VSCCValidateTxForCC starts for envbytes %p", envBytes)
-	defer logger.Debugf("VSCCValidateTxForCC completes for envbytes %p", envBytes)
-	ctxt, txsim, err := v.ccprovider.GetContext(v.support.Ledger(), txid)
-	if err != nil {
-		msg := fmt.Sprintf("Cannot obtain context for txid=%s, err: %s", txid, err)
-		logger.Errorf(msg)
-		return &commonerrors.VSCCExecutionFailureError{Reason: msg}
+// validateChaincodeCalls validates the chaincode-to-chaincode calls recorded in the
+// ChaincodeAction of a transaction: the chaincodes invoked on this channel must match
+// the version in lscc and their endorsement policies must be satisfied, even if they
+// did not write to the ledger; the reads performed on other channels must be consistent
+// with the height recorded by the endorsers (see validateCrossChannelReads)
+func (v *vsccValidatorImpl) validateChaincodeCalls(seq int, envBytes []byte, block *common.Block, chdr *common.ChannelHeader, calls []*peer.ChaincodeCall, wrNamespace []string) (error, peer.TxValidationCode) {
+	// the namespaces we write to have already been validated against their policy
+	validated := make(map[string]bool)
+	for _, ns := range wrNamespace {
+		validated[ns] = true
 	}
-	defer txsim.Done()
 
-	// build arguments for VSCC invocation
-	// args[0] - function name (not used now)
-	// args[1] - serialized Envelope
-	// args[2] - serialized policy
-	args := [][]byte{[]byte(""), envBytes, policy}
+	for _, call := range calls {
+		if call.ChaincodeId == nil || call.ChaincodeId.Name == "" {
+			return errors.New("invalid chaincode ID in chaincode call"), peer.TxValidationCode_INVALID_OTHER_REASON
+		}
+		ccName := call.ChaincodeId.Name
+
+		if call.ChannelId != chdr.ChannelId {
+			if err := v.validateCrossChannelReads(chdr.TxId, call); err != nil {
+				switch err.(type) {
+				case *commonerrors.VSCCInfoLookupFailureError:
+					return err, peer.TxValidationCode_INVALID_OTHER_REASON
+				default:
+					return err, peer.TxValidationCode_INVALID_CROSS_CHANNEL_READ
+				}
+			}
+			continue
+		}
+
+		// system chaincodes have no endorsement policy to speak of
+		if v.sccprovider.IsSysCC(ccName) {
+			continue
+		}
+
+		// Get latest chaincode version, vscc and validate policy
+		txcc, vscc, policy, err := v.GetInfoForValidate(chdr.TxId, chdr.ChannelId, ccName)
+		if err != nil {
+			logger.Errorf("GetInfoForValidate for txId = %s returned error: %+v", chdr.TxId, err)
+			return err, peer.TxValidationCode_INVALID_OTHER_REASON
+		}
 
-	// get context to invoke VSCC
-	vscctxid := coreUtil.GenerateUUID()
-	cccid := v.ccprovider.GetCCContext(chid, vsccName, vsccVer, vscctxid, true, nil, nil)
+		if txcc.ChaincodeVersion != call.ChaincodeId.Version {
+			err = errors.Errorf("invoked chaincode %s:%s/%s didn't match %s:%s/%s in lscc", ccName, call.ChaincodeId.Version, chdr.ChannelId, txcc.ChaincodeName, txcc.ChaincodeVersion, chdr.ChannelId)
+			logger.Errorf("%+v", err)
+			return err, peer.TxValidationCode_EXPIRED_CHAINCODE
+		}
 
-	// invoke VSCC
-	logger.Debug("Invoking VSCC txid", txid, "chaindID", chid)
-	res, _, err := v.ccprovider.ExecuteChaincode(ctxt, cccid, args)
-	if err != nil {
-		msg := fmt.Sprintf("Invoke VSCC failed for transaction txid=%s, error: %s", txid, err)
-		return &commonerrors.VSCCExecutionFailureError{Reason: msg}
+		if validated[ccName] {
+			continue
+		}
+		validated[ccName] = true
+
+		// do VSCC validation
+		ctx := &Context{
+			Seq:       seq,
+			Envelope:  envBytes,
+			Block:     block,
+			TxID:      chdr.TxId,
+			Channel:   chdr.ChannelId,
+			Namespace: ccName,
+			Policy:    policy,
+			VSCCName:  vscc.ChaincodeName,
+		}
+		if err = v.VSCCValidateTxForCC(ctx); err != nil {
+			switch err.(type) {
+			case *commonerrors.VSCCEndorsementPolicyError:
+				return err, peer.TxValidationCode_ENDORSEMENT_POLICY_FAILURE
+			default:
+				return err, peer.TxValidationCode_INVALID_OTHER_REASON
+			}
+		}
+	}
+
+	return nil, peer.TxValidationCode_VALID
+}
+
+// validateCrossChannelReads verifies the reads performed on another channel by a
+// chaincode-to-chaincode invocation. The ledger of that channel evolves independently
+// of this channel, and this peer may not even have joined it, so the outcome of the
+// validation depends only on what the transaction records, so that all the peers of
+// this channel reach the same outcome: the reads must be well formed, they must have
+// been performed at versions committed before the height recorded by the endorsers,
+// and the invocation must not have written to the other channel.
+func (v *vsccValidatorImpl) validateCrossChannelReads(txid string, call *peer.ChaincodeCall) error {
+	if len(call.Results) == 0 {
+		return nil
 	}
-	if res.Status != shim.OK {
-		if res.Status == IntermittentErrorCode {
-			return &commonerrors.VSCCExecutionFailureError{Reason: res.Message}
+
+	txRWSet := &rwsetutil.TxRwSet{}
+	if err := txRWSet.FromProtoBytes(call.Results); err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("invalid reads recorded for channel %s", call.ChannelId))
+	}
+
+	for _, ns := range txRWSet.NsRwSets {
+		if len(ns.KvRwSet.GetWrites()) > 0 {
+			return errors.Errorf("txid %s writes to namespace %s on channel %s", txid, ns.NameSpace, call.ChannelId)
+		}
+		for _, coll := range ns.CollHashedRwSets {
+			if len(coll.HashedRwSet.GetHashedWrites()) > 0 {
+				return errors.Errorf("txid %s writes to collection %s of namespace %s on channel %s", txid, coll.CollectionName, ns.NameSpace, call.ChannelId)
+			}
+		}
+		for _, read := range ns.KvRwSet.GetReads() {
+			if read.Version != nil && read.Version.BlockNum >= call.BlockHeight {
+				return errors.Errorf("read of key %s in namespace %s on channel %s at version %v is past the recorded height %d",
+					read.Key, ns.NameSpace, call.ChannelId, read.Version, call.BlockHeight)
+			}
 		}
-		return &commonerrors.VSCCEndorsementPolicyError{Reason: fmt.Sprintf("%s", res.Message)}
 	}
 
 	return nil
 }
 
+// VSCCValidateTxForCC validates the transaction described by the given
+// context with the validation plugin of the chaincode it writes to
+func (v *vsccValidatorImpl) VSCCValidateTxForCC(ctx *Context) error {
+	logger.Debug("Validating", ctx, "with plugin")
+	err := v.pluginValidator.ValidateWithPlugin(ctx)
+	if err == nil {
+		return nil
+	}
+	// if the plugin couldn't perform the validation, the block can't be committed;
+	// any other error means that the transaction doesn't satisfy the policy
+	if e, isExecutionError := err.(*hvalidation.ExecutionFailureError); isExecutionError {
+		return &commonerrors.VSCCExecutionFailureError{Reason: e.Reason}
+	}
+	return &commonerrors.VSCCEndorsementPolicyError{Reason: err.Error()}
+}
+
 func (v *vsccValidatorImpl) getCDataForCC(chid, ccid string) (resourcesconfig.ChaincodeDefinition, error) {
 	l := v.support.Ledger()
 	if l == nil {
@@ -22,14 +22,14 @@ import (
 	mockconfig "github.com/hyperledger/fabric/common/mocks/config"
 	"github.com/hyperledger/fabric/common/mocks/scc"
 	"github.com/hyperledger/fabric/common/util"
-	"github.com/hyperledger/fabric/core/chaincode/shim"
 	ccp "github.com/hyperledger/fabric/core/common/ccprovider"
 	"github.com/hyperledger/fabric/core/common/sysccprovider"
+	"github.com/hyperledger/fabric/core/handlers/validation"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
 	"github.com/hyperledger/fabric/core/ledger/ledgermgmt"
 	lutils "github.com/hyperledger/fabric/core/ledger/util"
-	"github.com/hyperledger/fabric/core/mocks/ccprovider"
 	mocktxvalidator "github.com/hyperledger/fabric/core/mocks/txvalidator"
 	"github.com/hyperledger/fabric/msp"
 	"github.com/hyperledger/fabric/msp/mgmt"
@@ -49,6 +49,10 @@ func signedByAnyMember(ids []string) []byte {
 }
 
 func setupLedgerAndValidator(t *testing.T) (ledger.PeerLedger, Validator) {
+	return setupLedgerAndValidatorWithCapabilities(t, &mockconfig.MockApplicationCapabilities{})
+}
+
+func setupLedgerAndValidatorWithCapabilities(t *testing.T, ac *mockconfig.MockApplicationCapabilities) (ledger.PeerLedger, Validator) {
 	viper.Set("peer.fileSystemPath", "/tmp/fabric/validatortest")
 	ledgermgmt.InitializeTestEnv()
 	gb, err := ctxt.MakeGenesisBlock("TestLedger")
@@ -58,8 +62,8 @@ func setupLedgerAndValidator(t *testing.T) (ledger.PeerLedger, Validator) {
 	vcs := struct {
 		*mocktxvalidator.Support
 		*semaphore.Weighted
-	}{&mocktxvalidator.Support{LedgerVal: theLedger, ACVal: &mockconfig.MockApplicationCapabilities{}}, semaphore.NewWeighted(10)}
-	theValidator := NewTxValidator("", vcs)
+	}{&mocktxvalidator.Support{LedgerVal: theLedger, ACVal: ac}, semaphore.NewWeighted(10)}
+	theValidator := NewTxValidator("", vcs, pluginMapper)
 
 	return theLedger, theValidator
 }
@@ -89,6 +93,10 @@ func getProposalWithType(ccID string, pType common.HeaderType) (*peer.Proposal,
 const ccVersion = "1.0"
 
 func getEnvWithType(ccID string, res []byte, pType common.HeaderType, t *testing.T) *common.Envelope {
+	return getEnvWithTypeAndCalls(ccID, res, nil, pType, t)
+}
+
+func getEnvWithTypeAndCalls(ccID string, res []byte, calls []*peer.ChaincodeCall, pType common.HeaderType, t *testing.T) *common.Envelope {
 	// get a toy proposal
 	prop, err := getProposalWithType(ccID, pType)
 	assert.NoError(t, err)
@@ -96,7 +104,7 @@ func getEnvWithType(ccID string, res []byte, pType common.HeaderType, t *testing
 	response := &peer.Response{Status: 200}
 
 	// endorse it to get a proposal response
-	presp, err := utils.CreateProposalResponse(prop.Header, prop.Payload, response, res, nil, &peer.ChaincodeID{Name: ccID, Version: ccVersion}, nil, signer)
+	presp, err := utils.CreateProposalResponseWithCalls(prop.Header, prop.Payload, response, res, nil, &peer.ChaincodeID{Name: ccID, Version: ccVersion}, calls, nil, signer)
 	assert.NoError(t, err)
 
 	// assemble a transaction from that proposal and endorsement
@@ -130,7 +138,9 @@ func putCCInfoWithVSCCAndVer(theLedger ledger.PeerLedger, ccname, vscc, ver stri
 	assert.NoError(t, err)
 	pubSimulationBytes, err := simRes.GetPubSimulationBytes()
 	assert.NoError(t, err)
-	block0 := testutil.ConstructBlock(t, 1, []byte("hash"), [][]byte{pubSimulationBytes}, true)
+	bcInfo, err := theLedger.GetBlockchainInfo()
+	assert.NoError(t, err)
+	block0 := testutil.ConstructBlock(t, bcInfo.Height, bcInfo.CurrentBlockHash, [][]byte{pubSimulationBytes}, true)
 	err = theLedger.CommitWithPvtData(&ledger.BlockAndPvtData{
 		Block: block0,
 	})
@@ -225,10 +235,12 @@ func TestInvokeOKPvtDataOnly(t *testing.T) {
 	tx := getEnv(ccID, rwsetBytes, t)
 	b := &common.Block{Data: &common.BlockData{Data: [][]byte{utils.MarshalOrPanic(tx)}}, Header: &common.BlockHeader{Number: 2}}
 
-	v.(*txValidator).vscc.(*vsccValidatorImpl).ccprovider.(*ccprovider.MockCcProviderImpl).ExecuteResultProvider = nil
-	v.(*txValidator).vscc.(*vsccValidatorImpl).ccprovider.(*ccprovider.MockCcProviderImpl).ExecuteChaincodeResponse = &peer.Response{Status: shim.ERROR}
-
+	c := validationPluginFactory.getCallback()
+	validationPluginFactory.setCallback(func() error {
+		return errors.New("endorsement policy failure")
+	})
 	err = v.Validate(b)
+	validationPluginFactory.setCallback(c)
 	assert.NoError(t, err)
 	assertInvalid(b, t, peer.TxValidationCode_ENDORSEMENT_POLICY_FAILURE)
 }
@@ -416,6 +428,26 @@ func TestInvokeNOKVSCCUnspecified(t *testing.T) {
 	assertInvalid(b, t, peer.TxValidationCode_INVALID_OTHER_REASON)
 }
 
+func TestInvokeValidationPluginNotFound(t *testing.T) {
+	l, v := setupLedgerAndValidator(t)
+	defer ledgermgmt.CleanupTestEnv()
+	defer l.Close()
+
+	ccID := "mycc"
+
+	putCCInfoWithVSCCAndVer(l, ccID, "missing", ccVersion, signedByAnyMember([]string{"DEFAULT"}), t)
+
+	tx := getEnv(ccID, createRWset(t, ccID), t)
+	b := &common.Block{Data: &common.BlockData{Data: [][]byte{utils.MarshalOrPanic(tx)}},
+		Header: &common.BlockHeader{},
+	}
+
+	// the block can't be committed if the validation plugin isn't configured
+	err := v.Validate(b)
+	assert.Error(t, err)
+	assert.IsType(t, &commonerrors.VSCCExecutionFailureError{}, err)
+}
+
 func TestInvokeNoBlock(t *testing.T) {
 	l, v := setupLedgerAndValidator(t)
 	defer ledgermgmt.CleanupTestEnv()
@@ -428,6 +460,163 @@ func TestInvokeNoBlock(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func getEnvWithCalls(ccID string, res []byte, calls []*peer.ChaincodeCall, t *testing.T) *common.Envelope {
+	return getEnvWithTypeAndCalls(ccID, res, calls, common.HeaderType_ENDORSER_TRANSACTION, t)
+}
+
+func TestInvokeChaincodeCalls(t *testing.T) {
+	l, v := setupLedgerAndValidatorWithCapabilities(t, &mockconfig.MockApplicationCapabilities{ChaincodeCallsValidationRv: true})
+	defer ledgermgmt.CleanupTestEnv()
+	defer l.Close()
+
+	ccID := "mycc"
+	calleeID := "callee"
+
+	putCCInfo(l, ccID, signedByAnyMember([]string{"DEFAULT"}), t)
+	putCCInfoWithVSCCAndVer(l, calleeID, "vscc", ccVersion, signedByAnyMember([]string{"DEFAULT"}), t)
+
+	validate := func(calls []*peer.ChaincodeCall, rwset []byte) *common.Block {
+		tx := getEnvWithCalls(ccID, rwset, calls, t)
+		b := &common.Block{Data: &common.BlockData{Data: [][]byte{utils.MarshalOrPanic(tx)}}, Header: &common.BlockHeader{Number: 3}}
+		err := v.Validate(b)
+		assert.NoError(t, err)
+		return b
+	}
+
+	t.Run("Valid call", func(t *testing.T) {
+		calls := []*peer.ChaincodeCall{
+			{ChaincodeId: &peer.ChaincodeID{Name: calleeID, Version: ccVersion}, ChannelId: util.GetTestChainID()},
+			{ChaincodeId: &peer.ChaincodeID{Name: "lscc", Version: util.GetSysCCVersion()}, ChannelId: util.GetTestChainID()},
+		}
+		assertValid(validate(calls, createRWset(t, ccID)), t)
+	})
+
+	t.Run("Invalid chaincode ID", func(t *testing.T) {
+		calls := []*peer.ChaincodeCall{{ChannelId: util.GetTestChainID()}}
+		assertInvalid(validate(calls, createRWset(t, ccID)), t, peer.TxValidationCode_INVALID_OTHER_REASON)
+	})
+
+	t.Run("Expired callee", func(t *testing.T) {
+		calls := []*peer.ChaincodeCall{{ChaincodeId: &peer.ChaincodeID{Name: calleeID, Version: "badversion"}, ChannelId: util.GetTestChainID()}}
+		assertInvalid(validate(calls, createRWset(t, ccID)), t, peer.TxValidationCode_EXPIRED_CHAINCODE)
+	})
+
+	t.Run("Callee doesn't exist", func(t *testing.T) {
+		calls := []*peer.ChaincodeCall{{ChaincodeId: &peer.ChaincodeID{Name: "missing", Version: ccVersion}, ChannelId: util.GetTestChainID()}}
+		assertInvalid(validate(calls, createRWset(t, ccID)), t, peer.TxValidationCode_INVALID_OTHER_REASON)
+	})
+
+	t.Run("Callee policy not satisfied", func(t *testing.T) {
+		calls := []*peer.ChaincodeCall{{ChaincodeId: &peer.ChaincodeID{Name: calleeID, Version: ccVersion}, ChannelId: util.GetTestChainID()}}
+
+		// the transaction doesn't write, hence only the policy of the callee is evaluated
+		c := validationPluginFactory.getCallback()
+		validationPluginFactory.setCallback(func() error {
+			return errors.New("endorsement policy failure")
+		})
+		b := validate(calls, createRWset(t))
+		validationPluginFactory.setCallback(c)
+		assertInvalid(b, t, peer.TxValidationCode_ENDORSEMENT_POLICY_FAILURE)
+	})
+}
+
+func TestInvokeChaincodeCallsNotValidated(t *testing.T) {
+	l, v := setupLedgerAndValidator(t)
+	defer ledgermgmt.CleanupTestEnv()
+	defer l.Close()
+
+	ccID := "mycc"
+
+	putCCInfo(l, ccID, signedByAnyMember([]string{"DEFAULT"}), t)
+
+	// without the capability, calls are not validated
+	calls := []*peer.ChaincodeCall{{ChaincodeId: &peer.ChaincodeID{Name: "missing", Version: ccVersion}, ChannelId: util.GetTestChainID()}}
+	tx := getEnvWithCalls(ccID, createRWset(t, ccID), calls, t)
+	b := &common.Block{Data: &common.BlockData{Data: [][]byte{utils.MarshalOrPanic(tx)}}, Header: &common.BlockHeader{Number: 2}}
+
+	err := v.Validate(b)
+	assert.NoError(t, err)
+	assertValid(b, t)
+}
+
+func TestInvokeCrossChannelReads(t *testing.T) {
+	l, v := setupLedgerAndValidatorWithCapabilities(t, &mockconfig.MockApplicationCapabilities{ChaincodeCallsValidationRv: true})
+	defer ledgermgmt.CleanupTestEnv()
+	defer l.Close()
+
+	ccID := "mycc"
+	otherChannel := "otherchannel"
+	putCCInfo(l, ccID, signedByAnyMember([]string{"DEFAULT"}), t)
+
+	simResults := func(build func(*rwsetutil.RWSetBuilder)) []byte {
+		rwsetBuilder := rwsetutil.NewRWSetBuilder()
+		build(rwsetBuilder)
+		simRes, err := rwsetBuilder.GetTxSimulationResults()
+		assert.NoError(t, err)
+		simResBytes, err := simRes.GetPubSimulationBytes()
+		assert.NoError(t, err)
+		return simResBytes
+	}
+	reads := func(key string, ver *version.Height) []byte {
+		return simResults(func(rwsetBuilder *rwsetutil.RWSetBuilder) {
+			rwsetBuilder.AddToReadSet("othercc", key, ver)
+		})
+	}
+
+	validate := func(call *peer.ChaincodeCall) *common.Block {
+		call.ChaincodeId = &peer.ChaincodeID{Name: "othercc", Version: ccVersion}
+		tx := getEnvWithCalls(ccID, createRWset(t, ccID), []*peer.ChaincodeCall{call}, t)
+		b := &common.Block{Data: &common.BlockData{Data: [][]byte{utils.MarshalOrPanic(tx)}}, Header: &common.BlockHeader{Number: 2}}
+		err := v.Validate(b)
+		assert.NoError(t, err)
+		return b
+	}
+
+	t.Run("Read before the recorded height", func(t *testing.T) {
+		b := validate(&peer.ChaincodeCall{ChannelId: otherChannel, BlockHeight: 3, Results: reads("key", version.NewHeight(2, 0))})
+		assertValid(b, t)
+	})
+
+	t.Run("Read of a missing key", func(t *testing.T) {
+		b := validate(&peer.ChaincodeCall{ChannelId: otherChannel, BlockHeight: 3, Results: reads("missingkey", nil)})
+		assertValid(b, t)
+	})
+
+	t.Run("Read past the recorded height", func(t *testing.T) {
+		b := validate(&peer.ChaincodeCall{ChannelId: otherChannel, BlockHeight: 3, Results: reads("key", version.NewHeight(3, 0))})
+		assertInvalid(b, t, peer.TxValidationCode_INVALID_CROSS_CHANNEL_READ)
+	})
+
+	t.Run("Writes on the other channel", func(t *testing.T) {
+		results := simResults(func(rwsetBuilder *rwsetutil.RWSetBuilder) {
+			rwsetBuilder.AddToReadSet("othercc", "key", version.NewHeight(1, 0))
+			rwsetBuilder.AddToWriteSet("othercc", "key", []byte("value"))
+		})
+		b := validate(&peer.ChaincodeCall{ChannelId: otherChannel, BlockHeight: 3, Results: results})
+		assertInvalid(b, t, peer.TxValidationCode_INVALID_CROSS_CHANNEL_READ)
+
+		results = simResults(func(rwsetBuilder *rwsetutil.RWSetBuilder) {
+			rwsetBuilder.AddToPvtAndHashedWriteSet("othercc", "coll", "key", []byte("value"))
+		})
+		b = validate(&peer.ChaincodeCall{ChannelId: otherChannel, BlockHeight: 3, Results: results})
+		assertInvalid(b, t, peer.TxValidationCode_INVALID_CROSS_CHANNEL_READ)
+	})
+
+	t.Run("Outcome independent of the local ledgers", func(t *testing.T) {
+		// whether or not this peer joined the other channel, the reads
+		// are validated against the recorded height only
+		b := validate(&peer.ChaincodeCall{ChannelId: "unknownchannel", BlockHeight: 3, Results: reads("key", version.NewHeight(2, 0))})
+		assertValid(b, t)
+		b = validate(&peer.ChaincodeCall{ChannelId: "unknownchannel", BlockHeight: 3, Results: reads("key", version.NewHeight(3, 0))})
+		assertInvalid(b, t, peer.TxValidationCode_INVALID_CROSS_CHANNEL_READ)
+	})
+
+	t.Run("Bogus reads", func(t *testing.T) {
+		b := validate(&peer.ChaincodeCall{ChannelId: otherChannel, BlockHeight: 3, Results: []byte("barf")})
+		assertInvalid(b, t, peer.TxValidationCode_INVALID_CROSS_CHANNEL_READ)
+	})
+}
+
 // mockLedger structure used to test ledger
 // failure, therefore leveraging mocking
 // library as need to simulate ledger which not
@@ -478,6 +667,12 @@ func (m *mockLedger) NewHistoryQueryExecutor() (ledger.HistoryQueryExecutor, err
 	return args.Get(0).(ledger.HistoryQueryExecutor), nil
 }
 
+// NewHistoricalQueryExecutor query executor at a past height
+func (m *mockLedger) NewHistoricalQueryExecutor(height uint64) (ledger.QueryExecutor, error) {
+	args := m.Called(height)
+	return args.Get(0).(ledger.QueryExecutor), nil
+}
+
 // GetPvtDataAndBlockByNum retrieves pvt data and block
 func (m *mockLedger) GetPvtDataAndBlockByNum(blockNum uint64, filter ledger.PvtNsCollFilter) (*ledger.BlockAndPvtData, error) {
 	args := m.Called()
@@ -510,6 +705,18 @@ func (m *mockLedger) Prune(policy ledger2.PrunePolicy) error {
 	return nil
 }
 
+// GetMVCCConflicts returns the most recent MVCC conflicts
+func (m *mockLedger) GetMVCCConflicts() []*peer.MVCCConflict {
+	args := m.Called()
+	return args.Get(0).([]*peer.MVCCConflict)
+}
+
+// GetHotKeys returns the most conflicted keys
+func (m *mockLedger) GetHotKeys(namespace string) []*peer.HotKey {
+	args := m.Called(namespace)
+	return args.Get(0).([]*peer.HotKey)
+}
+
 func (m *mockLedger) GetBlockchainInfo() (*common.BlockchainInfo, error) {
 	args := m.Called()
 	return args.Get(0).(*common.BlockchainInfo), nil
@@ -600,7 +807,7 @@ func TestLedgerIsNoAvailable(t *testing.T) {
 		*mocktxvalidator.Support
 		*semaphore.Weighted
 	}{&mocktxvalidator.Support{LedgerVal: theLedger, ACVal: &mockconfig.MockApplicationCapabilities{}}, semaphore.NewWeighted(10)}
-	validator := NewTxValidator("", vcs)
+	validator := NewTxValidator("", vcs, pluginMapper)
 
 	ccID := "mycc"
 	tx := getEnv(ccID, createRWset(t, ccID), t)
@@ -676,7 +883,7 @@ func TestValidationInvalidEndorsing(t *testing.T) {
 		*mocktxvalidator.Support
 		*semaphore.Weighted
 	}{&mocktxvalidator.Support{LedgerVal: theLedger, ACVal: &mockconfig.MockApplicationCapabilities{}}, semaphore.NewWeighted(10)}
-	validator := NewTxValidator("", vcs)
+	validator := NewTxValidator("", vcs, pluginMapper)
 
 	ccID := "mycc"
 	tx := getEnv(ccID, createRWset(t, ccID), t)
@@ -702,13 +909,13 @@ func TestValidationInvalidEndorsing(t *testing.T) {
 	}
 
 	// Keep default callback
-	c := executeChaincodeProvider.getCallback()
-	executeChaincodeProvider.setCallback(func() (*peer.Response, *peer.ChaincodeEvent, error) {
-		return &peer.Response{Status: shim.ERROR}, nil, nil
+	c := validationPluginFactory.getCallback()
+	validationPluginFactory.setCallback(func() error {
+		return errors.New("endorsement policy failure")
 	})
 	err := validator.Validate(b)
 	// Restore default callback
-	executeChaincodeProvider.setCallback(c)
+	validationPluginFactory.setCallback(c)
 	assert.NoError(t, err)
 	assertInvalid(b, t, peer.TxValidationCode_ENDORSEMENT_POLICY_FAILURE)
 }
@@ -720,7 +927,7 @@ func TestValidationResourceUpdate(t *testing.T) {
 		*mocktxvalidator.Support
 		*semaphore.Weighted
 	}{sup, semaphore.NewWeighted(10)}
-	validator := NewTxValidator("", vcs)
+	validator := NewTxValidator("", vcs, pluginMapper)
 
 	ccID := "mycc"
 	tx := getEnvWithType(ccID, createRWset(t, ccID), common.HeaderType_PEER_RESOURCE_UPDATE, t)
@@ -748,9 +955,9 @@ func TestValidationResourceUpdate(t *testing.T) {
 	}
 
 	// Keep default callback
-	c := executeChaincodeProvider.getCallback()
-	executeChaincodeProvider.setCallback(func() (*peer.Response, *peer.ChaincodeEvent, error) {
-		return &peer.Response{Status: shim.ERROR}, nil, nil
+	c := validationPluginFactory.getCallback()
+	validationPluginFactory.setCallback(func() error {
+		return errors.New("endorsement policy failure")
 	})
 	err := validator.Validate(b1)
 	assert.NoError(t, err)
@@ -758,7 +965,7 @@ func TestValidationResourceUpdate(t *testing.T) {
 	err = validator.Validate(b2)
 	assert.NoError(t, err)
 	// Restore default callback
-	executeChaincodeProvider.setCallback(c)
+	validationPluginFactory.setCallback(c)
 	assertInvalid(b1, t, peer.TxValidationCode_UNSUPPORTED_TX_PAYLOAD)
 	assertValid(b2, t)
 }
@@ -769,7 +976,7 @@ func TestValidationIntermittentErrorCode(t *testing.T) {
 		*mocktxvalidator.Support
 		*semaphore.Weighted
 	}{&mocktxvalidator.Support{LedgerVal: theLedger, ACVal: &mockconfig.MockApplicationCapabilities{}}, semaphore.NewWeighted(10)}
-	validator := NewTxValidator("", vcs)
+	validator := NewTxValidator("", vcs, pluginMapper)
 
 	ccID := "mycc"
 	tx := getEnv(ccID, createRWset(t, ccID), t)
@@ -795,49 +1002,64 @@ func TestValidationIntermittentErrorCode(t *testing.T) {
 	}
 
 	// Keep default callback
-	c := executeChaincodeProvider.getCallback()
-	executeChaincodeProvider.setCallback(func() (*peer.Response, *peer.ChaincodeEvent, error) {
-		return &peer.Response{Status: IntermittentErrorCode}, nil, nil
+	c := validationPluginFactory.getCallback()
+	validationPluginFactory.setCallback(func() error {
+		return &validation.ExecutionFailureError{Reason: "ledger unavailable"}
 	})
 	// Restore default callback
-	defer executeChaincodeProvider.setCallback(c)
+	defer validationPluginFactory.setCallback(c)
 	err := validator.Validate(b)
 	assert.Error(t, err)
 	_, ok := err.(*commonerrors.VSCCExecutionFailureError)
 	assert.True(t, ok)
 }
 
-type ccResultCallback func() (*peer.Response, *peer.ChaincodeEvent, error)
+type pluginResultCallback func() error
+
+// mockPluginFactory creates validation plugins that
+// return the result of the callback it is set with
+type mockPluginFactory struct {
+	callback pluginResultCallback
+}
+
+func (pf *mockPluginFactory) New() validation.Plugin {
+	return &mockPlugin{pf: pf}
+}
+
+func (pf *mockPluginFactory) getCallback() pluginResultCallback {
+	return pf.callback
+}
 
-type ccExecuteChaincode struct {
-	executeChaincodeCalback ccResultCallback
+func (pf *mockPluginFactory) setCallback(callback pluginResultCallback) {
+	pf.callback = callback
 }
 
-func (cc *ccExecuteChaincode) ExecuteChaincodeResult() (*peer.Response, *peer.ChaincodeEvent, error) {
-	return cc.executeChaincodeCalback()
+type mockPlugin struct {
+	pf *mockPluginFactory
 }
 
-func (cc *ccExecuteChaincode) getCallback() ccResultCallback {
-	return cc.executeChaincodeCalback
+func (p *mockPlugin) Validate(block *common.Block, namespace string, txPosition int, actionPosition int, contextData ...validation.ContextDatum) error {
+	return p.pf.callback()
 }
 
-func (cc *ccExecuteChaincode) setCallback(calback ccResultCallback) {
-	cc.executeChaincodeCalback = calback
+func (p *mockPlugin) Init(dependencies ...validation.Dependency) error {
+	return nil
 }
 
 var signer msp.SigningIdentity
 
 var signerSerialized []byte
 
-var executeChaincodeProvider = &ccExecuteChaincode{
-	executeChaincodeCalback: func() (*peer.Response, *peer.ChaincodeEvent, error) {
-		return &peer.Response{Status: shim.OK}, nil, nil
+var validationPluginFactory = &mockPluginFactory{
+	callback: func() error {
+		return nil
 	},
 }
 
+var pluginMapper = MapBasedPluginMapper{"vscc": validationPluginFactory}
+
 func TestMain(m *testing.M) {
 	sysccprovider.RegisterSystemChaincodeProviderFactory(&scc.MocksccProviderFactory{})
-	ccp.RegisterChaincodeProviderFactory(&ccprovider.MockCcProviderFactory{ExecuteResultProvider: executeChaincodeProvider})
 
 	msptesttools.LoadMSPSetupForTesting()
 
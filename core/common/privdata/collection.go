@@ -9,7 +9,9 @@ package privdata
 import (
 	"strings"
 
+	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
 )
 
 // Collection defines a common interface for collections
@@ -44,9 +46,21 @@ type CollectionAccessPolicy interface {
 	// upon endorsement. This number has to be bigger than RequiredPeerCount().
 	MaximumPeerCount() int
 
+	// DisseminationMode returns whether the endorsement fails if
+	// dissemination to RequiredPeerCount() peers is not achieved.
+	DisseminationMode() common.DisseminationMode
+
 	// MemberOrgs returns the collection's members as MSP IDs. This serves as
 	// a human-readable way of quickly identifying who is part of a collection.
 	MemberOrgs() []string
+
+	// IsMemberOnlyRead returns whether only clients of the collection's
+	// members can read its private data through chaincode invocations
+	IsMemberOnlyRead() bool
+
+	// IsMemberOnlyWrite returns whether only clients of the collection's
+	// members can write its private data through chaincode invocations
+	IsMemberOnlyWrite() bool
 }
 
 // Filter defines a rule that filters peers according to data signed by them.
@@ -74,6 +88,13 @@ type CollectionStore interface {
 	// RetrieveCollectionConfigPackage retrieves the configuration
 	// for the collection with the supplied criteria
 	RetrieveCollectionConfigPackage(common.CollectionCriteria) (*common.CollectionConfigPackage, error)
+
+	// RetrieveReadWritePermission returns whether the creator of the given proposal
+	// can read, and whether it can write, the private data of the collection with
+	// the supplied criteria. The collection is retrieved with the given query
+	// executor, which isn't released, without recording the read if the query
+	// executor is a ledger.NonRecordingStateReader
+	RetrieveReadWritePermission(cc common.CollectionCriteria, signedProposal *pb.SignedProposal, qe ledger.QueryExecutor) (bool, bool, error)
 }
 
 const (
@@ -47,6 +47,24 @@ func (sc *SimpleCollection) MaximumPeerCount() int {
 	return int(sc.conf.MaximumPeerCount)
 }
 
+// DisseminationMode returns whether the endorsement fails if
+// dissemination to the required number of peers is not achieved
+func (sc *SimpleCollection) DisseminationMode() common.DisseminationMode {
+	return sc.conf.DisseminationMode
+}
+
+// IsMemberOnlyRead returns whether only clients of the collection's
+// members can read its private data through chaincode invocations
+func (sc *SimpleCollection) IsMemberOnlyRead() bool {
+	return sc.conf.MemberOnlyRead
+}
+
+// IsMemberOnlyWrite returns whether only clients of the collection's
+// members can write its private data through chaincode invocations
+func (sc *SimpleCollection) IsMemberOnlyWrite() bool {
+	return sc.conf.MemberOnlyWrite
+}
+
 // AccessFilter returns the member filter function that evaluates signed data
 // against the member access policy of this collection
 func (sc *SimpleCollection) AccessFilter() Filter {
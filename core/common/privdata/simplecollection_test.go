@@ -104,6 +104,7 @@ func TestSetupGoodConfigCollection(t *testing.T) {
 		Name:              "test collection",
 		RequiredPeerCount: 1,
 		MemberOrgsPolicy:  accessPolicy,
+		DisseminationMode: pb.DisseminationMode_BEST_EFFORT,
 	}
 
 	// set up simple collection with valid data
@@ -121,6 +122,18 @@ func TestSetupGoodConfigCollection(t *testing.T) {
 
 	// check required peer count
 	assert.True(t, sc.RequiredPeerCount() == 1)
+
+	// check dissemination mode
+	assert.True(t, sc.DisseminationMode() == pb.DisseminationMode_BEST_EFFORT)
+
+	// check member-only access flags
+	assert.False(t, sc.IsMemberOnlyRead())
+	assert.False(t, sc.IsMemberOnlyWrite())
+	collectionConfig.MemberOnlyRead = true
+	collectionConfig.MemberOnlyWrite = true
+	assert.NoError(t, sc.Setup(collectionConfig, &mockDeserializer{}))
+	assert.True(t, sc.IsMemberOnlyRead())
+	assert.True(t, sc.IsMemberOnlyWrite())
 }
 
 func TestSimpleCollectionFilter(t *testing.T) {
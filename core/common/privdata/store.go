@@ -13,6 +13,8 @@ import (
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/msp"
 	"github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
 	"github.com/pkg/errors"
 )
 
@@ -62,7 +64,19 @@ func (c *simpleCollectionStore) retrieveCollectionConfigPackage(cc common.Collec
 	}
 	defer qe.Done()
 
-	cb, err := qe.GetState("lscc", c.s.GetCollectionKVSKey(cc))
+	return c.retrieveCollectionConfigPackageWith(cc, qe)
+}
+
+func (c *simpleCollectionStore) retrieveCollectionConfigPackageWith(cc common.CollectionCriteria, qe ledger.QueryExecutor) (*common.CollectionConfigPackage, error) {
+	var cb []byte
+	var err error
+	// the configuration is read without recording it in the results of the
+	// simulation the query executor may be part of, if it supports that
+	if r, isNonRecording := qe.(ledger.NonRecordingStateReader); isNonRecording {
+		cb, err = r.GetStateWithoutRecording("lscc", c.s.GetCollectionKVSKey(cc))
+	} else {
+		cb, err = qe.GetState("lscc", c.s.GetCollectionKVSKey(cc))
+	}
 	if err != nil {
 		return nil, &LedgerIOError{msg: fmt.Sprintf("error while retrieving collection for collection criteria %#v. Err=%s", cc, err)}
 	}
@@ -84,6 +98,10 @@ func (c *simpleCollectionStore) retrieveSimpleCollection(cc common.CollectionCri
 	if err != nil {
 		return nil, err
 	}
+	return c.simpleCollectionOf(cc, collections)
+}
+
+func (c *simpleCollectionStore) simpleCollectionOf(cc common.CollectionCriteria, collections *common.CollectionConfigPackage) (*SimpleCollection, error) {
 	if collections == nil {
 		return nil, nil
 	}
@@ -94,7 +112,7 @@ func (c *simpleCollectionStore) retrieveSimpleCollection(cc common.CollectionCri
 			if cconf.StaticCollectionConfig.Name == cc.Collection {
 				sc := &SimpleCollection{}
 
-				err = sc.Setup(cconf.StaticCollectionConfig, c.s.GetIdentityDeserializer(cc.Channel))
+				err := sc.Setup(cconf.StaticCollectionConfig, c.s.GetIdentityDeserializer(cc.Channel))
 				if err != nil {
 					return nil, errors.WithMessage(err, fmt.Sprintf("error setting up collection for collection criteria %#v", cc))
 				}
@@ -120,3 +138,58 @@ func (c *simpleCollectionStore) RetrieveCollectionAccessPolicy(cc common.Collect
 func (c *simpleCollectionStore) RetrieveCollectionConfigPackage(cc common.CollectionCriteria) (*common.CollectionConfigPackage, error) {
 	return c.retrieveCollectionConfigPackage(cc)
 }
+
+// RetrieveReadWritePermission returns whether the creator of the given proposal
+// can read, and whether it can write, the private data of the collection with
+// the supplied criteria. A collection that isn't defined places no restrictions
+// on its private data
+func (c *simpleCollectionStore) RetrieveReadWritePermission(cc common.CollectionCriteria, signedProposal *pb.SignedProposal, qe ledger.QueryExecutor) (bool, bool, error) {
+	collections, err := c.retrieveCollectionConfigPackageWith(cc, qe)
+	var sc *SimpleCollection
+	if err == nil {
+		sc, err = c.simpleCollectionOf(cc, collections)
+	}
+	if _, isNoSuchCollection := err.(NoSuchCollectionError); isNoSuchCollection {
+		return true, true, nil
+	}
+	if err != nil {
+		return false, false, err
+	}
+	return readWritePermission(sc, signedProposal)
+}
+
+func readWritePermission(ap CollectionAccessPolicy, signedProposal *pb.SignedProposal) (bool, bool, error) {
+	if !ap.IsMemberOnlyRead() && !ap.IsMemberOnlyWrite() {
+		return true, true, nil
+	}
+	signedData, err := signedDataOf(signedProposal)
+	if err != nil {
+		return false, false, err
+	}
+	isMember := ap.AccessFilter()(*signedData)
+	return isMember || !ap.IsMemberOnlyRead(), isMember || !ap.IsMemberOnlyWrite(), nil
+}
+
+// signedDataOf returns the data the creator of the given proposal signed
+func signedDataOf(signedProposal *pb.SignedProposal) (*common.SignedData, error) {
+	if signedProposal == nil {
+		return nil, errors.New("no signed proposal to identify the creator with")
+	}
+	proposal, err := utils.GetProposal(signedProposal.ProposalBytes)
+	if err != nil {
+		return nil, err
+	}
+	header, err := utils.GetHeader(proposal.Header)
+	if err != nil {
+		return nil, err
+	}
+	signatureHeader, err := utils.GetSignatureHeader(header.SignatureHeader)
+	if err != nil {
+		return nil, err
+	}
+	return &common.SignedData{
+		Data:      signedProposal.ProposalBytes,
+		Identity:  signatureHeader.Creator,
+		Signature: signedProposal.Signature,
+	}, nil
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/msp"
 	"github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/syndtr/goleveldb/leveldb/errors"
 )
@@ -104,3 +106,103 @@ func TestCollectionStore(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, ccc)
 }
+
+func signedProposalBy(t *testing.T, creator []byte) *pb.SignedProposal {
+	shdr := &common.SignatureHeader{Creator: creator}
+	hdr := &common.Header{SignatureHeader: utils.MarshalOrPanic(shdr)}
+	prop := &pb.Proposal{Header: utils.MarshalOrPanic(hdr)}
+	propBytes, err := proto.Marshal(prop)
+	assert.NoError(t, err)
+	return &pb.SignedProposal{ProposalBytes: propBytes, Signature: []byte("signed")}
+}
+
+func TestRetrieveReadWritePermission(t *testing.T) {
+	wState := map[string]map[string][]byte{"lscc": {}}
+	qe := &lm.MockQueryExecutor{wState}
+	// the query executor is supplied by the caller, not the support
+	support := &mockStoreSupport{QErr: errors.New("not to be used")}
+	cs := NewSimpleCollectionStore(support)
+
+	member := signedProposalBy(t, []byte("signer0"))
+	nonMember := signedProposalBy(t, []byte("signer2"))
+
+	// undefined collections place no restrictions
+	ccr := common.CollectionCriteria{Channel: "ch", Namespace: "cc", Collection: "mycollection"}
+	canRead, canWrite, err := cs.RetrieveReadWritePermission(ccr, nonMember, qe)
+	assert.NoError(t, err)
+	assert.True(t, canRead)
+	assert.True(t, canWrite)
+
+	var signers = [][]byte{[]byte("signer0"), []byte("signer1")}
+	policyEnvelope := cauthdsl.Envelope(cauthdsl.Or(cauthdsl.SignedBy(0), cauthdsl.SignedBy(1)), signers)
+	setCollections := func(confs ...*common.StaticCollectionConfig) {
+		ccp := &common.CollectionConfigPackage{}
+		for _, conf := range confs {
+			conf.MemberOrgsPolicy = createCollectionPolicyConfig(policyEnvelope)
+			ccp.Config = append(ccp.Config, &common.CollectionConfig{Payload: &common.CollectionConfig_StaticCollectionConfig{conf}})
+		}
+		ccpBytes, err := proto.Marshal(ccp)
+		assert.NoError(t, err)
+		wState["lscc"][support.GetCollectionKVSKey(ccr)] = ccpBytes
+	}
+
+	setCollections(
+		&common.StaticCollectionConfig{Name: "open"},
+		&common.StaticCollectionConfig{Name: "readonly", MemberOnlyRead: true},
+		&common.StaticCollectionConfig{Name: "writeonly", MemberOnlyWrite: true},
+		&common.StaticCollectionConfig{Name: "mycollection", MemberOnlyRead: true, MemberOnlyWrite: true},
+	)
+
+	for _, test := range []struct {
+		collection        string
+		signedProposal    *pb.SignedProposal
+		canRead, canWrite bool
+	}{
+		{"open", nonMember, true, true},
+		{"open", nil, true, true},
+		{"readonly", member, true, true},
+		{"readonly", nonMember, false, true},
+		{"writeonly", member, true, true},
+		{"writeonly", nonMember, true, false},
+		{"mycollection", member, true, true},
+		{"mycollection", nonMember, false, false},
+	} {
+		cc := common.CollectionCriteria{Channel: "ch", Namespace: "cc", Collection: test.collection}
+		canRead, canWrite, err := cs.RetrieveReadWritePermission(cc, test.signedProposal, qe)
+		assert.NoError(t, err)
+		assert.Equal(t, test.canRead, canRead, "read permission on %s", test.collection)
+		assert.Equal(t, test.canWrite, canWrite, "write permission on %s", test.collection)
+	}
+
+	// restricted collections need a proposal that identifies its creator
+	_, _, err = cs.RetrieveReadWritePermission(ccr, nil, qe)
+	assert.Error(t, err)
+	_, _, err = cs.RetrieveReadWritePermission(ccr, &pb.SignedProposal{ProposalBytes: []byte("barf")}, qe)
+	assert.Error(t, err)
+
+	// query executors that can read without recording are read from that way
+	nrqe := &nonRecordingQueryExecutor{MockQueryExecutor: qe}
+	canRead, canWrite, err = cs.RetrieveReadWritePermission(ccr, nonMember, nrqe)
+	assert.NoError(t, err)
+	assert.False(t, canRead)
+	assert.False(t, canWrite)
+	assert.Equal(t, 1, nrqe.reads)
+
+	wState["lscc"][support.GetCollectionKVSKey(ccr)] = []byte("barf")
+	_, _, err = cs.RetrieveReadWritePermission(ccr, member, qe)
+	assert.Error(t, err)
+}
+
+type nonRecordingQueryExecutor struct {
+	*lm.MockQueryExecutor
+	reads int
+}
+
+func (qe *nonRecordingQueryExecutor) GetState(namespace string, key string) ([]byte, error) {
+	return nil, errors.New("reads should not be recorded")
+}
+
+func (qe *nonRecordingQueryExecutor) GetStateWithoutRecording(namespace string, key string) ([]byte, error) {
+	qe.reads++
+	return qe.MockQueryExecutor.GetState(namespace, key)
+}
@@ -40,6 +40,13 @@ type CCSupport interface {
 	HandleChaincodeStream(context.Context, ChaincodeStream) error
 }
 
+// CCExitHandler may be implemented by the CCSupport passed via context to
+// learn why a chaincode container exited (such as being killed for exceeding
+// its memory limit). Only VMs that can observe container exits call it.
+type CCExitHandler interface {
+	HandleChaincodeExit(ccid CCID, reason string)
+}
+
 // GetCCHandlerKey is used to pass CCSupport via context
 func GetCCHandlerKey() string {
 	return "CCHANDLER"
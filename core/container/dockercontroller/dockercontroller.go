@@ -83,6 +83,12 @@ type dockerClient interface {
 	KillContainer(opts docker.KillContainerOptions) error
 	// RemoveContainer removes a docker container, returns an error in case of failure
 	RemoveContainer(opts docker.RemoveContainerOptions) error
+	// WaitContainer blocks until the given container stops, returning its exit
+	// code. Returns an error in case of failure
+	WaitContainer(id string) (int, error)
+	// InspectContainer returns information about a container, returns an error
+	// in case of failure
+	InspectContainer(id string) (*docker.Container, error)
 }
 
 // NewDockerVM returns a new DockerVM instance
@@ -151,16 +157,46 @@ func getDockerHostConfig() *docker.HostConfig {
 		CPUQuota:         getInt64("CpuQuota"),
 		CPUPeriod:        getInt64("CpuPeriod"),
 		BlkioWeight:      getInt64("BlkioWeight"),
+		PidsLimit:        getInt64("PidsLimit"),
 	}
 
 	return hostConfig
 }
 
+// getChaincodeHostConfig returns the docker HostConfig for the named
+// chaincode: vm.docker.hostConfig with any resource limits set under
+// chaincode.overrides.<name>.hostConfig applied on top.
+func getChaincodeHostConfig(ccName string) *docker.HostConfig {
+	hc := *getDockerHostConfig()
+
+	overrideKey := func(key string) string {
+		return "chaincode.overrides." + ccName + ".hostConfig." + key
+	}
+	override := func(key string, value *int64) {
+		defer func() {
+			if err := recover(); err != nil {
+				dockerLogger.Warningf("load %s failed, error: %v", overrideKey(key), err)
+			}
+		}()
+		if viper.IsSet(overrideKey(key)) {
+			*value = int64(viper.GetInt(overrideKey(key)))
+		}
+	}
+
+	override("Memory", &hc.Memory)
+	override("CpuShares", &hc.CPUShares)
+	override("CpuQuota", &hc.CPUQuota)
+	override("CpuPeriod", &hc.CPUPeriod)
+	override("PidsLimit", &hc.PidsLimit)
+
+	return &hc
+}
+
 func (vm *DockerVM) createContainer(ctxt context.Context, client dockerClient,
-	imageID string, containerID string, args []string,
+	imageID string, containerID string, ccName string, args []string,
 	env []string, attachStdout bool) error {
 	config := docker.Config{Cmd: args, Image: imageID, Env: env, AttachStdout: attachStdout, AttachStderr: attachStdout}
-	copts := docker.CreateContainerOptions{Name: containerID, Config: &config, HostConfig: getDockerHostConfig()}
+	copts := docker.CreateContainerOptions{Name: containerID, Config: &config, HostConfig: getChaincodeHostConfig(ccName)}
 	dockerLogger.Debugf("Create container: %s", containerID)
 	_, err := client.CreateContainer(copts)
 	if err != nil {
@@ -240,7 +276,7 @@ func (vm *DockerVM) Start(ctxt context.Context, ccid ccintf.CCID,
 	vm.stopInternal(ctxt, client, containerID, 0, false, false)
 
 	dockerLogger.Debugf("Start container %s", containerID)
-	err = vm.createContainer(ctxt, client, imageID, containerID, args, env, attachStdout)
+	err = vm.createContainer(ctxt, client, imageID, containerID, ccid.ChaincodeSpec.ChaincodeId.Name, args, env, attachStdout)
 	if err != nil {
 		//if image not found try to create image and retry
 		if err == docker.ErrNoSuchImage {
@@ -259,7 +295,7 @@ func (vm *DockerVM) Start(ctxt context.Context, ccid ccintf.CCID,
 				}
 
 				dockerLogger.Debug("start-recreated image successfully")
-				if err1 = vm.createContainer(ctxt, client, imageID, containerID, args, env, attachStdout); err1 != nil {
+				if err1 = vm.createContainer(ctxt, client, imageID, containerID, ccid.ChaincodeSpec.ChaincodeId.Name, args, env, attachStdout); err1 != nil {
 					dockerLogger.Errorf("start-could not recreate container post recreate image: %s", err1)
 					return err1
 				}
@@ -388,9 +424,57 @@ func (vm *DockerVM) Start(ctxt context.Context, ccid ccintf.CCID,
 	}
 
 	dockerLogger.Debugf("Started container %s", containerID)
+
+	if exitHandler, ok := ctxt.Value(ccintf.GetCCHandlerKey()).(ccintf.CCExitHandler); ok {
+		go vm.watchContainer(client, containerID, ccid, exitHandler)
+	}
+
 	return nil
 }
 
+// watchContainer waits for the container to exit and reports to the exit
+// handler why it did so, if it was not a clean exit
+func (vm *DockerVM) watchContainer(client dockerClient, containerID string, ccid ccintf.CCID, exitHandler ccintf.CCExitHandler) {
+	code, err := client.WaitContainer(containerID)
+	if err != nil {
+		dockerLogger.Debugf("Stopped watching container %s (%s)", containerID, err)
+		return
+	}
+
+	// the container may already have been removed, in which case only the
+	// exit code is known
+	cont, err := client.InspectContainer(containerID)
+	if err != nil {
+		dockerLogger.Debugf("Inspect container %s (%s)", containerID, err)
+		cont = nil
+	}
+
+	reason := getExitReason(code, cont)
+	if reason == "" {
+		dockerLogger.Debugf("Container %s exited", containerID)
+		return
+	}
+
+	dockerLogger.Warningf("Container %s %s", containerID, reason)
+	exitHandler.HandleChaincodeExit(ccid, reason)
+}
+
+// getExitReason describes why a container exited, favouring resource limit
+// violations over the bare exit code. It returns an empty string for a clean
+// exit.
+func getExitReason(code int, cont *docker.Container) string {
+	if cont != nil && cont.State.OOMKilled {
+		if cont.HostConfig != nil && cont.HostConfig.Memory > 0 {
+			return fmt.Sprintf("was killed for exceeding its memory limit of %d bytes", cont.HostConfig.Memory)
+		}
+		return "was killed for running out of memory"
+	}
+	if code != 0 {
+		return fmt.Sprintf("exited with code %d", code)
+	}
+	return ""
+}
+
 //Stop stops a running chaincode
 func (vm *DockerVM) Stop(ctxt context.Context, ccid ccintf.CCID, timeout uint, dontkill bool, dontremove bool) error {
 	id, err := vm.GetVMName(ccid, nil)
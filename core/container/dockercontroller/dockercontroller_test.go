@@ -68,6 +68,78 @@ func TestGetDockerHostConfig(t *testing.T) {
 	testutil.AssertEquals(t, hostConfig.CPUShares, int64(1024*1024*1024*2))
 }
 
+func TestGetChaincodeHostConfig(t *testing.T) {
+	coreutil.SetupTestConfig()
+	viper.Set("vm.docker.hostConfig.PidsLimit", 100)
+	defer viper.Set("vm.docker.hostConfig.PidsLimit", nil)
+	hostConfig = nil
+	defer func() { hostConfig = nil }()
+
+	overrides := map[string]interface{}{
+		"chaincode.overrides.greedy.hostConfig.Memory":    "1073741824",
+		"chaincode.overrides.greedy.hostConfig.CpuQuota":  50000,
+		"chaincode.overrides.greedy.hostConfig.PidsLimit": 10,
+	}
+	for key, value := range overrides {
+		viper.Set(key, value)
+		defer viper.Set(key, nil)
+	}
+
+	hc := getChaincodeHostConfig("greedy")
+	assert.Equal(t, int64(1073741824), hc.Memory)
+	assert.Equal(t, int64(50000), hc.CPUQuota)
+	assert.Equal(t, int64(10), hc.PidsLimit)
+
+	// chaincodes without overrides get the defaults, which the overrides
+	// must not have modified
+	hc = getChaincodeHostConfig("modest")
+	assert.Equal(t, getDockerHostConfig().Memory, hc.Memory)
+	assert.Equal(t, int64(0), hc.CPUQuota)
+	assert.Equal(t, int64(100), hc.PidsLimit)
+}
+
+func TestGetExitReason(t *testing.T) {
+	assert.Equal(t, "", getExitReason(0, nil))
+	assert.Equal(t, "exited with code 2", getExitReason(2, nil))
+	assert.Equal(t, "exited with code 137", getExitReason(137, &docker.Container{}))
+	assert.Equal(t, "was killed for exceeding its memory limit of 1024 bytes",
+		getExitReason(137, &docker.Container{State: docker.State{OOMKilled: true}, HostConfig: &docker.HostConfig{Memory: 1024}}))
+	assert.Equal(t, "was killed for running out of memory",
+		getExitReason(137, &docker.Container{State: docker.State{OOMKilled: true}}))
+}
+
+func TestStartWatchesContainerExit(t *testing.T) {
+	dvm := DockerVM{getClientFnc: getMockClient}
+	ccid := ccintf.CCID{ChaincodeSpec: &pb.ChaincodeSpec{ChaincodeId: &pb.ChaincodeID{Name: "simple"}}}
+	eh := &mockExitHandler{reasons: make(chan string, 1)}
+	ctx := context.WithValue(context.Background(), ccintf.GetCCHandlerKey(), eh)
+
+	oomKilled, exitCode = true, 137
+	defer func() { oomKilled, exitCode = false, 0 }()
+
+	err := dvm.Start(ctx, ccid, nil, nil, nil, nil, nil)
+	assert.NoError(t, err)
+	select {
+	case reason := <-eh.reasons:
+		assert.Equal(t, "was killed for exceeding its memory limit of 1024 bytes", reason)
+	case <-time.After(5 * time.Second):
+		t.Fatal("exit of the container was not reported")
+	}
+
+	// a container that is gone by the time it is inspected is reported by
+	// its exit code
+	inspectErr = true
+	defer func() { inspectErr = false }()
+	err = dvm.Start(ctx, ccid, nil, nil, nil, nil, nil)
+	assert.NoError(t, err)
+	select {
+	case reason := <-eh.reasons:
+		assert.Equal(t, "exited with code 137", reason)
+	case <-time.After(5 * time.Second):
+		t.Fatal("exit of the container was not reported")
+	}
+}
+
 func Test_Deploy(t *testing.T) {
 	dvm := DockerVM{}
 	ccid := ccintf.CCID{ChaincodeSpec: &pb.ChaincodeSpec{ChaincodeId: &pb.ChaincodeID{Name: "simple"}}}
@@ -306,7 +378,9 @@ type mockClient struct {
 }
 
 var getClientErr, createErr, uploadErr, noSuchImgErr, buildErr, removeImgErr,
-	startErr, stopErr, killErr, removeErr bool
+	startErr, stopErr, killErr, removeErr, waitErr, inspectErr, oomKilled bool
+
+var exitCode int
 
 func (c *mockClient) CreateContainer(options docker.CreateContainerOptions) (*docker.Container, error) {
 	if createErr {
@@ -374,6 +448,35 @@ func (c *mockClient) RemoveContainer(opts docker.RemoveContainerOptions) error {
 	return nil
 }
 
+func (c *mockClient) WaitContainer(id string) (int, error) {
+	if waitErr {
+		return 0, errors.New("Error waiting for container")
+	}
+	return exitCode, nil
+}
+
+func (c *mockClient) InspectContainer(id string) (*docker.Container, error) {
+	if inspectErr {
+		return nil, errors.New("Error inspecting container")
+	}
+	return &docker.Container{
+		State:      docker.State{OOMKilled: oomKilled, ExitCode: exitCode},
+		HostConfig: &docker.HostConfig{Memory: 1024},
+	}, nil
+}
+
+type mockExitHandler struct {
+	reasons chan string
+}
+
+func (h *mockExitHandler) HandleChaincodeStream(ctx context.Context, stream ccintf.ChaincodeStream) error {
+	return nil
+}
+
+func (h *mockExitHandler) HandleChaincodeExit(ccid ccintf.CCID, reason string) {
+	h.reasons <- reason
+}
+
 func formatInvalidChars(name string) (string, error) {
 	return "inv@lid*character$/", nil
 }
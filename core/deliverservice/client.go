@@ -36,7 +36,10 @@ type retryPolicy func(attemptNum int, elapsedTime time.Duration) (time.Duration,
 type clientFactory func(*grpc.ClientConn) orderer.AtomicBroadcastClient
 
 type broadcastClient struct {
-	stopFlag int32
+	// failingSince is the time (in nanoseconds since the epoch) since which
+	// attempts to communicate with the ordering service fail, or zero if they don't
+	failingSince int64
+	stopFlag     int32
 	sync.Mutex
 	stopChan     chan struct{}
 	createClient clientFactory
@@ -87,6 +90,7 @@ func (bc *broadcastClient) try(action func() (interface{}, error)) (interface{},
 		attempt++
 		resp, err := bc.doAction(action)
 		if err != nil {
+			atomic.CompareAndSwapInt64(&bc.failingSince, 0, time.Now().UnixNano())
 			backoffDuration, retry = bc.shouldRetry(attempt, totalRetryTime)
 			if !retry {
 				logger.Warning("Got error:", err, "at", attempt, "attempt. Ceasing to retry")
@@ -97,6 +101,7 @@ func (bc *broadcastClient) try(action func() (interface{}, error)) (interface{},
 			bc.sleep(backoffDuration)
 			continue
 		}
+		atomic.StoreInt64(&bc.failingSince, 0)
 		return resp, nil
 	}
 	if bc.shouldStop() {
@@ -189,6 +194,16 @@ func (bc *broadcastClient) afterConnect(conn *grpc.ClientConn, abc orderer.Atomi
 	return err
 }
 
+// failingFor returns for how long attempts to communicate
+// with the ordering service have been failing
+func (bc *broadcastClient) failingFor() time.Duration {
+	failingSince := atomic.LoadInt64(&bc.failingSince)
+	if failingSince == 0 {
+		return 0
+	}
+	return time.Since(time.Unix(0, failingSince))
+}
+
 func (bc *broadcastClient) shouldStop() bool {
 	return atomic.LoadInt32(&bc.stopFlag) == int32(1)
 }
@@ -374,6 +374,42 @@ func testOrderingServicePermanentCrash(t *testing.T, bdc blocksDelivererConsumer
 	assert.Equal(t, 1, setupInvoked)
 }
 
+func TestFailingFor(t *testing.T) {
+	// Scenario: The ordering service is OK at first usage of Recv,
+	// but subsequent calls fail until the client gives up.
+	// The client should report it is failing, and after the ordering service
+	// recovers, it should report it isn't failing anymore
+	cp := &connProducer{}
+	abStream := &abc{}
+	abcClient := &abclient{stream: abStream}
+	clFactory := func(*grpc.ClientConn) orderer.AtomicBroadcastClient {
+		return abcClient
+	}
+	setup := func(blocksprovider.BlocksDeliverer) error {
+		return nil
+	}
+	backoffStrategy := func(attemptNum int, elapsedTime time.Duration) (time.Duration, bool) {
+		return time.Millisecond, attemptNum < 3
+	}
+	bc := NewBroadcastClient(cp, clFactory, setup, backoffStrategy)
+	defer bc.Close()
+	_, err := bc.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), bc.failingFor())
+	// Now fail the subsequent Recv
+	abStream.shouldFail = true
+	cp.shouldFail = true
+	_, err = bc.Recv()
+	assert.Error(t, err)
+	assert.True(t, bc.failingFor() > 0)
+	// The ordering service recovers
+	abStream.shouldFail = false
+	cp.shouldFail = false
+	_, err = bc.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), bc.failingFor())
+}
+
 func TestLimitedConnAttempts(t *testing.T) {
 	testLimitedConnAttempts(t, blockDelivererConsumerWithRecv)
 	testLimitedConnAttempts(t, blockDelivererConsumerWithSend)
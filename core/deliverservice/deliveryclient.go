@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/rand"
 	"sync"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/hyperledger/fabric/core/deliverservice/blocksprovider"
 	"github.com/hyperledger/fabric/gossip/api"
 	"github.com/hyperledger/fabric/gossip/util"
+	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/orderer"
 	"github.com/op/go-logging"
 	"github.com/spf13/viper"
@@ -33,6 +35,8 @@ func init() {
 
 const (
 	defaultReConnectTotalTimeThreshold = time.Second * 60 * 60
+	defaultFetchTimeout                = time.Second * 30
+	defaultUnhealthyThreshold          = time.Second * 30
 )
 
 var (
@@ -44,6 +48,14 @@ func getReConnectTotalTimeThreshold() time.Duration {
 	return util.GetDurationOrDefault("peer.deliveryclient.reconnectTotalTimeThreshold", defaultReConnectTotalTimeThreshold)
 }
 
+func getFetchTimeout() time.Duration {
+	return util.GetDurationOrDefault("peer.deliveryclient.fetchTimeout", defaultFetchTimeout)
+}
+
+func getUnhealthyThreshold() time.Duration {
+	return util.GetDurationOrDefault("peer.deliveryclient.unhealthyThreshold", defaultUnhealthyThreshold)
+}
+
 // DeliverService used to communicate with orderers to obtain
 // new blocks and send them to the committer service
 type DeliverService interface {
@@ -59,6 +71,15 @@ type DeliverService interface {
 	// UpdateEndpoints
 	UpdateEndpoints(chainID string, endpoints []string) error
 
+	// FetchBlocks fetches the blocks in the range [start...end] of the channel from
+	// the ordering service, and returns the blocks of the range it has, in their order
+	FetchBlocks(chainID string, start, end uint64) ([]*common.Block, error)
+
+	// Healthy returns whether delivery of blocks for the channel doesn't fail
+	// for longer than the unhealthy threshold. It returns true if blocks aren't
+	// delivered for the channel at all
+	Healthy(chainID string) bool
+
 	// Stop terminates delivery service and closes the connection
 	Stop()
 }
@@ -69,6 +90,7 @@ type DeliverService interface {
 type deliverServiceImpl struct {
 	conf           *Config
 	blockProviders map[string]blocksprovider.BlocksProvider
+	clients        map[string]*broadcastClient
 	lock           sync.RWMutex
 	stopping       bool
 }
@@ -100,6 +122,7 @@ func NewDeliverService(conf *Config) (DeliverService, error) {
 	ds := &deliverServiceImpl{
 		conf:           conf,
 		blockProviders: make(map[string]blocksprovider.BlocksProvider),
+		clients:        make(map[string]*broadcastClient),
 	}
 	if err := ds.validateConfiguration(); err != nil {
 		return nil, err
@@ -108,6 +131,11 @@ func NewDeliverService(conf *Config) (DeliverService, error) {
 }
 
 func (d *deliverServiceImpl) UpdateEndpoints(chainID string, endpoints []string) error {
+	// Keep the endpoints blocks are fetched from up to date,
+	// even if blocks aren't delivered for the channel
+	d.lock.Lock()
+	d.conf.Endpoints = endpoints
+	d.lock.Unlock()
 	// Use chainID to obtain blocks provider and pass endpoints
 	// for update
 	if bp, ok := d.blockProviders[chainID]; ok {
@@ -156,6 +184,7 @@ func (d *deliverServiceImpl) StartDeliverForChannel(chainID string, ledgerInfo b
 		return errors.New(errMsg)
 	} else {
 		client := d.newClient(chainID, ledgerInfo)
+		d.clients[chainID] = client
 		logger.Debug("This peer will pass blocks from orderer service to other peers for channel", chainID)
 		d.blockProviders[chainID] = blocksprovider.NewBlocksProvider(chainID, client, d.conf.Gossip, d.conf.CryptoSvc)
 		go d.launchBlockProvider(chainID, finalizer)
@@ -187,6 +216,7 @@ func (d *deliverServiceImpl) StopDeliverForChannel(chainID string) error {
 	if client, exist := d.blockProviders[chainID]; exist {
 		client.Stop()
 		delete(d.blockProviders, chainID)
+		delete(d.clients, chainID)
 		logger.Debug("This peer will stop pass blocks from orderer service to other peers")
 	} else {
 		errMsg := fmt.Sprintf("Delivery service - no block provider for %s found, can't stop delivery", chainID)
@@ -208,6 +238,87 @@ func (d *deliverServiceImpl) Stop() {
 	}
 }
 
+// Healthy returns whether delivery of blocks for the channel doesn't fail
+// for longer than the unhealthy threshold
+func (d *deliverServiceImpl) Healthy(chainID string) bool {
+	d.lock.RLock()
+	client, exists := d.clients[chainID]
+	d.lock.RUnlock()
+	if !exists {
+		return true
+	}
+	return client.failingFor() < getUnhealthyThreshold()
+}
+
+// FetchBlocks fetches the blocks in the range [start...end] of the channel from one of the
+// ordering service endpoints, trying them in random order until one of them succeeds.
+// The ordering service is asked not to wait for blocks it doesn't have yet, hence
+// less blocks than requested are returned if it doesn't have all of them.
+func (d *deliverServiceImpl) FetchBlocks(chainID string, start, end uint64) ([]*common.Block, error) {
+	d.lock.RLock()
+	endpoints := d.conf.Endpoints
+	d.lock.RUnlock()
+	var err error
+	for _, i := range rand.Perm(len(endpoints)) {
+		var blocks []*common.Block
+		blocks, err = d.fetchBlocksFrom(endpoints[i], chainID, start, end)
+		if err == nil {
+			return blocks, nil
+		}
+		logger.Warningf("Failed fetching blocks in range [%d...%d] of channel %s from %s: %s", start, end, chainID, endpoints[i], err)
+	}
+	if err == nil {
+		err = errors.New("No endpoints specified")
+	}
+	return nil, err
+}
+
+func (d *deliverServiceImpl) fetchBlocksFrom(endpoint, chainID string, start, end uint64) ([]*common.Block, error) {
+	conn, err := d.conf.ConnFactory(chainID)(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), getFetchTimeout())
+	defer cancel()
+	stream, err := d.conf.ABCFactory(conn).Deliver(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requester := &blocksRequester{
+		tls:     comm.TLSEnabled(),
+		chainID: chainID,
+		client:  stream,
+	}
+	if err := requester.seekRange(start, end); err != nil {
+		return nil, err
+	}
+	var blocks []*common.Block
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		switch t := msg.Type.(type) {
+		case *orderer.DeliverResponse_Block:
+			expected := start + uint64(len(blocks))
+			if t.Block.Header == nil || t.Block.Header.Number != expected {
+				return nil, fmt.Errorf("Expected block [%d] but received a different block", expected)
+			}
+			blocks = append(blocks, t.Block)
+		case *orderer.DeliverResponse_Status:
+			// The ordering service replies with NOT_FOUND
+			// once it has no more blocks of the range
+			if t.Status == common.Status_SUCCESS || t.Status == common.Status_NOT_FOUND {
+				return blocks, nil
+			}
+			return nil, fmt.Errorf("Received status %s", t.Status)
+		default:
+			return nil, fmt.Errorf("Received unknown response type %T", t)
+		}
+	}
+}
+
 func (d *deliverServiceImpl) newClient(chainID string, ledgerInfoProvider blocksprovider.LedgerInfo) *broadcastClient {
 	requester := &blocksRequester{
 		tls:     comm.TLSEnabled(),
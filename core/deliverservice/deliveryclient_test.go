@@ -22,6 +22,7 @@ import (
 	"github.com/hyperledger/fabric/gossip/api"
 	"github.com/hyperledger/fabric/gossip/common"
 	"github.com/hyperledger/fabric/msp/mgmt/testtools"
+	pcommon "github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/orderer"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -603,6 +604,89 @@ func TestDeliverServiceBadConfig(t *testing.T) {
 	assert.Nil(t, service)
 }
 
+func TestDeliverServiceFetchBlocks(t *testing.T) {
+	// Scenario: the ordering service has blocks up to 4, and the peer
+	// fetches blocks from it, while one of its endpoints is down
+	blocksDeliverer := &mocks.MockBlocksDeliverer{}
+	blocksDeliverer.MockRecv = func(mock *mocks.MockBlocksDeliverer) (*orderer.DeliverResponse, error) {
+		if mock.Pos > 4 {
+			return &orderer.DeliverResponse{
+				Type: &orderer.DeliverResponse_Status{Status: pcommon.Status_NOT_FOUND},
+			}, nil
+		}
+		return mocks.MockRecv(mock)
+	}
+	abcf := func(*grpc.ClientConn) orderer.AtomicBroadcastClient {
+		return &mocks.MockAtomicBroadcastClient{blocksDeliverer}
+	}
+	connFactory := func(_ string) func(string) (*grpc.ClientConn, error) {
+		return func(endpoint string) (*grpc.ClientConn, error) {
+			if endpoint == "down" {
+				return nil, errors.New("unreachable")
+			}
+			lock.Lock()
+			defer lock.Unlock()
+			return newConnection(), nil
+		}
+	}
+	service, err := NewDeliverService(&Config{
+		Endpoints:   []string{"down", "up"},
+		Gossip:      &mocks.MockGossipServiceAdapter{},
+		CryptoSvc:   &mockMCS{},
+		ABCFactory:  abcf,
+		ConnFactory: connFactory,
+	})
+	assert.NoError(t, err)
+	defer service.Stop()
+
+	// Only the blocks the ordering service has are returned
+	blocks, err := service.FetchBlocks("TEST_CHAINID", 2, 10)
+	assert.NoError(t, err)
+	assert.Len(t, blocks, 3)
+	for i, block := range blocks {
+		assert.Equal(t, uint64(i+2), block.Header.Number)
+	}
+	blocks, err = service.FetchBlocks("TEST_CHAINID", 5, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, blocks)
+
+	// Blocks out of order are rejected
+	blocksDeliverer.MockRecv = func(mock *mocks.MockBlocksDeliverer) (*orderer.DeliverResponse, error) {
+		mock.Pos++
+		return mocks.MockRecv(mock)
+	}
+	_, err = service.FetchBlocks("TEST_CHAINID", 2, 10)
+	assert.Error(t, err)
+
+	// Blocks are fetched from the updated endpoints
+	service.UpdateEndpoints("TEST_CHAINID", []string{"down"})
+	_, err = service.FetchBlocks("TEST_CHAINID", 2, 10)
+	assert.EqualError(t, err, "unreachable")
+
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Equal(t, 0, connNumber)
+}
+
+func TestDeliverServiceHealthy(t *testing.T) {
+	defer viper.Reset()
+	viper.Set("peer.deliveryclient.unhealthyThreshold", time.Second)
+	client := &broadcastClient{}
+	ds := &deliverServiceImpl{
+		blockProviders: make(map[string]blocksprovider.BlocksProvider),
+		clients:        map[string]*broadcastClient{"TEST_CHAINID": client},
+	}
+	// Channels blocks aren't delivered for are considered healthy
+	assert.True(t, ds.Healthy("OTHER_CHAINID"))
+	assert.True(t, ds.Healthy("TEST_CHAINID"))
+	// Failing for less than the threshold is still considered healthy
+	atomic.StoreInt64(&client.failingSince, time.Now().UnixNano())
+	assert.True(t, ds.Healthy("TEST_CHAINID"))
+	// Failing for longer than the threshold is considered unhealthy
+	atomic.StoreInt64(&client.failingSince, time.Now().Add(-time.Minute).UnixNano())
+	assert.False(t, ds.Healthy("TEST_CHAINID"))
+}
+
 func TestRetryPolicyOverflow(t *testing.T) {
 	connFactory := func(channelID string) func(endpoint string) (*grpc.ClientConn, error) {
 		return func(_ string) (*grpc.ClientConn, error) {
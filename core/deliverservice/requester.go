@@ -98,3 +98,23 @@ func (b *blocksRequester) seekLatestFromCommitter(height uint64) error {
 	}
 	return b.client.Send(env)
 }
+
+// seekRange requests the blocks in the range [start...end], without
+// waiting for the blocks the ordering service doesn't have yet
+func (b *blocksRequester) seekRange(start, end uint64) error {
+	seekInfo := &orderer.SeekInfo{
+		Start:    &orderer.SeekPosition{Type: &orderer.SeekPosition_Specified{Specified: &orderer.SeekSpecified{Number: start}}},
+		Stop:     &orderer.SeekPosition{Type: &orderer.SeekPosition_Specified{Specified: &orderer.SeekSpecified{Number: end}}},
+		Behavior: orderer.SeekInfo_FAIL_IF_NOT_READY,
+	}
+
+	//TODO- epoch and msgVersion may need to be obtained for nowfollowing usage in orderer/configupdate/configupdate.go
+	msgVersion := int32(0)
+	epoch := uint64(0)
+	tlsCertHash := b.getTLSCertHash()
+	env, err := utils.CreateSignedEnvelopeWithTLSBinding(common.HeaderType_DELIVER_SEEK_INFO, b.chainID, localmsp.NewSigner(), seekInfo, msgVersion, epoch, tlsCertHash)
+	if err != nil {
+		return err
+	}
+	return b.client.Send(env)
+}
@@ -0,0 +1,318 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package endorser
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/common/validation"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
+	"github.com/hyperledger/fabric/protos/msp"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	putils "github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// localEndorser identifies the simulation of a proposal by the peer in divergence reports
+const localEndorser = "local"
+
+// simulationResults are the results of a simulation of a proposal, as
+// endorsed in a proposal response, or as produced by the peer
+type simulationResults struct {
+	response *pb.Response
+	rwSet    *rwsetutil.TxRwSet
+	events   []*pb.ChaincodeEvent
+}
+
+// CheckDivergence diffs the read-write sets of the responses of endorsers to a proposal,
+// and those of a simulation of the proposal by the peer if the request asks for it, in
+// order to report the keys that the endorsers didn't read or write alike
+func (e *Endorser) CheckDivergence(ctx context.Context, request *pb.DivergenceRequest) (*pb.DivergenceReport, error) {
+	prop, hdr, hdrExt, err := validation.ValidateProposalMessage(request.SignedProposal)
+	if err != nil {
+		return nil, err
+	}
+	proposalHash, err := putils.GetProposalHash1(hdr, prop.Payload, hdrExt.PayloadVisibility)
+	if err != nil {
+		return nil, errors.WithMessage(err, "could not compute proposal hash")
+	}
+
+	report := &pb.DivergenceReport{}
+	var results []*simulationResults
+	for i, resp := range request.ProposalResponses {
+		res, err := resultsFromProposalResponse(resp, proposalHash)
+		if err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("invalid proposal response %d", i))
+		}
+		results = append(results, res)
+		report.Endorsers = append(report.Endorsers, endorserMSPID(resp))
+	}
+	if request.Resimulate {
+		res, height, err := e.resimulate(ctx, request.SignedProposal, prop, hdr.ChannelHeader, hdr.SignatureHeader, hdrExt, request.BlockHeight)
+		if err != nil {
+			return nil, errors.WithMessage(err, "failed simulating the proposal")
+		}
+		results = append(results, res)
+		report.Endorsers = append(report.Endorsers, localEndorser)
+		report.BlockHeight = height
+	}
+	if len(results) < 2 {
+		return nil, errors.New("at least two simulations of the proposal are needed to check their divergence")
+	}
+
+	diffResults(report, results)
+	return report, nil
+}
+
+// resimulate simulates the proposal with a transaction simulator, at the current height
+// of the ledger, which must be the given height if it is not 0. Unlike the simulation of
+// proposals to be endorsed, the private data written is not distributed to other peers.
+func (e *Endorser) resimulate(ctx context.Context, signedProp *pb.SignedProposal, prop *pb.Proposal, chdrBytes, shdrBytes []byte, hdrExt *pb.ChaincodeHeaderExtension, height uint64) (*simulationResults, uint64, error) {
+	chdr, err := putils.UnmarshalChannelHeader(chdrBytes)
+	if err != nil {
+		return nil, 0, err
+	}
+	shdr, err := putils.GetSignatureHeader(shdrBytes)
+	if err != nil {
+		return nil, 0, err
+	}
+	chainID := chdr.ChannelId
+	if chainID == "" {
+		return nil, 0, errors.New("proposals without a channel cannot be simulated")
+	}
+	// system chaincodes don't simulate proposals the same way, and
+	// some of them can't be invoked through proposals at all
+	if e.s.IsSysCC(hdrExt.ChaincodeId.Name) {
+		return nil, 0, errors.Errorf("proposals to system chaincode %s cannot be simulated", hdrExt.ChaincodeId.Name)
+	}
+	if err = e.s.CheckACL(signedProp, chdr, shdr, hdrExt); err != nil {
+		return nil, 0, err
+	}
+
+	before, err := e.s.GetLedgerHeight(chainID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if height != 0 && height != before {
+		return nil, 0, errors.Errorf("the ledger is at height %d, not %d", before, height)
+	}
+	diagnostic := *e
+	diagnostic.distributePrivateData = func(string, string, *rwset.TxPvtReadWriteSet) error { return nil }
+	vr := &validateResult{prop: prop, hdrExt: hdrExt, chainID: chainID, txid: chdr.TxId, creator: shdr.Creator}
+	sim, err := diagnostic.simulate(ctx, signedProp, vr, false)
+	if err != nil {
+		return nil, 0, err
+	}
+	after, err := e.s.GetLedgerHeight(chainID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if after != before {
+		return nil, 0, errors.Errorf("the ledger moved from height %d to %d during the simulation", before, after)
+	}
+
+	txRWSet := &rwsetutil.TxRwSet{}
+	if err = txRWSet.FromProtoBytes(sim.results); err != nil {
+		return nil, 0, errors.Wrap(err, "failed unmarshaling simulation results")
+	}
+	return &simulationResults{response: sim.response, rwSet: txRWSet, events: sim.events}, before, nil
+}
+
+// resultsFromProposalResponse extracts the simulation results endorsed in the given
+// proposal response, which must be a response to the proposal with the given hash.
+// Responses that could not be endorsed only carry the response of the chaincode.
+func resultsFromProposalResponse(resp *pb.ProposalResponse, proposalHash []byte) (*simulationResults, error) {
+	if len(resp.Payload) == 0 {
+		return &simulationResults{response: resp.Response, rwSet: &rwsetutil.TxRwSet{}}, nil
+	}
+	prp, err := putils.GetProposalResponsePayload(resp.Payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed unmarshaling proposal response payload")
+	}
+	if !bytes.Equal(prp.ProposalHash, proposalHash) {
+		return nil, errors.New("the response is not a response to the proposal")
+	}
+	cAct, err := putils.GetChaincodeAction(prp.Extension)
+	if err != nil {
+		return nil, err
+	}
+	txRWSet := &rwsetutil.TxRwSet{}
+	if err = txRWSet.FromProtoBytes(cAct.Results); err != nil {
+		return nil, errors.Wrap(err, "failed unmarshaling simulation results")
+	}
+	events, err := putils.GetChaincodeActionEvents(cAct)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed unmarshaling chaincode events")
+	}
+	return &simulationResults{response: cAct.Response, rwSet: txRWSet, events: events}, nil
+}
+
+// endorserMSPID returns the MSP ID of the endorser of the given proposal
+// response, or an empty string if the response is not endorsed
+func endorserMSPID(resp *pb.ProposalResponse) string {
+	if resp.Endorsement == nil {
+		return ""
+	}
+	sID := &msp.SerializedIdentity{}
+	if err := proto.Unmarshal(resp.Endorsement.Endorser, sID); err != nil {
+		return ""
+	}
+	return sID.Mspid
+}
+
+// keyAccess identifies the read or the write of a key by a simulation, where the key
+// is the hash of the key for private keys, or the start key for range queries
+type keyAccess struct {
+	namespace  string
+	collection string
+	access     pb.DivergentKey_Access
+	key        string
+	endKey     string
+}
+
+func (a keyAccess) less(b keyAccess) bool {
+	switch {
+	case a.namespace != b.namespace:
+		return a.namespace < b.namespace
+	case a.collection != b.collection:
+		return a.collection < b.collection
+	case a.access != b.access:
+		return a.access < b.access
+	case a.key != b.key:
+		return a.key < b.key
+	default:
+		return a.endKey < b.endKey
+	}
+}
+
+// diffResults fills the report with the keys that the simulations
+// didn't read or write alike, and with whether their responses
+// and events differ
+func diffResults(report *pb.DivergenceReport, results []*simulationResults) {
+	observations := make([]map[keyAccess]*pb.KeyObservation, len(results))
+	accesses := make(map[keyAccess]struct{})
+	for i, res := range results {
+		observations[i] = observe(res.rwSet)
+		for access := range observations[i] {
+			accesses[access] = struct{}{}
+		}
+	}
+
+	var divergent []keyAccess
+	for access := range accesses {
+		first := observationOf(observations[0], access)
+		for _, obs := range observations[1:] {
+			if !proto.Equal(first, observationOf(obs, access)) {
+				divergent = append(divergent, access)
+				break
+			}
+		}
+	}
+	sort.Slice(divergent, func(i, j int) bool {
+		return divergent[i].less(divergent[j])
+	})
+	for _, access := range divergent {
+		key := &pb.DivergentKey{Namespace: access.namespace, Collection: access.collection, Access: access.access}
+		switch {
+		case access.collection != "":
+			key.KeyHash = []byte(access.key)
+		case access.access == pb.DivergentKey_RANGE_QUERY:
+			key.StartKey, key.EndKey = access.key, access.endKey
+		default:
+			key.Key = access.key
+		}
+		for _, obs := range observations {
+			key.Observations = append(key.Observations, observationOf(obs, access))
+		}
+		report.DivergentKeys = append(report.DivergentKeys, key)
+	}
+
+	for _, res := range results[1:] {
+		if !proto.Equal(results[0].response, res.response) {
+			report.ResponsesDiverge = true
+		}
+		if !eventsEqual(results[0].events, res.events) {
+			report.EventsDiverge = true
+		}
+	}
+}
+
+// observe returns what the given read-write set read or wrote for each key
+func observe(txRWSet *rwsetutil.TxRwSet) map[keyAccess]*pb.KeyObservation {
+	observations := make(map[keyAccess]*pb.KeyObservation)
+	for _, nsRWSet := range txRWSet.NsRwSets {
+		ns := nsRWSet.NameSpace
+		for _, read := range nsRWSet.KvRwSet.GetReads() {
+			access := keyAccess{namespace: ns, access: pb.DivergentKey_READ, key: read.Key}
+			observations[access] = &pb.KeyObservation{Present: true, Version: keyVersion(read.Version)}
+		}
+		for _, write := range nsRWSet.KvRwSet.GetWrites() {
+			access := keyAccess{namespace: ns, access: pb.DivergentKey_WRITE, key: write.Key}
+			observations[access] = &pb.KeyObservation{Present: true, Value: write.Value, IsDelete: write.IsDelete}
+		}
+		for _, rqi := range nsRWSet.KvRwSet.GetRangeQueriesInfo() {
+			access := keyAccess{namespace: ns, access: pb.DivergentKey_RANGE_QUERY, key: rqi.StartKey, endKey: rqi.EndKey}
+			rqiBytes, err := proto.Marshal(rqi)
+			if err != nil {
+				endorserLogger.Warningf("Failed marshaling range query info: %s", err)
+			}
+			// the same range may be queried several times
+			obs, exists := observations[access]
+			if !exists {
+				obs = &pb.KeyObservation{Present: true}
+				observations[access] = obs
+			}
+			obs.Value = append(obs.Value, rqiBytes...)
+		}
+		for _, collRWSet := range nsRWSet.CollHashedRwSets {
+			coll := collRWSet.CollectionName
+			for _, read := range collRWSet.HashedRwSet.GetHashedReads() {
+				access := keyAccess{namespace: ns, collection: coll, access: pb.DivergentKey_READ, key: string(read.KeyHash)}
+				observations[access] = &pb.KeyObservation{Present: true, Version: keyVersion(read.Version)}
+			}
+			for _, write := range collRWSet.HashedRwSet.GetHashedWrites() {
+				access := keyAccess{namespace: ns, collection: coll, access: pb.DivergentKey_WRITE, key: string(write.KeyHash)}
+				observations[access] = &pb.KeyObservation{Present: true, Value: write.ValueHash, IsDelete: write.IsDelete}
+			}
+		}
+	}
+	return observations
+}
+
+// observationOf returns the observation of the given access, which is
+// an empty observation if the simulation didn't perform the access
+func observationOf(observations map[keyAccess]*pb.KeyObservation, access keyAccess) *pb.KeyObservation {
+	if obs, exists := observations[access]; exists {
+		return obs
+	}
+	return &pb.KeyObservation{}
+}
+
+func keyVersion(version *kvrwset.Version) *pb.KeyVersion {
+	if version == nil {
+		return nil
+	}
+	return &pb.KeyVersion{BlockNum: version.BlockNum, TxNum: version.TxNum}
+}
+
+func eventsEqual(a, b []*pb.ChaincodeEvent) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,230 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package endorser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/core/endorser/mocks"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
+	ledgerutil "github.com/hyperledger/fabric/core/ledger/util"
+	"github.com/hyperledger/fabric/core/mocks/ccprovider"
+	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	"github.com/hyperledger/fabric/protos/msp"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// newDivergenceFakeSupport returns the support of an endorser of the given MSP,
+// whose simulations read and write the given keys of chaincode mycc
+func newDivergenceFakeSupport(t *testing.T, mspID string, reads map[string]*version.Height, writes map[string]string) *mocks.Support {
+	b := rwsetutil.NewRWSetBuilder()
+	for key, ver := range reads {
+		b.AddToReadSet("mycc", key, ver)
+	}
+	for key, value := range writes {
+		b.AddToWriteSet("mycc", key, []byte(value))
+	}
+	assert.NoError(t, b.AddToPvtAndHashedWriteSet("mycc", "coll", "pvtkey", []byte("pvtvalue")))
+	simRes, err := b.GetTxSimulationResults()
+	assert.NoError(t, err)
+
+	fakeSupport := newReadOnlyFakeSupport(nil)
+	fakeSupport.GetTxSimulatorReturns(&ccprovider.MockTxSim{GetTxSimulationResultsRv: simRes}, nil)
+	fakeSupport.EndorseWithPluginStub = func(_, _ string, prpBytes []byte, _ *pb.SignedProposal) (*pb.Endorsement, []byte, error) {
+		endorser := utils.MarshalOrPanic(&msp.SerializedIdentity{Mspid: mspID})
+		return &pb.Endorsement{Endorser: endorser, Signature: []byte("signature")}, prpBytes, nil
+	}
+	return fakeSupport
+}
+
+func failingDistributor(t *testing.T) privateDataDistributor {
+	return func(channel string, txID string, privateData *rwset.TxPvtReadWriteSet) error {
+		t.Error("private data of diagnostic simulations must not be distributed")
+		return errors.New("unexpected distribution")
+	}
+}
+
+func TestCheckDivergence(t *testing.T) {
+	chainID := util.GetTestChainID()
+	signedProp := getSignedPropWithCHIdAndArgs(chainID, "mycc", "0", [][]byte{[]byte("invoke")}, t)
+	noDistribution := func(string, string, *rwset.TxPvtReadWriteSet) error { return nil }
+
+	endorse := func(support *mocks.Support) *pb.ProposalResponse {
+		resp, err := NewEndorserServer(noDistribution, support).ProcessProposal(context.Background(), signedProp)
+		assert.NoError(t, err)
+		return resp
+	}
+	org1Resp := endorse(newDivergenceFakeSupport(t, "Org1MSP",
+		map[string]*version.Height{"a": version.NewHeight(1, 0)}, map[string]string{"a": "1", "b": "2"}))
+	org2Resp := endorse(newDivergenceFakeSupport(t, "Org2MSP",
+		map[string]*version.Height{"a": version.NewHeight(1, 0)}, map[string]string{"a": "1", "b": "3"}))
+
+	t.Run("ResponsesOnly", func(t *testing.T) {
+		es := NewEndorserServer(failingDistributor(t), &mocks.Support{}).(*Endorser)
+		report, err := es.CheckDivergence(context.Background(), &pb.DivergenceRequest{
+			SignedProposal:    signedProp,
+			ProposalResponses: []*pb.ProposalResponse{org1Resp, org2Resp},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &pb.DivergenceReport{
+			Endorsers: []string{"Org1MSP", "Org2MSP"},
+			DivergentKeys: []*pb.DivergentKey{{
+				Namespace: "mycc",
+				Key:       "b",
+				Access:    pb.DivergentKey_WRITE,
+				Observations: []*pb.KeyObservation{
+					{Present: true, Value: []byte("2")},
+					{Present: true, Value: []byte("3")},
+				},
+			}},
+		}, report)
+	})
+
+	t.Run("Resimulated", func(t *testing.T) {
+		fakeSupport := newDivergenceFakeSupport(t, "Org1MSP",
+			map[string]*version.Height{"a": version.NewHeight(2, 0)}, map[string]string{"a": "1", "b": "2"})
+		fakeSupport.GetLedgerHeightReturns(3, nil)
+		fakeSupport.ExecuteReturns(&pb.Response{Status: 200, Payload: []byte("other result")}, nil, nil)
+		es := NewEndorserServer(failingDistributor(t), fakeSupport).(*Endorser)
+		report, err := es.CheckDivergence(context.Background(), &pb.DivergenceRequest{
+			SignedProposal:    signedProp,
+			ProposalResponses: []*pb.ProposalResponse{org1Resp},
+			Resimulate:        true,
+			BlockHeight:       3,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Org1MSP", "local"}, report.Endorsers)
+		assert.Equal(t, uint64(3), report.BlockHeight)
+		assert.True(t, report.ResponsesDiverge)
+		assert.False(t, report.EventsDiverge)
+		assert.Equal(t, []*pb.DivergentKey{{
+			Namespace: "mycc",
+			Key:       "a",
+			Access:    pb.DivergentKey_READ,
+			Observations: []*pb.KeyObservation{
+				{Present: true, Version: &pb.KeyVersion{BlockNum: 1}},
+				{Present: true, Version: &pb.KeyVersion{BlockNum: 2}},
+			},
+		}}, report.DivergentKeys)
+		assert.Equal(t, 0, fakeSupport.EndorseWithPluginCallCount())
+	})
+
+	t.Run("ResimulatedAtAnotherHeight", func(t *testing.T) {
+		fakeSupport := newDivergenceFakeSupport(t, "Org1MSP", nil, nil)
+		fakeSupport.GetLedgerHeightReturns(3, nil)
+		es := NewEndorserServer(failingDistributor(t), fakeSupport).(*Endorser)
+		_, err := es.CheckDivergence(context.Background(), &pb.DivergenceRequest{
+			SignedProposal:    signedProp,
+			ProposalResponses: []*pb.ProposalResponse{org1Resp},
+			Resimulate:        true,
+			BlockHeight:       2,
+		})
+		assert.EqualError(t, err, "failed simulating the proposal: the ledger is at height 3, not 2")
+
+		// a block is committed during the simulation
+		fakeSupport = newDivergenceFakeSupport(t, "Org1MSP", nil, nil)
+		fakeSupport.GetLedgerHeightReturnsOnCall(0, 3, nil)
+		fakeSupport.GetLedgerHeightReturnsOnCall(1, 4, nil)
+		es = NewEndorserServer(failingDistributor(t), fakeSupport).(*Endorser)
+		_, err = es.CheckDivergence(context.Background(), &pb.DivergenceRequest{
+			SignedProposal:    signedProp,
+			ProposalResponses: []*pb.ProposalResponse{org1Resp},
+			Resimulate:        true,
+		})
+		assert.EqualError(t, err, "failed simulating the proposal: the ledger moved from height 3 to 4 during the simulation")
+	})
+
+	t.Run("SystemChaincode", func(t *testing.T) {
+		fakeSupport := newDivergenceFakeSupport(t, "Org1MSP", nil, nil)
+		fakeSupport.IsSysCCReturns(true)
+		es := NewEndorserServer(failingDistributor(t), fakeSupport).(*Endorser)
+		_, err := es.CheckDivergence(context.Background(), &pb.DivergenceRequest{
+			SignedProposal:    signedProp,
+			ProposalResponses: []*pb.ProposalResponse{org1Resp},
+			Resimulate:        true,
+		})
+		assert.EqualError(t, err, "failed simulating the proposal: proposals to system chaincode mycc cannot be simulated")
+	})
+
+	t.Run("ResponseToAnotherProposal", func(t *testing.T) {
+		otherProp := getSignedPropWithCHIdAndArgs(chainID, "mycc", "0", [][]byte{[]byte("invoke")}, t)
+		es := NewEndorserServer(failingDistributor(t), &mocks.Support{}).(*Endorser)
+		_, err := es.CheckDivergence(context.Background(), &pb.DivergenceRequest{
+			SignedProposal:    otherProp,
+			ProposalResponses: []*pb.ProposalResponse{org1Resp, org2Resp},
+		})
+		assert.EqualError(t, err, "invalid proposal response 0: the response is not a response to the proposal")
+	})
+
+	t.Run("SingleSimulation", func(t *testing.T) {
+		es := NewEndorserServer(failingDistributor(t), &mocks.Support{}).(*Endorser)
+		_, err := es.CheckDivergence(context.Background(), &pb.DivergenceRequest{
+			SignedProposal:    signedProp,
+			ProposalResponses: []*pb.ProposalResponse{org1Resp},
+		})
+		assert.EqualError(t, err, "at least two simulations of the proposal are needed to check their divergence")
+	})
+}
+
+func TestDiffResults(t *testing.T) {
+	b := rwsetutil.NewRWSetBuilder()
+	b.AddToWriteSet("mycc", "a", []byte("1"))
+	assert.NoError(t, b.AddToPvtAndHashedWriteSet("mycc", "coll", "pvtkey", []byte("pvtvalue")))
+	first := b.GetTxReadWriteSet()
+
+	b = rwsetutil.NewRWSetBuilder()
+	b.AddToWriteSet("mycc", "a", nil)
+	assert.NoError(t, b.AddToHashedReadSet("mycc", "coll", "pvtkey", version.NewHeight(1, 1)))
+	second := b.GetTxReadWriteSet()
+
+	report := &pb.DivergenceReport{}
+	diffResults(report, []*simulationResults{
+		{response: &pb.Response{Status: 200}, rwSet: first, events: []*pb.ChaincodeEvent{{EventName: "event"}}},
+		{response: &pb.Response{Status: 200}, rwSet: second},
+	})
+	assert.False(t, report.ResponsesDiverge)
+	assert.True(t, report.EventsDiverge)
+	assert.Len(t, report.DivergentKeys, 3)
+
+	// private keys are identified by the hashes of the keys
+	pvtKeyHash := ledgerutil.ComputeStringHash("pvtkey")
+	assert.Equal(t, &pb.DivergentKey{
+		Namespace:  "mycc",
+		Collection: "coll",
+		KeyHash:    pvtKeyHash,
+		Access:     pb.DivergentKey_READ,
+		Observations: []*pb.KeyObservation{
+			{},
+			{Present: true, Version: &pb.KeyVersion{BlockNum: 1, TxNum: 1}},
+		},
+	}, report.DivergentKeys[1])
+	assert.Equal(t, &pb.DivergentKey{
+		Namespace:  "mycc",
+		Collection: "coll",
+		KeyHash:    pvtKeyHash,
+		Access:     pb.DivergentKey_WRITE,
+		Observations: []*pb.KeyObservation{
+			{Present: true, Value: ledgerutil.ComputeHash([]byte("pvtvalue"))},
+			{},
+		},
+	}, report.DivergentKeys[2])
+	assert.Equal(t, &pb.DivergentKey{
+		Namespace: "mycc",
+		Key:       "a",
+		Access:    pb.DivergentKey_WRITE,
+		Observations: []*pb.KeyObservation{
+			{Present: true, Value: []byte("1")},
+			{Present: true, IsDelete: true},
+		},
+	}, report.DivergentKeys[0])
+}
@@ -23,6 +23,7 @@ import (
 	pb "github.com/hyperledger/fabric/protos/peer"
 	putils "github.com/hyperledger/fabric/protos/utils"
 	"github.com/pkg/errors"
+	"github.com/spf13/viper"
 	"golang.org/x/net/context"
 )
 
@@ -57,6 +58,18 @@ type Support interface {
 	// by way of the supplied txid
 	GetTxSimulator(ledgername string, txid string) (ledger.TxSimulator, error)
 
+	// GetQueryExecutor returns a query executor for the specified ledger, which
+	// is used to simulate read-only proposals
+	GetQueryExecutor(ledgername string) (ledger.QueryExecutor, error)
+
+	// GetHistoricalQueryExecutor returns a query executor reading the state of the
+	// specified ledger as of the given height, which is used to simulate read-only
+	// proposals at a past height
+	GetHistoricalQueryExecutor(ledgername string, height uint64) (ledger.QueryExecutor, error)
+
+	// GetLedgerHeight returns the height of the specified ledger
+	GetLedgerHeight(ledgername string) (uint64, error)
+
 	// GetHistoryQueryExecutor gives handle to a history query executor for the
 	// specified ledger
 	GetHistoryQueryExecutor(ledgername string) (ledger.HistoryQueryExecutor, error)
@@ -69,7 +82,7 @@ type Support interface {
 	IsSysCC(name string) bool
 
 	//Execute - execute proposal, return original response of chaincode
-	Execute(ctxt context.Context, cid, name, version, txid string, syscc bool, signedProp *pb.SignedProposal, prop *pb.Proposal, spec interface{}) (*pb.Response, *pb.ChaincodeEvent, error)
+	Execute(ctxt context.Context, cid, name, version, txid string, syscc bool, signedProp *pb.SignedProposal, prop *pb.Proposal, spec interface{}) (*pb.Response, []*pb.ChaincodeEvent, error)
 
 	// GetChaincodeDefinition returns resourcesconfig.ChaincodeDefinition for the chaincode with the supplied name
 	GetChaincodeDefinition(ctx context.Context, chainID string, txid string, signedProp *pb.SignedProposal, prop *pb.Proposal, chaincodeID string, txsim ledger.TxSimulator) (resourcesconfig.ChaincodeDefinition, error)
@@ -92,12 +105,19 @@ type Support interface {
 	// GetApplicationConfig returns the configtxapplication.SharedConfig for the channel
 	// and whether the Application config exists
 	GetApplicationConfig(cid string) (channelconfig.Application, bool)
+
+	// EndorseWithPlugin endorses the given proposal response payload with the
+	// endorsement plugin of the given name, returning the endorsement and the
+	// payload, which the plugin may have modified
+	EndorseWithPlugin(pluginName, channelID string, prpBytes []byte, signedProposal *pb.SignedProposal) (*pb.Endorsement, []byte, error)
 }
 
 // Endorser provides the Endorser service ProcessProposal
 type Endorser struct {
-	distributePrivateData privateDataDistributor
-	s                     Support
+	distributePrivateData   privateDataDistributor
+	s                       Support
+	skipReadOnlyEndorsement bool
+	responseCache           *responseCache
 }
 
 // validateResult provides the result of endorseProposal verification
@@ -106,25 +126,34 @@ type validateResult struct {
 	hdrExt  *pb.ChaincodeHeaderExtension
 	chainID string
 	txid    string
+	creator []byte
 	resp    *pb.ProposalResponse
 }
 
 // NewEndorserServer creates and returns a new Endorser server instance.
 func NewEndorserServer(privDist privateDataDistributor, s Support) pb.EndorserServer {
 	e := &Endorser{
-		distributePrivateData: privDist,
-		s: s,
+		distributePrivateData:   privDist,
+		s:                       s,
+		skipReadOnlyEndorsement: viper.GetBool(skipReadOnlyEndorsementConfigKey),
+	}
+	if size := viper.GetInt(responseCacheSizeConfigKey); size > 0 {
+		ttl := viper.GetDuration(responseCacheTTLConfigKey)
+		if ttl <= 0 {
+			ttl = defaultResponseCacheTTL
+		}
+		e.responseCache = newResponseCache(size, ttl)
 	}
 	return e
 }
 
 //call specified chaincode (system or user)
-func (e *Endorser) callChaincode(ctxt context.Context, chainID string, version string, txid string, signedProp *pb.SignedProposal, prop *pb.Proposal, cis *pb.ChaincodeInvocationSpec, cid *pb.ChaincodeID, txsim ledger.TxSimulator) (*pb.Response, *pb.ChaincodeEvent, error) {
+func (e *Endorser) callChaincode(ctxt context.Context, chainID string, version string, txid string, signedProp *pb.SignedProposal, prop *pb.Proposal, cis *pb.ChaincodeInvocationSpec, cid *pb.ChaincodeID, txsim ledger.TxSimulator) (*pb.Response, []*pb.ChaincodeEvent, error) {
 	endorserLogger.Debugf("[%s][%s] Entry chaincode: %s version: %s", chainID, shorttxid(txid), cid, version)
 	defer endorserLogger.Debugf("[%s][%s] Exit", chainID, shorttxid(txid))
 	var err error
 	var res *pb.Response
-	var ccevent *pb.ChaincodeEvent
+	var ccevents []*pb.ChaincodeEvent
 
 	if txsim != nil {
 		ctxt = context.WithValue(ctxt, chaincode.TXSimulatorKey, txsim)
@@ -133,7 +162,7 @@ func (e *Endorser) callChaincode(ctxt context.Context, chainID string, version s
 	//is this a system chaincode
 	scc := e.s.IsSysCC(cid.Name)
 
-	res, ccevent, err = e.s.Execute(ctxt, chainID, cid.Name, version, txid, scc, signedProp, prop, cis)
+	res, ccevents, err = e.s.Execute(ctxt, chainID, cid.Name, version, txid, scc, signedProp, prop, cis)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -177,7 +206,7 @@ func (e *Endorser) callChaincode(ctxt context.Context, chainID string, version s
 	}
 	//----- END -------
 
-	return res, ccevent, err
+	return res, ccevents, err
 }
 
 func (e *Endorser) SanitizeUserCDS(userCDS *pb.ChaincodeDeploymentSpec) (*pb.ChaincodeDeploymentSpec, error) {
@@ -245,7 +274,7 @@ func (e *Endorser) disableJavaCCInst(cid *pb.ChaincodeID, cis *pb.ChaincodeInvoc
 }
 
 //simulate the proposal by calling the chaincode
-func (e *Endorser) simulateProposal(ctx context.Context, chainID string, txid string, signedProp *pb.SignedProposal, prop *pb.Proposal, cid *pb.ChaincodeID, txsim ledger.TxSimulator) (resourcesconfig.ChaincodeDefinition, *pb.Response, []byte, *pb.ChaincodeEvent, error) {
+func (e *Endorser) simulateProposal(ctx context.Context, chainID string, txid string, signedProp *pb.SignedProposal, prop *pb.Proposal, cid *pb.ChaincodeID, txsim ledger.TxSimulator) (resourcesconfig.ChaincodeDefinition, *pb.Response, []byte, []*pb.ChaincodeEvent, error) {
 	endorserLogger.Debugf("[%s][%s] Entry chaincode: %s", chainID, shorttxid(txid), cid)
 	defer endorserLogger.Debugf("[%s][%s] Exit", chainID, shorttxid(txid))
 	//we do expect the payload to be a ChaincodeInvocationSpec
@@ -283,8 +312,8 @@ func (e *Endorser) simulateProposal(ctx context.Context, chainID string, txid st
 	var simResult *ledger.TxSimulationResults
 	var pubSimResBytes []byte
 	var res *pb.Response
-	var ccevent *pb.ChaincodeEvent
-	res, ccevent, err = e.callChaincode(ctx, chainID, version, txid, signedProp, prop, cis, cid, txsim)
+	var ccevents []*pb.ChaincodeEvent
+	res, ccevents, err = e.callChaincode(ctx, chainID, version, txid, signedProp, prop, cis, cid, txsim)
 	if err != nil {
 		endorserLogger.Errorf("[%s][%s] failed to invoke chaincode %s, error: %+v", chainID, shorttxid(txid), cid, err)
 		return nil, nil, nil, nil, err
@@ -308,16 +337,20 @@ func (e *Endorser) simulateProposal(ctx context.Context, chainID string, txid st
 			return nil, nil, nil, nil, err
 		}
 	}
-	return cdLedger, res, pubSimResBytes, ccevent, nil
+	return cdLedger, res, pubSimResBytes, ccevents, nil
 }
 
-//endorse the proposal by calling the ESCC
-func (e *Endorser) endorseProposal(ctx context.Context, chainID string, txid string, signedProp *pb.SignedProposal, proposal *pb.Proposal, response *pb.Response, simRes []byte, event *pb.ChaincodeEvent, visibility []byte, ccid *pb.ChaincodeID, txsim ledger.TxSimulator, cd resourcesconfig.ChaincodeDefinition) (*pb.ProposalResponse, error) {
+// endorse the proposal with the endorsement plugin of the chaincode; if skipEndorsement
+// is set, and the chaincode is endorsed by the default plugin, the proposal response
+// payload is returned without an endorsement
+func (e *Endorser) endorseProposal(ctx context.Context, chainID string, txid string, signedProp *pb.SignedProposal, proposal *pb.Proposal, response *pb.Response, simRes []byte, events []*pb.ChaincodeEvent, calls []*pb.ChaincodeCall, visibility []byte, ccid *pb.ChaincodeID, cd resourcesconfig.ChaincodeDefinition, skipEndorsement bool) (*pb.ProposalResponse, error) {
 	endorserLogger.Debugf("[%s][%s] Entry chaincode: %s", chainID, shorttxid(txid), ccid)
 	defer endorserLogger.Debugf("[%s][%s] Exit", chainID, shorttxid(txid))
 
 	isSysCC := cd == nil
-	// 1) extract the name of the escc that is requested to endorse this chaincode
+	// 1) extract the name of the endorsement plugin that is requested to
+	// endorse this chaincode; the chaincode definition refers to it by the
+	// name of the escc it used to be endorsed with
 	var escc string
 	//ie, "lscc" or system chaincodes
 	if isSysCC {
@@ -328,19 +361,9 @@ func (e *Endorser) endorseProposal(ctx context.Context, chainID string, txid str
 
 	endorserLogger.Debugf("[%s][%s] escc for chaincode %s is %s", chainID, shorttxid(txid), ccid, escc)
 
-	// marshalling event bytes
-	var err error
-	var eventBytes []byte
-	if event != nil {
-		eventBytes, err = putils.GetBytesChaincodeEvent(event)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshal event bytes")
-		}
-	}
-
-	resBytes, err := putils.GetBytesResponse(response)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to marshal response bytes")
+	// only responses with a status code less than shim.ERRORTHRESHOLD can be endorsed
+	if response.Status >= shim.ERRORTHRESHOLD {
+		return &pb.ProposalResponse{Response: response}, nil
 	}
 
 	// set version of executing chaincode
@@ -352,50 +375,54 @@ func (e *Endorser) endorseProposal(ctx context.Context, chainID string, txid str
 		ccid.Version = cd.CCVersion()
 	}
 
-	ccidBytes, err := putils.Marshal(ccid)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to marshal ChaincodeID")
-	}
-
-	// 3) call the ESCC we've identified
-	// arguments:
-	// args[0] - function name (not used now)
-	// args[1] - serialized Header object
-	// args[2] - serialized ChaincodeProposalPayload object
-	// args[3] - ChaincodeID of executing chaincode
-	// args[4] - result of executing chaincode
-	// args[5] - binary blob of simulation results
-	// args[6] - serialized events
-	// args[7] - payloadVisibility
-	args := [][]byte{[]byte(""), proposal.Header, proposal.Payload, ccidBytes, resBytes, simRes, eventBytes, visibility}
-	version := util.GetSysCCVersion()
-	ecccis := &pb.ChaincodeInvocationSpec{ChaincodeSpec: &pb.ChaincodeSpec{Type: pb.ChaincodeSpec_GOLANG, ChaincodeId: &pb.ChaincodeID{Name: escc}, Input: &pb.ChaincodeInput{Args: args}}}
-	res, _, err := e.callChaincode(ctx, chainID, version, txid, signedProp, proposal, ecccis, &pb.ChaincodeID{Name: escc}, txsim)
+	// 2) build the proposal response payload to be endorsed: the ChaincodeAction
+	// carries the last event in its events field, and all of them only if the
+	// chaincode set more than one
+	eventBytes, err := getLastEventBytes(events)
 	if err != nil {
 		return nil, err
 	}
+	cAct := &pb.ChaincodeAction{Events: eventBytes, Results: simRes, Response: response, ChaincodeId: ccid, Calls: calls}
+	if len(events) > 1 {
+		cAct.ChaincodeEvents = events
+	}
 
-	if res.Status >= shim.ERRORTHRESHOLD {
-		return &pb.ProposalResponse{Response: res}, nil
+	hdr, err := putils.GetHeader(proposal.Header)
+	if err != nil {
+		return nil, err
+	}
+	pHashBytes, err := putils.GetProposalHash1(hdr, proposal.Payload, visibility)
+	if err != nil {
+		return nil, errors.WithMessage(err, "could not compute proposal hash")
+	}
+	prpBytes, err := putils.GetBytesProposalResponsePayloadForAction(pHashBytes, cAct)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal ProposalResponsePayload")
 	}
 
-	prBytes := res.Payload
-	// Note that we do not extract any simulation results from
-	// the call to ESCC. This is intentional becuse ESCC is meant
-	// to endorse (i.e. sign) the simulation results of a chaincode,
-	// but it can't obviously sign its own. Furthermore, ESCC runs
-	// on private input (its own signing key) and so if it were to
-	// produce simulationr results, they are likely to be different
-	// from other ESCCs, which would stand in the way of the
-	// endorsement process.
+	// the default plugin only signs the payload, which
+	// is pointless for responses that are not submitted
+	if skipEndorsement && escc == "escc" {
+		endorserLogger.Debugf("[%s][%s] skipping the endorsement of the response of chaincode %s", chainID, shorttxid(txid), ccid)
+		return &pb.ProposalResponse{
+			Version:  1,
+			Payload:  prpBytes,
+			Response: &pb.Response{Status: 200, Message: "OK"},
+		}, nil
+	}
 
-	//3 -- respond
-	pResp, err := putils.GetProposalResponse(prBytes)
+	// 3) have the plugin endorse it
+	endorsement, prpBytes, err := e.s.EndorseWithPlugin(escc, chainID, prpBytes, signedProp)
 	if err != nil {
-		return nil, err
+		return nil, errors.WithMessage(err, fmt.Sprintf("endorsing with plugin %s failed", escc))
 	}
 
-	return pResp, nil
+	return &pb.ProposalResponse{
+		Version:     1,
+		Endorsement: endorsement,
+		Payload:     prpBytes,
+		Response:    &pb.Response{Status: 200, Message: "OK"},
+	}, nil
 }
 
 //preProcess checks the tx proposal headers, uniqueness and ACL
@@ -464,7 +491,7 @@ func (e *Endorser) preProcess(signedProp *pb.SignedProposal) (*validateResult, e
 		// MSP of the peer instead by the call to ValidateProposalMessage above
 	}
 
-	vr.prop, vr.hdrExt, vr.chainID, vr.txid = prop, hdrExt, chainID, txid
+	vr.prop, vr.hdrExt, vr.chainID, vr.txid, vr.creator = prop, hdrExt, chainID, txid, shdr.Creator
 	return vr, nil
 }
 
@@ -483,48 +510,52 @@ func (e *Endorser) ProcessProposal(ctx context.Context, signedProp *pb.SignedPro
 
 	prop, hdrExt, chainID, txid := vr.prop, vr.hdrExt, vr.chainID, vr.txid
 
-	// obtaining once the tx simulator for this proposal. This will be nil
-	// for chainless proposals
-	// Also obtain a history query executor for history queries, since tx simulator does not cover history
-	var txsim ledger.TxSimulator
-	var historyQueryExecutor ledger.HistoryQueryExecutor
-	if acquireTxSimulator(chainID, vr.hdrExt.ChaincodeId) {
-		if txsim, err = e.s.GetTxSimulator(chainID, txid); err != nil {
-			return &pb.ProposalResponse{Response: &pb.Response{Status: 500, Message: err.Error()}}, err
-		}
-		if historyQueryExecutor, err = e.s.GetHistoryQueryExecutor(chainID); err != nil {
-			return &pb.ProposalResponse{Response: &pb.Response{Status: 500, Message: err.Error()}}, err
-		}
-		// Add the historyQueryExecutor to context
-		// TODO shouldn't we also add txsim to context here as well? Rather than passing txsim parameter
-		// around separately, since eventually it gets added to context anyways
-		ctx = context.WithValue(ctx, chaincode.HistoryQueryExecutorKey, historyQueryExecutor)
+	// proposals marked as read-only by the client are simulated with a query
+	// executor; chainless proposals don't affect the ledger in the first place
+	readOnly := hdrExt.ReadOnly && chainID != ""
 
-		defer txsim.Done()
+	// the state of the ledger at a past height can only be read
+	if hdrExt.BlockHeight != 0 && !readOnly {
+		err = errors.New("only read-only proposals can be simulated at a past height of the ledger")
+		return &pb.ProposalResponse{Response: &pb.Response{Status: 500, Message: err.Error()}}, err
 	}
-	//this could be a request to a chainless SysCC
 
 	// TODO: if the proposal has an extension, it will be of type ChaincodeAction;
 	//       if it's present it means that no simulation is to be performed because
 	//       we're trying to emulate a submitting peer. On the other hand, we need
 	//       to validate the supplied action before endorsing it
 
-	//1 -- simulate
-	cd, res, simulationResult, ccevent, err := e.simulateProposal(ctx, chainID, txid, signedProp, prop, hdrExt.ChaincodeId, txsim)
-	if err != nil {
-		return &pb.ProposalResponse{Response: &pb.Response{Status: 500, Message: err.Error()}}, err
+	//1 -- simulate, unless an identical read-only proposal
+	//     was simulated since the last block was committed
+	var sim *simulation
+	var cacheKey string
+	var height uint64
+	if readOnly && hdrExt.BlockHeight == 0 && e.responseCache != nil {
+		if height, err = e.s.GetLedgerHeight(chainID); err != nil {
+			return &pb.ProposalResponse{Response: &pb.Response{Status: 500, Message: err.Error()}}, err
+		}
+		cacheKey = responseCacheKey(chainID, hdrExt.ChaincodeId.Name, vr.creator, prop.Payload)
+		if sim = e.responseCache.get(cacheKey, height); sim != nil {
+			endorserLogger.Debugf("[%s][%s] reusing the simulation of an identical read-only proposal", chainID, shorttxid(txid))
+		}
+	}
+	if sim == nil {
+		if sim, err = e.simulate(ctx, signedProp, vr, readOnly); err != nil {
+			return &pb.ProposalResponse{Response: &pb.Response{Status: 500, Message: err.Error()}}, err
+		}
+		if cacheKey != "" && sim.response != nil && sim.response.Status < shim.ERRORTHRESHOLD {
+			e.responseCache.put(cacheKey, height, sim)
+		}
 	}
+	res := sim.response
 	if res != nil {
 		if res.Status >= shim.ERROR {
 			endorserLogger.Errorf("[%s][%s] simulateProposal() resulted in chaincode %s response status %d for txid: %s", chainID, shorttxid(txid), hdrExt.ChaincodeId, res.Status, txid)
-			var cceventBytes []byte
-			if ccevent != nil {
-				cceventBytes, err = putils.GetBytesChaincodeEvent(ccevent)
-				if err != nil {
-					return nil, errors.Wrap(err, "failed to marshal event bytes")
-				}
+			cceventBytes, err := getLastEventBytes(sim.events)
+			if err != nil {
+				return nil, err
 			}
-			pResp, err := putils.CreateProposalResponseFailure(prop.Header, prop.Payload, res, simulationResult, cceventBytes, hdrExt.ChaincodeId, hdrExt.PayloadVisibility)
+			pResp, err := putils.CreateProposalResponseFailure(prop.Header, prop.Payload, res, sim.results, cceventBytes, hdrExt.ChaincodeId, hdrExt.PayloadVisibility)
 			if err != nil {
 				return &pb.ProposalResponse{Response: &pb.Response{Status: 500, Message: err.Error()}}, err
 			}
@@ -541,7 +572,10 @@ func (e *Endorser) ProcessProposal(ctx context.Context, signedProp *pb.SignedPro
 	if chainID == "" {
 		pResp = &pb.ProposalResponse{Response: res}
 	} else {
-		pResp, err = e.endorseProposal(ctx, chainID, txid, signedProp, prop, res, simulationResult, ccevent, hdrExt.PayloadVisibility, hdrExt.ChaincodeId, txsim, cd)
+		// responses that are not meant to be submitted as transactions
+		// need not be endorsed, if the peer is configured so
+		skipEndorsement := e.skipReadOnlyEndorsement && (readOnly || !sim.writesToLedger())
+		pResp, err = e.endorseProposal(ctx, chainID, txid, signedProp, prop, res, sim.results, sim.events, sim.calls, hdrExt.PayloadVisibility, hdrExt.ChaincodeId, sim.cd, skipEndorsement)
 		if err != nil {
 			return &pb.ProposalResponse{Response: &pb.Response{Status: 500, Message: err.Error()}}, err
 		}
@@ -561,6 +595,66 @@ func (e *Endorser) ProcessProposal(ctx context.Context, signedProp *pb.SignedPro
 	return pResp, nil
 }
 
+// simulate simulates the supplied proposal with a transaction simulator,
+// or with a query executor if the proposal is read-only
+func (e *Endorser) simulate(ctx context.Context, signedProp *pb.SignedProposal, vr *validateResult, readOnly bool) (*simulation, error) {
+	chainID, txid := vr.chainID, vr.txid
+
+	// obtaining once the tx simulator for this proposal. This will be nil
+	// for chainless proposals
+	// Also obtain a history query executor for history queries, since tx simulator does not cover history
+	var txsim ledger.TxSimulator
+	var recorder *chaincode.ChaincodeCallRecorder
+	if acquireTxSimulator(chainID, vr.hdrExt.ChaincodeId) {
+		if readOnly {
+			var qe ledger.QueryExecutor
+			var err error
+			if height := vr.hdrExt.BlockHeight; height != 0 {
+				qe, err = e.s.GetHistoricalQueryExecutor(chainID, height)
+			} else {
+				qe, err = e.s.GetQueryExecutor(chainID)
+			}
+			if err != nil {
+				return nil, err
+			}
+			txsim = &readOnlySimulator{QueryExecutor: qe}
+		} else {
+			var err error
+			if txsim, err = e.s.GetTxSimulator(chainID, txid); err != nil {
+				return nil, err
+			}
+		}
+		defer txsim.Done()
+
+		historyQueryExecutor, err := e.s.GetHistoryQueryExecutor(chainID)
+		if err != nil {
+			return nil, err
+		}
+		// Add the historyQueryExecutor to context
+		// TODO shouldn't we also add txsim to context here as well? Rather than passing txsim parameter
+		// around separately, since eventually it gets added to context anyways
+		ctx = context.WithValue(ctx, chaincode.HistoryQueryExecutorKey, historyQueryExecutor)
+
+		// Record the chaincode-to-chaincode invocations, so that committers
+		// can validate them, if the channel is configured to do so
+		if e.chaincodeCallsValidation(chainID) {
+			recorder = chaincode.NewChaincodeCallRecorder()
+			ctx = context.WithValue(ctx, chaincode.ChaincodeCallRecorderKey, recorder)
+		}
+	}
+	//this could be a request to a chainless SysCC
+
+	cd, res, results, events, err := e.simulateProposal(ctx, chainID, txid, signedProp, vr.prop, vr.hdrExt.ChaincodeId, txsim)
+	if err != nil {
+		return nil, err
+	}
+	sim := &simulation{cd: cd, response: res, results: results, events: events}
+	if recorder != nil {
+		sim.calls = recorder.Calls()
+	}
+	return sim, nil
+}
+
 // determine whether or not a transaction simulator should be
 // obtained for a proposal.
 func acquireTxSimulator(chainID string, ccid *pb.ChaincodeID) bool {
@@ -578,3 +672,25 @@ func acquireTxSimulator(chainID string, ccid *pb.ChaincodeID) bool {
 		return true
 	}
 }
+
+// chaincodeCallsValidation returns whether the chaincode-to-chaincode calls
+// performed while simulating proposals on the supplied channel are validated
+// by the committers, and thus need to be recorded in the proposal responses
+func (e *Endorser) chaincodeCallsValidation(chainID string) bool {
+	ac, exists := e.s.GetApplicationConfig(chainID)
+	return exists && ac.Capabilities().ChaincodeCallsValidation()
+}
+
+// getLastEventBytes returns the serialized form of the last of the supplied
+// events, which is the single event of a transaction known to the consumers
+// that do not support multiple events, or nil if there are no events
+func getLastEventBytes(events []*pb.ChaincodeEvent) ([]byte, error) {
+	if len(events) == 0 {
+		return nil, nil
+	}
+	eventBytes, err := putils.GetBytesChaincodeEvent(events[len(events)-1])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal event bytes")
+	}
+	return eventBytes, nil
+}
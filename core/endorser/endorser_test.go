@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	mc "github.com/hyperledger/fabric/common/mocks/config"
@@ -18,6 +19,7 @@ import (
 	"github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/core/endorser/mocks"
 	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
 	"github.com/hyperledger/fabric/core/mocks/ccprovider"
 	em "github.com/hyperledger/fabric/core/mocks/endorser"
 	"github.com/hyperledger/fabric/msp"
@@ -28,6 +30,7 @@ import (
 	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/hyperledger/fabric/protos/utils"
 	"github.com/pkg/errors"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -390,7 +393,7 @@ func TestEndorserGoodPathWEvents(t *testing.T) {
 		GetTransactionByIDErr:      errors.New(""),
 		ChaincodeDefinitionRv:      &resourceconfig.MockChaincodeDefinition{EndorsementStr: "ESCC"},
 		ExecuteResp:                &pb.Response{Status: 200, Payload: utils.MarshalOrPanic(&pb.ProposalResponse{Response: &pb.Response{}})},
-		ExecuteEvent:               &pb.ChaincodeEvent{},
+		ExecuteEvents:              []*pb.ChaincodeEvent{{}},
 		GetTxSimulatorRv:           &ccprovider.MockTxSim{&ledger.TxSimulationResults{PubSimulationResults: &rwset.TxReadWriteSet{}}},
 	})
 
@@ -436,6 +439,130 @@ func TestEndorserGoodPath(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestEndorserGoodPathWithChaincodeCalls(t *testing.T) {
+	es := NewEndorserServer(func(channel string, txID string, privateData *rwset.TxPvtReadWriteSet) error {
+		return nil
+	}, &em.MockSupport{
+		GetApplicationConfigBoolRv: true,
+		GetApplicationConfigRv:     &mc.MockApplication{&mc.MockApplicationCapabilities{ChaincodeCallsValidationRv: true}},
+		GetTransactionByIDErr:      errors.New(""),
+		ChaincodeDefinitionRv:      &resourceconfig.MockChaincodeDefinition{EndorsementStr: "ESCC"},
+		ExecuteResp:                &pb.Response{Status: 200, Payload: utils.MarshalOrPanic(&pb.ProposalResponse{Response: &pb.Response{}})},
+		GetTxSimulatorRv:           &ccprovider.MockTxSim{&ledger.TxSimulationResults{PubSimulationResults: &rwset.TxReadWriteSet{}}},
+	})
+
+	signedProp := getSignedProp("ccid", "0", t)
+
+	_, err := es.ProcessProposal(context.Background(), signedProp)
+	assert.NoError(t, err)
+}
+
+func TestEndorserGoodPathWithMultipleEvents(t *testing.T) {
+	es := NewEndorserServer(func(channel string, txID string, privateData *rwset.TxPvtReadWriteSet) error {
+		return nil
+	}, &em.MockSupport{
+		GetApplicationConfigBoolRv: true,
+		GetApplicationConfigRv:     &mc.MockApplication{&mc.MockApplicationCapabilities{}},
+		GetTransactionByIDErr:      errors.New(""),
+		ChaincodeDefinitionRv:      &resourceconfig.MockChaincodeDefinition{EndorsementStr: "ESCC"},
+		ExecuteResp:                &pb.Response{Status: 200, Payload: utils.MarshalOrPanic(&pb.ProposalResponse{Response: &pb.Response{}})},
+		ExecuteEvents:              []*pb.ChaincodeEvent{{EventName: "first"}, {EventName: "second"}},
+		GetTxSimulatorRv:           &ccprovider.MockTxSim{&ledger.TxSimulationResults{PubSimulationResults: &rwset.TxReadWriteSet{}}},
+	})
+
+	signedProp := getSignedProp("ccid", "0", t)
+
+	resp, err := es.ProcessProposal(context.Background(), signedProp)
+	assert.NoError(t, err)
+
+	prp, err := utils.GetProposalResponsePayload(resp.Payload)
+	assert.NoError(t, err)
+	cAct, err := utils.GetChaincodeAction(prp.Extension)
+	assert.NoError(t, err)
+	assert.Len(t, cAct.ChaincodeEvents, 2)
+	event, err := utils.GetChaincodeEvents(cAct.Events)
+	assert.NoError(t, err)
+	assert.Equal(t, "second", event.EventName)
+}
+
+func TestEndorserEndorsementPlugin(t *testing.T) {
+	support := &mocks.Support{}
+	support.GetApplicationConfigReturns(&mc.MockApplication{&mc.MockApplicationCapabilities{}}, true)
+	support.GetTransactionByIDReturns(nil, errors.New(""))
+	support.GetChaincodeDefinitionReturns(&resourceconfig.MockChaincodeDefinition{EndorsementStr: "custom", VersionRv: "1"}, nil)
+	support.ExecuteReturns(&pb.Response{Status: 200, Payload: []byte("result")}, nil, nil)
+	support.GetTxSimulatorReturns(&ccprovider.MockTxSim{&ledger.TxSimulationResults{PubSimulationResults: &rwset.TxReadWriteSet{}}}, nil)
+	support.GetHistoryQueryExecutorReturns(nil, nil)
+	endorsement := &pb.Endorsement{Endorser: []byte("endorser"), Signature: []byte("signature")}
+	support.EndorseWithPluginReturns(endorsement, []byte("payload"), nil)
+	es := NewEndorserServer(func(channel string, txID string, privateData *rwset.TxPvtReadWriteSet) error {
+		return nil
+	}, support)
+
+	signedProp := getSignedProp("ccid", "0", t)
+
+	// the plugin named by the chaincode definition endorses the simulation
+	// results, and has the final say on the payload
+	resp, err := es.ProcessProposal(context.Background(), signedProp)
+	assert.NoError(t, err)
+	assert.Equal(t, endorsement, resp.Endorsement)
+	assert.Equal(t, []byte("payload"), resp.Payload)
+	assert.Equal(t, []byte("result"), resp.Response.Payload)
+	assert.Equal(t, 1, support.EndorseWithPluginCallCount())
+	pluginName, channelID, prpBytes, sp := support.EndorseWithPluginArgsForCall(0)
+	assert.Equal(t, "custom", pluginName)
+	assert.Equal(t, util.GetTestChainID(), channelID)
+	assert.Equal(t, signedProp, sp)
+	prp, err := utils.GetProposalResponsePayload(prpBytes)
+	assert.NoError(t, err)
+	cAct, err := utils.GetChaincodeAction(prp.Extension)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("result"), cAct.Response.Payload)
+	assert.Equal(t, "1", cAct.ChaincodeId.Version)
+
+	// a plugin refusing to endorse fails the proposal
+	support.EndorseWithPluginReturns(nil, nil, errors.New("write set not allowed"))
+	resp, err = es.ProcessProposal(context.Background(), signedProp)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "endorsing with plugin custom failed: write set not allowed")
+	assert.Equal(t, int32(500), resp.Response.Status)
+
+	// responses with a status of at least shim.ERRORTHRESHOLD are not endorsed
+	support.ExecuteReturns(&pb.Response{Status: 400, Message: "bad request"}, nil, nil)
+	resp, err = es.ProcessProposal(context.Background(), signedProp)
+	assert.Error(t, err)
+	assert.Equal(t, int32(400), resp.Response.Status)
+	assert.Nil(t, resp.Endorsement)
+	assert.Equal(t, 2, support.EndorseWithPluginCallCount())
+}
+
+func TestGetLastEventBytes(t *testing.T) {
+	eventBytes, err := getLastEventBytes(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, eventBytes)
+
+	eventBytes, err = getLastEventBytes([]*pb.ChaincodeEvent{{EventName: "first"}, {EventName: "second"}})
+	assert.NoError(t, err)
+	event, err := utils.GetChaincodeEvents(eventBytes)
+	assert.NoError(t, err)
+	assert.Equal(t, "second", event.EventName)
+}
+
+func TestEndorserChaincodeCallsValidation(t *testing.T) {
+	support := &em.MockSupport{}
+	e := NewEndorserServer(nil, support).(*Endorser)
+
+	// no application config
+	assert.False(t, e.chaincodeCallsValidation(util.GetTestChainID()))
+
+	support.GetApplicationConfigBoolRv = true
+	support.GetApplicationConfigRv = &mc.MockApplication{&mc.MockApplicationCapabilities{}}
+	assert.False(t, e.chaincodeCallsValidation(util.GetTestChainID()))
+
+	support.GetApplicationConfigRv = &mc.MockApplication{&mc.MockApplicationCapabilities{ChaincodeCallsValidationRv: true}}
+	assert.True(t, e.chaincodeCallsValidation(util.GetTestChainID()))
+}
+
 func TestEndorserLSCC(t *testing.T) {
 	es := NewEndorserServer(func(channel string, txID string, privateData *rwset.TxPvtReadWriteSet) error {
 		return nil
@@ -632,3 +759,276 @@ func TestUserCDSSanitization(t *testing.T) {
 		assert.Contains(t, err.Error(), "fake-error")
 	})
 }
+
+func getReadOnlySignedProp(chid, ccid string, ccargs [][]byte, t *testing.T) *pb.SignedProposal {
+	spec := &pb.ChaincodeSpec{Type: 1, ChaincodeId: &pb.ChaincodeID{Name: ccid}, Input: &pb.ChaincodeInput{Args: ccargs}}
+	creator, err := signer.Serialize()
+	assert.NoError(t, err)
+	prop, _, err := utils.CreateChaincodeProposal(common.HeaderType_ENDORSER_TRANSACTION, chid, &pb.ChaincodeInvocationSpec{ChaincodeSpec: spec}, creator)
+	assert.NoError(t, err)
+	assert.NoError(t, utils.MarkProposalReadOnly(prop))
+	signedProp, err := utils.GetSignedProposal(prop, signer)
+	assert.NoError(t, err)
+	return signedProp
+}
+
+func newReadOnlyFakeSupport(simResults *rwset.TxReadWriteSet) *mocks.Support {
+	fakeSupport := &mocks.Support{}
+	sim := &ccprovider.MockTxSim{GetTxSimulationResultsRv: &ledger.TxSimulationResults{PubSimulationResults: simResults}}
+	fakeSupport.GetTxSimulatorReturns(sim, nil)
+	fakeSupport.GetQueryExecutorReturns(sim, nil)
+	fakeSupport.GetTransactionByIDReturns(nil, errors.New("not found"))
+	fakeSupport.GetApplicationConfigReturns(&mc.MockApplication{CapabilitiesRv: &mc.MockApplicationCapabilities{}}, true)
+	fakeSupport.GetChaincodeDefinitionReturns(&resourceconfig.MockChaincodeDefinition{EndorsementStr: "escc"}, nil)
+	fakeSupport.ExecuteReturns(&pb.Response{Status: 200, Payload: []byte("result")}, nil, nil)
+	fakeSupport.EndorseWithPluginStub = func(_, _ string, prpBytes []byte, _ *pb.SignedProposal) (*pb.Endorsement, []byte, error) {
+		return &pb.Endorsement{Signature: []byte("signature")}, prpBytes, nil
+	}
+	return fakeSupport
+}
+
+func TestEndorserReadOnlyProposals(t *testing.T) {
+	chainID := util.GetTestChainID()
+	args := [][]byte{[]byte("query"), []byte("a")}
+
+	t.Run("SimulatedWithQueryExecutor", func(t *testing.T) {
+		fakeSupport := newReadOnlyFakeSupport(&rwset.TxReadWriteSet{})
+		es := NewEndorserServer(nil, fakeSupport)
+		resp, err := es.ProcessProposal(context.Background(), getReadOnlySignedProp(chainID, "mycc", args, t))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("result"), resp.Response.Payload)
+		assert.NotNil(t, resp.Endorsement)
+		assert.Equal(t, 1, fakeSupport.GetQueryExecutorCallCount())
+		assert.Equal(t, 0, fakeSupport.GetTxSimulatorCallCount())
+	})
+
+	t.Run("QueryExecutorUnavailable", func(t *testing.T) {
+		fakeSupport := newReadOnlyFakeSupport(&rwset.TxReadWriteSet{})
+		fakeSupport.GetQueryExecutorReturns(nil, errors.New("ledger unavailable"))
+		es := NewEndorserServer(nil, fakeSupport)
+		resp, err := es.ProcessProposal(context.Background(), getReadOnlySignedProp(chainID, "mycc", args, t))
+		assert.EqualError(t, err, "ledger unavailable")
+		assert.Equal(t, int32(500), resp.Response.Status)
+	})
+
+	viper.Set(skipReadOnlyEndorsementConfigKey, true)
+	defer viper.Set(skipReadOnlyEndorsementConfigKey, false)
+
+	t.Run("EndorsementSkipped", func(t *testing.T) {
+		fakeSupport := newReadOnlyFakeSupport(&rwset.TxReadWriteSet{})
+		es := NewEndorserServer(nil, fakeSupport)
+		resp, err := es.ProcessProposal(context.Background(), getReadOnlySignedProp(chainID, "mycc", args, t))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("result"), resp.Response.Payload)
+		assert.Nil(t, resp.Endorsement)
+		assert.NotEmpty(t, resp.Payload)
+		assert.Equal(t, 0, fakeSupport.EndorseWithPluginCallCount())
+	})
+
+	t.Run("CustomEndorsementPlugin", func(t *testing.T) {
+		fakeSupport := newReadOnlyFakeSupport(&rwset.TxReadWriteSet{})
+		fakeSupport.GetChaincodeDefinitionReturns(&resourceconfig.MockChaincodeDefinition{EndorsementStr: "custom"}, nil)
+		es := NewEndorserServer(nil, fakeSupport)
+		resp, err := es.ProcessProposal(context.Background(), getReadOnlySignedProp(chainID, "mycc", args, t))
+		assert.NoError(t, err)
+		assert.NotNil(t, resp.Endorsement)
+		assert.Equal(t, 1, fakeSupport.EndorseWithPluginCallCount())
+	})
+
+	t.Run("SimulationWithoutWrites", func(t *testing.T) {
+		fakeSupport := newReadOnlyFakeSupport(&rwset.TxReadWriteSet{})
+		es := NewEndorserServer(nil, fakeSupport)
+		resp, err := es.ProcessProposal(context.Background(), getSignedPropWithCHIdAndArgs(chainID, "mycc", "0", args, t))
+		assert.NoError(t, err)
+		assert.Nil(t, resp.Endorsement)
+		assert.Equal(t, 1, fakeSupport.GetTxSimulatorCallCount())
+	})
+
+	t.Run("SimulationWithWrites", func(t *testing.T) {
+		b := rwsetutil.NewRWSetBuilder()
+		b.AddToWriteSet("mycc", "a", []byte("value"))
+		simRes, err := b.GetTxSimulationResults()
+		assert.NoError(t, err)
+		fakeSupport := newReadOnlyFakeSupport(simRes.PubSimulationResults)
+		es := NewEndorserServer(nil, fakeSupport)
+		resp, err := es.ProcessProposal(context.Background(), getSignedPropWithCHIdAndArgs(chainID, "mycc", "0", args, t))
+		assert.NoError(t, err)
+		assert.NotNil(t, resp.Endorsement)
+	})
+
+	t.Run("SimulationWithEvents", func(t *testing.T) {
+		fakeSupport := newReadOnlyFakeSupport(&rwset.TxReadWriteSet{})
+		fakeSupport.ExecuteReturns(&pb.Response{Status: 200}, []*pb.ChaincodeEvent{{EventName: "event"}}, nil)
+		es := NewEndorserServer(nil, fakeSupport)
+		resp, err := es.ProcessProposal(context.Background(), getSignedPropWithCHIdAndArgs(chainID, "mycc", "0", args, t))
+		assert.NoError(t, err)
+		assert.NotNil(t, resp.Endorsement)
+	})
+}
+
+func TestReadOnlySimulator(t *testing.T) {
+	sim := &readOnlySimulator{QueryExecutor: &ccprovider.MockTxSim{}}
+	assert.Equal(t, errReadOnlyProposal, sim.SetState("ns", "key", []byte("value")))
+	assert.Equal(t, errReadOnlyProposal, sim.DeleteState("ns", "key"))
+	assert.Equal(t, errReadOnlyProposal, sim.SetStateMultipleKeys("ns", map[string][]byte{"key": []byte("value")}))
+	assert.Equal(t, errReadOnlyProposal, sim.ExecuteUpdate("query"))
+	assert.Equal(t, errReadOnlyProposal, sim.SetPrivateData("ns", "coll", "key", []byte("value")))
+	assert.Equal(t, errReadOnlyProposal, sim.SetPrivateDataMultipleKeys("ns", "coll", map[string][]byte{"key": []byte("value")}))
+	assert.Equal(t, errReadOnlyProposal, sim.DeletePrivateData("ns", "coll", "key"))
+
+	simRes, err := sim.GetTxSimulationResults()
+	assert.NoError(t, err)
+	assert.Nil(t, simRes.PvtSimulationResults)
+	pubSimResBytes, err := simRes.GetPubSimulationBytes()
+	assert.NoError(t, err)
+	assert.Empty(t, pubSimResBytes)
+}
+
+func TestEndorserResponseCache(t *testing.T) {
+	viper.Set(responseCacheSizeConfigKey, 10)
+	defer viper.Set(responseCacheSizeConfigKey, 0)
+
+	chainID := util.GetTestChainID()
+	fakeSupport := newReadOnlyFakeSupport(&rwset.TxReadWriteSet{})
+	fakeSupport.GetLedgerHeightReturns(5, nil)
+	es := NewEndorserServer(nil, fakeSupport)
+
+	process := func(signedProp *pb.SignedProposal) {
+		resp, err := es.ProcessProposal(context.Background(), signedProp)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("result"), resp.Response.Payload)
+		assert.NotNil(t, resp.Endorsement)
+	}
+
+	// identical read-only proposals are simulated once
+	process(getReadOnlySignedProp(chainID, "mycc", [][]byte{[]byte("query"), []byte("a")}, t))
+	process(getReadOnlySignedProp(chainID, "mycc", [][]byte{[]byte("query"), []byte("a")}, t))
+	assert.Equal(t, 1, fakeSupport.ExecuteCallCount())
+	assert.Equal(t, 2, fakeSupport.EndorseWithPluginCallCount())
+
+	// proposals with other arguments are not
+	process(getReadOnlySignedProp(chainID, "mycc", [][]byte{[]byte("query"), []byte("b")}, t))
+	assert.Equal(t, 2, fakeSupport.ExecuteCallCount())
+
+	// nor are the proposals that are not read-only
+	process(getSignedPropWithCHIdAndArgs(chainID, "mycc", "0", [][]byte{[]byte("query"), []byte("a")}, t))
+	assert.Equal(t, 3, fakeSupport.ExecuteCallCount())
+
+	// the cache is invalidated once a block is committed
+	fakeSupport.GetLedgerHeightReturns(6, nil)
+	process(getReadOnlySignedProp(chainID, "mycc", [][]byte{[]byte("query"), []byte("a")}, t))
+	assert.Equal(t, 4, fakeSupport.ExecuteCallCount())
+
+	// failed simulations are not cached
+	fakeSupport.ExecuteReturns(&pb.Response{Status: 500, Message: "failure"}, nil, nil)
+	for i := 0; i < 2; i++ {
+		_, err := es.ProcessProposal(context.Background(), getReadOnlySignedProp(chainID, "mycc", [][]byte{[]byte("query"), []byte("c")}, t))
+		assert.Error(t, err)
+	}
+	assert.Equal(t, 6, fakeSupport.ExecuteCallCount())
+
+	fakeSupport.GetLedgerHeightReturns(0, errors.New("ledger unavailable"))
+	_, err := es.ProcessProposal(context.Background(), getReadOnlySignedProp(chainID, "mycc", [][]byte{[]byte("query"), []byte("a")}, t))
+	assert.EqualError(t, err, "ledger unavailable")
+}
+
+// getHistoricalSignedProp returns a proposal to simulate at the given height of the ledger,
+// which is marked read-only unless readOnly is false
+func getHistoricalSignedProp(chid, ccid string, ccargs [][]byte, height uint64, readOnly bool, t *testing.T) *pb.SignedProposal {
+	spec := &pb.ChaincodeSpec{Type: 1, ChaincodeId: &pb.ChaincodeID{Name: ccid}, Input: &pb.ChaincodeInput{Args: ccargs}}
+	creator, err := signer.Serialize()
+	assert.NoError(t, err)
+	prop, _, err := utils.CreateChaincodeProposal(common.HeaderType_ENDORSER_TRANSACTION, chid, &pb.ChaincodeInvocationSpec{ChaincodeSpec: spec}, creator)
+	assert.NoError(t, err)
+	assert.NoError(t, utils.SetProposalBlockHeight(prop, height))
+	if !readOnly {
+		hdr, err := utils.GetHeader(prop.Header)
+		assert.NoError(t, err)
+		chdr, err := utils.UnmarshalChannelHeader(hdr.ChannelHeader)
+		assert.NoError(t, err)
+		hdrExt, err := utils.GetChaincodeHeaderExtension(hdr)
+		assert.NoError(t, err)
+		hdrExt.ReadOnly = false
+		chdr.Extension = utils.MarshalOrPanic(hdrExt)
+		hdr.ChannelHeader = utils.MarshalOrPanic(chdr)
+		prop.Header = utils.MarshalOrPanic(hdr)
+	}
+	signedProp, err := utils.GetSignedProposal(prop, signer)
+	assert.NoError(t, err)
+	return signedProp
+}
+
+func TestEndorserHistoricalProposals(t *testing.T) {
+	chainID := util.GetTestChainID()
+	args := [][]byte{[]byte("query"), []byte("a")}
+
+	t.Run("SimulatedAtHeight", func(t *testing.T) {
+		viper.Set(responseCacheSizeConfigKey, 10)
+		defer viper.Set(responseCacheSizeConfigKey, 0)
+		fakeSupport := newReadOnlyFakeSupport(&rwset.TxReadWriteSet{})
+		fakeSupport.GetHistoricalQueryExecutorReturns(&ccprovider.MockTxSim{}, nil)
+		fakeSupport.GetLedgerHeightReturns(5, nil)
+		es := NewEndorserServer(nil, fakeSupport)
+		for i := 0; i < 2; i++ {
+			resp, err := es.ProcessProposal(context.Background(), getHistoricalSignedProp(chainID, "mycc", args, 3, true, t))
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("result"), resp.Response.Payload)
+			assert.NotNil(t, resp.Endorsement)
+		}
+		// simulations at a past height bypass the response cache
+		assert.Equal(t, 2, fakeSupport.ExecuteCallCount())
+		assert.Equal(t, 2, fakeSupport.GetHistoricalQueryExecutorCallCount())
+		ledgerName, height := fakeSupport.GetHistoricalQueryExecutorArgsForCall(0)
+		assert.Equal(t, chainID, ledgerName)
+		assert.Equal(t, uint64(3), height)
+		assert.Equal(t, 0, fakeSupport.GetQueryExecutorCallCount())
+		assert.Equal(t, 0, fakeSupport.GetTxSimulatorCallCount())
+	})
+
+	t.Run("HistoryUnavailable", func(t *testing.T) {
+		fakeSupport := newReadOnlyFakeSupport(&rwset.TxReadWriteSet{})
+		fakeSupport.GetHistoricalQueryExecutorReturns(nil, errors.New("the history of the ledger has not reached height 3 yet"))
+		es := NewEndorserServer(nil, fakeSupport)
+		resp, err := es.ProcessProposal(context.Background(), getHistoricalSignedProp(chainID, "mycc", args, 3, true, t))
+		assert.Error(t, err)
+		assert.Equal(t, int32(500), resp.Response.Status)
+		assert.Equal(t, 0, fakeSupport.ExecuteCallCount())
+	})
+
+	t.Run("NotReadOnly", func(t *testing.T) {
+		fakeSupport := newReadOnlyFakeSupport(&rwset.TxReadWriteSet{})
+		es := NewEndorserServer(nil, fakeSupport)
+		resp, err := es.ProcessProposal(context.Background(), getHistoricalSignedProp(chainID, "mycc", args, 3, false, t))
+		assert.EqualError(t, err, "only read-only proposals can be simulated at a past height of the ledger")
+		assert.Equal(t, int32(500), resp.Response.Status)
+		assert.Equal(t, 0, fakeSupport.ExecuteCallCount())
+	})
+}
+
+func TestResponseCache(t *testing.T) {
+	now := time.Now()
+	cache := newResponseCache(2, time.Second)
+	cache.now = func() time.Time { return now }
+
+	sim1, sim2, sim3 := &simulation{}, &simulation{}, &simulation{}
+	cache.put("key1", 1, sim1)
+	cache.put("key2", 1, sim2)
+	assert.True(t, sim1 == cache.get("key1", 1))
+	assert.Nil(t, cache.get("key1", 2))
+	assert.Nil(t, cache.get("key1", 1))
+
+	// the least recently used simulation is evicted
+	cache.put("key1", 1, sim1)
+	assert.True(t, sim2 == cache.get("key2", 1))
+	cache.put("key3", 1, sim3)
+	assert.Nil(t, cache.get("key1", 1))
+	assert.True(t, sim2 == cache.get("key2", 1))
+	assert.True(t, sim3 == cache.get("key3", 1))
+
+	// simulations expire
+	now = now.Add(2 * time.Second)
+	assert.Nil(t, cache.get("key2", 1))
+
+	assert.NotEqual(t, responseCacheKey("ch", "cc", []byte("creator"), []byte("payload")), responseCacheKey("ch", "cc", []byte("creatorp"), []byte("ayload")))
+	assert.Equal(t, responseCacheKey("ch", "cc", []byte("creator"), []byte("payload")), responseCacheKey("ch", "cc", []byte("creator"), []byte("payload")))
+}
@@ -51,6 +51,46 @@ type Support struct {
 		result1 ledger.HistoryQueryExecutor
 		result2 error
 	}
+	GetQueryExecutorStub        func(ledgername string) (ledger.QueryExecutor, error)
+	getQueryExecutorMutex       sync.RWMutex
+	getQueryExecutorArgsForCall []struct {
+		ledgername string
+	}
+	getQueryExecutorReturns struct {
+		result1 ledger.QueryExecutor
+		result2 error
+	}
+	getQueryExecutorReturnsOnCall map[int]struct {
+		result1 ledger.QueryExecutor
+		result2 error
+	}
+	GetHistoricalQueryExecutorStub        func(ledgername string, height uint64) (ledger.QueryExecutor, error)
+	getHistoricalQueryExecutorMutex       sync.RWMutex
+	getHistoricalQueryExecutorArgsForCall []struct {
+		ledgername string
+		height     uint64
+	}
+	getHistoricalQueryExecutorReturns struct {
+		result1 ledger.QueryExecutor
+		result2 error
+	}
+	getHistoricalQueryExecutorReturnsOnCall map[int]struct {
+		result1 ledger.QueryExecutor
+		result2 error
+	}
+	GetLedgerHeightStub        func(ledgername string) (uint64, error)
+	getLedgerHeightMutex       sync.RWMutex
+	getLedgerHeightArgsForCall []struct {
+		ledgername string
+	}
+	getLedgerHeightReturns struct {
+		result1 uint64
+		result2 error
+	}
+	getLedgerHeightReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 error
+	}
 	GetTransactionByIDStub        func(chid, txID string) (*pb.ProcessedTransaction, error)
 	getTransactionByIDMutex       sync.RWMutex
 	getTransactionByIDArgsForCall []struct {
@@ -76,7 +116,7 @@ type Support struct {
 	isSysCCReturnsOnCall map[int]struct {
 		result1 bool
 	}
-	ExecuteStub        func(ctxt context.Context, cid, name, version, txid string, syscc bool, signedProp *pb.SignedProposal, prop *pb.Proposal, spec interface{}) (*pb.Response, *pb.ChaincodeEvent, error)
+	ExecuteStub        func(ctxt context.Context, cid, name, version, txid string, syscc bool, signedProp *pb.SignedProposal, prop *pb.Proposal, spec interface{}) (*pb.Response, []*pb.ChaincodeEvent, error)
 	executeMutex       sync.RWMutex
 	executeArgsForCall []struct {
 		ctxt       context.Context
@@ -91,12 +131,12 @@ type Support struct {
 	}
 	executeReturns struct {
 		result1 *pb.Response
-		result2 *pb.ChaincodeEvent
+		result2 []*pb.ChaincodeEvent
 		result3 error
 	}
 	executeReturnsOnCall map[int]struct {
 		result1 *pb.Response
-		result2 *pb.ChaincodeEvent
+		result2 []*pb.ChaincodeEvent
 		result3 error
 	}
 	GetChaincodeDefinitionStub        func(ctx context.Context, chainID string, txid string, signedProp *pb.SignedProposal, prop *pb.Proposal, chaincodeID string, txsim ledger.TxSimulator) (resourcesconfig.ChaincodeDefinition, error)
@@ -184,6 +224,24 @@ type Support struct {
 		result1 channelconfig.Application
 		result2 bool
 	}
+	EndorseWithPluginStub        func(pluginName, channelID string, prpBytes []byte, signedProposal *pb.SignedProposal) (*pb.Endorsement, []byte, error)
+	endorseWithPluginMutex       sync.RWMutex
+	endorseWithPluginArgsForCall []struct {
+		pluginName     string
+		channelID      string
+		prpBytes       []byte
+		signedProposal *pb.SignedProposal
+	}
+	endorseWithPluginReturns struct {
+		result1 *pb.Endorsement
+		result2 []byte
+		result3 error
+	}
+	endorseWithPluginReturnsOnCall map[int]struct {
+		result1 *pb.Endorsement
+		result2 []byte
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -339,6 +397,160 @@ func (fake *Support) GetHistoryQueryExecutorReturnsOnCall(i int, result1 ledger.
 	}{result1, result2}
 }
 
+func (fake *Support) GetQueryExecutor(ledgername string) (ledger.QueryExecutor, error) {
+	fake.getQueryExecutorMutex.Lock()
+	ret, specificReturn := fake.getQueryExecutorReturnsOnCall[len(fake.getQueryExecutorArgsForCall)]
+	fake.getQueryExecutorArgsForCall = append(fake.getQueryExecutorArgsForCall, struct {
+		ledgername string
+	}{ledgername})
+	fake.recordInvocation("GetQueryExecutor", []interface{}{ledgername})
+	fake.getQueryExecutorMutex.Unlock()
+	if fake.GetQueryExecutorStub != nil {
+		return fake.GetQueryExecutorStub(ledgername)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getQueryExecutorReturns.result1, fake.getQueryExecutorReturns.result2
+}
+
+func (fake *Support) GetQueryExecutorCallCount() int {
+	fake.getQueryExecutorMutex.RLock()
+	defer fake.getQueryExecutorMutex.RUnlock()
+	return len(fake.getQueryExecutorArgsForCall)
+}
+
+func (fake *Support) GetQueryExecutorArgsForCall(i int) string {
+	fake.getQueryExecutorMutex.RLock()
+	defer fake.getQueryExecutorMutex.RUnlock()
+	return fake.getQueryExecutorArgsForCall[i].ledgername
+}
+
+func (fake *Support) GetQueryExecutorReturns(result1 ledger.QueryExecutor, result2 error) {
+	fake.GetQueryExecutorStub = nil
+	fake.getQueryExecutorReturns = struct {
+		result1 ledger.QueryExecutor
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Support) GetQueryExecutorReturnsOnCall(i int, result1 ledger.QueryExecutor, result2 error) {
+	fake.GetQueryExecutorStub = nil
+	if fake.getQueryExecutorReturnsOnCall == nil {
+		fake.getQueryExecutorReturnsOnCall = make(map[int]struct {
+			result1 ledger.QueryExecutor
+			result2 error
+		})
+	}
+	fake.getQueryExecutorReturnsOnCall[i] = struct {
+		result1 ledger.QueryExecutor
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Support) GetHistoricalQueryExecutor(ledgername string, height uint64) (ledger.QueryExecutor, error) {
+	fake.getHistoricalQueryExecutorMutex.Lock()
+	ret, specificReturn := fake.getHistoricalQueryExecutorReturnsOnCall[len(fake.getHistoricalQueryExecutorArgsForCall)]
+	fake.getHistoricalQueryExecutorArgsForCall = append(fake.getHistoricalQueryExecutorArgsForCall, struct {
+		ledgername string
+		height     uint64
+	}{ledgername, height})
+	fake.recordInvocation("GetHistoricalQueryExecutor", []interface{}{ledgername, height})
+	fake.getHistoricalQueryExecutorMutex.Unlock()
+	if fake.GetHistoricalQueryExecutorStub != nil {
+		return fake.GetHistoricalQueryExecutorStub(ledgername, height)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getHistoricalQueryExecutorReturns.result1, fake.getHistoricalQueryExecutorReturns.result2
+}
+
+func (fake *Support) GetHistoricalQueryExecutorCallCount() int {
+	fake.getHistoricalQueryExecutorMutex.RLock()
+	defer fake.getHistoricalQueryExecutorMutex.RUnlock()
+	return len(fake.getHistoricalQueryExecutorArgsForCall)
+}
+
+func (fake *Support) GetHistoricalQueryExecutorArgsForCall(i int) (string, uint64) {
+	fake.getHistoricalQueryExecutorMutex.RLock()
+	defer fake.getHistoricalQueryExecutorMutex.RUnlock()
+	return fake.getHistoricalQueryExecutorArgsForCall[i].ledgername, fake.getHistoricalQueryExecutorArgsForCall[i].height
+}
+
+func (fake *Support) GetHistoricalQueryExecutorReturns(result1 ledger.QueryExecutor, result2 error) {
+	fake.GetHistoricalQueryExecutorStub = nil
+	fake.getHistoricalQueryExecutorReturns = struct {
+		result1 ledger.QueryExecutor
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Support) GetHistoricalQueryExecutorReturnsOnCall(i int, result1 ledger.QueryExecutor, result2 error) {
+	fake.GetHistoricalQueryExecutorStub = nil
+	if fake.getHistoricalQueryExecutorReturnsOnCall == nil {
+		fake.getHistoricalQueryExecutorReturnsOnCall = make(map[int]struct {
+			result1 ledger.QueryExecutor
+			result2 error
+		})
+	}
+	fake.getHistoricalQueryExecutorReturnsOnCall[i] = struct {
+		result1 ledger.QueryExecutor
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Support) GetLedgerHeight(ledgername string) (uint64, error) {
+	fake.getLedgerHeightMutex.Lock()
+	ret, specificReturn := fake.getLedgerHeightReturnsOnCall[len(fake.getLedgerHeightArgsForCall)]
+	fake.getLedgerHeightArgsForCall = append(fake.getLedgerHeightArgsForCall, struct {
+		ledgername string
+	}{ledgername})
+	fake.recordInvocation("GetLedgerHeight", []interface{}{ledgername})
+	fake.getLedgerHeightMutex.Unlock()
+	if fake.GetLedgerHeightStub != nil {
+		return fake.GetLedgerHeightStub(ledgername)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getLedgerHeightReturns.result1, fake.getLedgerHeightReturns.result2
+}
+
+func (fake *Support) GetLedgerHeightCallCount() int {
+	fake.getLedgerHeightMutex.RLock()
+	defer fake.getLedgerHeightMutex.RUnlock()
+	return len(fake.getLedgerHeightArgsForCall)
+}
+
+func (fake *Support) GetLedgerHeightArgsForCall(i int) string {
+	fake.getLedgerHeightMutex.RLock()
+	defer fake.getLedgerHeightMutex.RUnlock()
+	return fake.getLedgerHeightArgsForCall[i].ledgername
+}
+
+func (fake *Support) GetLedgerHeightReturns(result1 uint64, result2 error) {
+	fake.GetLedgerHeightStub = nil
+	fake.getLedgerHeightReturns = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Support) GetLedgerHeightReturnsOnCall(i int, result1 uint64, result2 error) {
+	fake.GetLedgerHeightStub = nil
+	if fake.getLedgerHeightReturnsOnCall == nil {
+		fake.getLedgerHeightReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 error
+		})
+	}
+	fake.getLedgerHeightReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Support) GetTransactionByID(chid string, txID string) (*pb.ProcessedTransaction, error) {
 	fake.getTransactionByIDMutex.Lock()
 	ret, specificReturn := fake.getTransactionByIDReturnsOnCall[len(fake.getTransactionByIDArgsForCall)]
@@ -439,7 +651,7 @@ func (fake *Support) IsSysCCReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
-func (fake *Support) Execute(ctxt context.Context, cid string, name string, version string, txid string, syscc bool, signedProp *pb.SignedProposal, prop *pb.Proposal, spec interface{}) (*pb.Response, *pb.ChaincodeEvent, error) {
+func (fake *Support) Execute(ctxt context.Context, cid string, name string, version string, txid string, syscc bool, signedProp *pb.SignedProposal, prop *pb.Proposal, spec interface{}) (*pb.Response, []*pb.ChaincodeEvent, error) {
 	fake.executeMutex.Lock()
 	ret, specificReturn := fake.executeReturnsOnCall[len(fake.executeArgsForCall)]
 	fake.executeArgsForCall = append(fake.executeArgsForCall, struct {
@@ -476,27 +688,27 @@ func (fake *Support) ExecuteArgsForCall(i int) (context.Context, string, string,
 	return fake.executeArgsForCall[i].ctxt, fake.executeArgsForCall[i].cid, fake.executeArgsForCall[i].name, fake.executeArgsForCall[i].version, fake.executeArgsForCall[i].txid, fake.executeArgsForCall[i].syscc, fake.executeArgsForCall[i].signedProp, fake.executeArgsForCall[i].prop, fake.executeArgsForCall[i].spec
 }
 
-func (fake *Support) ExecuteReturns(result1 *pb.Response, result2 *pb.ChaincodeEvent, result3 error) {
+func (fake *Support) ExecuteReturns(result1 *pb.Response, result2 []*pb.ChaincodeEvent, result3 error) {
 	fake.ExecuteStub = nil
 	fake.executeReturns = struct {
 		result1 *pb.Response
-		result2 *pb.ChaincodeEvent
+		result2 []*pb.ChaincodeEvent
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *Support) ExecuteReturnsOnCall(i int, result1 *pb.Response, result2 *pb.ChaincodeEvent, result3 error) {
+func (fake *Support) ExecuteReturnsOnCall(i int, result1 *pb.Response, result2 []*pb.ChaincodeEvent, result3 error) {
 	fake.ExecuteStub = nil
 	if fake.executeReturnsOnCall == nil {
 		fake.executeReturnsOnCall = make(map[int]struct {
 			result1 *pb.Response
-			result2 *pb.ChaincodeEvent
+			result2 []*pb.ChaincodeEvent
 			result3 error
 		})
 	}
 	fake.executeReturnsOnCall[i] = struct {
 		result1 *pb.Response
-		result2 *pb.ChaincodeEvent
+		result2 []*pb.ChaincodeEvent
 		result3 error
 	}{result1, result2, result3}
 }
@@ -817,6 +1029,68 @@ func (fake *Support) GetApplicationConfigReturnsOnCall(i int, result1 channelcon
 	}{result1, result2}
 }
 
+func (fake *Support) EndorseWithPlugin(pluginName string, channelID string, prpBytes []byte, signedProposal *pb.SignedProposal) (*pb.Endorsement, []byte, error) {
+	var prpBytesCopy []byte
+	if prpBytes != nil {
+		prpBytesCopy = make([]byte, len(prpBytes))
+		copy(prpBytesCopy, prpBytes)
+	}
+	fake.endorseWithPluginMutex.Lock()
+	ret, specificReturn := fake.endorseWithPluginReturnsOnCall[len(fake.endorseWithPluginArgsForCall)]
+	fake.endorseWithPluginArgsForCall = append(fake.endorseWithPluginArgsForCall, struct {
+		pluginName     string
+		channelID      string
+		prpBytes       []byte
+		signedProposal *pb.SignedProposal
+	}{pluginName, channelID, prpBytesCopy, signedProposal})
+	fake.recordInvocation("EndorseWithPlugin", []interface{}{pluginName, channelID, prpBytesCopy, signedProposal})
+	fake.endorseWithPluginMutex.Unlock()
+	if fake.EndorseWithPluginStub != nil {
+		return fake.EndorseWithPluginStub(pluginName, channelID, prpBytes, signedProposal)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.endorseWithPluginReturns.result1, fake.endorseWithPluginReturns.result2, fake.endorseWithPluginReturns.result3
+}
+
+func (fake *Support) EndorseWithPluginCallCount() int {
+	fake.endorseWithPluginMutex.RLock()
+	defer fake.endorseWithPluginMutex.RUnlock()
+	return len(fake.endorseWithPluginArgsForCall)
+}
+
+func (fake *Support) EndorseWithPluginArgsForCall(i int) (string, string, []byte, *pb.SignedProposal) {
+	fake.endorseWithPluginMutex.RLock()
+	defer fake.endorseWithPluginMutex.RUnlock()
+	return fake.endorseWithPluginArgsForCall[i].pluginName, fake.endorseWithPluginArgsForCall[i].channelID, fake.endorseWithPluginArgsForCall[i].prpBytes, fake.endorseWithPluginArgsForCall[i].signedProposal
+}
+
+func (fake *Support) EndorseWithPluginReturns(result1 *pb.Endorsement, result2 []byte, result3 error) {
+	fake.EndorseWithPluginStub = nil
+	fake.endorseWithPluginReturns = struct {
+		result1 *pb.Endorsement
+		result2 []byte
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *Support) EndorseWithPluginReturnsOnCall(i int, result1 *pb.Endorsement, result2 []byte, result3 error) {
+	fake.EndorseWithPluginStub = nil
+	if fake.endorseWithPluginReturnsOnCall == nil {
+		fake.endorseWithPluginReturnsOnCall = make(map[int]struct {
+			result1 *pb.Endorsement
+			result2 []byte
+			result3 error
+		})
+	}
+	fake.endorseWithPluginReturnsOnCall[i] = struct {
+		result1 *pb.Endorsement
+		result2 []byte
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *Support) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -826,6 +1100,12 @@ func (fake *Support) Invocations() map[string][][]interface{} {
 	defer fake.getTxSimulatorMutex.RUnlock()
 	fake.getHistoryQueryExecutorMutex.RLock()
 	defer fake.getHistoryQueryExecutorMutex.RUnlock()
+	fake.getQueryExecutorMutex.RLock()
+	defer fake.getQueryExecutorMutex.RUnlock()
+	fake.getHistoricalQueryExecutorMutex.RLock()
+	defer fake.getHistoricalQueryExecutorMutex.RUnlock()
+	fake.getLedgerHeightMutex.RLock()
+	defer fake.getLedgerHeightMutex.RUnlock()
 	fake.getTransactionByIDMutex.RLock()
 	defer fake.getTransactionByIDMutex.RUnlock()
 	fake.isSysCCMutex.RLock()
@@ -844,6 +1124,8 @@ func (fake *Support) Invocations() map[string][][]interface{} {
 	defer fake.getChaincodeDeploymentSpecFSMutex.RUnlock()
 	fake.getApplicationConfigMutex.RLock()
 	defer fake.getApplicationConfigMutex.RUnlock()
+	fake.endorseWithPluginMutex.RLock()
+	defer fake.endorseWithPluginMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
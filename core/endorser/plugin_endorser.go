@@ -0,0 +1,125 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package endorser
+
+import (
+	"sync"
+
+	"github.com/hyperledger/fabric/core/handlers/endorsement"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+)
+
+// PluginName defines the name of the plugin as it appears in the configuration
+type PluginName string
+
+// PluginMapper maps plugin names to their corresponding factories
+type PluginMapper interface {
+	PluginFactoryByName(name PluginName) endorsement.PluginFactory
+}
+
+// MapBasedPluginMapper maps plugin names to their corresponding factories
+type MapBasedPluginMapper map[string]endorsement.PluginFactory
+
+// PluginFactoryByName returns a plugin factory for the given plugin name, or nil if not found
+func (m MapBasedPluginMapper) PluginFactoryByName(name PluginName) endorsement.PluginFactory {
+	return m[string(name)]
+}
+
+// PluginSupport aggregates the support interfaces
+// needed for the operation of the endorsement plugins
+type PluginSupport struct {
+	endorsement.SigningIdentityFetcher
+	PluginMapper
+}
+
+// NewPluginEndorser creates a new PluginEndorser
+func NewPluginEndorser(ps *PluginSupport) *PluginEndorser {
+	return &PluginEndorser{
+		SigningIdentityFetcher: ps.SigningIdentityFetcher,
+		PluginMapper:           ps.PluginMapper,
+		pluginChannelMapping:   make(map[PluginName]*pluginsByChannel),
+	}
+}
+
+// PluginEndorser endorses proposal responses using endorsement plugins,
+// creating an instance of each plugin for every channel it endorses on
+type PluginEndorser struct {
+	sync.Mutex
+	PluginMapper
+	pluginChannelMapping map[PluginName]*pluginsByChannel
+	endorsement.SigningIdentityFetcher
+}
+
+// EndorseWithPlugin endorses the given proposal response payload with the
+// plugin of the given name, and returns the endorsement and the payload,
+// which the plugin may have modified
+func (pe *PluginEndorser) EndorseWithPlugin(pluginName, channelID string, prpBytes []byte, signedProposal *pb.SignedProposal) (*pb.Endorsement, []byte, error) {
+	plugin, err := pe.getOrCreatePlugin(PluginName(pluginName), channelID)
+	if err != nil {
+		endorserLogger.Warning("Endorsement with plugin for", channelID, "failed:", err)
+		return nil, nil, errors.Errorf("plugin with name %s could not be used: %v", pluginName, err)
+	}
+
+	return plugin.Endorse(prpBytes, signedProposal)
+}
+
+// getOrCreatePlugin returns a plugin instance for the given plugin name and channel
+func (pe *PluginEndorser) getOrCreatePlugin(plugin PluginName, channel string) (endorsement.Plugin, error) {
+	pluginFactory := pe.PluginFactoryByName(plugin)
+	if pluginFactory == nil {
+		return nil, errors.Errorf("plugin with name %s wasn't found", plugin)
+	}
+
+	pluginsByChannel := pe.getOrCreatePluginChannelMapping(plugin, pluginFactory)
+	return pluginsByChannel.createPluginIfAbsent(channel)
+}
+
+func (pe *PluginEndorser) getOrCreatePluginChannelMapping(plugin PluginName, pf endorsement.PluginFactory) *pluginsByChannel {
+	pe.Lock()
+	defer pe.Unlock()
+	endorserChannelMapping, exists := pe.pluginChannelMapping[plugin]
+	if !exists {
+		endorserChannelMapping = &pluginsByChannel{
+			pluginFactory:    pf,
+			channels2Plugins: make(map[string]endorsement.Plugin),
+			pe:               pe,
+		}
+		pe.pluginChannelMapping[plugin] = endorserChannelMapping
+	}
+	return endorserChannelMapping
+}
+
+type pluginsByChannel struct {
+	sync.RWMutex
+	pluginFactory    endorsement.PluginFactory
+	channels2Plugins map[string]endorsement.Plugin
+	pe               *PluginEndorser
+}
+
+func (pbc *pluginsByChannel) createPluginIfAbsent(channel string) (endorsement.Plugin, error) {
+	pbc.RLock()
+	plugin, exists := pbc.channels2Plugins[channel]
+	pbc.RUnlock()
+	if exists {
+		return plugin, nil
+	}
+
+	pbc.Lock()
+	defer pbc.Unlock()
+	plugin, exists = pbc.channels2Plugins[channel]
+	if exists {
+		return plugin, nil
+	}
+
+	pluginInstance := pbc.pluginFactory.New()
+	if err := pluginInstance.Init(pbc.pe.SigningIdentityFetcher); err != nil {
+		return nil, errors.Wrap(err, "failed initializing plugin")
+	}
+	pbc.channels2Plugins[channel] = pluginInstance
+	return pluginInstance, nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package endorser
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/core/handlers/endorsement"
+	"github.com/hyperledger/fabric/core/handlers/endorsement/builtin"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockSigningIdentityFetcher struct{}
+
+func (*mockSigningIdentityFetcher) SigningIdentityForRequest(*pb.SignedProposal) (endorsement.SigningIdentity, error) {
+	return signer, nil
+}
+
+type countingPluginFactory struct {
+	instances int
+	initErr   error
+}
+
+func (f *countingPluginFactory) New() endorsement.Plugin {
+	f.instances++
+	return &failingInitPlugin{DefaultEndorsement: &builtin.DefaultEndorsement{}, initErr: f.initErr}
+}
+
+type failingInitPlugin struct {
+	*builtin.DefaultEndorsement
+	initErr error
+}
+
+func (p *failingInitPlugin) Init(dependencies ...endorsement.Dependency) error {
+	if p.initErr != nil {
+		return p.initErr
+	}
+	return p.DefaultEndorsement.Init(dependencies...)
+}
+
+func TestPluginEndorserNotFound(t *testing.T) {
+	pe := NewPluginEndorser(&PluginSupport{
+		SigningIdentityFetcher: &mockSigningIdentityFetcher{},
+		PluginMapper:           MapBasedPluginMapper{},
+	})
+	_, _, err := pe.EndorseWithPlugin("escc", "mychannel", []byte("payload"), nil)
+	assert.EqualError(t, err, "plugin with name escc could not be used: plugin with name escc wasn't found")
+}
+
+func TestPluginEndorserInitFailure(t *testing.T) {
+	factory := &countingPluginFactory{initErr: errors.New("missing dependency")}
+	pe := NewPluginEndorser(&PluginSupport{
+		SigningIdentityFetcher: &mockSigningIdentityFetcher{},
+		PluginMapper:           MapBasedPluginMapper{"escc": factory},
+	})
+	_, _, err := pe.EndorseWithPlugin("escc", "mychannel", []byte("payload"), nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed initializing plugin: missing dependency")
+}
+
+func TestPluginEndorserGreenPath(t *testing.T) {
+	factory := &countingPluginFactory{}
+	pe := NewPluginEndorser(&PluginSupport{
+		SigningIdentityFetcher: &mockSigningIdentityFetcher{},
+		PluginMapper:           MapBasedPluginMapper{"escc": factory},
+	})
+
+	endorsement, prpBytes, err := pe.EndorseWithPlugin("escc", "mychannel", []byte("payload"), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("payload"), prpBytes)
+	identity, err := signer.Serialize()
+	assert.NoError(t, err)
+	assert.Equal(t, identity, endorsement.Endorser)
+	assert.NoError(t, signer.Verify(append([]byte("payload"), identity...), endorsement.Signature))
+
+	// plugin instances are created once per channel
+	_, _, err = pe.EndorseWithPlugin("escc", "mychannel", []byte("payload"), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, factory.instances)
+	_, _, err = pe.EndorseWithPlugin("escc", "otherchannel", []byte("payload"), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, factory.instances)
+}
+
+func TestLocalSigningIdentityFetcher(t *testing.T) {
+	sID, err := (&LocalSigningIdentityFetcher{}).SigningIdentityForRequest(nil)
+	assert.NoError(t, err)
+	identity, err := sID.Serialize()
+	assert.NoError(t, err)
+	expected, err := signer.Serialize()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, identity)
+}
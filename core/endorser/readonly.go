@@ -0,0 +1,191 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package endorser
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric/common/resourcesconfig"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+)
+
+const (
+	skipReadOnlyEndorsementConfigKey = "peer.endorser.readOnlyProposals.skipEndorsement"
+	responseCacheSizeConfigKey       = "peer.endorser.readOnlyProposals.cache.size"
+	responseCacheTTLConfigKey        = "peer.endorser.readOnlyProposals.cache.ttl"
+	defaultResponseCacheTTL          = 2 * time.Second
+)
+
+// errReadOnlyProposal is returned to the chaincodes that attempt
+// to write to the ledger while simulating a read-only proposal
+var errReadOnlyProposal = errors.New("writes are not allowed while simulating a read-only proposal")
+
+// readOnlySimulator simulates read-only proposals with a query executor,
+// which doesn't record the reads into a read-write set
+type readOnlySimulator struct {
+	ledger.QueryExecutor
+}
+
+func (s *readOnlySimulator) SetState(namespace string, key string, value []byte) error {
+	return errReadOnlyProposal
+}
+
+func (s *readOnlySimulator) DeleteState(namespace string, key string) error {
+	return errReadOnlyProposal
+}
+
+func (s *readOnlySimulator) SetStateMultipleKeys(namespace string, kvs map[string][]byte) error {
+	return errReadOnlyProposal
+}
+
+func (s *readOnlySimulator) ExecuteUpdate(query string) error {
+	return errReadOnlyProposal
+}
+
+func (s *readOnlySimulator) SetPrivateData(namespace, collection, key string, value []byte) error {
+	return errReadOnlyProposal
+}
+
+func (s *readOnlySimulator) SetPrivateDataMultipleKeys(namespace, collection string, kvs map[string][]byte) error {
+	return errReadOnlyProposal
+}
+
+func (s *readOnlySimulator) DeletePrivateData(namespace, collection, key string) error {
+	return errReadOnlyProposal
+}
+
+// GetTxSimulationResults returns empty simulation results,
+// since no reads nor writes are recorded
+func (s *readOnlySimulator) GetTxSimulationResults() (*ledger.TxSimulationResults, error) {
+	return &ledger.TxSimulationResults{PubSimulationResults: &rwset.TxReadWriteSet{}}, nil
+}
+
+// simulation is the outcome of the simulation of a proposal
+type simulation struct {
+	cd       resourcesconfig.ChaincodeDefinition
+	response *pb.Response
+	results  []byte
+	events   []*pb.ChaincodeEvent
+	calls    []*pb.ChaincodeCall
+}
+
+// writesToLedger returns whether the transaction of the simulation
+// would affect the ledger if committed, i.e., whether it writes to
+// the state, or emits chaincode events
+func (s *simulation) writesToLedger() bool {
+	if len(s.events) > 0 {
+		return true
+	}
+	txRWSet := &rwsetutil.TxRwSet{}
+	if err := txRWSet.FromProtoBytes(s.results); err != nil {
+		endorserLogger.Warningf("Failed unmarshaling simulation results: %s", err)
+		return true
+	}
+	for _, ns := range txRWSet.NsRwSets {
+		if len(ns.KvRwSet.GetWrites()) > 0 {
+			return true
+		}
+		for _, coll := range ns.CollHashedRwSets {
+			if len(coll.HashedRwSet.GetHashedWrites()) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// responseCacheKey returns the key of the simulation of a read-only proposal in
+// the cache, which identifies the channel, the chaincode and the creator of the
+// proposal, as well as its payload, i.e., the arguments of the invocation and
+// the transient map
+func responseCacheKey(chainID, ccName string, creator, payload []byte) string {
+	h := sha256.New()
+	for _, field := range [][]byte{[]byte(chainID), []byte(ccName), creator, payload} {
+		length := make([]byte, 8)
+		binary.BigEndian.PutUint64(length, uint64(len(field)))
+		h.Write(length)
+		h.Write(field)
+	}
+	return string(h.Sum(nil))
+}
+
+type cachedSimulation struct {
+	key    string
+	height uint64
+	expiry time.Time
+	*simulation
+}
+
+// responseCache caches the simulations of recent read-only proposals for a short
+// time, and only as long as no block is committed to the ledger of their channel,
+// so that identical proposals can be answered without simulating them again
+type responseCache struct {
+	sync.Mutex
+	size    int
+	ttl     time.Duration
+	entries map[string]*list.Element
+	lru     *list.List
+	now     func() time.Time
+}
+
+// newResponseCache creates a responseCache holding up to the given
+// number of simulations, for the given duration at most
+func newResponseCache(size int, ttl time.Duration) *responseCache {
+	return &responseCache{
+		size:    size,
+		ttl:     ttl,
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+		now:     time.Now,
+	}
+}
+
+// get returns the simulation cached with the given key, if it was
+// performed at the given height of the ledger and has not expired
+func (c *responseCache) get(key string, height uint64) *simulation {
+	c.Lock()
+	defer c.Unlock()
+	element, exists := c.entries[key]
+	if !exists {
+		return nil
+	}
+	entry := element.Value.(*cachedSimulation)
+	if entry.height != height || c.now().After(entry.expiry) {
+		c.lru.Remove(element)
+		delete(c.entries, key)
+		return nil
+	}
+	c.lru.MoveToBack(element)
+	return entry.simulation
+}
+
+// put caches the given simulation, performed at the given height of the
+// ledger, evicting the least recently used simulations if the cache is full
+func (c *responseCache) put(key string, height uint64, sim *simulation) {
+	c.Lock()
+	defer c.Unlock()
+	entry := &cachedSimulation{key: key, height: height, expiry: c.now().Add(c.ttl), simulation: sim}
+	if element, exists := c.entries[key]; exists {
+		element.Value = entry
+		c.lru.MoveToBack(element)
+		return
+	}
+	c.entries[key] = c.lru.PushBack(entry)
+	for c.lru.Len() > c.size {
+		oldest := c.lru.Front()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cachedSimulation).key)
+	}
+}
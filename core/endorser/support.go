@@ -14,10 +14,12 @@ import (
 	"github.com/hyperledger/fabric/core/chaincode"
 	"github.com/hyperledger/fabric/core/common/ccprovider"
 	"github.com/hyperledger/fabric/core/handlers/decoration"
+	"github.com/hyperledger/fabric/core/handlers/endorsement"
 	"github.com/hyperledger/fabric/core/handlers/library"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/peer"
 	"github.com/hyperledger/fabric/core/scc"
+	mspmgmt "github.com/hyperledger/fabric/msp/mgmt"
 	"github.com/hyperledger/fabric/protos/common"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/pkg/errors"
@@ -26,7 +28,27 @@ import (
 
 // SupportImpl provides an implementation of the endorser.Support interface
 // issuing calls to various static methods of the peer
-type SupportImpl struct{}
+type SupportImpl struct {
+	*PluginEndorser
+}
+
+// LocalSigningIdentityFetcher provides the default signing identity of the
+// local MSP, with which the peer endorses all proposals
+type LocalSigningIdentityFetcher struct{}
+
+// SigningIdentityForRequest returns the signing identity of the local MSP
+func (*LocalSigningIdentityFetcher) SigningIdentityForRequest(*pb.SignedProposal) (endorsement.SigningIdentity, error) {
+	localMsp := mspmgmt.GetLocalMSP()
+	if localMsp == nil {
+		return nil, errors.New("nil local MSP manager")
+	}
+
+	signingEndorser, err := localMsp.GetDefaultSigningIdentity()
+	if err != nil {
+		return nil, errors.WithMessage(err, "could not obtain the default signing identity")
+	}
+	return signingEndorser, nil
+}
 
 // IsSysCCAndNotInvokableExternal returns true if the supplied chaincode is
 // ia system chaincode and it NOT invokable
@@ -45,6 +67,40 @@ func (s *SupportImpl) GetTxSimulator(ledgername string, txid string) (ledger.TxS
 	return lgr.NewTxSimulator(txid)
 }
 
+// GetQueryExecutor returns a query executor for the specified ledger, which
+// is used to simulate read-only proposals
+func (s *SupportImpl) GetQueryExecutor(ledgername string) (ledger.QueryExecutor, error) {
+	lgr := peer.GetLedger(ledgername)
+	if lgr == nil {
+		return nil, errors.Errorf("channel does not exist: %s", ledgername)
+	}
+	return lgr.NewQueryExecutor()
+}
+
+// GetHistoricalQueryExecutor returns a query executor reading the state of
+// the specified ledger as of the given height, which is used to simulate
+// read-only proposals at a past height
+func (s *SupportImpl) GetHistoricalQueryExecutor(ledgername string, height uint64) (ledger.QueryExecutor, error) {
+	lgr := peer.GetLedger(ledgername)
+	if lgr == nil {
+		return nil, errors.Errorf("channel does not exist: %s", ledgername)
+	}
+	return lgr.NewHistoricalQueryExecutor(height)
+}
+
+// GetLedgerHeight returns the height of the specified ledger
+func (s *SupportImpl) GetLedgerHeight(ledgername string) (uint64, error) {
+	lgr := peer.GetLedger(ledgername)
+	if lgr == nil {
+		return 0, errors.Errorf("channel does not exist: %s", ledgername)
+	}
+	info, err := lgr.GetBlockchainInfo()
+	if err != nil {
+		return 0, err
+	}
+	return info.Height, nil
+}
+
 // GetHistoryQueryExecutor gives handle to a history query executor for the
 // specified ledger
 func (s *SupportImpl) GetHistoryQueryExecutor(ledgername string) (ledger.HistoryQueryExecutor, error) {
@@ -85,7 +141,7 @@ func (s *SupportImpl) GetChaincodeDeploymentSpecFS(cds *pb.ChaincodeDeploymentSp
 }
 
 //Execute - execute proposal, return original response of chaincode
-func (s *SupportImpl) Execute(ctxt context.Context, cid, name, version, txid string, syscc bool, signedProp *pb.SignedProposal, prop *pb.Proposal, spec interface{}) (*pb.Response, *pb.ChaincodeEvent, error) {
+func (s *SupportImpl) Execute(ctxt context.Context, cid, name, version, txid string, syscc bool, signedProp *pb.SignedProposal, prop *pb.Proposal, spec interface{}) (*pb.Response, []*pb.ChaincodeEvent, error) {
 	cccid := ccprovider.NewCCContext(cid, name, version, txid, syscc, signedProp, prop)
 
 	switch spec.(type) {
@@ -8,7 +8,10 @@ package filter
 
 import (
 	"github.com/hyperledger/fabric/core/handlers/auth"
+	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 )
 
@@ -30,3 +33,27 @@ func (f *filter) Init(next peer.EndorserServer) {
 func (f *filter) ProcessProposal(ctx context.Context, signedProp *peer.SignedProposal) (*peer.ProposalResponse, error) {
 	return f.next.ProcessProposal(ctx, signedProp)
 }
+
+// parseHeaders returns the channel header and the signature header of the given proposal
+func parseHeaders(signedProp *peer.SignedProposal) (*common.ChannelHeader, *common.SignatureHeader, error) {
+	prop, err := utils.GetProposal(signedProp.ProposalBytes)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed parsing proposal")
+	}
+
+	hdr, err := utils.GetHeader(prop.Header)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed parsing header")
+	}
+
+	chdr, err := utils.UnmarshalChannelHeader(hdr.ChannelHeader)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed parsing channel header")
+	}
+
+	sh, err := utils.GetSignatureHeader(hdr.SignatureHeader)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed parsing signature header")
+	}
+	return chdr, sh, nil
+}
@@ -0,0 +1,164 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package filter
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/handlers/auth"
+	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// MSPAccessConfig configures the filter returned by NewMSPAccessFilter
+type MSPAccessConfig struct {
+	// Default holds the rules of the channels that have none of their own,
+	// and of chainless proposals
+	Default MSPAccessRules `mapstructure:"default" yaml:"default"`
+	// Channels maps the names of channels to their rules
+	Channels map[string]MSPAccessRules `mapstructure:"channels" yaml:"channels"`
+}
+
+// MSPAccessRules defines which client identities may submit proposals.
+// Identities that match any of the Deny entries are rejected; if there are
+// Allow entries, identities that match none of them are rejected as well.
+type MSPAccessRules struct {
+	Allow []MSPEntry `mapstructure:"allow" yaml:"allow"`
+	Deny  []MSPEntry `mapstructure:"deny" yaml:"deny"`
+}
+
+// MSPEntry matches the identities of an MSP, or only those
+// of one of its organizational units, if OU is set
+type MSPEntry struct {
+	MSPID string `mapstructure:"mspID" yaml:"mspID"`
+	OU    string `mapstructure:"ou" yaml:"ou"`
+}
+
+func (e MSPEntry) matches(id *identity) bool {
+	if e.MSPID != id.mspID {
+		return false
+	}
+	if e.OU == "" {
+		return true
+	}
+	for _, ou := range id.ous {
+		if ou == e.OU {
+			return true
+		}
+	}
+	return false
+}
+
+func (r MSPAccessRules) validate() error {
+	for _, entry := range append(append([]MSPEntry{}, r.Allow...), r.Deny...) {
+		if entry.MSPID == "" {
+			return errors.New("MSP ID is missing")
+		}
+	}
+	return nil
+}
+
+func (r MSPAccessRules) check(id *identity) error {
+	for _, entry := range r.Deny {
+		if entry.matches(id) {
+			return errors.Errorf("identity of MSP %s is denied access", id.mspID)
+		}
+	}
+	if len(r.Allow) == 0 {
+		return nil
+	}
+	for _, entry := range r.Allow {
+		if entry.matches(id) {
+			return nil
+		}
+	}
+	return errors.Errorf("identity of MSP %s is not allowed access", id.mspID)
+}
+
+// NewMSPAccessFilter creates a new Filter that rejects proposals
+// according to the MSP and the organizational units of their creator
+func NewMSPAccessFilter(config MSPAccessConfig) (auth.Filter, error) {
+	if err := config.Default.validate(); err != nil {
+		return nil, errors.WithMessage(err, "invalid default rules")
+	}
+	for channel, rules := range config.Channels {
+		if err := rules.validate(); err != nil {
+			return nil, errors.WithMessage(err, "invalid rules of channel "+channel)
+		}
+	}
+	return &mspAccessFilter{config: config}, nil
+}
+
+type mspAccessFilter struct {
+	next   peer.EndorserServer
+	config MSPAccessConfig
+}
+
+// Init initializes the Filter with the next EndorserServer
+func (f *mspAccessFilter) Init(next peer.EndorserServer) {
+	f.next = next
+}
+
+// ProcessProposal processes a signed proposal
+func (f *mspAccessFilter) ProcessProposal(ctx context.Context, signedProp *peer.SignedProposal) (*peer.ProposalResponse, error) {
+	chdr, sh, err := parseHeaders(signedProp)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseIdentity(sh.Creator)
+	if err != nil {
+		return nil, err
+	}
+	rules, exists := f.config.Channels[chdr.ChannelId]
+	if !exists || chdr.ChannelId == "" {
+		rules = f.config.Default
+	}
+	if err := rules.check(id); err != nil {
+		return nil, err
+	}
+	return f.next.ProcessProposal(ctx, signedProp)
+}
+
+// identity holds the attributes of a client identity that rules match
+type identity struct {
+	mspID string
+	ous   []string
+}
+
+// parseIdentity extracts the MSP ID and the organizational units from the given
+// serialized identity, which is either an x509 or an idemix identity
+func parseIdentity(creator []byte) (*identity, error) {
+	sId := &msp.SerializedIdentity{}
+	if err := proto.Unmarshal(creator, sId); err != nil {
+		return nil, errors.Wrap(err, "failed parsing identity")
+	}
+	id := &identity{mspID: sId.Mspid}
+	if bl, _ := pem.Decode(sId.IdBytes); bl != nil {
+		cert, err := x509.ParseCertificate(bl.Bytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed parsing certificate")
+		}
+		id.ous = cert.Subject.OrganizationalUnit
+		return id, nil
+	}
+	idemixId := &msp.SerializedIdemixIdentity{}
+	if err := proto.Unmarshal(sId.IdBytes, idemixId); err != nil {
+		return nil, errors.Wrap(err, "failed parsing identity")
+	}
+	ou := &msp.OrganizationUnit{}
+	if err := proto.Unmarshal(idemixId.OU, ou); err != nil {
+		return nil, errors.Wrap(err, "failed parsing organizational unit")
+	}
+	if ou.OrganizationalUnitIdentifier != "" {
+		id.ous = []string{ou.OrganizationalUnitIdentifier}
+	}
+	return id, nil
+}
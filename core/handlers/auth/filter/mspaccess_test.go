@@ -0,0 +1,94 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package filter
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+func createX509IdentityOfMSP(t *testing.T, mspID string) []byte {
+	certBytes, err := ioutil.ReadFile(filepath.Join("testdata", "notExpiredCert.pem"))
+	assert.NoError(t, err)
+	idBytes, err := proto.Marshal(&msp.SerializedIdentity{Mspid: mspID, IdBytes: certBytes})
+	assert.NoError(t, err)
+	return idBytes
+}
+
+func createIdemixIdentityOfMSP(t *testing.T, mspID, ou string) []byte {
+	ouBytes, err := proto.Marshal(&msp.OrganizationUnit{MspIdentifier: mspID, OrganizationalUnitIdentifier: ou})
+	assert.NoError(t, err)
+	idemixBytes, err := proto.Marshal(&msp.SerializedIdemixIdentity{NymX: []byte{1}, NymY: []byte{1}, OU: ouBytes})
+	assert.NoError(t, err)
+	idBytes, err := proto.Marshal(&msp.SerializedIdentity{Mspid: mspID, IdBytes: idemixBytes})
+	assert.NoError(t, err)
+	return idBytes
+}
+
+func TestMSPAccessFilter(t *testing.T) {
+	f, err := NewMSPAccessFilter(MSPAccessConfig{
+		Default: MSPAccessRules{
+			Deny: []MSPEntry{{MSPID: "Org3MSP"}},
+		},
+		Channels: map[string]MSPAccessRules{
+			"ch1": {
+				// the certificate of the tests has the COP organizational unit
+				Allow: []MSPEntry{{MSPID: "Org1MSP", OU: "COP"}, {MSPID: "Org2MSP"}},
+				Deny:  []MSPEntry{{MSPID: "Org2MSP", OU: "banned"}},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	nextEndorser := &mockEndorserServer{}
+	f.Init(nextEndorser)
+
+	for _, testCase := range []struct {
+		name     string
+		channel  string
+		identity []byte
+		err      string
+	}{
+		{"Allowed MSP and OU", "ch1", createX509IdentityOfMSP(t, "Org1MSP"), ""},
+		{"Allowed MSP", "ch1", createIdemixIdentityOfMSP(t, "Org2MSP", "any"), ""},
+		{"Allowed MSP and denied OU", "ch1", createIdemixIdentityOfMSP(t, "Org2MSP", "banned"), "identity of MSP Org2MSP is denied access"},
+		{"Allowed MSP and other OU", "ch1", createIdemixIdentityOfMSP(t, "Org1MSP", "other"), "identity of MSP Org1MSP is not allowed access"},
+		{"MSP not allowed", "ch1", createX509IdentityOfMSP(t, "Org3MSP"), "identity of MSP Org3MSP is not allowed access"},
+		{"Default rules", "ch2", createX509IdentityOfMSP(t, "Org1MSP"), ""},
+		{"MSP denied by default rules", "ch2", createX509IdentityOfMSP(t, "Org3MSP"), "identity of MSP Org3MSP is denied access"},
+		{"Chainless proposal", "", createX509IdentityOfMSP(t, "Org3MSP"), "identity of MSP Org3MSP is denied access"},
+		{"Malformed identity", "ch1", []byte{1, 2, 3}, "failed parsing identity"},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			nextEndorser.invoked = false
+			_, err := f.ProcessProposal(context.Background(), createSignedProposalForChannel(t, testCase.channel, testCase.identity))
+			if testCase.err == "" {
+				assert.NoError(t, err)
+				assert.True(t, nextEndorser.invoked)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.err)
+				assert.False(t, nextEndorser.invoked)
+			}
+		})
+	}
+
+	_, err = f.ProcessProposal(context.Background(), createSignedProposalWithInvalidSigHeader(t, createX509IdentityOfMSP(t, "Org1MSP")))
+	assert.Contains(t, err.Error(), "failed parsing signature header")
+}
+
+func TestMSPAccessFilterInvalidConfig(t *testing.T) {
+	_, err := NewMSPAccessFilter(MSPAccessConfig{Default: MSPAccessRules{Allow: []MSPEntry{{OU: "COP"}}}})
+	assert.EqualError(t, err, "invalid default rules: MSP ID is missing")
+	_, err = NewMSPAccessFilter(MSPAccessConfig{Channels: map[string]MSPAccessRules{"ch1": {Deny: []MSPEntry{{}}}}})
+	assert.EqualError(t, err, "invalid rules of channel ch1: MSP ID is missing")
+}
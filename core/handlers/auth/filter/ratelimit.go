@@ -0,0 +1,220 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package filter
+
+import (
+	"crypto/sha256"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric/core/handlers/auth"
+	mspmgmt "github.com/hyperledger/fabric/msp/mgmt"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// maxIdleBuckets is the number of token buckets past which
+// the buckets of the idle clients and channels are discarded
+const maxIdleBuckets = 10000
+
+// RateLimitConfig configures the rate limits of the filter
+// returned by NewRateLimitFilter
+type RateLimitConfig struct {
+	// PerClient limits the rate of the proposals of each client identity,
+	// across all channels
+	PerClient RateLimit `mapstructure:"perClient" yaml:"perClient"`
+	// PerChannel limits the rate of the proposals of all the clients
+	// of each channel
+	PerChannel RateLimit `mapstructure:"perChannel" yaml:"perChannel"`
+}
+
+// RateLimit defines a rate of proposals
+type RateLimit struct {
+	// Rate is the number of proposals per second that are let through
+	// on average; a rate of 0 means that proposals are not limited
+	Rate float64 `mapstructure:"rate" yaml:"rate"`
+	// Burst is the number of proposals that may be let through at once,
+	// which defaults to the rate, rounded up
+	Burst int `mapstructure:"burst" yaml:"burst"`
+}
+
+func (l RateLimit) validate() error {
+	if l.Rate < 0 {
+		return errors.Errorf("invalid rate %f, must not be negative", l.Rate)
+	}
+	if l.Burst < 0 {
+		return errors.Errorf("invalid burst %d, must not be negative", l.Burst)
+	}
+	return nil
+}
+
+// NewRateLimitFilter creates a new Filter that rejects the proposals of the
+// client identities, and of the channels, that exceed the given rate limits
+func NewRateLimitFilter(config RateLimitConfig) (auth.Filter, error) {
+	if err := config.PerClient.validate(); err != nil {
+		return nil, errors.WithMessage(err, "invalid per client rate limit")
+	}
+	if err := config.PerChannel.validate(); err != nil {
+		return nil, errors.WithMessage(err, "invalid per channel rate limit")
+	}
+	return &rateLimitFilter{
+		clients:  newLimiter(config.PerClient),
+		channels: newLimiter(config.PerChannel),
+		now:      time.Now,
+		verify:   verifyCreatorSignature,
+	}, nil
+}
+
+type rateLimitFilter struct {
+	next peer.EndorserServer
+
+	sync.Mutex
+	clients  *limiter
+	channels *limiter
+	now      func() time.Time
+	verify   func(signedProp *peer.SignedProposal, creator []byte, channel string) error
+}
+
+// Init initializes the Filter with the next EndorserServer
+func (f *rateLimitFilter) Init(next peer.EndorserServer) {
+	f.next = next
+}
+
+// ProcessProposal processes a signed proposal
+func (f *rateLimitFilter) ProcessProposal(ctx context.Context, signedProp *peer.SignedProposal) (*peer.ProposalResponse, error) {
+	chdr, sh, err := parseHeaders(signedProp)
+	if err != nil {
+		return nil, err
+	}
+	// the creator is only charged once it has proven to be the signer of
+	// the proposal, lest clients exhaust the tokens of others
+	if err := f.verify(signedProp, sh.Creator, chdr.ChannelId); err != nil {
+		return nil, err
+	}
+	// clients are told apart by the hash of their serialized identity
+	client := sha256.Sum256(sh.Creator)
+	if err := f.take(string(client[:]), chdr.ChannelId); err != nil {
+		return nil, err
+	}
+	return f.next.ProcessProposal(ctx, signedProp)
+}
+
+// verifyCreatorSignature verifies that the given proposal has been signed by
+// its creator, with the MSPs of the given channel, or with the local MSP for
+// chainless proposals
+func verifyCreatorSignature(signedProp *peer.SignedProposal, creator []byte, channel string) error {
+	deserializer := mspmgmt.GetIdentityDeserializer(channel)
+	if deserializer == nil {
+		return errors.Errorf("could not get msp for channel %s", channel)
+	}
+	id, err := deserializer.DeserializeIdentity(creator)
+	if err != nil {
+		return errors.WithMessage(err, "failed deserializing proposal creator")
+	}
+	if err := id.Validate(); err != nil {
+		return errors.WithMessage(err, "proposal creator is not valid")
+	}
+	if err := id.Verify(signedProp.ProposalBytes, signedProp.Signature); err != nil {
+		return errors.WithMessage(err, "creator's signature over the proposal is not valid")
+	}
+	return nil
+}
+
+// take consumes a token of the given client and of the given channel, if both
+// have one left. Chainless proposals are only limited per client.
+func (f *rateLimitFilter) take(client, channel string) error {
+	f.Lock()
+	defer f.Unlock()
+	now := f.now()
+	clientBucket := f.clients.bucket(client, now)
+	var channelBucket *tokenBucket
+	if channel != "" {
+		channelBucket = f.channels.bucket(channel, now)
+	}
+	if !clientBucket.available() {
+		return errors.New("rate limit exceeded for client identity")
+	}
+	if !channelBucket.available() {
+		return errors.Errorf("rate limit exceeded for channel %s", channel)
+	}
+	clientBucket.take()
+	channelBucket.take()
+	return nil
+}
+
+// limiter keeps a token bucket for each of the keys proposals are limited by
+type limiter struct {
+	rate    float64
+	burst   float64
+	buckets map[string]*tokenBucket
+}
+
+func newLimiter(l RateLimit) *limiter {
+	burst := float64(l.Burst)
+	if burst == 0 {
+		burst = math.Ceil(l.Rate)
+	}
+	return &limiter{
+		rate:    l.Rate,
+		burst:   burst,
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// bucket returns the bucket of the given key, refilled as of the given time,
+// or nil if proposals are not limited
+func (l *limiter) bucket(key string, now time.Time) *tokenBucket {
+	if l.rate == 0 {
+		return nil
+	}
+	b, exists := l.buckets[key]
+	if !exists {
+		if len(l.buckets) >= maxIdleBuckets {
+			l.discardIdle(now)
+		}
+		b = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+	b.refill(now, l.rate, l.burst)
+	return b
+}
+
+// discardIdle discards the buckets that are full as of the given time,
+// as they are indistinguishable from new buckets
+func (l *limiter) discardIdle(now time.Time) {
+	for key, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func (b *tokenBucket) refill(now time.Time, rate, burst float64) {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(burst, b.tokens+elapsed*rate)
+		b.last = now
+	}
+}
+
+// available returns whether a token is left in the bucket;
+// a nil bucket always has one
+func (b *tokenBucket) available() bool {
+	return b == nil || b.tokens >= 1
+}
+
+func (b *tokenBucket) take() {
+	if b != nil {
+		b.tokens--
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package filter
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+func createSignedProposalForChannel(t *testing.T, channel string, serializedIdentity []byte) *peer.SignedProposal {
+	sHdr := utils.MakeSignatureHeader(serializedIdentity, nil)
+	hdr := utils.MakePayloadHeader(&common.ChannelHeader{ChannelId: channel}, sHdr)
+	prop := &peer.Proposal{Header: utils.MarshalOrPanic(hdr)}
+	return &peer.SignedProposal{ProposalBytes: utils.MarshalOrPanic(prop)}
+}
+
+func createMSPIdentity(t *testing.T, mspID string) []byte {
+	idBytes, err := proto.Marshal(&msp.SerializedIdentity{Mspid: mspID, IdBytes: []byte(mspID)})
+	assert.NoError(t, err)
+	return idBytes
+}
+
+func TestRateLimitFilter(t *testing.T) {
+	now := time.Now()
+	f, err := NewRateLimitFilter(RateLimitConfig{
+		PerClient:  RateLimit{Rate: 1, Burst: 2},
+		PerChannel: RateLimit{Rate: 2},
+	})
+	assert.NoError(t, err)
+	f.(*rateLimitFilter).now = func() time.Time { return now }
+	f.(*rateLimitFilter).verify = acceptSignature
+	nextEndorser := &mockEndorserServer{}
+	f.Init(nextEndorser)
+
+	process := func(channel, client string) error {
+		nextEndorser.invoked = false
+		_, err := f.ProcessProposal(context.Background(), createSignedProposalForChannel(t, channel, createMSPIdentity(t, client)))
+		assert.Equal(t, err == nil, nextEndorser.invoked)
+		return err
+	}
+
+	// clients may submit a burst of proposals
+	assert.NoError(t, process("ch1", "client1"))
+	assert.NoError(t, process("ch2", "client1"))
+	assert.EqualError(t, process("ch3", "client1"), "rate limit exceeded for client identity")
+
+	// channels as well
+	assert.NoError(t, process("ch1", "client2"))
+	assert.EqualError(t, process("ch1", "client3"), "rate limit exceeded for channel ch1")
+	// the proposal rejected on the channel doesn't count for its client
+	assert.NoError(t, process("ch2", "client3"))
+
+	// chainless proposals are only limited per client
+	assert.NoError(t, process("", "client4"))
+	assert.NoError(t, process("", "client4"))
+	assert.Error(t, process("", "client4"))
+
+	// tokens are refilled over time
+	now = now.Add(time.Second)
+	assert.NoError(t, process("ch3", "client1"))
+	assert.Error(t, process("ch3", "client1"))
+	now = now.Add(time.Hour)
+	assert.NoError(t, process("ch3", "client1"))
+	assert.NoError(t, process("ch3", "client1"))
+	assert.Error(t, process("ch3", "client1"))
+
+	// malformed proposals are rejected
+	_, err = f.ProcessProposal(context.Background(), createSignedProposalWithInvalidHeader(t, createMSPIdentity(t, "client1")))
+	assert.Contains(t, err.Error(), "failed parsing header")
+}
+
+func acceptSignature(*peer.SignedProposal, []byte, string) error {
+	return nil
+}
+
+func TestRateLimitFilterForgedSignature(t *testing.T) {
+	f, err := NewRateLimitFilter(RateLimitConfig{PerClient: RateLimit{Rate: 1}})
+	assert.NoError(t, err)
+	nextEndorser := &mockEndorserServer{}
+	f.Init(nextEndorser)
+	forged := true
+	f.(*rateLimitFilter).verify = func(signedProp *peer.SignedProposal, creator []byte, channel string) error {
+		assert.Equal(t, "ch1", channel)
+		assert.Equal(t, createMSPIdentity(t, "client1"), creator)
+		if forged {
+			return errors.New("creator's signature over the proposal is not valid")
+		}
+		return nil
+	}
+
+	// proposals forged on behalf of a client don't consume its tokens
+	for i := 0; i < 10; i++ {
+		_, err = f.ProcessProposal(context.Background(), createSignedProposalForChannel(t, "ch1", createMSPIdentity(t, "client1")))
+		assert.EqualError(t, err, "creator's signature over the proposal is not valid")
+		assert.False(t, nextEndorser.invoked)
+	}
+	forged = false
+	_, err = f.ProcessProposal(context.Background(), createSignedProposalForChannel(t, "ch1", createMSPIdentity(t, "client1")))
+	assert.NoError(t, err)
+	assert.True(t, nextEndorser.invoked)
+}
+
+func TestRateLimitFilterUnlimited(t *testing.T) {
+	f, err := NewRateLimitFilter(RateLimitConfig{})
+	assert.NoError(t, err)
+	f.(*rateLimitFilter).verify = acceptSignature
+	nextEndorser := &mockEndorserServer{}
+	f.Init(nextEndorser)
+	for i := 0; i < 100; i++ {
+		_, err := f.ProcessProposal(context.Background(), createSignedProposalForChannel(t, "ch1", createMSPIdentity(t, "client1")))
+		assert.NoError(t, err)
+	}
+}
+
+func TestRateLimitFilterInvalidConfig(t *testing.T) {
+	_, err := NewRateLimitFilter(RateLimitConfig{PerClient: RateLimit{Rate: -1}})
+	assert.EqualError(t, err, "invalid per client rate limit: invalid rate -1.000000, must not be negative")
+	_, err = NewRateLimitFilter(RateLimitConfig{PerChannel: RateLimit{Rate: 1, Burst: -1}})
+	assert.EqualError(t, err, "invalid per channel rate limit: invalid burst -1, must not be negative")
+}
+
+func TestLimiterDiscardIdle(t *testing.T) {
+	now := time.Now()
+	l := newLimiter(RateLimit{Rate: 1})
+	for i := 0; i < maxIdleBuckets; i++ {
+		l.bucket(string(rune(i)), now).take()
+	}
+	// the buckets of the clients that have been idle for long are full again
+	now = now.Add(time.Second)
+	l.bucket("busy", now).take()
+	assert.Len(t, l.buckets, 1)
+}
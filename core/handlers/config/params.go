@@ -0,0 +1,167 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+)
+
+// Params holds the configuration parameters of a handler, as
+// defined in the config section of the handler in core.yaml
+type Params map[string]interface{}
+
+// New returns the Params holding the given parameters, with the nested
+// maps converted into maps with string keys, as YAML decoders may
+// yield maps with keys of any type
+func New(params map[string]interface{}) (Params, error) {
+	p := Params{}
+	for key, value := range params {
+		normalized, err := normalize(value)
+		if err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("invalid parameter %s", key))
+		}
+		p[key] = normalized
+	}
+	return p, nil
+}
+
+func normalize(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return normalizeMap(v)
+	case Params:
+		return normalizeMap(v)
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			s, isString := key.(string)
+			if !isString {
+				return nil, errors.Errorf("key %v is not a string", key)
+			}
+			m[s] = value
+		}
+		return normalizeMap(m)
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, value := range v {
+			normalized, err := normalize(value)
+			if err != nil {
+				return nil, err
+			}
+			s[i] = normalized
+		}
+		return s, nil
+	default:
+		return value, nil
+	}
+}
+
+func normalizeMap(m map[string]interface{}) (interface{}, error) {
+	p, err := New(m)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}(p), nil
+}
+
+// Decode decodes the parameters into the given structure, failing
+// on the parameters that the structure doesn't define
+func (p Params) Decode(output interface{}) error {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:           output,
+		ErrorUnused:      true,
+		WeaklyTypedInput: true,
+		DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+	})
+	if err != nil {
+		return err
+	}
+	if err := decoder.Decode(map[string]interface{}(p)); err != nil {
+		return errors.Wrap(err, "invalid configuration")
+	}
+	return nil
+}
+
+// String returns the string value of the parameter with the given key,
+// or the given default value if it is not set
+func (p Params) String(key string, defaultValue string) (string, error) {
+	value := defaultValue
+	return value, p.decodeValue(key, &value)
+}
+
+// Int returns the integer value of the parameter with the given key,
+// or the given default value if it is not set
+func (p Params) Int(key string, defaultValue int) (int, error) {
+	value := defaultValue
+	return value, p.decodeValue(key, &value)
+}
+
+// Bool returns the boolean value of the parameter with the given key,
+// or the given default value if it is not set
+func (p Params) Bool(key string, defaultValue bool) (bool, error) {
+	value := defaultValue
+	return value, p.decodeValue(key, &value)
+}
+
+// Duration returns the duration value, such as 5s, of the parameter
+// with the given key, or the given default value if it is not set
+func (p Params) Duration(key string, defaultValue time.Duration) (time.Duration, error) {
+	value := defaultValue
+	return value, p.decodeValue(key, &value)
+}
+
+// decodeValue decodes the value of the parameter with the given key into the
+// given output, if it is set. Keys of nested parameters are separated by dots.
+func (p Params) decodeValue(key string, output interface{}) error {
+	value, exists := p.lookup(key)
+	if !exists {
+		return nil
+	}
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:           output,
+		WeaklyTypedInput: true,
+		DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+	})
+	if err != nil {
+		return err
+	}
+	if err := decoder.Decode(value); err != nil {
+		return errors.Wrapf(err, "invalid value of parameter %s", key)
+	}
+	return nil
+}
+
+// lookup returns the value of the parameter with the given key; as
+// viper lowercases keys, they are matched regardless of case
+func (p Params) lookup(key string) (interface{}, bool) {
+	var current interface{} = map[string]interface{}(p)
+	for _, part := range strings.Split(key, ".") {
+		m, isMap := current.(map[string]interface{})
+		if !isMap {
+			return nil, false
+		}
+		value, exists := m[part]
+		if !exists {
+			for k, v := range m {
+				if strings.EqualFold(k, part) {
+					value, exists = v, true
+					break
+				}
+			}
+		}
+		if !exists {
+			return nil, false
+		}
+		current = value
+	}
+	return current, true
+}
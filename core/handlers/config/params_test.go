@@ -0,0 +1,86 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package config
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	p, err := New(map[string]interface{}{
+		"timeout": "5s",
+		"nested": map[interface{}]interface{}{
+			"list": []interface{}{map[interface{}]interface{}{"key": "value"}},
+		},
+	})
+	assert.NoError(t, err)
+	bytes, err := json.Marshal(p)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"nested":{"list":[{"key":"value"}]},"timeout":"5s"}`, string(bytes))
+
+	_, err = New(map[string]interface{}{"nested": map[interface{}]interface{}{1: "value"}})
+	assert.EqualError(t, err, "invalid parameter nested: key 1 is not a string")
+
+	p, err = New(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, p)
+}
+
+func TestDecode(t *testing.T) {
+	type settings struct {
+		Timeout time.Duration
+		Retries int
+		Peers   []string
+	}
+	p := Params{"timeout": "5s", "retries": "3", "peers": []interface{}{"peer0", "peer1"}}
+	s := settings{}
+	assert.NoError(t, p.Decode(&s))
+	assert.Equal(t, settings{Timeout: 5 * time.Second, Retries: 3, Peers: []string{"peer0", "peer1"}}, s)
+
+	err := Params{"unknown": true}.Decode(&s)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid configuration")
+}
+
+func TestGetters(t *testing.T) {
+	p := Params{
+		"name":    "filter",
+		"limit":   10,
+		"enabled": "true",
+		"nested":  map[string]interface{}{"Timeout": "2s"},
+	}
+
+	s, err := p.String("name", "default")
+	assert.NoError(t, err)
+	assert.Equal(t, "filter", s)
+	s, err = p.String("missing", "default")
+	assert.NoError(t, err)
+	assert.Equal(t, "default", s)
+
+	i, err := p.Int("limit", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, i)
+	_, err = p.Int("name", 0)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid value of parameter name")
+
+	b, err := p.Bool("enabled", false)
+	assert.NoError(t, err)
+	assert.True(t, b)
+
+	// nested keys are separated by dots, and matched regardless of case
+	d, err := p.Duration("nested.timeout", time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, 2*time.Second, d)
+	d, err = p.Duration("name.timeout", time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Second, d)
+}
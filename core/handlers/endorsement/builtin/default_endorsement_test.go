@@ -0,0 +1,76 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package builtin
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hyperledger/fabric/core/handlers/endorsement"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockSigningIdentity struct {
+	serializeErr error
+	signErr      error
+}
+
+func (id *mockSigningIdentity) Serialize() ([]byte, error) {
+	return []byte("endorser"), id.serializeErr
+}
+
+func (id *mockSigningIdentity) Sign(msg []byte) ([]byte, error) {
+	return append([]byte("signed:"), msg...), id.signErr
+}
+
+type mockSigningIdentityFetcher struct {
+	identity *mockSigningIdentity
+	err      error
+}
+
+func (f *mockSigningIdentityFetcher) SigningIdentityForRequest(*peer.SignedProposal) (endorsement.SigningIdentity, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.identity, nil
+}
+
+func TestDefaultEndorsementInit(t *testing.T) {
+	plugin := (&DefaultEndorsementFactory{}).New()
+
+	err := plugin.Init()
+	assert.EqualError(t, err, "could not find SigningIdentityFetcher in dependencies")
+
+	err = plugin.Init("not a fetcher", &mockSigningIdentityFetcher{})
+	assert.NoError(t, err)
+}
+
+func TestDefaultEndorsementEndorse(t *testing.T) {
+	fetcher := &mockSigningIdentityFetcher{identity: &mockSigningIdentity{}}
+	plugin := (&DefaultEndorsementFactory{}).New()
+	assert.NoError(t, plugin.Init(fetcher))
+
+	// the payload is signed together with the identity, and returned as is
+	endorsement, prpBytes, err := plugin.Endorse([]byte("payload"), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("payload"), prpBytes)
+	assert.Equal(t, []byte("endorser"), endorsement.Endorser)
+	assert.Equal(t, []byte("signed:payloadendorser"), endorsement.Signature)
+
+	fetcher.identity.signErr = errors.New("no key")
+	_, _, err = plugin.Endorse([]byte("payload"), nil)
+	assert.Contains(t, err.Error(), "could not sign the proposal response payload: no key")
+
+	fetcher.identity.serializeErr = errors.New("bad cert")
+	_, _, err = plugin.Endorse([]byte("payload"), nil)
+	assert.Contains(t, err.Error(), "could not serialize the signing identity: bad cert")
+
+	fetcher.err = errors.New("no identity")
+	_, _, err = plugin.Endorse([]byte("payload"), nil)
+	assert.Contains(t, err.Error(), "failed fetching signing identity: no identity")
+}
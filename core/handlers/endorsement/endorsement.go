@@ -0,0 +1,50 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package endorsement
+
+import (
+	"github.com/hyperledger/fabric/protos/peer"
+)
+
+// Plugin endorses a proposal response
+type Plugin interface {
+	// Endorse signs the given payload (the bytes of a ProposalResponsePayload,
+	// whose extension is the ChaincodeAction carrying the response and the
+	// simulation results of the chaincode), and optionally mutates it.
+	// Returns the endorsement, which is a signature over the payload and the
+	// identity used to verify it, and the payload that was given as input,
+	// possibly modified. An error means the proposal is not endorsed.
+	Endorse(payload []byte, sp *peer.SignedProposal) (*peer.Endorsement, []byte, error)
+
+	// Init injects dependencies into the instance of the Plugin
+	Init(dependencies ...Dependency) error
+}
+
+// PluginFactory creates a new instance of a Plugin
+type PluginFactory interface {
+	New() Plugin
+}
+
+// Dependency marks a dependency passed to the Init() method
+type Dependency interface{}
+
+// SigningIdentity signs messages and serializes its public identity to bytes
+type SigningIdentity interface {
+	// Serialize returns a byte representation of this identity which is used
+	// to verify messages signed by this SigningIdentity
+	Serialize() ([]byte, error)
+
+	// Sign signs the given payload and returns a signature
+	Sign([]byte) ([]byte, error)
+}
+
+// SigningIdentityFetcher fetches a signing identity
+type SigningIdentityFetcher interface {
+	Dependency
+	// SigningIdentityForRequest returns a signing identity for the given proposal
+	SigningIdentityForRequest(*peer.SignedProposal) (SigningIdentity, error)
+}
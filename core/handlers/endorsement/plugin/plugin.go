@@ -0,0 +1,28 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"github.com/hyperledger/fabric/core/handlers/endorsement"
+	"github.com/hyperledger/fabric/core/handlers/endorsement/builtin"
+)
+
+type endorsementFactory struct {
+}
+
+// New returns an endorsement plugin that behaves as the default one
+func (*endorsementFactory) New() endorsement.Plugin {
+	return &builtin.DefaultEndorsement{}
+}
+
+// NewPluginFactory is the function ran by the plugin infrastructure to create an endorsement plugin factory.
+func NewPluginFactory() endorsement.PluginFactory {
+	return &endorsementFactory{}
+}
+
+func main() {
+}
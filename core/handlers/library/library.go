@@ -9,8 +9,13 @@ package library
 import (
 	"github.com/hyperledger/fabric/core/handlers/auth"
 	"github.com/hyperledger/fabric/core/handlers/auth/filter"
+	"github.com/hyperledger/fabric/core/handlers/config"
 	"github.com/hyperledger/fabric/core/handlers/decoration"
 	"github.com/hyperledger/fabric/core/handlers/decoration/decorator"
+	"github.com/hyperledger/fabric/core/handlers/endorsement"
+	"github.com/hyperledger/fabric/core/handlers/endorsement/builtin"
+	"github.com/hyperledger/fabric/core/handlers/validation"
+	validationbuiltin "github.com/hyperledger/fabric/core/handlers/validation/builtin"
 )
 
 // HandlerLibrary is used to assert
@@ -33,9 +38,45 @@ func (r *HandlerLibrary) ExpirationCheck() auth.Filter {
 	return filter.NewExpirationCheckFilter()
 }
 
+// RateLimit is an auth filter which rejects the proposals of
+// the client identities, and of the channels, that exceed the
+// configured rate limits (see filter.RateLimitConfig)
+func (r *HandlerLibrary) RateLimit(params config.Params) (auth.Filter, error) {
+	conf := filter.RateLimitConfig{}
+	if err := params.Decode(&conf); err != nil {
+		return nil, err
+	}
+	return filter.NewRateLimitFilter(conf)
+}
+
+// MSPAccess is an auth filter which rejects proposals according to
+// the MSP and the organizational units of their creator, and the
+// configured rules of their channel (see filter.MSPAccessConfig)
+func (r *HandlerLibrary) MSPAccess(params config.Params) (auth.Filter, error) {
+	conf := filter.MSPAccessConfig{}
+	if err := params.Decode(&conf); err != nil {
+		return nil, err
+	}
+	return filter.NewMSPAccessFilter(conf)
+}
+
 // DefaultDecorator creates a default decorator
 // that doesn't do anything with the input, simply
 // returns the input as output.
 func (r *HandlerLibrary) DefaultDecorator() decoration.Decorator {
 	return decorator.NewDecorator()
 }
+
+// DefaultEndorsement creates a factory of endorsement plugins
+// that sign the proposal response payload with the peer's
+// signing identity, as the escc system chaincode does.
+func (r *HandlerLibrary) DefaultEndorsement() endorsement.PluginFactory {
+	return &builtin.DefaultEndorsementFactory{}
+}
+
+// DefaultValidation creates a factory of validation plugins
+// that validate transactions against the endorsement policy
+// of their chaincode, as the vscc system chaincode does.
+func (r *HandlerLibrary) DefaultValidation() validation.PluginFactory {
+	return &validationbuiltin.DefaultValidationFactory{}
+}
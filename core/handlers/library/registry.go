@@ -11,10 +11,15 @@ import (
 	"os"
 	"plugin"
 	"reflect"
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/hyperledger/fabric/core/handlers/auth"
+	"github.com/hyperledger/fabric/core/handlers/config"
 	"github.com/hyperledger/fabric/core/handlers/decoration"
+	"github.com/hyperledger/fabric/core/handlers/endorsement"
+	"github.com/hyperledger/fabric/core/handlers/validation"
 )
 
 // Registry defines an object that looks up
@@ -23,6 +28,10 @@ type Registry interface {
 	// Lookup returns a handler with a given
 	// registered name, or nil if does not exist
 	Lookup(HandlerType) interface{}
+
+	// Handlers returns the handlers that were loaded,
+	// along with their configuration
+	Handlers() []LoadedHandler
 }
 
 // HandlerType defines custom handlers that can filter and mutate
@@ -35,141 +44,396 @@ const (
 	// Decoration handler - append or mutate the chaincode input
 	// passed to the chaincode
 	Decoration
+	// Endorsement handler - endorse the proposal response
+	// produced by the simulation of a proposal
+	Endorsement
+	// Validation handler - validate the transactions
+	// of blocks before they are committed
+	Validation
 
-	authPluginFactory      = "NewFilter"
-	decoratorPluginFactory = "NewDecorator"
+	authPluginFactory        = "NewFilter"
+	decoratorPluginFactory   = "NewDecorator"
+	endorsementPluginFactory = "NewPluginFactory"
+	validationPluginFactory  = "NewPluginFactory"
 )
 
+// String returns the name of the handler type
+func (t HandlerType) String() string {
+	switch t {
+	case Auth:
+		return "auth"
+	case Decoration:
+		return "decoration"
+	case Endorsement:
+		return "endorsement"
+	case Validation:
+		return "validation"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(t))
+	}
+}
+
+// LoadedHandler describes a handler that was loaded by the registry
+type LoadedHandler struct {
+	Type HandlerType
+	// Key is the name endorsement and validation
+	// plugins are registered under
+	Key string
+	HandlerConfig
+}
+
 type registry struct {
 	filters    []auth.Filter
 	decorators []decoration.Decorator
+	endorsers  map[string]endorsement.PluginFactory
+	validators map[string]validation.PluginFactory
+	loaded     []LoadedHandler
 }
 
 var once sync.Once
 var reg registry
+var loadErr error
+
+// defaultEndorsementPlugin is the name of the endorsement
+// plugin chaincodes are endorsed with by default
+const defaultEndorsementPlugin = "escc"
+
+// defaultValidationPlugin is the name of the validation
+// plugin transactions are validated with by default
+const defaultValidationPlugin = "vscc"
 
 // Config configures the factory methods
 // and plugins for the registry
 type Config struct {
 	AuthFilters []*HandlerConfig `mapstructure:"authFilters" yaml:"authFilters"`
 	Decorators  []*HandlerConfig `mapstructure:"decorators" yaml:"decorators"`
+	Endorsers   PluginMapping    `mapstructure:"endorsers" yaml:"endorsers"`
+	Validators  PluginMapping    `mapstructure:"validators" yaml:"validators"`
 }
 
-// HandlerConfig defines configuration for a plugin or compiled handler
+// PluginMapping maps the names under which plugins are
+// referenced to their configuration
+type PluginMapping map[string]*HandlerConfig
+
+// HandlerConfig defines configuration for a plugin or compiled handler.
+// The Config parameters are passed to the factory methods of compiled
+// handlers, and to the constructors of plugins, that take an argument.
 type HandlerConfig struct {
-	Name    string `mapstructure:"name" yaml:"name"`
-	Library string `mapstructure:"library" yaml:"library"`
+	Name    string        `mapstructure:"name" yaml:"name"`
+	Library string        `mapstructure:"library" yaml:"library"`
+	Config  config.Params `mapstructure:"config" yaml:"config"`
 }
 
 // InitRegistry creates the (only) instance
-// of the registry
+// of the registry, and panics if any of
+// the handlers fails to load
 func InitRegistry(c Config) Registry {
+	r, err := LoadRegistry(c)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// LoadRegistry creates the (only) instance of the registry, and
+// returns an error that lists the handlers that failed to load
+func LoadRegistry(c Config) (Registry, error) {
 	once.Do(func() {
-		reg = registry{}
-		reg.loadHandlers(c)
+		reg = registry{
+			endorsers:  make(map[string]endorsement.PluginFactory),
+			validators: make(map[string]validation.PluginFactory),
+		}
+		loadErr = reg.loadHandlers(c)
 	})
-	return &reg
+	return &reg, loadErr
 }
 
 // loadHandlers loads the configured handlers
-func (r *registry) loadHandlers(c Config) {
+func (r *registry) loadHandlers(c Config) error {
+	var errs []string
+	load := func(config *HandlerConfig, handlerType HandlerType, extraArgs ...string) {
+		if err := r.evaluateModeAndLoad(config, handlerType, extraArgs...); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
 	for _, config := range c.AuthFilters {
-		r.evaluateModeAndLoad(config, Auth)
+		load(config, Auth)
 	}
 	for _, config := range c.Decorators {
-		r.evaluateModeAndLoad(config, Decoration)
+		load(config, Decoration)
+	}
+	for _, chaincodeID := range sortedKeys(c.Endorsers) {
+		load(c.Endorsers[chaincodeID], Endorsement, chaincodeID)
+	}
+	// A core.yaml that predates endorsement plugins doesn't configure the
+	// escc plugin, which all chaincodes are endorsed with by default
+	if _, configured := c.Endorsers[defaultEndorsementPlugin]; !configured {
+		load(&HandlerConfig{Name: "DefaultEndorsement"}, Endorsement, defaultEndorsementPlugin)
+	}
+	for _, chaincodeID := range sortedKeys(c.Validators) {
+		load(c.Validators[chaincodeID], Validation, chaincodeID)
 	}
+	// Likewise, transactions are validated with the vscc plugin by default,
+	// and a peer that can't find it can't commit any block
+	if _, configured := c.Validators[defaultValidationPlugin]; !configured {
+		load(&HandlerConfig{Name: "DefaultValidation"}, Validation, defaultValidationPlugin)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed loading handlers: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func sortedKeys(mapping PluginMapping) []string {
+	var keys []string
+	for key := range mapping {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
 }
 
-// evaluateModeAndLoad if a library path is provided, load the shared object
-func (r *registry) evaluateModeAndLoad(c *HandlerConfig, handlerType HandlerType) {
+// evaluateModeAndLoad if a library path is provided, load the shared object.
+// Plugins that are looked up by name, such as endorsement and validation plugins, are
+// passed the name they are registered under as an extra argument.
+func (r *registry) evaluateModeAndLoad(c *HandlerConfig, handlerType HandlerType, extraArgs ...string) error {
+	description := fmt.Sprintf("%s handler %s", handlerType, c.Name)
+	if len(extraArgs) == 1 {
+		description = fmt.Sprintf("%s handler %s (%s)", handlerType, c.Name, extraArgs[0])
+	}
+	if c.Name == "" && c.Library == "" {
+		return fmt.Errorf("%s: either a name or a library must be set", description)
+	}
+	params, err := config.New(c.Config)
+	if err != nil {
+		return fmt.Errorf("%s: %s", description, err)
+	}
+
 	if c.Library != "" {
-		r.loadPlugin(c.Library, handlerType)
+		err = r.loadPlugin(c.Library, params, handlerType, extraArgs...)
 	} else {
-		r.loadCompiled(c.Name, handlerType)
+		err = r.loadCompiled(c.Name, params, handlerType, extraArgs...)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %s", description, err)
+	}
+
+	loaded := LoadedHandler{Type: handlerType, HandlerConfig: HandlerConfig{Name: c.Name, Library: c.Library, Config: params}}
+	if len(extraArgs) == 1 {
+		loaded.Key = extraArgs[0]
 	}
+	r.loaded = append(r.loaded, loaded)
+	return nil
 }
 
-// loadCompiled loads a statically compiled handler
-func (r *registry) loadCompiled(handlerFactory string, handlerType HandlerType) {
+// loadCompiled loads a statically compiled handler. Factory methods may take
+// the configuration of the handler, and may return an error as well.
+func (r *registry) loadCompiled(handlerFactory string, params config.Params, handlerType HandlerType, extraArgs ...string) error {
 	registryMD := reflect.ValueOf(&HandlerLibrary{})
 
 	o := registryMD.MethodByName(handlerFactory)
 	if !o.IsValid() {
-		panic(fmt.Errorf("Method %s isn't a method of HandlerLibrary", handlerFactory))
+		return fmt.Errorf("Method %s isn't a method of HandlerLibrary", handlerFactory)
 	}
 
-	inst := o.Call(nil)[0].Interface()
+	var in []reflect.Value
+	if o.Type().NumIn() == 1 {
+		in = append(in, reflect.ValueOf(params))
+	} else if len(params) > 0 {
+		return fmt.Errorf("Handler %s doesn't take any configuration", handlerFactory)
+	}
+	out := o.Call(in)
+	if len(out) == 2 && !out[1].IsNil() {
+		return fmt.Errorf("Failed creating handler %s: %s", handlerFactory, out[1].Interface())
+	}
+	inst := out[0].Interface()
 
 	if handlerType == Auth {
 		r.filters = append(r.filters, inst.(auth.Filter))
 	} else if handlerType == Decoration {
 		r.decorators = append(r.decorators, inst.(decoration.Decorator))
+	} else if handlerType == Endorsement {
+		if len(extraArgs) != 1 {
+			return fmt.Errorf("expected 1 argument in extraArgs")
+		}
+		r.endorsers[extraArgs[0]] = inst.(endorsement.PluginFactory)
+	} else if handlerType == Validation {
+		if len(extraArgs) != 1 {
+			return fmt.Errorf("expected 1 argument in extraArgs")
+		}
+		r.validators[extraArgs[0]] = inst.(validation.PluginFactory)
 	}
+	return nil
 }
 
 // loadPlugin loads a pluggagle handler
-func (r *registry) loadPlugin(pluginPath string, handlerType HandlerType) {
+func (r *registry) loadPlugin(pluginPath string, params config.Params, handlerType HandlerType, extraArgs ...string) error {
 	if _, err := os.Stat(pluginPath); err != nil {
-		panic(fmt.Errorf("Could not find plugin at path %s: %s", pluginPath, err))
+		return fmt.Errorf("Could not find plugin at path %s: %s", pluginPath, err)
 	}
 
 	p, err := plugin.Open(pluginPath)
 	if err != nil {
-		panic(fmt.Errorf("Error opening plugin at path %s: %s", pluginPath, err))
+		return fmt.Errorf("Error opening plugin at path %s: %s", pluginPath, err)
 	}
 
 	if handlerType == Auth {
-		r.initAuthPlugin(p)
+		return r.initAuthPlugin(p, params)
 	} else if handlerType == Decoration {
-		r.initDecoratorPlugin(p)
+		return r.initDecoratorPlugin(p, params)
+	} else if handlerType == Endorsement {
+		return r.initEndorsementPlugin(p, params, extraArgs...)
+	} else if handlerType == Validation {
+		return r.initValidationPlugin(p, params, extraArgs...)
 	}
+	return nil
 }
 
-// initAuthPlugin constructs an auth filter from the given plugin
-func (r *registry) initAuthPlugin(p *plugin.Plugin) {
+// initAuthPlugin constructs an auth filter from the given plugin, whose
+// constructor is either a func() auth.Filter, or a
+// func(config.Params) (auth.Filter, error)
+func (r *registry) initAuthPlugin(p *plugin.Plugin, params config.Params) error {
 	constructorSymbol, err := p.Lookup(authPluginFactory)
 	if err != nil {
-		panicWithLookupError(authPluginFactory, err)
+		return lookupError(authPluginFactory, err)
 	}
-	constructor, ok := constructorSymbol.(func() auth.Filter)
-	if !ok {
-		panicWithDefinitionError(authPluginFactory)
+
+	var filter auth.Filter
+	switch constructor := constructorSymbol.(type) {
+	case func() auth.Filter:
+		if len(params) > 0 {
+			return noConfigError(authPluginFactory)
+		}
+		filter = constructor()
+	case func(config.Params) (auth.Filter, error):
+		if filter, err = constructor(params); err != nil {
+			return fmt.Errorf("%s failed: %s", authPluginFactory, err)
+		}
+	default:
+		return definitionError(authPluginFactory)
 	}
 
-	filter := constructor()
 	if filter != nil {
 		r.filters = append(r.filters, filter)
 	}
+	return nil
 }
 
-// initDecoratorPlugin constructs a decorator from the given plugin
-func (r *registry) initDecoratorPlugin(p *plugin.Plugin) {
+// initDecoratorPlugin constructs a decorator from the given plugin, whose
+// constructor is either a func() decoration.Decorator, or a
+// func(config.Params) (decoration.Decorator, error)
+func (r *registry) initDecoratorPlugin(p *plugin.Plugin, params config.Params) error {
 	constructorSymbol, err := p.Lookup(decoratorPluginFactory)
 	if err != nil {
-		panicWithLookupError(decoratorPluginFactory, err)
+		return lookupError(decoratorPluginFactory, err)
 	}
-	constructor, ok := constructorSymbol.(func() decoration.Decorator)
-	if !ok {
-		panicWithDefinitionError(decoratorPluginFactory)
+
+	var decorator decoration.Decorator
+	switch constructor := constructorSymbol.(type) {
+	case func() decoration.Decorator:
+		if len(params) > 0 {
+			return noConfigError(decoratorPluginFactory)
+		}
+		decorator = constructor()
+	case func(config.Params) (decoration.Decorator, error):
+		if decorator, err = constructor(params); err != nil {
+			return fmt.Errorf("%s failed: %s", decoratorPluginFactory, err)
+		}
+	default:
+		return definitionError(decoratorPluginFactory)
 	}
-	decorator := constructor()
+
 	if decorator != nil {
-		r.decorators = append(r.decorators, constructor())
+		r.decorators = append(r.decorators, decorator)
 	}
+	return nil
 }
 
-// panicWithLookupError panics when a handler constructor lookup fails
-func panicWithLookupError(factory string, err error) {
-	panic(fmt.Errorf("Filter must contain constructor with name %s. Error from lookup: %s",
-		factory, err))
+// initEndorsementPlugin constructs an endorsement plugin factory from the given
+// plugin, whose constructor is either a func() endorsement.PluginFactory, or a
+// func(config.Params) (endorsement.PluginFactory, error)
+func (r *registry) initEndorsementPlugin(p *plugin.Plugin, params config.Params, extraArgs ...string) error {
+	if len(extraArgs) != 1 {
+		return fmt.Errorf("expected 1 argument in extraArgs")
+	}
+	factorySymbol, err := p.Lookup(endorsementPluginFactory)
+	if err != nil {
+		return lookupError(endorsementPluginFactory, err)
+	}
+
+	var factory endorsement.PluginFactory
+	switch constructor := factorySymbol.(type) {
+	case func() endorsement.PluginFactory:
+		if len(params) > 0 {
+			return noConfigError(endorsementPluginFactory)
+		}
+		factory = constructor()
+	case func(config.Params) (endorsement.PluginFactory, error):
+		if factory, err = constructor(params); err != nil {
+			return fmt.Errorf("%s failed: %s", endorsementPluginFactory, err)
+		}
+	default:
+		return definitionError(endorsementPluginFactory)
+	}
+
+	if factory == nil {
+		return fmt.Errorf("Plugin %s returned a nil endorsement plugin factory", endorsementPluginFactory)
+	}
+	r.endorsers[extraArgs[0]] = factory
+	return nil
 }
 
-// panicWithDefinitionError panics when a handler constructor does not match
+// initValidationPlugin constructs a validation plugin factory from the given
+// plugin, whose constructor is either a func() validation.PluginFactory, or a
+// func(config.Params) (validation.PluginFactory, error)
+func (r *registry) initValidationPlugin(p *plugin.Plugin, params config.Params, extraArgs ...string) error {
+	if len(extraArgs) != 1 {
+		return fmt.Errorf("expected 1 argument in extraArgs")
+	}
+	factorySymbol, err := p.Lookup(validationPluginFactory)
+	if err != nil {
+		return lookupError(validationPluginFactory, err)
+	}
+
+	var factory validation.PluginFactory
+	switch constructor := factorySymbol.(type) {
+	case func() validation.PluginFactory:
+		if len(params) > 0 {
+			return noConfigError(validationPluginFactory)
+		}
+		factory = constructor()
+	case func(config.Params) (validation.PluginFactory, error):
+		if factory, err = constructor(params); err != nil {
+			return fmt.Errorf("%s failed: %s", validationPluginFactory, err)
+		}
+	default:
+		return definitionError(validationPluginFactory)
+	}
+
+	if factory == nil {
+		return fmt.Errorf("Plugin %s returned a nil validation plugin factory", validationPluginFactory)
+	}
+	r.validators[extraArgs[0]] = factory
+	return nil
+}
+
+// lookupError is returned when a handler constructor lookup fails
+func lookupError(factory string, err error) error {
+	return fmt.Errorf("Filter must contain constructor with name %s. Error from lookup: %s",
+		factory, err)
+}
+
+// definitionError is returned when a handler constructor does not match
 // the expected function definition
-func panicWithDefinitionError(factory string) {
-	panic(fmt.Errorf("Constructor method %s does not match expected definition",
-		authPluginFactory))
+func definitionError(factory string) error {
+	return fmt.Errorf("Constructor method %s does not match expected definition",
+		factory)
+}
+
+// noConfigError is returned when a configuration is given
+// to a handler whose constructor doesn't take any
+func noConfigError(factory string) error {
+	return fmt.Errorf("Constructor method %s doesn't take any configuration", factory)
 }
 
 // Lookup returns a list of handlers with the given
@@ -179,7 +443,17 @@ func (r *registry) Lookup(handlerType HandlerType) interface{} {
 		return r.filters
 	} else if handlerType == Decoration {
 		return r.decorators
+	} else if handlerType == Endorsement {
+		return r.endorsers
+	} else if handlerType == Validation {
+		return r.validators
 	}
 
 	return nil
 }
+
+// Handlers returns the handlers that were loaded,
+// along with their configuration
+func (r *registry) Handlers() []LoadedHandler {
+	return append([]LoadedHandler{}, r.loaded...)
+}
@@ -19,6 +19,8 @@ import (
 	"golang.org/x/net/context"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/handlers/endorsement"
+	"github.com/hyperledger/fabric/core/handlers/validation"
 	"github.com/hyperledger/fabric/protos/peer"
 	"github.com/stretchr/testify/assert"
 )
@@ -26,6 +28,8 @@ import (
 const (
 	authPluginPackage      = "github.com/hyperledger/fabric/core/handlers/auth/plugin"
 	decoratorPluginPackage = "github.com/hyperledger/fabric/core/handlers/decoration/plugin"
+	endorsementTestPlugin  = "github.com/hyperledger/fabric/core/handlers/endorsement/plugin"
+	validationTestPlugin   = "github.com/hyperledger/fabric/core/handlers/validation/plugin"
 )
 
 func TestLoadAuthPlugin(t *testing.T) {
@@ -42,7 +46,7 @@ func TestLoadAuthPlugin(t *testing.T) {
 	assert.NoError(t, err, "Could not build plugin: "+string(output))
 
 	testReg := registry{}
-	testReg.loadPlugin(pluginPath, Auth)
+	assert.NoError(t, testReg.loadPlugin(pluginPath, nil, Auth))
 	assert.Len(t, testReg.filters, 1, "Expected filter to be registered")
 
 	testReg.filters[0].Init(endorser)
@@ -65,20 +69,59 @@ func TestLoadDecoratorPlugin(t *testing.T) {
 	assert.NoError(t, err, "Could not build plugin: "+string(output))
 
 	testReg := registry{}
-	testReg.loadPlugin(pluginPath, Decoration)
+	assert.NoError(t, testReg.loadPlugin(pluginPath, nil, Decoration))
 	assert.Len(t, testReg.decorators, 1, "Expected decorator to be registered")
 
 	decoratedInput := testReg.decorators[0].Decorate(testProposal, testInput)
 	assert.True(t, proto.Equal(decoratedInput, testInput), "Expected chaincode input to remain unchanged")
 }
 
+func TestLoadEndorsementPlugin(t *testing.T) {
+	testDir, err := ioutil.TempDir("", "")
+	assert.NoError(t, err, "Could not create temp directory for plugins")
+	defer os.Remove(testDir)
+	pluginPath := strings.Join([]string{testDir, "/", "endorsementplugin.so"}, "")
+
+	cmd := exec.Command("go", "build", "-o", pluginPath, "-buildmode=plugin",
+		endorsementTestPlugin)
+	output, err := cmd.CombinedOutput()
+	assert.NoError(t, err, "Could not build plugin: "+string(output))
+
+	testReg := registry{endorsers: make(map[string]endorsement.PluginFactory)}
+	assert.NoError(t, testReg.loadPlugin(pluginPath, nil, Endorsement, "escc"))
+	mapping := testReg.Lookup(Endorsement).(map[string]endorsement.PluginFactory)
+	factory := mapping["escc"]
+	assert.NotNil(t, factory)
+	instance := factory.New()
+	assert.NotNil(t, instance)
+	assert.Error(t, instance.Init())
+}
+
+func TestLoadValidationPlugin(t *testing.T) {
+	testDir, err := ioutil.TempDir("", "")
+	assert.NoError(t, err, "Could not create temp directory for plugins")
+	defer os.Remove(testDir)
+	pluginPath := strings.Join([]string{testDir, "/", "validationplugin.so"}, "")
+
+	cmd := exec.Command("go", "build", "-o", pluginPath, "-buildmode=plugin",
+		validationTestPlugin)
+	output, err := cmd.CombinedOutput()
+	assert.NoError(t, err, "Could not build plugin: "+string(output))
+
+	testReg := registry{validators: make(map[string]validation.PluginFactory)}
+	assert.NoError(t, testReg.loadPlugin(pluginPath, nil, Validation, "vscc"))
+	mapping := testReg.Lookup(Validation).(map[string]validation.PluginFactory)
+	factory := mapping["vscc"]
+	assert.NotNil(t, factory)
+	instance := factory.New()
+	assert.NotNil(t, instance)
+	assert.Error(t, instance.Init())
+}
+
 func TestLoadPluginInvalidPath(t *testing.T) {
-	defer func() {
-		if r := recover(); r == nil {
-			t.Errorf("Expected panic with incorrect plugin path")
-		}
-	}()
-	reg.loadPlugin("/NotAReal/Plugin.so", Auth)
+	err := reg.loadPlugin("/NotAReal/Plugin.so", nil, Auth)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Could not find plugin at path /NotAReal/Plugin.so")
 }
 
 type mockEndorserServer struct {
@@ -7,10 +7,16 @@ SPDX-License-Identifier: Apache-2.0
 package library
 
 import (
+	"bytes"
 	"testing"
 
+	"github.com/hyperledger/fabric/common/viperutil"
 	"github.com/hyperledger/fabric/core/handlers/auth"
+	"github.com/hyperledger/fabric/core/handlers/config"
 	"github.com/hyperledger/fabric/core/handlers/decoration"
+	"github.com/hyperledger/fabric/core/handlers/endorsement"
+	"github.com/hyperledger/fabric/core/handlers/validation"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -18,6 +24,12 @@ func TestInitRegistry(t *testing.T) {
 	r := InitRegistry(Config{
 		AuthFilters: []*HandlerConfig{{Name: "DefaultAuth"}},
 		Decorators:  []*HandlerConfig{{Name: "DefaultDecorator"}},
+		Endorsers: PluginMapping{
+			"escc": &HandlerConfig{Name: "DefaultEndorsement"},
+		},
+		Validators: PluginMapping{
+			"vscc": &HandlerConfig{Name: "DefaultValidation"},
+		},
 	})
 	assert.NotNil(t, r)
 	authHandlers := r.Lookup(Auth)
@@ -31,15 +43,153 @@ func TestInitRegistry(t *testing.T) {
 	decorators, isDecorators := decorationHandlers.([]decoration.Decorator)
 	assert.True(t, isDecorators)
 	assert.Len(t, decorators, 1)
+
+	endorsementHandlers := r.Lookup(Endorsement)
+	assert.NotNil(t, endorsementHandlers)
+	endorsers, isEndorsers := endorsementHandlers.(map[string]endorsement.PluginFactory)
+	assert.True(t, isEndorsers)
+	assert.Len(t, endorsers, 1)
+	assert.NotNil(t, endorsers["escc"])
+
+	validationHandlers := r.Lookup(Validation)
+	assert.NotNil(t, validationHandlers)
+	validators, isValidators := validationHandlers.(map[string]validation.PluginFactory)
+	assert.True(t, isValidators)
+	assert.Len(t, validators, 1)
+	assert.NotNil(t, validators["vscc"])
 }
 
 func TestLoadCompiledInvalid(t *testing.T) {
-	defer func() {
-		if r := recover(); r == nil {
-			t.Errorf("Expected panic with invalid factory method")
-		}
-	}()
-
 	testReg := registry{}
-	testReg.loadCompiled("InvalidFactory", Auth)
+	err := testReg.loadCompiled("InvalidFactory", nil, Auth)
+	assert.EqualError(t, err, "Method InvalidFactory isn't a method of HandlerLibrary")
+}
+
+func TestLoadCompiledEndorsementWithoutName(t *testing.T) {
+	testReg := registry{endorsers: make(map[string]endorsement.PluginFactory)}
+	assert.Error(t, testReg.loadCompiled("DefaultEndorsement", nil, Endorsement))
+}
+
+func TestLoadCompiledValidationWithoutName(t *testing.T) {
+	testReg := registry{validators: make(map[string]validation.PluginFactory)}
+	assert.Error(t, testReg.loadCompiled("DefaultValidation", nil, Validation))
+}
+
+func TestLoadCompiledWithConfig(t *testing.T) {
+	viper.SetConfigType("yaml")
+	err := viper.ReadConfig(bytes.NewBufferString(`
+handlers:
+    authFilters:
+      -
+        name: RateLimit
+        config:
+          perClient:
+            rate: 10
+            burst: 20
+      -
+        name: MSPAccess
+        config:
+          channels:
+            mychannel:
+              allow:
+                - mspID: Org1MSP
+                  ou: client
+`))
+	assert.NoError(t, err)
+	conf := Config{}
+	assert.NoError(t, viperutil.EnhancedExactUnmarshalKey("handlers", &conf))
+
+	testReg := registry{
+		endorsers:  make(map[string]endorsement.PluginFactory),
+		validators: make(map[string]validation.PluginFactory),
+	}
+	assert.NoError(t, testReg.loadHandlers(conf))
+	assert.Len(t, testReg.filters, 2)
+	handlers := testReg.Handlers()
+	// the escc and vscc plugins aren't configured, hence the default ones are loaded too
+	assert.Len(t, handlers, 4)
+	assert.Equal(t, Auth, handlers[0].Type)
+	assert.Equal(t, "RateLimit", handlers[0].Name)
+	rate, err := handlers[0].Config.Int("perClient.rate", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, rate)
+	assert.Equal(t, "MSPAccess", handlers[1].Name)
+
+	err = testReg.loadCompiled("RateLimit", config.Params{"perClient": map[string]interface{}{"rate": -1}}, Auth)
+	assert.EqualError(t, err, "Failed creating handler RateLimit: invalid per client rate limit: invalid rate -1.000000, must not be negative")
+	err = testReg.loadCompiled("MSPAccess", config.Params{"unknown": true}, Auth)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid configuration")
+	err = testReg.loadCompiled("DefaultAuth", config.Params{"unknown": true}, Auth)
+	assert.EqualError(t, err, "Handler DefaultAuth doesn't take any configuration")
+	assert.Len(t, testReg.filters, 2)
+}
+
+func TestLoadHandlersErrors(t *testing.T) {
+	testReg := registry{
+		endorsers:  make(map[string]endorsement.PluginFactory),
+		validators: make(map[string]validation.PluginFactory),
+	}
+	err := testReg.loadHandlers(Config{
+		AuthFilters: []*HandlerConfig{
+			{Name: "DefaultAuth"},
+			{Name: "RateLimit", Config: config.Params{"perChannel": map[interface{}]interface{}{"burst": -1}}},
+			{},
+		},
+		Endorsers: PluginMapping{
+			"custom": &HandlerConfig{Name: "CustomEndorsement"},
+			"escc":   &HandlerConfig{Name: "DefaultEndorsement"},
+		},
+		Validators: PluginMapping{
+			"vscc": &HandlerConfig{Library: "/NotAReal/Plugin.so"},
+		},
+	})
+	assert.EqualError(t, err, "failed loading handlers: "+
+		"auth handler RateLimit: Failed creating handler RateLimit: invalid per channel rate limit: invalid burst -1, must not be negative; "+
+		"auth handler : either a name or a library must be set; "+
+		"endorsement handler CustomEndorsement (custom): Method CustomEndorsement isn't a method of HandlerLibrary; "+
+		"validation handler  (vscc): Could not find plugin at path /NotAReal/Plugin.so: stat /NotAReal/Plugin.so: no such file or directory")
+
+	// the handlers that loaded successfully are listed
+	handlers := testReg.Handlers()
+	assert.Len(t, handlers, 2)
+	assert.Equal(t, LoadedHandler{Type: Auth, HandlerConfig: HandlerConfig{Name: "DefaultAuth", Config: config.Params{}}}, handlers[0])
+	assert.Equal(t, LoadedHandler{Type: Endorsement, Key: "escc", HandlerConfig: HandlerConfig{Name: "DefaultEndorsement", Config: config.Params{}}}, handlers[1])
+}
+
+func TestLoadHandlersDefaultPlugins(t *testing.T) {
+	testReg := registry{
+		endorsers:  make(map[string]endorsement.PluginFactory),
+		validators: make(map[string]validation.PluginFactory),
+	}
+	// a core.yaml that doesn't configure the escc and vscc plugins
+	err := testReg.loadHandlers(Config{
+		AuthFilters: []*HandlerConfig{{Name: "DefaultAuth"}},
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, testReg.endorsers["escc"])
+	assert.NotNil(t, testReg.validators["vscc"])
+	assert.Contains(t, testReg.Handlers(), LoadedHandler{Type: Endorsement, Key: "escc", HandlerConfig: HandlerConfig{Name: "DefaultEndorsement", Config: config.Params{}}})
+	assert.Contains(t, testReg.Handlers(), LoadedHandler{Type: Validation, Key: "vscc", HandlerConfig: HandlerConfig{Name: "DefaultValidation", Config: config.Params{}}})
+
+	// configured escc and vscc plugins aren't replaced by the default ones
+	testReg = registry{
+		endorsers:  make(map[string]endorsement.PluginFactory),
+		validators: make(map[string]validation.PluginFactory),
+	}
+	err = testReg.loadHandlers(Config{
+		Endorsers:  PluginMapping{"escc": &HandlerConfig{Name: "CustomEndorsement"}},
+		Validators: PluginMapping{"vscc": &HandlerConfig{Name: "CustomValidation"}},
+	})
+	assert.Error(t, err)
+	assert.Nil(t, testReg.endorsers["escc"])
+	assert.Nil(t, testReg.validators["vscc"])
+}
+
+func TestHandlerTypeString(t *testing.T) {
+	assert.Equal(t, "auth", Auth.String())
+	assert.Equal(t, "decoration", Decoration.String())
+	assert.Equal(t, "endorsement", Endorsement.String())
+	assert.Equal(t, "validation", Validation.String())
+	assert.Equal(t, "unknown(42)", HandlerType(42).String())
 }
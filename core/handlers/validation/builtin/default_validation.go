@@ -0,0 +1,103 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package builtin
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/core/handlers/validation"
+	"github.com/hyperledger/fabric/core/scc/vscc"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/pkg/errors"
+)
+
+var logger = flogging.MustGetLogger("validation/builtin")
+
+// DefaultValidationFactory returns a validation plugin factory which returns plugins
+// that behave as the default validation system chaincode
+type DefaultValidationFactory struct {
+}
+
+// New returns a validation plugin that behaves as the default validation system chaincode
+func (*DefaultValidationFactory) New() validation.Plugin {
+	return &DefaultValidation{}
+}
+
+// TxValidator validates a transaction against a serialized endorsement policy
+type TxValidator interface {
+	Validate(envBytes []byte, policyBytes []byte) error
+}
+
+// DefaultValidation is a validation plugin that behaves as the default validation system chaincode
+type DefaultValidation struct {
+	TxValidator TxValidator
+}
+
+// Validate validates the transaction at the given position in the block against the
+// serialized endorsement policy passed as the first context datum. All the actions of
+// the transaction are validated, hence the action position is ignored
+func (v *DefaultValidation) Validate(block *common.Block, namespace string, txPosition int, actionPosition int, contextData ...validation.ContextDatum) error {
+	if len(contextData) == 0 {
+		return &validation.ExecutionFailureError{Reason: "expected to receive a serialized policy in the first context data"}
+	}
+	serializedPolicy, isSerializedPolicy := contextData[0].(validation.SerializedPolicy)
+	if !isSerializedPolicy {
+		return &validation.ExecutionFailureError{Reason: "expected to receive a serialized policy in the first context data"}
+	}
+	if block == nil || block.Data == nil || block.Header == nil {
+		return &validation.ExecutionFailureError{Reason: "empty block"}
+	}
+	if txPosition >= len(block.Data.Data) {
+		return &validation.ExecutionFailureError{
+			Reason: fmt.Sprintf("block has only %d transactions, but requested tx at position %d", len(block.Data.Data), txPosition),
+		}
+	}
+
+	err := v.TxValidator.Validate(block.Data.Data[txPosition], serializedPolicy.Bytes())
+	if err == nil {
+		return nil
+	}
+
+	if _, isExecutionError := err.(*validation.ExecutionFailureError); isExecutionError {
+		logger.Errorf("block %d, namespace: %s, tx %d could not be validated: %v", block.Header.Number, namespace, txPosition, err)
+		return err
+	}
+	logger.Debugf("block %d, namespace: %s, tx %d validation results is: %v", block.Header.Number, namespace, txPosition, err)
+	return err
+}
+
+// Init injects dependencies into the instance of the Plugin
+func (v *DefaultValidation) Init(dependencies ...validation.Dependency) error {
+	var (
+		c  validation.Capabilities
+		sf validation.StateFetcher
+		pe validation.PolicyEvaluator
+	)
+	for _, dep := range dependencies {
+		if capabilities, isCapabilities := dep.(validation.Capabilities); isCapabilities {
+			c = capabilities
+		}
+		if stateFetcher, isStateFetcher := dep.(validation.StateFetcher); isStateFetcher {
+			sf = stateFetcher
+		}
+		if policyEvaluator, isPolicyEvaluator := dep.(validation.PolicyEvaluator); isPolicyEvaluator {
+			pe = policyEvaluator
+		}
+	}
+	if c == nil {
+		return errors.New("could not find Capabilities in dependencies")
+	}
+	if sf == nil {
+		return errors.New("could not find StateFetcher in dependencies")
+	}
+	if pe == nil {
+		return errors.New("could not find PolicyEvaluator in dependencies")
+	}
+	v.TxValidator = vscc.New(c, sf, pe)
+	return nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package builtin
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hyperledger/fabric/common/mocks/config"
+	"github.com/hyperledger/fabric/core/handlers/validation"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockTxValidator struct {
+	envBytes    []byte
+	policyBytes []byte
+	err         error
+}
+
+func (v *mockTxValidator) Validate(envBytes []byte, policyBytes []byte) error {
+	v.envBytes = envBytes
+	v.policyBytes = policyBytes
+	return v.err
+}
+
+type mockStateFetcher struct {
+}
+
+func (*mockStateFetcher) FetchState() (validation.State, error) {
+	return nil, errors.New("not implemented")
+}
+
+type mockPolicyEvaluator struct {
+}
+
+func (*mockPolicyEvaluator) Evaluate(policyBytes []byte, signatureSet []*common.SignedData) error {
+	return nil
+}
+
+type serializedPolicy []byte
+
+func (sp serializedPolicy) Bytes() []byte {
+	return sp
+}
+
+func TestDefaultValidationInit(t *testing.T) {
+	capabilities := &config.MockApplicationCapabilities{}
+	plugin := (&DefaultValidationFactory{}).New()
+
+	err := plugin.Init(&mockStateFetcher{}, &mockPolicyEvaluator{})
+	assert.EqualError(t, err, "could not find Capabilities in dependencies")
+
+	err = plugin.Init(capabilities, &mockPolicyEvaluator{})
+	assert.EqualError(t, err, "could not find StateFetcher in dependencies")
+
+	err = plugin.Init(capabilities, &mockStateFetcher{})
+	assert.EqualError(t, err, "could not find PolicyEvaluator in dependencies")
+
+	err = plugin.Init("not a dependency", capabilities, &mockStateFetcher{}, &mockPolicyEvaluator{})
+	assert.NoError(t, err)
+	assert.NotNil(t, plugin.(*DefaultValidation).TxValidator)
+}
+
+func TestDefaultValidationValidate(t *testing.T) {
+	txValidator := &mockTxValidator{}
+	plugin := &DefaultValidation{TxValidator: txValidator}
+	block := &common.Block{
+		Header: &common.BlockHeader{Number: 1},
+		Data:   &common.BlockData{Data: [][]byte{[]byte("tx0"), []byte("tx1")}},
+	}
+	policy := serializedPolicy("policy")
+
+	t.Run("No policy", func(t *testing.T) {
+		err := plugin.Validate(block, "mycc", 0, 0)
+		assert.IsType(t, &validation.ExecutionFailureError{}, err)
+		err = plugin.Validate(block, "mycc", 0, 0, "not a policy")
+		assert.IsType(t, &validation.ExecutionFailureError{}, err)
+	})
+
+	t.Run("Bad block", func(t *testing.T) {
+		err := plugin.Validate(&common.Block{}, "mycc", 0, 0, policy)
+		assert.IsType(t, &validation.ExecutionFailureError{}, err)
+		err = plugin.Validate(block, "mycc", 2, 0, policy)
+		assert.IsType(t, &validation.ExecutionFailureError{}, err)
+	})
+
+	t.Run("Valid transaction", func(t *testing.T) {
+		err := plugin.Validate(block, "mycc", 1, 0, policy)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("tx1"), txValidator.envBytes)
+		assert.Equal(t, []byte("policy"), txValidator.policyBytes)
+	})
+
+	t.Run("Invalid transaction", func(t *testing.T) {
+		txValidator.err = errors.New("endorsement policy failure")
+		err := plugin.Validate(block, "mycc", 0, 0, policy)
+		assert.EqualError(t, err, "endorsement policy failure")
+		assert.Equal(t, []byte("tx0"), txValidator.envBytes)
+	})
+
+	t.Run("Execution failure", func(t *testing.T) {
+		txValidator.err = &validation.ExecutionFailureError{Reason: "ledger unavailable"}
+		err := plugin.Validate(block, "mycc", 0, 0, policy)
+		assert.Equal(t, txValidator.err, err)
+	})
+}
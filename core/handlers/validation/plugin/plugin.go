@@ -0,0 +1,28 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"github.com/hyperledger/fabric/core/handlers/validation"
+	"github.com/hyperledger/fabric/core/handlers/validation/builtin"
+)
+
+type validationFactory struct {
+}
+
+// New returns a validation plugin that behaves as the default one
+func (*validationFactory) New() validation.Plugin {
+	return &builtin.DefaultValidation{}
+}
+
+// NewPluginFactory is the function ran by the plugin infrastructure to create a validation plugin factory.
+func NewPluginFactory() validation.PluginFactory {
+	return &validationFactory{}
+}
+
+func main() {
+}
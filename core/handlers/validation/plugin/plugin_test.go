@@ -0,0 +1,19 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/core/handlers/validation/builtin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPluginFactory(t *testing.T) {
+	plugin := NewPluginFactory().New()
+	assert.IsType(t, &builtin.DefaultValidation{}, plugin)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package validation
+
+import (
+	"github.com/hyperledger/fabric/common/channelconfig"
+	commonledger "github.com/hyperledger/fabric/common/ledger"
+	"github.com/hyperledger/fabric/protos/common"
+)
+
+// Plugin validates transactions
+type Plugin interface {
+	// Validate returns nil if the action at the given position inside the transaction
+	// at the given position in the given block is valid, or an error if not.
+	// The namespace is the chaincode whose validation policy is to be checked,
+	// and the context data carries additional information about it, such as
+	// its serialized endorsement policy (see SerializedPolicy).
+	// An *ExecutionFailureError means that validation could not be performed,
+	// and that the block must not be committed; any other error means that
+	// the transaction is invalid.
+	Validate(block *common.Block, namespace string, txPosition int, actionPosition int, contextData ...ContextDatum) error
+
+	// Init injects dependencies into the instance of the Plugin
+	Init(dependencies ...Dependency) error
+}
+
+// PluginFactory creates a new instance of a Plugin
+type PluginFactory interface {
+	New() Plugin
+}
+
+// Dependency marks a dependency passed to the Init() method
+type Dependency interface{}
+
+// ContextDatum defines additional data that is passed from the validator
+// into the Validate() invocation
+type ContextDatum interface{}
+
+// SerializedPolicy defines a serialized policy
+type SerializedPolicy interface {
+	ContextDatum
+
+	// Bytes returns the bytes of the SerializedPolicy
+	Bytes() []byte
+}
+
+// PolicyEvaluator evaluates policies
+type PolicyEvaluator interface {
+	Dependency
+
+	// Evaluate takes a set of SignedData and evaluates whether this set of signatures satisfies
+	// the policy with the given bytes
+	Evaluate(policyBytes []byte, signatureSet []*common.SignedData) error
+}
+
+// State defines a read-only view of the committed state of the channel
+type State interface {
+	// GetStateMultipleKeys gets the values for multiple keys in a single call
+	GetStateMultipleKeys(namespace string, keys []string) ([][]byte, error)
+
+	// GetStateRangeScanIterator returns an iterator that contains all the key-values between given key ranges.
+	// startKey is included in the results and endKey is excluded. An empty startKey refers to the first available key
+	// and an empty endKey refers to the last available key.
+	// The returned ResultsIterator contains results of type *KV which is defined in protos/ledger/queryresult.
+	GetStateRangeScanIterator(namespace string, startKey string, endKey string) (commonledger.ResultsIterator, error)
+
+	// Done releases resources occupied by the State
+	Done()
+}
+
+// StateFetcher retrieves an instance of a state
+type StateFetcher interface {
+	Dependency
+
+	// FetchState fetches state
+	FetchState() (State, error)
+}
+
+// Capabilities defines the capabilities for the application portion of the channel,
+// as they are at the time a transaction is validated
+type Capabilities interface {
+	Dependency
+	channelconfig.ApplicationCapabilities
+}
+
+// ExecutionFailureError indicates that the validation
+// failed because of an execution problem, and thus
+// the transaction validation status could not be computed
+type ExecutionFailureError struct {
+	Reason string
+}
+
+// Error conveys this is an error, and also contains
+// the reason for the error
+func (e *ExecutionFailureError) Error() string {
+	return e.Reason
+}
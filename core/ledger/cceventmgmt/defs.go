@@ -31,6 +31,15 @@ type ChaincodeLifecycleEventListener interface {
 	HandleChaincodeDeploy(chaincodeDefinition *ChaincodeDefinition, dbArtifactsTar []byte) error
 }
 
+// ChaincodeInfoListener interface enables peer components (such as gossip) to be notified
+// of the chaincodes deployed on a channel and of the chaincodes installed on the peer
+type ChaincodeInfoListener interface {
+	// HandleChaincodesDeployed is invoked with the chaincodes deployed in a block of the channel
+	HandleChaincodesDeployed(chaincodeDefinitions []*ChaincodeDefinition)
+	// HandleChaincodeInstalled is invoked when a chaincode is installed on the peer
+	HandleChaincodeInstalled(chaincodeDefinition *ChaincodeDefinition)
+}
+
 // ChaincodeInfoProvider interface enables event mgr to retrieve chaincode info for a given chaincode
 type ChaincodeInfoProvider interface {
 	// IsChaincodeDeployed returns true if the given chaincode is deployed on the given channel
@@ -119,6 +119,49 @@ func TestLSCCListener(t *testing.T) {
 	})
 }
 
+func TestChaincodeInfoListeners(t *testing.T) {
+	cc1Def := &ChaincodeDefinition{Name: "cc1", Version: "v1", Hash: []byte("cc1")}
+	cc2Def := &ChaincodeDefinition{Name: "cc2", Version: "v1", Hash: []byte("cc2")}
+
+	// cc1 is installed but not deployed, cc2 is neither installed nor deployed
+	mockProvider := newMockProvider()
+	mockProvider.setChaincodeInstalled(cc1Def, nil)
+	setEventMgrForTest(newMgr(mockProvider))
+	defer clearEventMgrForTest()
+
+	listener1, listener2 := &mockInfoListener{}, &mockInfoListener{}
+	eventMgr := GetMgr()
+	eventMgr.RegisterInfoListener("channel1", listener1)
+	eventMgr.RegisterInfoListener("channel2", listener2)
+
+	// Deploy events are sent to the listener of the channel, whether the chaincodes are installed or not
+	eventMgr.HandleChaincodeDeploy("channel1", []*ChaincodeDefinition{cc1Def, cc2Def})
+	assert.Equal(t, [][]*ChaincodeDefinition{{cc1Def, cc2Def}}, listener1.deployed)
+	assert.Empty(t, listener2.deployed)
+
+	// Blocks without chaincode deployments aren't sent
+	eventMgr.HandleChaincodeDeploy("channel1", []*ChaincodeDefinition{})
+	assert.Len(t, listener1.deployed, 1)
+
+	// Install events are sent to the listeners of all channels
+	eventMgr.HandleChaincodeInstall(cc2Def, nil)
+	assert.Equal(t, []*ChaincodeDefinition{cc2Def}, listener1.installed)
+	assert.Equal(t, []*ChaincodeDefinition{cc2Def}, listener2.installed)
+}
+
+type mockInfoListener struct {
+	deployed  [][]*ChaincodeDefinition
+	installed []*ChaincodeDefinition
+}
+
+func (l *mockInfoListener) HandleChaincodesDeployed(chaincodeDefinitions []*ChaincodeDefinition) {
+	l.deployed = append(l.deployed, chaincodeDefinitions)
+}
+
+func (l *mockInfoListener) HandleChaincodeInstalled(chaincodeDefinition *ChaincodeDefinition) {
+	l.installed = append(l.installed, chaincodeDefinition)
+}
+
 type mockProvider struct {
 	chaincodesDeployed  map[[3]string]bool
 	chaincodesInstalled map[[2]string][]byte
@@ -40,6 +40,7 @@ type Mgr struct {
 	rwlock               sync.RWMutex
 	infoProvider         ChaincodeInfoProvider
 	ccLifecycleListeners map[string]ChaincodeLifecycleEventListener
+	ccInfoListeners      map[string]ChaincodeInfoListener
 	// latestChaincodeDeploys maintains last chaincode deployed for a ledger. As stated in the above comment,
 	// since it is not easy to synchronize across block commit and install activity, this leaves a small window
 	// where we could miss 'deployed AND installed' state. So, we explicitly maintain the chaincodes deplyed
@@ -51,6 +52,7 @@ func newMgr(chaincodeInfoProvider ChaincodeInfoProvider) *Mgr {
 	return &Mgr{
 		infoProvider:           chaincodeInfoProvider,
 		ccLifecycleListeners:   make(map[string]ChaincodeLifecycleEventListener),
+		ccInfoListeners:        make(map[string]ChaincodeInfoListener),
 		latestChaincodeDeploys: make(map[string][]*ChaincodeDefinition)}
 }
 
@@ -63,6 +65,13 @@ func (m *Mgr) Register(ledgerid string, l ChaincodeLifecycleEventListener) {
 	m.ccLifecycleListeners[ledgerid] = l
 }
 
+// RegisterInfoListener registers a ChaincodeInfoListener for given ledgerid
+func (m *Mgr) RegisterInfoListener(ledgerid string, l ChaincodeInfoListener) {
+	m.rwlock.Lock()
+	defer m.rwlock.Unlock()
+	m.ccInfoListeners[ledgerid] = l
+}
+
 // HandleChaincodeDeploy is expected to be invoked when a chaincode is deployed via a deploy transaction
 // The `chaincodeDefinitions` parameter contains all the chaincodes deployed in a block
 // We need to store the last received `chaincodeDefinitions` because this function is expected to be invoked
@@ -94,6 +103,9 @@ func (m *Mgr) HandleChaincodeDeploy(chainid string, chaincodeDefinitions []*Chai
 		}
 		logger.Debugf("Channel [%s]: Handled chaincode deploy event for chaincode [%s]", chainid, chaincodeDefinitions)
 	}
+	if l := m.ccInfoListeners[chainid]; l != nil && len(chaincodeDefinitions) > 0 {
+		l.HandleChaincodesDeployed(chaincodeDefinitions)
+	}
 	return nil
 }
 
@@ -125,6 +137,9 @@ func (m *Mgr) HandleChaincodeInstall(chaincodeDefinition *ChaincodeDefinition, d
 		}
 		logger.Debugf("Channel [%s]: Handled chaincode install event for chaincode [%s]", chainid, chaincodeDefinition)
 	}
+	for _, l := range m.ccInfoListeners {
+		l.HandleChaincodeInstalled(chaincodeDefinition)
+	}
 	return nil
 }
 
@@ -183,6 +183,11 @@ func (historyDB *historyDB) NewHistoryQueryExecutor(blockStore blkstorage.BlockS
 	return &LevelHistoryDBQueryExecutor{historyDB, blockStore}, nil
 }
 
+// NewHistoricalQueryExecutor implements method in HistoryDB interface
+func (historyDB *historyDB) NewHistoricalQueryExecutor(blockStore blkstorage.BlockStore, height uint64) (ledger.QueryExecutor, error) {
+	return &LevelHistoricalQueryExecutor{historyDB, blockStore, height}, nil
+}
+
 // GetBlockNumFromSavepoint implements method in HistoryDB interface
 func (historyDB *historyDB) GetLastSavepoint() (*version.Height, error) {
 	versionBytes, err := historyDB.db.Get(savePointKey)
@@ -0,0 +1,233 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package historyleveldb
+
+import (
+	"bytes"
+	"sort"
+
+	commonledger "github.com/hyperledger/fabric/common/ledger"
+	"github.com/hyperledger/fabric/common/ledger/blkstorage"
+	"github.com/hyperledger/fabric/common/ledger/util"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/history/historydb"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
+	"github.com/hyperledger/fabric/protos/ledger/queryresult"
+	"github.com/pkg/errors"
+)
+
+// errPrivateDataAtHeight is returned when private data is queried at a past height,
+// as the history database only records the writes of public keys
+var errPrivateDataAtHeight = errors.New("private data is not available at a past height of the ledger")
+
+// LevelHistoricalQueryExecutor is a query executor reading the state of the ledger as
+// of a past height, i.e., after the commit of the blocks numbered below the height.
+// The value of a key at that height is the value written by the last transaction
+// that wrote the key before that height, which is found through the history records
+// of the key, and read from the transaction in the block store.
+type LevelHistoricalQueryExecutor struct {
+	historyDB  *historyDB
+	blockStore blkstorage.BlockStore
+	height     uint64
+}
+
+// GetState implements method in interface `ledger.QueryExecutor`
+func (q *LevelHistoricalQueryExecutor) GetState(namespace string, key string) ([]byte, error) {
+	compositeStartKey := historydb.ConstructPartialCompositeHistoryKey(namespace, key, false)
+	compositeEndKey := historydb.ConstructPartialCompositeHistoryKey(namespace, key, true)
+	dbItr := q.historyDB.db.GetIterator(compositeStartKey, compositeEndKey)
+	defer dbItr.Release()
+
+	var lastWrite *version.Height
+	for dbItr.Next() {
+		_, blockNumTranNumBytes := historydb.SplitCompositeHistoryKey(dbItr.Key(), compositeStartKey)
+		// skip the records of the other keys that have the key as prefix,
+		// as explained in historyScanner.Next
+		if bytes.Contains(blockNumTranNumBytes[:len(blockNumTranNumBytes)-1], historydb.CompositeKeySep) {
+			continue
+		}
+		blockNum, bytesConsumed := util.DecodeOrderPreservingVarUint64(blockNumTranNumBytes)
+		if blockNum >= q.height {
+			break
+		}
+		tranNum, _ := util.DecodeOrderPreservingVarUint64(blockNumTranNumBytes[bytesConsumed:])
+		lastWrite = version.NewHeight(blockNum, tranNum)
+	}
+	if lastWrite == nil {
+		return nil, nil
+	}
+	return q.valueWrittenAt(namespace, key, lastWrite)
+}
+
+// GetStateMultipleKeys implements method in interface `ledger.QueryExecutor`
+func (q *LevelHistoricalQueryExecutor) GetStateMultipleKeys(namespace string, keys []string) ([][]byte, error) {
+	values := make([][]byte, len(keys))
+	for i, key := range keys {
+		value, err := q.GetState(namespace, key)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// GetStateRangeScanIterator implements method in interface `ledger.QueryExecutor`.
+// The history records of all the keys of the range are scanned before the first
+// result is returned, in order to find the keys that existed at the height.
+func (q *LevelHistoricalQueryExecutor) GetStateRangeScanIterator(namespace string, startKey string, endKey string) (commonledger.ResultsIterator, error) {
+	nsPrefix := append([]byte(namespace), historydb.CompositeKeySep...)
+	compositeStartKey := append(append([]byte{}, nsPrefix...), []byte(startKey)...)
+	var compositeEndKey []byte
+	if endKey != "" {
+		compositeEndKey = append(append([]byte{}, nsPrefix...), []byte(endKey)...)
+	} else {
+		// the first key after all the records of the namespace
+		compositeEndKey = append([]byte(namespace), historydb.CompositeKeySep[0]+1)
+	}
+	dbItr := q.historyDB.db.GetIterator(compositeStartKey, compositeEndKey)
+	defer dbItr.Release()
+
+	lastWrites := make(map[string]*version.Height)
+	for dbItr.Next() {
+		historyKey := dbItr.Key()
+		if !bytes.HasPrefix(historyKey, nsPrefix) {
+			continue
+		}
+		key, blockNum, tranNum, ok := splitHistoryKey(historyKey[len(nsPrefix):])
+		if !ok || key < startKey || (endKey != "" && key >= endKey) || blockNum >= q.height {
+			continue
+		}
+		write := version.NewHeight(blockNum, tranNum)
+		if lastWrite, exists := lastWrites[key]; !exists || lastWrite.Compare(write) < 0 {
+			lastWrites[key] = write
+		}
+	}
+
+	keys := make([]string, 0, len(lastWrites))
+	for key := range lastWrites {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return &historicalRangeScanner{queryExecutor: q, namespace: namespace, keys: keys, lastWrites: lastWrites}, nil
+}
+
+// ExecuteQuery implements method in interface `ledger.QueryExecutor`
+func (q *LevelHistoricalQueryExecutor) ExecuteQuery(namespace, query string) (commonledger.ResultsIterator, error) {
+	return nil, errors.New("rich queries are not supported at a past height of the ledger")
+}
+
+// GetPrivateData implements method in interface `ledger.QueryExecutor`
+func (q *LevelHistoricalQueryExecutor) GetPrivateData(namespace, collection, key string) ([]byte, error) {
+	return nil, errPrivateDataAtHeight
+}
+
+// GetPrivateDataMultipleKeys implements method in interface `ledger.QueryExecutor`
+func (q *LevelHistoricalQueryExecutor) GetPrivateDataMultipleKeys(namespace, collection string, keys []string) ([][]byte, error) {
+	return nil, errPrivateDataAtHeight
+}
+
+// GetPrivateDataRangeScanIterator implements method in interface `ledger.QueryExecutor`
+func (q *LevelHistoricalQueryExecutor) GetPrivateDataRangeScanIterator(namespace, collection, startKey, endKey string) (commonledger.ResultsIterator, error) {
+	return nil, errPrivateDataAtHeight
+}
+
+// ExecuteQueryOnPrivateData implements method in interface `ledger.QueryExecutor`
+func (q *LevelHistoricalQueryExecutor) ExecuteQueryOnPrivateData(namespace, collection, query string) (commonledger.ResultsIterator, error) {
+	return nil, errPrivateDataAtHeight
+}
+
+// Done implements method in interface `ledger.QueryExecutor`
+func (q *LevelHistoricalQueryExecutor) Done() {
+	// nothing to release, as the records below the height never change
+}
+
+// valueWrittenAt returns the value of the key written by the transaction at the given
+// height, which is nil if the transaction deleted the key
+func (q *LevelHistoricalQueryExecutor) valueWrittenAt(namespace, key string, write *version.Height) ([]byte, error) {
+	tranEnvelope, err := q.blockStore.RetrieveTxByBlockNumTranNum(write.BlockNum, write.TxNum)
+	if err != nil {
+		return nil, err
+	}
+	queryResult, err := getKeyModificationFromTran(tranEnvelope, namespace, key)
+	if err != nil {
+		return nil, err
+	}
+	keyModification := queryResult.(*queryresult.KeyModification)
+	if keyModification.IsDelete {
+		return nil, nil
+	}
+	return keyModification.Value, nil
+}
+
+// splitHistoryKey splits the key~blocknum~trannum part of a history key. As keys may contain
+// the separator, e.g., composite keys, the key is assumed to end at the first separator that
+// is followed by a valid encoding of the block and transaction numbers.
+func splitHistoryKey(keyBlockNumTranNum []byte) (string, uint64, uint64, bool) {
+	for i, b := range keyBlockNumTranNum {
+		if b != historydb.CompositeKeySep[0] {
+			continue
+		}
+		blockNumTranNumBytes := keyBlockNumTranNum[i+1:]
+		blockNumSize, ok := orderPreservingVarUint64Size(blockNumTranNumBytes)
+		if !ok {
+			continue
+		}
+		tranNumSize, ok := orderPreservingVarUint64Size(blockNumTranNumBytes[blockNumSize:])
+		if !ok || blockNumSize+tranNumSize != len(blockNumTranNumBytes) {
+			continue
+		}
+		blockNum, _ := util.DecodeOrderPreservingVarUint64(blockNumTranNumBytes)
+		tranNum, _ := util.DecodeOrderPreservingVarUint64(blockNumTranNumBytes[blockNumSize:])
+		return string(keyBlockNumTranNum[:i]), blockNum, tranNum, true
+	}
+	return "", 0, 0, false
+}
+
+// orderPreservingVarUint64Size returns the size of the number encoded by
+// util.EncodeOrderPreservingVarUint64 at the start of the given bytes, if
+// they start with a valid encoding, which has no leading zero
+func orderPreservingVarUint64Size(b []byte) (int, bool) {
+	if len(b) == 0 {
+		return 0, false
+	}
+	size := int(b[0])
+	if size > 8 || len(b) < size+1 || (size > 0 && b[1] == 0) {
+		return 0, false
+	}
+	return size + 1, true
+}
+
+// historicalRangeScanner implements ResultsIterator for iterating through the keys
+// of a range at a past height, reading their values from the block store lazily
+type historicalRangeScanner struct {
+	queryExecutor *LevelHistoricalQueryExecutor
+	namespace     string
+	keys          []string
+	lastWrites    map[string]*version.Height
+	next          int
+}
+
+func (scanner *historicalRangeScanner) Next() (commonledger.QueryResult, error) {
+	for scanner.next < len(scanner.keys) {
+		key := scanner.keys[scanner.next]
+		scanner.next++
+		value, err := scanner.queryExecutor.valueWrittenAt(scanner.namespace, key, scanner.lastWrites[key])
+		if err != nil {
+			return nil, err
+		}
+		// the key was deleted before the height
+		if value == nil {
+			continue
+		}
+		return &queryresult.KV{Namespace: scanner.namespace, Key: key, Value: value}, nil
+	}
+	return nil, nil
+}
+
+func (scanner *historicalRangeScanner) Close() {
+	scanner.next = len(scanner.keys)
+}
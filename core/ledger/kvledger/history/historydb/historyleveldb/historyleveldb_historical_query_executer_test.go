@@ -0,0 +1,159 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package historyleveldb
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/common/ledger/testutil"
+	util2 "github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/history/historydb"
+	"github.com/hyperledger/fabric/protos/ledger/queryresult"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistoricalQueryExecutor(t *testing.T) {
+	env := newTestHistoryEnv(t)
+	defer env.cleanup()
+	store, err := env.testBlockStorageEnv.provider.OpenBlockStore("ledger1")
+	assert.NoError(t, err)
+	defer store.Shutdown()
+
+	bg, gb := testutil.NewBlockGenerator(t, "ledger1", false)
+	assert.NoError(t, store.AddBlock(gb))
+	assert.NoError(t, env.testHistoryDB.Commit(gb))
+
+	compositeKey := "\x00obj\x00a\x00"
+	commitBlock := func(updates ...func(ledger.TxSimulator)) {
+		var simulationResults [][]byte
+		for _, update := range updates {
+			simulator, err := env.txmgr.NewTxSimulator(util2.GenerateUUID())
+			assert.NoError(t, err)
+			update(simulator)
+			simulator.Done()
+			simRes, err := simulator.GetTxSimulationResults()
+			assert.NoError(t, err)
+			pubSimResBytes, err := simRes.GetPubSimulationBytes()
+			assert.NoError(t, err)
+			simulationResults = append(simulationResults, pubSimResBytes)
+		}
+		block := bg.NextBlock(simulationResults)
+		assert.NoError(t, store.AddBlock(block))
+		assert.NoError(t, env.testHistoryDB.Commit(block))
+	}
+	// block 1
+	commitBlock(func(s ledger.TxSimulator) {
+		s.SetState("ns1", "key1", []byte("value1"))
+		s.SetState("ns1", "key2", []byte("value1"))
+		s.SetState("ns2", "key1", []byte("value1"))
+	})
+	// block 2
+	commitBlock(func(s ledger.TxSimulator) {
+		s.SetState("ns1", "key1", []byte("value2"))
+		s.DeleteState("ns1", "key2")
+		s.SetState("ns1", "key3", []byte("value2"))
+		s.SetState("ns1", compositeKey, []byte("value2"))
+	})
+	// block 3
+	commitBlock(func(s ledger.TxSimulator) {
+		s.SetState("ns1", "key1", []byte("value3"))
+	}, func(s ledger.TxSimulator) {
+		s.SetState("ns1", "key3", []byte("value3"))
+	})
+
+	getState := func(height uint64, key string) string {
+		qe, err := env.testHistoryDB.NewHistoricalQueryExecutor(store, height)
+		assert.NoError(t, err)
+		defer qe.Done()
+		value, err := qe.GetState("ns1", key)
+		assert.NoError(t, err)
+		return string(value)
+	}
+	assert.Equal(t, "", getState(1, "key1"))
+	assert.Equal(t, "value1", getState(2, "key1"))
+	assert.Equal(t, "value2", getState(3, "key1"))
+	assert.Equal(t, "value3", getState(4, "key1"))
+	assert.Equal(t, "value1", getState(2, "key2"))
+	assert.Equal(t, "", getState(3, "key2"))
+	assert.Equal(t, "value3", getState(4, "key3"))
+	assert.Equal(t, "value2", getState(3, compositeKey))
+
+	qe, err := env.testHistoryDB.NewHistoricalQueryExecutor(store, 3)
+	assert.NoError(t, err)
+	values, err := qe.GetStateMultipleKeys("ns1", []string{"key1", "key2", "key3"})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("value2"), nil, []byte("value2")}, values)
+
+	rangeScan := func(height uint64, startKey, endKey string) []*queryresult.KV {
+		qe, err := env.testHistoryDB.NewHistoricalQueryExecutor(store, height)
+		assert.NoError(t, err)
+		itr, err := qe.GetStateRangeScanIterator("ns1", startKey, endKey)
+		assert.NoError(t, err)
+		defer itr.Close()
+		var results []*queryresult.KV
+		for {
+			result, err := itr.Next()
+			assert.NoError(t, err)
+			if result == nil {
+				return results
+			}
+			results = append(results, result.(*queryresult.KV))
+		}
+	}
+	assert.Equal(t, []*queryresult.KV{
+		{Namespace: "ns1", Key: "key1", Value: []byte("value1")},
+		{Namespace: "ns1", Key: "key2", Value: []byte("value1")},
+	}, rangeScan(2, "", ""))
+	assert.Equal(t, []*queryresult.KV{
+		{Namespace: "ns1", Key: compositeKey, Value: []byte("value2")},
+		{Namespace: "ns1", Key: "key1", Value: []byte("value2")},
+		{Namespace: "ns1", Key: "key3", Value: []byte("value2")},
+	}, rangeScan(3, "", ""))
+	assert.Equal(t, []*queryresult.KV{
+		{Namespace: "ns1", Key: "key1", Value: []byte("value3")},
+	}, rangeScan(4, "key1", "key3"))
+	assert.Nil(t, rangeScan(1, "", ""))
+
+	_, err = qe.ExecuteQuery("ns1", "{}")
+	assert.Error(t, err)
+	_, err = qe.GetPrivateData("ns1", "coll", "key1")
+	assert.Equal(t, errPrivateDataAtHeight, err)
+	_, err = qe.GetPrivateDataMultipleKeys("ns1", "coll", []string{"key1"})
+	assert.Equal(t, errPrivateDataAtHeight, err)
+	_, err = qe.GetPrivateDataRangeScanIterator("ns1", "coll", "", "")
+	assert.Equal(t, errPrivateDataAtHeight, err)
+	_, err = qe.ExecuteQueryOnPrivateData("ns1", "coll", "{}")
+	assert.Equal(t, errPrivateDataAtHeight, err)
+}
+
+func TestSplitHistoryKey(t *testing.T) {
+	for _, test := range []struct {
+		key      string
+		blockNum uint64
+		tranNum  uint64
+	}{
+		{"key", 0, 0},
+		{"key", 256, 0},
+		{"key", 1, 256},
+		{"key", 0x10000, 0x100},
+		{"\x00obj\x00a\x00", 5, 0},
+		{"", 7, 3},
+	} {
+		historyKey := historydb.ConstructCompositeHistoryKey("ns", test.key, test.blockNum, test.tranNum)
+		key, blockNum, tranNum, ok := splitHistoryKey(historyKey[len("ns\x00"):])
+		assert.True(t, ok)
+		assert.Equal(t, test.key, key)
+		assert.Equal(t, test.blockNum, blockNum)
+		assert.Equal(t, test.tranNum, tranNum)
+	}
+
+	_, _, _, ok := splitHistoryKey([]byte("key"))
+	assert.False(t, ok)
+	_, _, _, ok = splitHistoryKey([]byte("key\x00\x09"))
+	assert.False(t, ok)
+}
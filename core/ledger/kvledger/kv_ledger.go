@@ -226,6 +226,26 @@ func (l *kvLedger) NewHistoryQueryExecutor() (ledger.HistoryQueryExecutor, error
 	return l.historyDB.NewHistoryQueryExecutor(l.blockStore)
 }
 
+// NewHistoricalQueryExecutor gives handle to a query executor reading the state of
+// the ledger as of the given height, which is reconstructed from the history records
+// of the keys, and from the transactions that wrote them in the blockstore
+func (l *kvLedger) NewHistoricalQueryExecutor(height uint64) (ledger.QueryExecutor, error) {
+	if !ledgerconfig.IsHistoryDBEnabled() {
+		return nil, errors.New("History tracking not enabled - historyDatabase is false")
+	}
+	if height == 0 {
+		return nil, errors.New("the height of the ledger to query must be greater than 0")
+	}
+	savepoint, err := l.historyDB.GetLastSavepoint()
+	if err != nil {
+		return nil, err
+	}
+	if savepoint == nil || savepoint.BlockNum+1 < height {
+		return nil, fmt.Errorf("the history of the ledger has not reached height %d yet", height)
+	}
+	return l.historyDB.NewHistoricalQueryExecutor(l.blockStore, height)
+}
+
 // CommitWithPvtData commits the block and the corresponding pvt data in an atomic operation
 func (l *kvLedger) CommitWithPvtData(pvtdataAndBlock *ledger.BlockAndPvtData) error {
 	var err error
@@ -304,6 +324,18 @@ func (l *kvLedger) PrivateDataMinBlockNum() (uint64, error) {
 	return 0, fmt.Errorf("not yet implemented")
 }
 
+// GetMVCCConflicts returns the most recent MVCC and phantom read conflicts
+// found while validating the blocks of the ledger, the oldest first
+func (l *kvLedger) GetMVCCConflicts() []*peer.MVCCConflict {
+	return l.txtmgmt.GetMVCCConflicts()
+}
+
+// GetHotKeys returns the keys with the most MVCC and phantom read conflicts
+// of each namespace, or only of the given namespace if it is not empty
+func (l *kvLedger) GetHotKeys(namespace string) []*peer.HotKey {
+	return l.txtmgmt.GetHotKeys(namespace)
+}
+
 // Close closes `KVLedger`
 func (l *kvLedger) Close() {
 	l.blockStore.Shutdown()
@@ -363,6 +363,43 @@ func TestKVLedgerDBRecovery(t *testing.T) {
 	)
 }
 
+func TestKVLedgerHistoricalQueryExecutor(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+	provider, _ := NewProvider()
+	defer provider.Close()
+	testLedgerid := "testLedger"
+	bg, gb := testutil.NewBlockGenerator(t, testLedgerid, false)
+	ledger, _ := provider.Create(gb)
+	defer ledger.Close()
+
+	for i := 1; i <= 2; i++ {
+		blockAndPvtdata := prepareNextBlockForTest(t, ledger, bg, fmt.Sprintf("SimulateForBlk%d", i),
+			map[string]string{"key1": fmt.Sprintf("value1.%d", i)},
+			map[string]string{"key1": fmt.Sprintf("pvtValue1.%d", i)})
+		assert.NoError(t, ledger.CommitWithPvtData(blockAndPvtdata))
+	}
+
+	for height, expectedValue := range map[uint64]string{1: "", 2: "value1.1", 3: "value1.2"} {
+		qe, err := ledger.NewHistoricalQueryExecutor(height)
+		assert.NoError(t, err)
+		value, err := qe.GetState("ns", "key1")
+		assert.NoError(t, err)
+		assert.Equal(t, expectedValue, string(value))
+		qe.Done()
+	}
+
+	qe, err := ledger.NewHistoricalQueryExecutor(2)
+	assert.NoError(t, err)
+	_, err = qe.GetPrivateData("ns", "coll", "key1")
+	assert.Error(t, err)
+
+	_, err = ledger.NewHistoricalQueryExecutor(0)
+	assert.EqualError(t, err, "the height of the ledger to query must be greater than 0")
+	_, err = ledger.NewHistoricalQueryExecutor(4)
+	assert.EqualError(t, err, "the history of the ledger has not reached height 4 yet")
+}
+
 func TestLedgerWithCouchDbEnabledWithBinaryAndJSONData(t *testing.T) {
 
 	//call a helper method to load the core.yaml
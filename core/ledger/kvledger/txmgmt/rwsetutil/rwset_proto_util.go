@@ -73,6 +73,12 @@ type CollPvtRwSet struct {
 // FUNCTIONS for converting messages to/from proto bytes
 /////////////////////////////////////////////////////////////////
 
+// ComputePvtRwSetHash returns the hash of the serialized private read-write set of a collection,
+// as recorded in the 'PvtRwSetHash' of the collection in the public read-write set
+func ComputePvtRwSetHash(collPvtRwSet *rwset.CollectionPvtReadWriteSet) []byte {
+	return util.ComputeHash(collPvtRwSet.Rwset)
+}
+
 // ToProtoBytes constructs TxReadWriteSet proto message and serializes using protobuf Marshal
 func (txRwSet *TxRwSet) ToProtoBytes() ([]byte, error) {
 	var protoMsg *rwset.TxReadWriteSet
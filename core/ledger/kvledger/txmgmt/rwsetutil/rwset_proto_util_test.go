@@ -169,6 +169,16 @@ func TestTxPvtRwSetConversion(t *testing.T) {
 	testutil.AssertEquals(t, txPvtRwSet1, txPvtRwSet)
 }
 
+func TestComputePvtRwSetHash(t *testing.T) {
+	rwsetBuilder := NewRWSetBuilder()
+	rwsetBuilder.AddToPvtAndHashedWriteSet("ns-1", "coll-1", "key1", []byte("value1"))
+	simRes, err := rwsetBuilder.GetTxSimulationResults()
+	testutil.AssertNoError(t, err, "")
+	collPvtRwSet := simRes.PvtSimulationResults.NsPvtRwset[0].CollectionPvtRwset[0]
+	collHashedRwSet := simRes.PubSimulationResults.NsRwset[0].CollectionHashedRwset[0]
+	testutil.AssertEquals(t, ComputePvtRwSetHash(collPvtRwSet), collHashedRwSet.PvtRwsetHash)
+}
+
 func sampleTxPvtRwSet() *TxPvtRwSet {
 	txPvtRwSet := &TxPvtRwSet{}
 	txPvtRwSet.NsPvtRwSet = append(txPvtRwSet.NsPvtRwSet, sampleNsPvtRwSet("ns-1"))
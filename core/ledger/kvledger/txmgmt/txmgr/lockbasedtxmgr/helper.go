@@ -55,6 +55,20 @@ func (h *queryHelper) getState(ns string, key string) ([]byte, error) {
 	return val, nil
 }
 
+// getStateWithoutRecording gets the value for the given namespace and
+// key without adding it to the read set of the transaction simulation
+func (h *queryHelper) getStateWithoutRecording(ns string, key string) ([]byte, error) {
+	if err := h.checkDone(); err != nil {
+		return nil, err
+	}
+	versionedValue, err := h.txmgr.db.GetState(ns, key)
+	if err != nil {
+		return nil, err
+	}
+	val, _ := decomposeVersionedValue(versionedValue)
+	return val, nil
+}
+
 func (h *queryHelper) getStateMultipleKeys(namespace string, keys []string) ([][]byte, error) {
 	if err := h.checkDone(); err != nil {
 		return nil, err
@@ -37,6 +37,11 @@ func (q *lockBasedQueryExecutor) GetState(ns string, key string) ([]byte, error)
 	return q.helper.getState(ns, key)
 }
 
+// GetStateWithoutRecording implements method in interface `ledger.NonRecordingStateReader`
+func (q *lockBasedQueryExecutor) GetStateWithoutRecording(ns string, key string) ([]byte, error) {
+	return q.helper.getStateWithoutRecording(ns, key)
+}
+
 // GetStateMultipleKeys implements method in interface `ledger.QueryExecutor`
 func (q *lockBasedQueryExecutor) GetStateMultipleKeys(namespace string, keys []string) ([][]byte, error) {
 	return q.helper.getStateMultipleKeys(namespace, keys)
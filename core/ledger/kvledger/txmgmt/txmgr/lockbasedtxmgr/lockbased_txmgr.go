@@ -16,7 +16,9 @@ import (
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/validator"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/validator/valimpl"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
+	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
 	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/peer"
 )
 
 var logger = flogging.MustGetLogger("lockbasedtxmgr")
@@ -27,6 +29,8 @@ type LockBasedTxMgr struct {
 	ledgerid       string
 	db             privacyenabledstate.DB
 	validator      validator.Validator
+	conflicts      *validator.ConflictLog
+	contention     *validator.ContentionStats
 	batch          *privacyenabledstate.UpdateBatch
 	currentBlock   *common.Block
 	stateListeners ledger.StateListeners
@@ -37,10 +41,22 @@ type LockBasedTxMgr struct {
 func NewLockBasedTxMgr(ledgerid string, db privacyenabledstate.DB, stateListeners ledger.StateListeners) *LockBasedTxMgr {
 	db.Open()
 	txmgr := &LockBasedTxMgr{ledgerid: ledgerid, db: db, stateListeners: stateListeners}
-	txmgr.validator = valimpl.NewStatebasedValidator(txmgr, db)
+	txmgr.conflicts = validator.NewConflictLog(ledgerconfig.GetMaxMVCCConflicts())
+	txmgr.contention = validator.NewContentionStats(ledgerid, ledgerconfig.GetHotKeysPerNamespace())
+	txmgr.validator = valimpl.NewStatebasedValidator(txmgr, db, txmgr.conflicts, txmgr.contention)
 	return txmgr
 }
 
+// GetMVCCConflicts implements method in interface `txmgmt.TxMgr`
+func (txmgr *LockBasedTxMgr) GetMVCCConflicts() []*peer.MVCCConflict {
+	return txmgr.conflicts.Conflicts()
+}
+
+// GetHotKeys implements method in interface `txmgmt.TxMgr`
+func (txmgr *LockBasedTxMgr) GetHotKeys(namespace string) []*peer.HotKey {
+	return txmgr.contention.HotKeys(namespace)
+}
+
 // GetLastSavepoint returns the block num recorded in savepoint,
 // returns 0 if NO savepoint is found
 func (txmgr *LockBasedTxMgr) GetLastSavepoint() (*version.Height, error) {
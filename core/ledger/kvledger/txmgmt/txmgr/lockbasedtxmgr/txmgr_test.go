@@ -14,6 +14,8 @@ import (
 
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/ledger/testutil"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/privacyenabledstate"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/txmgr"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
@@ -119,6 +121,47 @@ func testTxSimulatorWithExistingData(t *testing.T, env testEnv) {
 	testutil.AssertEquals(t, vv.Version, version.NewHeight(1, 0))
 }
 
+func TestTxSimulatorGetStateWithoutRecording(t *testing.T) {
+	for _, testEnv := range testEnvs {
+		t.Run(testEnv.getName(), func(t *testing.T) {
+			testLedgerID := "testtxsimulatorgetstatewithoutrecording"
+			testEnv.init(t, testLedgerID)
+			testTxSimulatorGetStateWithoutRecording(t, testEnv)
+			testEnv.cleanup()
+		})
+	}
+}
+
+func testTxSimulatorGetStateWithoutRecording(t *testing.T, env testEnv) {
+	txMgr := env.getTxMgr()
+	txMgrHelper := newTxMgrTestHelper(t, txMgr)
+	s1, _ := txMgr.NewTxSimulator("test_tx1")
+	s1.SetState("ns1", "key1", []byte("value1"))
+	s1.SetState("ns1", "key2", []byte("value2"))
+	s1.Done()
+	txRWSet1, _ := s1.GetTxSimulationResults()
+	txMgrHelper.validateAndCommitRWSet(txRWSet1.PubSimulationResults)
+
+	// only the read of key2 is recorded
+	s2, _ := txMgr.NewTxSimulator("test_tx2")
+	value, err := s2.(ledger.NonRecordingStateReader).GetStateWithoutRecording("ns1", "key1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value1"), value)
+	value, err = s2.GetState("ns1", "key2")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value2"), value)
+	s2.Done()
+	txRWSet2, _ := s2.GetTxSimulationResults()
+	rwSet, err := rwsetutil.TxRwSetFromProtoMsg(txRWSet2.PubSimulationResults)
+	assert.NoError(t, err)
+	assert.Len(t, rwSet.NsRwSets, 1)
+	assert.Len(t, rwSet.NsRwSets[0].KvRwSet.Reads, 1)
+	assert.Equal(t, "key2", rwSet.NsRwSets[0].KvRwSet.Reads[0].Key)
+
+	_, err = s2.(ledger.NonRecordingStateReader).GetStateWithoutRecording("ns1", "key1")
+	assert.Error(t, err)
+}
+
 func TestTxValidation(t *testing.T) {
 	for _, testEnv := range testEnvs {
 		t.Logf("Running test for TestEnv = %s", testEnv.getName())
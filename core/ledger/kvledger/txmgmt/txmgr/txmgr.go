@@ -19,6 +19,7 @@ package txmgr
 import (
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
+	"github.com/hyperledger/fabric/protos/peer"
 )
 
 // TxMgr - an interface that a transaction manager should implement
@@ -32,6 +33,8 @@ type TxMgr interface {
 	Commit() error
 	Rollback()
 	Shutdown()
+	GetMVCCConflicts() []*peer.MVCCConflict
+	GetHotKeys(namespace string) []*peer.HotKey
 }
 
 // ErrUnsupportedTransaction is expected to be thrown if a unsupported query is performed in an update transaction
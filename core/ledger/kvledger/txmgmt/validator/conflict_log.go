@@ -0,0 +1,54 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package validator
+
+import (
+	"sync"
+
+	"github.com/hyperledger/fabric/protos/peer"
+)
+
+// ConflictLog keeps the most recent MVCC and phantom read conflicts found while
+// validating the blocks of a ledger, up to a fixed number of conflicts
+type ConflictLog struct {
+	sync.RWMutex
+	conflicts []*peer.MVCCConflict
+	next      int
+	full      bool
+}
+
+// NewConflictLog constructs a ConflictLog that keeps the given number of conflicts.
+// A ConflictLog of size 0 keeps none.
+func NewConflictLog(size int) *ConflictLog {
+	return &ConflictLog{conflicts: make([]*peer.MVCCConflict, size)}
+}
+
+// Add records the given conflicts, discarding the oldest ones if the log is full
+func (l *ConflictLog) Add(conflicts ...*peer.MVCCConflict) {
+	l.Lock()
+	defer l.Unlock()
+	if len(l.conflicts) == 0 {
+		return
+	}
+	for _, conflict := range conflicts {
+		l.conflicts[l.next] = conflict
+		l.next = (l.next + 1) % len(l.conflicts)
+		if l.next == 0 {
+			l.full = true
+		}
+	}
+}
+
+// Conflicts returns the recorded conflicts, the oldest first
+func (l *ConflictLog) Conflicts() []*peer.MVCCConflict {
+	l.RLock()
+	defer l.RUnlock()
+	if !l.full {
+		return append([]*peer.MVCCConflict{}, l.conflicts[:l.next]...)
+	}
+	return append(append([]*peer.MVCCConflict{}, l.conflicts[l.next:]...), l.conflicts[:l.next]...)
+}
@@ -0,0 +1,36 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package validator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConflictLog(t *testing.T) {
+	conflicts := make([]*peer.MVCCConflict, 5)
+	for i := range conflicts {
+		conflicts[i] = &peer.MVCCConflict{TxId: fmt.Sprintf("txid-%d", i)}
+	}
+
+	log := NewConflictLog(3)
+	assert.Empty(t, log.Conflicts())
+	log.Add(conflicts[0], conflicts[1])
+	assert.Equal(t, conflicts[:2], log.Conflicts())
+	log.Add(conflicts[2])
+	assert.Equal(t, conflicts[:3], log.Conflicts())
+	// the oldest conflicts are discarded once the log is full
+	log.Add(conflicts[3:]...)
+	assert.Equal(t, conflicts[2:], log.Conflicts())
+
+	log = NewConflictLog(0)
+	log.Add(conflicts...)
+	assert.Empty(t, log.Conflicts())
+}
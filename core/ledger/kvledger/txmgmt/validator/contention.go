@@ -0,0 +1,226 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package validator
+
+import (
+	"container/heap"
+	"encoding/hex"
+	"sort"
+	"sync"
+
+	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/protos/peer"
+)
+
+// trackedKeysPerHotKey is the number of keys whose conflicts are counted
+// for each of the hot keys reported, which bounds the error of the counts
+const trackedKeysPerHotKey = 10
+
+// ContentionStats counts the MVCC and phantom read conflicts of the keys of each
+// namespace of a ledger, so as to report the keys with the most conflicts.
+// Counting all the keys would take an unbounded amount of memory, so conflicts are
+// counted with the Space-Saving algorithm, which only tracks a fixed number of keys
+// per namespace: when a conflict involves an untracked key, it replaces the tracked
+// key with the fewest conflicts, and inherits its count. The counts are therefore
+// overestimated, by at most the count inherited, yet any key involved in more than
+// a fraction 1/tracked of the conflicts of its namespace is always tracked.
+type ContentionStats struct {
+	sync.Mutex
+	ledgerID   string
+	topK       int
+	namespaces map[string]*spaceSaving
+}
+
+// NewContentionStats constructs a ContentionStats reporting the given number of hot
+// keys per namespace of the given ledger. A ContentionStats reporting no keys
+// doesn't count conflicts at all.
+func NewContentionStats(ledgerID string, topK int) *ContentionStats {
+	return &ContentionStats{
+		ledgerID:   ledgerID,
+		topK:       topK,
+		namespaces: make(map[string]*spaceSaving),
+	}
+}
+
+// Add counts the given conflicts, and updates the metrics of the namespaces they
+// belong to: the number of conflicts, and the number of conflicts of the hottest key
+func (s *ContentionStats) Add(conflicts ...*peer.MVCCConflict) {
+	if s.topK == 0 || len(conflicts) == 0 {
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+	counts := make(map[string]int64)
+	for _, conflict := range conflicts {
+		ss, exists := s.namespaces[conflict.Namespace]
+		if !exists {
+			ss = newSpaceSaving(s.topK * trackedKeysPerHotKey)
+			s.namespaces[conflict.Namespace] = ss
+		}
+		ss.add(newKeyID(conflict))
+		counts[conflict.Namespace]++
+	}
+	if metrics.RootScope == nil {
+		return
+	}
+	// the metrics are only tagged with the namespace, as tagging them with the keys
+	// would leak them and create an unbounded number of series: HotKeys reports
+	// the keys themselves
+	for ns, count := range counts {
+		scope := metrics.RootScope.SubScope("ledger").Tagged(map[string]string{"channel": s.ledgerID, "namespace": ns})
+		scope.Counter("mvcc_conflicts").Inc(count)
+		if top := s.namespaces[ns].top(1); len(top) > 0 {
+			scope.Gauge("hottest_key_conflicts").Update(float64(top[0].count))
+		}
+	}
+}
+
+// HotKeys returns the keys with the most conflicts of each namespace,
+// or only of the given namespace if it is not empty
+func (s *ContentionStats) HotKeys(namespace string) []*peer.HotKey {
+	s.Lock()
+	defer s.Unlock()
+	var namespaces []string
+	for ns := range s.namespaces {
+		if namespace == "" || ns == namespace {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	sort.Strings(namespaces)
+	var hotKeys []*peer.HotKey
+	for _, ns := range namespaces {
+		for _, c := range s.namespaces[ns].top(s.topK) {
+			hotKey := &peer.HotKey{
+				Namespace:  ns,
+				Collection: c.key.collection,
+				Key:        c.key.key,
+				StartKey:   c.key.startKey,
+				EndKey:     c.key.endKey,
+				Conflicts:  c.count,
+				MaxError:   c.err,
+			}
+			if c.key.collection != "" {
+				hotKey.Key = ""
+				hotKey.KeyHash = []byte(c.key.key)
+			}
+			hotKeys = append(hotKeys, hotKey)
+		}
+	}
+	return hotKeys
+}
+
+// keyID identifies a key of a namespace, which is the hash of the key for
+// private data, or a range of keys for phantom reads
+type keyID struct {
+	collection string
+	key        string
+	startKey   string
+	endKey     string
+}
+
+func newKeyID(conflict *peer.MVCCConflict) keyID {
+	if conflict.Collection != "" {
+		return keyID{collection: conflict.Collection, key: string(conflict.KeyHash)}
+	}
+	return keyID{key: conflict.Key, startKey: conflict.StartKey, endKey: conflict.EndKey}
+}
+
+// String returns the key, the collection and the hex encoded hash of
+// a private key, or the range of keys of a range query
+func (k keyID) String() string {
+	switch {
+	case k.collection != "":
+		return k.collection + "/" + hex.EncodeToString([]byte(k.key))
+	case k.startKey != "" || k.endKey != "":
+		return "[" + k.startKey + "," + k.endKey + ")"
+	default:
+		return k.key
+	}
+}
+
+type counter struct {
+	key   keyID
+	count uint64
+	err   uint64
+	index int
+}
+
+// spaceSaving counts the occurrences of up to a fixed number of keys, the
+// counters being kept in a min-heap, so that the key with the lowest count
+// is replaced in logarithmic time
+type spaceSaving struct {
+	capacity int
+	counters map[keyID]*counter
+	heap     counterHeap
+}
+
+func newSpaceSaving(capacity int) *spaceSaving {
+	return &spaceSaving{capacity: capacity, counters: make(map[keyID]*counter)}
+}
+
+func (s *spaceSaving) add(key keyID) {
+	if c, exists := s.counters[key]; exists {
+		c.count++
+		heap.Fix(&s.heap, c.index)
+		return
+	}
+	if len(s.counters) < s.capacity {
+		c := &counter{key: key, count: 1}
+		s.counters[key] = c
+		heap.Push(&s.heap, c)
+		return
+	}
+	c := s.heap[0]
+	delete(s.counters, c.key)
+	c.key = key
+	c.err = c.count
+	c.count++
+	s.counters[key] = c
+	heap.Fix(&s.heap, 0)
+}
+
+// top returns the k counters with the highest counts, the highest first
+func (s *spaceSaving) top(k int) []counter {
+	counters := make([]counter, 0, len(s.heap))
+	for _, c := range s.heap {
+		counters = append(counters, *c)
+	}
+	sort.Slice(counters, func(i, j int) bool {
+		if counters[i].count != counters[j].count {
+			return counters[i].count > counters[j].count
+		}
+		return counters[i].key.String() < counters[j].key.String()
+	})
+	if len(counters) > k {
+		counters = counters[:k]
+	}
+	return counters
+}
+
+type counterHeap []*counter
+
+func (h counterHeap) Len() int           { return len(h) }
+func (h counterHeap) Less(i, j int) bool { return h[i].count < h[j].count }
+
+func (h counterHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *counterHeap) Push(x interface{}) {
+	c := x.(*counter)
+	c.index = len(*h)
+	*h = append(*h, c)
+}
+
+func (h *counterHeap) Pop() interface{} {
+	old := *h
+	c := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return c
+}
@@ -0,0 +1,86 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package validator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContentionStats(t *testing.T) {
+	stats := NewContentionStats("mychannel", 2)
+	var conflicts []*peer.MVCCConflict
+	for i := 0; i < 5; i++ {
+		conflicts = append(conflicts, &peer.MVCCConflict{Namespace: "ns1", Key: "key1"})
+	}
+	for i := 0; i < 3; i++ {
+		conflicts = append(conflicts, &peer.MVCCConflict{Namespace: "ns1", Collection: "coll1", KeyHash: []byte{0x01}})
+	}
+	conflicts = append(conflicts,
+		&peer.MVCCConflict{Namespace: "ns1", StartKey: "a", EndKey: "z"},
+		&peer.MVCCConflict{Namespace: "ns2", Key: "key1"},
+	)
+	stats.Add(conflicts...)
+
+	assert.Equal(t, []*peer.HotKey{
+		{Namespace: "ns1", Key: "key1", Conflicts: 5},
+		{Namespace: "ns1", Collection: "coll1", KeyHash: []byte{0x01}, Conflicts: 3},
+		{Namespace: "ns2", Key: "key1", Conflicts: 1},
+	}, stats.HotKeys(""))
+	assert.Equal(t, []*peer.HotKey{
+		{Namespace: "ns2", Key: "key1", Conflicts: 1},
+	}, stats.HotKeys("ns2"))
+	assert.Empty(t, stats.HotKeys("ns3"))
+
+	stats = NewContentionStats("mychannel", 0)
+	stats.Add(conflicts...)
+	assert.Empty(t, stats.HotKeys(""))
+}
+
+func TestContentionStatsBoundedKeys(t *testing.T) {
+	stats := NewContentionStats("mychannel", 1)
+	// the hot key stands out among many keys, which cannot all be tracked
+	for i := 0; i < 1000; i++ {
+		stats.Add(&peer.MVCCConflict{Namespace: "ns1", Key: fmt.Sprintf("key%d", i)})
+		if i%5 == 0 {
+			stats.Add(&peer.MVCCConflict{Namespace: "ns1", Key: "hotkey"})
+		}
+	}
+	assert.Len(t, stats.namespaces["ns1"].counters, trackedKeysPerHotKey)
+	hotKeys := stats.HotKeys("ns1")
+	assert.Len(t, hotKeys, 1)
+	assert.Equal(t, "hotkey", hotKeys[0].Key)
+	assert.True(t, hotKeys[0].Conflicts >= 200)
+	assert.True(t, hotKeys[0].Conflicts-hotKeys[0].MaxError <= 200)
+}
+
+func TestSpaceSaving(t *testing.T) {
+	ss := newSpaceSaving(2)
+	for _, key := range []string{"a", "a", "b", "c", "a", "c"} {
+		ss.add(keyID{key: key})
+	}
+	// c replaced b, inheriting its count
+	assert.Equal(t, []counter{
+		{key: keyID{key: "a"}, count: 3},
+		{key: keyID{key: "c"}, count: 3, err: 1},
+	}, clearIndexes(ss.top(2)))
+	assert.Equal(t, []counter{{key: keyID{key: "a"}, count: 3}}, clearIndexes(ss.top(1)))
+
+	assert.Equal(t, "key", keyID{key: "key"}.String())
+	assert.Equal(t, "coll/0102", keyID{collection: "coll", key: "\x01\x02"}.String())
+	assert.Equal(t, "[a,z)", keyID{startKey: "a", endKey: "z"}.String())
+}
+
+func clearIndexes(counters []counter) []counter {
+	for i := range counters {
+		counters[i].index = 0
+	}
+	return counters
+}
@@ -100,8 +100,9 @@ func (v *Validator) ValidateAndPrepareBatch(block *valinternal.Block, doMVCCVali
 	updates := valinternal.NewPubAndHashUpdates()
 	for _, tx := range block.Txs {
 		var validationCode peer.TxValidationCode
+		var conflict *peer.MVCCConflict
 		var err error
-		if validationCode, err = v.validateEndorserTX(tx.RWSet, doMVCCValidation, updates); err != nil {
+		if validationCode, conflict, err = v.validateEndorserTX(tx.RWSet, doMVCCValidation, updates); err != nil {
 			return nil, err
 		}
 
@@ -113,6 +114,13 @@ func (v *Validator) ValidateAndPrepareBatch(block *valinternal.Block, doMVCCVali
 		} else {
 			logger.Warningf("Block [%d] Transaction index [%d] TxId [%s] marked as invalid by state validator. Reason code [%s]",
 				block.Num, tx.IndexInBlock, tx.ID, validationCode.String())
+			if conflict != nil {
+				conflict.TxId = tx.ID
+				conflict.BlockNum = block.Num
+				conflict.TxNum = uint64(tx.IndexInBlock)
+				conflict.ValidationCode = validationCode
+				tx.Conflict = conflict
+			}
 		}
 	}
 	return updates, nil
@@ -122,91 +130,107 @@ func (v *Validator) ValidateAndPrepareBatch(block *valinternal.Block, doMVCCVali
 func (v *Validator) validateEndorserTX(
 	txRWSet *rwsetutil.TxRwSet,
 	doMVCCValidation bool,
-	updates *valinternal.PubAndHashUpdates) (peer.TxValidationCode, error) {
+	updates *valinternal.PubAndHashUpdates) (peer.TxValidationCode, *peer.MVCCConflict, error) {
 
 	var validationCode = peer.TxValidationCode_VALID
+	var conflict *peer.MVCCConflict
 	var err error
 	//mvccvalidation, may invalidate transaction
 	if doMVCCValidation {
-		validationCode, err = v.validateTx(txRWSet, updates)
+		validationCode, conflict, err = v.validateTx(txRWSet, updates)
 	}
-	return validationCode, err
+	return validationCode, conflict, err
 }
 
-func (v *Validator) validateTx(txRWSet *rwsetutil.TxRwSet, updates *valinternal.PubAndHashUpdates) (peer.TxValidationCode, error) {
+// validateTx returns, along with the validation code, the description of the read that
+// invalidated the transaction, in case of an MVCC or a phantom read conflict
+func (v *Validator) validateTx(txRWSet *rwsetutil.TxRwSet, updates *valinternal.PubAndHashUpdates) (peer.TxValidationCode, *peer.MVCCConflict, error) {
 	// Uncomment the following only for local debugging. Don't want to print data in the logs in production
 	//logger.Debugf("validateTx - validating txRWSet: %s", spew.Sdump(txRWSet))
 	for _, nsRWSet := range txRWSet.NsRwSets {
 		ns := nsRWSet.NameSpace
 		// Validate public reads
-		if valid, err := v.validateReadSet(ns, nsRWSet.KvRwSet.Reads, updates.PubUpdates); !valid || err != nil {
+		if conflict, err := v.validateReadSet(ns, nsRWSet.KvRwSet.Reads, updates.PubUpdates); conflict != nil || err != nil {
 			if err != nil {
-				return peer.TxValidationCode(-1), err
+				return peer.TxValidationCode(-1), nil, err
 			}
-			return peer.TxValidationCode_MVCC_READ_CONFLICT, nil
+			return peer.TxValidationCode_MVCC_READ_CONFLICT, conflict, nil
 		}
 		// Validate range queries for phantom items
-		if valid, err := v.validateRangeQueries(ns, nsRWSet.KvRwSet.RangeQueriesInfo, updates.PubUpdates); !valid || err != nil {
+		if conflict, err := v.validateRangeQueries(ns, nsRWSet.KvRwSet.RangeQueriesInfo, updates.PubUpdates); conflict != nil || err != nil {
 			if err != nil {
-				return peer.TxValidationCode(-1), err
+				return peer.TxValidationCode(-1), nil, err
 			}
-			return peer.TxValidationCode_PHANTOM_READ_CONFLICT, nil
+			return peer.TxValidationCode_PHANTOM_READ_CONFLICT, conflict, nil
 		}
 		// Validate hashes for private reads
-		if valid, err := v.validateNsHashedReadSets(ns, nsRWSet.CollHashedRwSets, updates.HashUpdates); !valid || err != nil {
+		if conflict, err := v.validateNsHashedReadSets(ns, nsRWSet.CollHashedRwSets, updates.HashUpdates); conflict != nil || err != nil {
 			if err != nil {
-				return peer.TxValidationCode(-1), err
+				return peer.TxValidationCode(-1), nil, err
 			}
-			return peer.TxValidationCode_MVCC_READ_CONFLICT, nil
+			return peer.TxValidationCode_MVCC_READ_CONFLICT, conflict, nil
 		}
 	}
-	return peer.TxValidationCode_VALID, nil
+	return peer.TxValidationCode_VALID, nil, nil
 }
 
 ////////////////////////////////////////////////////////////////////////////////
 /////                 Validation of public read-set
 ////////////////////////////////////////////////////////////////////////////////
-func (v *Validator) validateReadSet(ns string, kvReads []*kvrwset.KVRead, updates *privacyenabledstate.PubUpdateBatch) (bool, error) {
+func (v *Validator) validateReadSet(ns string, kvReads []*kvrwset.KVRead, updates *privacyenabledstate.PubUpdateBatch) (*peer.MVCCConflict, error) {
 	for _, kvRead := range kvReads {
-		if valid, err := v.validateKVRead(ns, kvRead, updates); !valid || err != nil {
-			return valid, err
+		if conflict, err := v.validateKVRead(ns, kvRead, updates); conflict != nil || err != nil {
+			return conflict, err
 		}
 	}
-	return true, nil
+	return nil, nil
 }
 
 // validateKVRead performs mvcc check for a key read during transaction simulation.
 // i.e., it checks whether a key/version combination is already updated in the statedb (by an already committed block)
-// or in the updates (by a preceding valid transaction in the current block)
-func (v *Validator) validateKVRead(ns string, kvRead *kvrwset.KVRead, updates *privacyenabledstate.PubUpdateBatch) (bool, error) {
+// or in the updates (by a preceding valid transaction in the current block), in which case it returns the conflict
+func (v *Validator) validateKVRead(ns string, kvRead *kvrwset.KVRead, updates *privacyenabledstate.PubUpdateBatch) (*peer.MVCCConflict, error) {
 	if updates.Exists(ns, kvRead.Key) {
-		return false, nil
+		return &peer.MVCCConflict{
+			Namespace:        ns,
+			Key:              kvRead.Key,
+			ReadVersion:      readVersion(kvRead.Version),
+			CommittedVersion: updatedVersion(updates.Get(ns, kvRead.Key)),
+		}, nil
 	}
-	committedVersion, err := v.db.GetVersion(ns, kvRead.Key)
+	committedVer, err := v.db.GetVersion(ns, kvRead.Key)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
 	logger.Debugf("Comparing versions for key [%s]: committed version=%#v and read version=%#v",
-		kvRead.Key, committedVersion, rwsetutil.NewVersion(kvRead.Version))
-	if !version.AreSame(committedVersion, rwsetutil.NewVersion(kvRead.Version)) {
+		kvRead.Key, committedVer, rwsetutil.NewVersion(kvRead.Version))
+	if !version.AreSame(committedVer, rwsetutil.NewVersion(kvRead.Version)) {
 		logger.Debugf("Version mismatch for key [%s:%s]. Committed version = [%#v], Version in readSet [%#v]",
-			ns, kvRead.Key, committedVersion, kvRead.Version)
-		return false, nil
+			ns, kvRead.Key, committedVer, kvRead.Version)
+		return &peer.MVCCConflict{
+			Namespace:        ns,
+			Key:              kvRead.Key,
+			ReadVersion:      readVersion(kvRead.Version),
+			CommittedVersion: committedVersion(committedVer),
+		}, nil
 	}
-	return true, nil
+	return nil, nil
 }
 
 ////////////////////////////////////////////////////////////////////////////////
 /////                 Validation of range queries
 ////////////////////////////////////////////////////////////////////////////////
-func (v *Validator) validateRangeQueries(ns string, rangeQueriesInfo []*kvrwset.RangeQueryInfo, updates *privacyenabledstate.PubUpdateBatch) (bool, error) {
+func (v *Validator) validateRangeQueries(ns string, rangeQueriesInfo []*kvrwset.RangeQueryInfo, updates *privacyenabledstate.PubUpdateBatch) (*peer.MVCCConflict, error) {
 	for _, rqi := range rangeQueriesInfo {
 		if valid, err := v.validateRangeQuery(ns, rqi, updates); !valid || err != nil {
-			return valid, err
+			if err != nil {
+				return nil, err
+			}
+			return &peer.MVCCConflict{Namespace: ns, StartKey: rqi.StartKey, EndKey: rqi.EndKey}, nil
 		}
 	}
-	return true, nil
+	return nil, nil
 }
 
 // validateRangeQuery performs a phantom read check i.e., it
@@ -243,42 +267,77 @@ func (v *Validator) validateRangeQuery(ns string, rangeQueryInfo *kvrwset.RangeQ
 /////                 Validation of hashed read-set
 ////////////////////////////////////////////////////////////////////////////////
 func (v *Validator) validateNsHashedReadSets(ns string, collHashedRWSets []*rwsetutil.CollHashedRwSet,
-	updates *privacyenabledstate.HashedUpdateBatch) (bool, error) {
+	updates *privacyenabledstate.HashedUpdateBatch) (*peer.MVCCConflict, error) {
 	for _, collHashedRWSet := range collHashedRWSets {
-		if valid, err := v.validateCollHashedReadSet(ns, collHashedRWSet.CollectionName, collHashedRWSet.HashedRwSet.HashedReads, updates); !valid || err != nil {
-			return valid, err
+		if conflict, err := v.validateCollHashedReadSet(ns, collHashedRWSet.CollectionName, collHashedRWSet.HashedRwSet.HashedReads, updates); conflict != nil || err != nil {
+			return conflict, err
 		}
 	}
-	return true, nil
+	return nil, nil
 }
 
 func (v *Validator) validateCollHashedReadSet(ns, coll string, kvReadHashes []*kvrwset.KVReadHash,
-	updates *privacyenabledstate.HashedUpdateBatch) (bool, error) {
+	updates *privacyenabledstate.HashedUpdateBatch) (*peer.MVCCConflict, error) {
 	for _, kvReadHash := range kvReadHashes {
-		if valid, err := v.validateKVReadHash(ns, coll, kvReadHash, updates); !valid || err != nil {
-			return valid, err
+		if conflict, err := v.validateKVReadHash(ns, coll, kvReadHash, updates); conflict != nil || err != nil {
+			return conflict, err
 		}
 	}
-	return true, nil
+	return nil, nil
 }
 
 // validateKVReadHash performs mvcc check for a hash of a key that is present in the private data space
 // i.e., it checks whether a key/version combination is already updated in the statedb (by an already committed block)
-// or in the updates (by a preceding valid transaction in the current block)
+// or in the updates (by a preceding valid transaction in the current block), in which case it returns the conflict
 func (v *Validator) validateKVReadHash(ns, coll string, kvReadHash *kvrwset.KVReadHash,
-	updates *privacyenabledstate.HashedUpdateBatch) (bool, error) {
+	updates *privacyenabledstate.HashedUpdateBatch) (*peer.MVCCConflict, error) {
 	if updates.Contains(ns, coll, kvReadHash.KeyHash) {
-		return false, nil
+		return &peer.MVCCConflict{
+			Namespace:        ns,
+			Collection:       coll,
+			KeyHash:          kvReadHash.KeyHash,
+			ReadVersion:      readVersion(kvReadHash.Version),
+			CommittedVersion: updatedVersion(updates.Get(ns, coll, string(kvReadHash.KeyHash))),
+		}, nil
 	}
-	committedVersion, err := v.db.GetKeyHashVersion(ns, coll, kvReadHash.KeyHash)
+	committedVer, err := v.db.GetKeyHashVersion(ns, coll, kvReadHash.KeyHash)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	if !version.AreSame(committedVersion, rwsetutil.NewVersion(kvReadHash.Version)) {
+	if !version.AreSame(committedVer, rwsetutil.NewVersion(kvReadHash.Version)) {
 		logger.Debugf("Version mismatch for key hash [%s:%s:%#v]. Committed version = [%s], Version in hashedReadSet [%s]",
-			ns, coll, kvReadHash.KeyHash, committedVersion, kvReadHash.Version)
-		return false, nil
+			ns, coll, kvReadHash.KeyHash, committedVer, kvReadHash.Version)
+		return &peer.MVCCConflict{
+			Namespace:        ns,
+			Collection:       coll,
+			KeyHash:          kvReadHash.KeyHash,
+			ReadVersion:      readVersion(kvReadHash.Version),
+			CommittedVersion: committedVersion(committedVer),
+		}, nil
+	}
+	return nil, nil
+}
+
+func readVersion(v *kvrwset.Version) *peer.KeyVersion {
+	if v == nil {
+		return nil
+	}
+	return &peer.KeyVersion{BlockNum: v.BlockNum, TxNum: v.TxNum}
+}
+
+func committedVersion(h *version.Height) *peer.KeyVersion {
+	if h == nil {
+		return nil
+	}
+	return &peer.KeyVersion{BlockNum: h.BlockNum, TxNum: h.TxNum}
+}
+
+// updatedVersion returns the version of a key updated by a preceding
+// transaction of the block, or nil if the transaction deleted the key
+func updatedVersion(vv *statedb.VersionedValue) *peer.KeyVersion {
+	if vv.Value == nil {
+		return nil
 	}
-	return true, nil
+	return committedVersion(vv.Version)
 }
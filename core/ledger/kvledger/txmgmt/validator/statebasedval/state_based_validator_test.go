@@ -346,6 +346,86 @@ func TestPhantomHashBasedValidation(t *testing.T) {
 	checkValidation(t, validator, getTestPubSimulationRWSet(t, rwsetBuilder2), []int{0})
 }
 
+func TestValidatorConflicts(t *testing.T) {
+	testDBEnv := privacyenabledstate.LevelDBCommonStorageTestEnv{}
+	testDBEnv.Init(t)
+	defer testDBEnv.Cleanup()
+	db := testDBEnv.GetDBHandle("TestDB")
+
+	//populate db with initial data
+	batch := privacyenabledstate.NewUpdateBatch()
+	batch.PubUpdates.Put("ns1", "key1", []byte("value1"), version.NewHeight(1, 0))
+	batch.HashUpdates.Put("ns1", "coll1", util.ComputeStringHash("key2"), util.ComputeStringHash("value2"), version.NewHeight(1, 1))
+	db.ApplyPrivacyAwareUpdates(batch, version.NewHeight(1, 1))
+
+	validator := NewValidator(db)
+
+	// tx0 read a stale version of key1
+	rwsetBuilder0 := rwsetutil.NewRWSetBuilder()
+	rwsetBuilder0.AddToReadSet("ns1", "key1", version.NewHeight(0, 5))
+	// tx1 creates key3, which tx2 read as missing and tx3 didn't find in its range query
+	rwsetBuilder1 := rwsetutil.NewRWSetBuilder()
+	rwsetBuilder1.AddToWriteSet("ns1", "key3", []byte("value3"))
+	rwsetBuilder2 := rwsetutil.NewRWSetBuilder()
+	rwsetBuilder2.AddToReadSet("ns1", "key3", nil)
+	rwsetBuilder3 := rwsetutil.NewRWSetBuilder()
+	rqi3 := &kvrwset.RangeQueryInfo{StartKey: "key1", EndKey: "key9", ItrExhausted: true}
+	rqi3.SetRawReads([]*kvrwset.KVRead{rwsetutil.NewKVRead("key1", version.NewHeight(1, 0))})
+	rwsetBuilder3.AddToRangeQuerySet("ns1", rqi3)
+	// tx4 read a stale version of the private key2
+	rwsetBuilder4 := rwsetutil.NewRWSetBuilder()
+	rwsetBuilder4.AddToHashedReadSet("ns1", "coll1", "key2", version.NewHeight(1, 0))
+
+	var txs []*valinternal.Transaction
+	for i, txRWSet := range getTestPubSimulationRWSet(t, rwsetBuilder0, rwsetBuilder1, rwsetBuilder2, rwsetBuilder3, rwsetBuilder4) {
+		txs = append(txs, &valinternal.Transaction{ID: fmt.Sprintf("txid-%d", i), IndexInBlock: i, RWSet: txRWSet})
+	}
+	block := &valinternal.Block{Num: 2, Txs: txs}
+	_, err := validator.ValidateAndPrepareBatch(block, true)
+	testutil.AssertNoError(t, err, "")
+
+	testutil.AssertEquals(t, txs[0].Conflict, &peer.MVCCConflict{
+		TxId:             "txid-0",
+		BlockNum:         2,
+		TxNum:            0,
+		ValidationCode:   peer.TxValidationCode_MVCC_READ_CONFLICT,
+		Namespace:        "ns1",
+		Key:              "key1",
+		ReadVersion:      &peer.KeyVersion{BlockNum: 0, TxNum: 5},
+		CommittedVersion: &peer.KeyVersion{BlockNum: 1, TxNum: 0},
+	})
+	testutil.AssertNil(t, txs[1].Conflict)
+	testutil.AssertEquals(t, txs[2].Conflict, &peer.MVCCConflict{
+		TxId:             "txid-2",
+		BlockNum:         2,
+		TxNum:            2,
+		ValidationCode:   peer.TxValidationCode_MVCC_READ_CONFLICT,
+		Namespace:        "ns1",
+		Key:              "key3",
+		CommittedVersion: &peer.KeyVersion{BlockNum: 2, TxNum: 1},
+	})
+	testutil.AssertEquals(t, txs[3].Conflict, &peer.MVCCConflict{
+		TxId:           "txid-3",
+		BlockNum:       2,
+		TxNum:          3,
+		ValidationCode: peer.TxValidationCode_PHANTOM_READ_CONFLICT,
+		Namespace:      "ns1",
+		StartKey:       "key1",
+		EndKey:         "key9",
+	})
+	testutil.AssertEquals(t, txs[4].Conflict, &peer.MVCCConflict{
+		TxId:             "txid-4",
+		BlockNum:         2,
+		TxNum:            4,
+		ValidationCode:   peer.TxValidationCode_MVCC_READ_CONFLICT,
+		Namespace:        "ns1",
+		Collection:       "coll1",
+		KeyHash:          util.ComputeStringHash("key2"),
+		ReadVersion:      &peer.KeyVersion{BlockNum: 1, TxNum: 0},
+		CommittedVersion: &peer.KeyVersion{BlockNum: 1, TxNum: 1},
+	})
+}
+
 func checkValidation(t *testing.T, val *Validator, transRWSets []*rwsetutil.TxRwSet, expectedInvalidTxIndexes []int) {
 	var trans []*valinternal.Transaction
 	for i, tranRWSet := range transRWSets {
@@ -14,6 +14,7 @@ import (
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/validator"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/validator/statebasedval"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/validator/valinternal"
+	"github.com/hyperledger/fabric/protos/peer"
 )
 
 var logger = flogging.MustGetLogger("valimpl")
@@ -23,15 +24,20 @@ var logger = flogging.MustGetLogger("valimpl")
 // and for actual validation of the public rwset, it encloses an internal validator (that implements interface
 // valinternal.InternalValidator) such as statebased validator
 type DefaultImpl struct {
-	txmgr txmgr.TxMgr
-	db    privacyenabledstate.DB
+	txmgr      txmgr.TxMgr
+	db         privacyenabledstate.DB
+	conflicts  *validator.ConflictLog
+	contention *validator.ContentionStats
 	valinternal.InternalValidator
 }
 
 // NewStatebasedValidator constructs a validator that internally manages statebased validator and in addition
-// handles the tasks that are agnostic to a particular validation scheme such as parsing the block and handling the pvt data
-func NewStatebasedValidator(txmgr txmgr.TxMgr, db privacyenabledstate.DB) validator.Validator {
-	return &DefaultImpl{txmgr, db, statebasedval.NewValidator(db)}
+// handles the tasks that are agnostic to a particular validation scheme such as parsing the block and handling the pvt data.
+// The MVCC and phantom read conflicts found by the statebased validator are recorded in the given ConflictLog,
+// and counted by the given ContentionStats
+func NewStatebasedValidator(txmgr txmgr.TxMgr, db privacyenabledstate.DB,
+	conflicts *validator.ConflictLog, contention *validator.ContentionStats) validator.Validator {
+	return &DefaultImpl{txmgr, db, conflicts, contention, statebasedval.NewValidator(db)}
 }
 
 // ValidateAndPrepareBatch implements the function in interface validator.Validator
@@ -58,6 +64,14 @@ func (impl *DefaultImpl) ValidateAndPrepareBatch(blockAndPvtdata *ledger.BlockAn
 	}
 	logger.Debug("postprocessing ProtoBlock...")
 	postprocessProtoBlock(block, internalBlock)
+	var conflicts []*peer.MVCCConflict
+	for _, tx := range internalBlock.Txs {
+		if tx.Conflict != nil {
+			conflicts = append(conflicts, tx.Conflict)
+		}
+	}
+	impl.conflicts.Add(conflicts...)
+	impl.contention.Add(conflicts...)
 	logger.Debug("ValidateAndPrepareBatch() complete")
 	return &privacyenabledstate.UpdateBatch{
 		PubUpdates:  pubAndHashUpdates.PubUpdates,
@@ -33,6 +33,9 @@ type Transaction struct {
 	ID             string
 	RWSet          *rwsetutil.TxRwSet
 	ValidationCode peer.TxValidationCode
+	// Conflict describes the read that invalidated the transaction, if its
+	// ValidationCode is either MVCC_READ_CONFLICT or PHANTOM_READ_CONFLICT
+	Conflict *peer.MVCCConflict
 }
 
 // PubAndHashUpdates encapsulates public and hash updates. The intended use of this to hold the updates
@@ -65,6 +65,11 @@ type PeerLedger interface {
 	// A client can obtain more than one 'HistoryQueryExecutor's for parallel execution.
 	// Any synchronization should be performed at the implementation level if required
 	NewHistoryQueryExecutor() (HistoryQueryExecutor, error)
+	// NewHistoricalQueryExecutor gives handle to a query executor reading the state
+	// of the ledger as of the given height, i.e., after the commit of the blocks
+	// numbered below the height. It requires the history database, and cannot
+	// read private data.
+	NewHistoricalQueryExecutor(height uint64) (QueryExecutor, error)
 	// GetPvtDataAndBlockByNum returns the block and the corresponding pvt data.
 	// The pvt data is filtered by the list of 'ns/collections' supplied
 	// A nil filter does not filter any results and causes retrieving all the pvt data for the given blockNum
@@ -83,6 +88,12 @@ type PeerLedger interface {
 	PrivateDataMinBlockNum() (uint64, error)
 	//Prune prunes the blocks/transactions that satisfy the given policy
 	Prune(policy commonledger.PrunePolicy) error
+	// GetMVCCConflicts returns the most recent MVCC and phantom read conflicts
+	// found while validating the blocks of the ledger, the oldest first
+	GetMVCCConflicts() []*peer.MVCCConflict
+	// GetHotKeys returns the keys with the most MVCC and phantom read conflicts
+	// of each namespace, or only of the given namespace if it is not empty
+	GetHotKeys(namespace string) []*peer.HotKey
 }
 
 // ValidatedLedger represents the 'final ledger' after filtering out invalid transactions from PeerLedger.
@@ -131,6 +142,16 @@ type QueryExecutor interface {
 	Done()
 }
 
+// NonRecordingStateReader is implemented by the query executors and transaction simulators
+// that can read the state without recording the read in the simulation results. It is meant
+// for reads that the peer performs on its own behalf, such as reading the configuration of
+// a chaincode, which must not make the transaction depend on the version that was read
+type NonRecordingStateReader interface {
+	// GetStateWithoutRecording gets the value for given namespace and key,
+	// without adding it to the read set of the transaction simulation
+	GetStateWithoutRecording(namespace string, key string) ([]byte, error)
+}
+
 // HistoryQueryExecutor executes the history queries
 type HistoryQueryExecutor interface {
 	// GetHistoryForKey retrieves the history of values for a key.
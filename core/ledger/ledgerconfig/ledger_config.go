@@ -45,6 +45,8 @@ const confEnableHistoryDatabase = "ledger.history.enableHistoryDatabase"
 const confMaxBatchSize = "ledger.state.couchDBConfig.maxBatchUpdateSize"
 const confAutoWarmIndexes = "ledger.state.couchDBConfig.autoWarmIndexes"
 const confWarmIndexesAfterNBlocks = "ledger.state.couchDBConfig.warmIndexesAfterNBlocks"
+const confMaxMVCCConflicts = "ledger.diagnostics.maxMVCCConflicts"
+const confHotKeysPerNamespace = "ledger.diagnostics.hotKeysPerNamespace"
 
 // GetRootPath returns the filesystem path.
 // All ledger related contents are expected to be stored under this path
@@ -145,3 +147,23 @@ func GetWarmIndexesAfterNBlocks() int {
 	}
 	return warmAfterNBlocks
 }
+
+//GetMaxMVCCConflicts exposes the maxMVCCConflicts variable
+func GetMaxMVCCConflicts() int {
+	maxMVCCConflicts := viper.GetInt(confMaxMVCCConflicts)
+	// if maxMVCCConflicts was unset, default to 1000
+	if !viper.IsSet(confMaxMVCCConflicts) {
+		maxMVCCConflicts = 1000
+	}
+	return maxMVCCConflicts
+}
+
+//GetHotKeysPerNamespace exposes the hotKeysPerNamespace variable
+func GetHotKeysPerNamespace() int {
+	hotKeysPerNamespace := viper.GetInt(confHotKeysPerNamespace)
+	// if hotKeysPerNamespace was unset, default to 10
+	if !viper.IsSet(confHotKeysPerNamespace) {
+		hotKeysPerNamespace = 10
+	}
+	return hotKeysPerNamespace
+}
@@ -0,0 +1,275 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package pvtdataverifier
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/hyperledger/fabric/core/common/privdata"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/core/ledger/util"
+	"github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+)
+
+// Ledger is the part of the peer ledger the private data is verified with
+type Ledger interface {
+	// GetBlockchainInfo returns basic info about the blockchain
+	GetBlockchainInfo() (*common.BlockchainInfo, error)
+	// GetPvtDataAndBlockByNum returns the block and the corresponding pvt data
+	GetPvtDataAndBlockByNum(blockNum uint64, filter ledger.PvtNsCollFilter) (*ledger.BlockAndPvtData, error)
+}
+
+// Eligibility returns whether the peer is eligible for the private data
+// of the given collection, i.e., whether it is expected to store it
+type Eligibility func(namespace, collection string) (bool, error)
+
+// CollectionEligibility returns the Eligibility of the peer that signed the given
+// data, according to the access policies of the collections of the given channel
+// in the given store. The peer is not eligible for collections that aren't defined
+func CollectionEligibility(store privdata.CollectionStore, channel string, self common.SignedData) Eligibility {
+	return func(namespace, collection string) (bool, error) {
+		cc := common.CollectionCriteria{Channel: channel, Namespace: namespace, Collection: collection}
+		ap, err := store.RetrieveCollectionAccessPolicy(cc)
+		if _, isNoSuchCollection := err.(privdata.NoSuchCollectionError); isNoSuchCollection {
+			return false, nil
+		}
+		if err != nil {
+			return false, errors.WithMessage(err, fmt.Sprintf("failed retrieving the access policy of collection %s/%s", namespace, collection))
+		}
+		filter := ap.AccessFilter()
+		if filter == nil {
+			return false, errors.Errorf("failed parsing the access policy of collection %s/%s", namespace, collection)
+		}
+		return filter(self), nil
+	}
+}
+
+// cached returns an Eligibility that only looks up the
+// eligibility for each collection once
+func (e Eligibility) cached() Eligibility {
+	cache := make(map[[2]string]bool)
+	return func(namespace, collection string) (bool, error) {
+		key := [2]string{namespace, collection}
+		if eligible, exists := cache[key]; exists {
+			return eligible, nil
+		}
+		eligible, err := e(namespace, collection)
+		if err != nil {
+			return false, err
+		}
+		cache[key] = eligible
+		return eligible, nil
+	}
+}
+
+// Verify checks the private data stored in the ledger for the blocks from
+// startBlock to endBlock against the hashes of the private write sets the
+// blocks record. An endBlock past the last block of the ledger verifies up to
+// the last block. The private data of the collections the peer isn't eligible
+// for is not expected to be stored
+func Verify(lgr Ledger, eligible Eligibility, startBlock, endBlock uint64) (*pb.PvtDataVerification, error) {
+	info, err := lgr.GetBlockchainInfo()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed retrieving blockchain info")
+	}
+	if info.Height == 0 {
+		return nil, errors.New("the ledger has no blocks")
+	}
+	if endBlock >= info.Height {
+		endBlock = info.Height - 1
+	}
+	if startBlock > endBlock {
+		return nil, errors.Errorf("start block %d is past end block %d", startBlock, endBlock)
+	}
+
+	verification := &pb.PvtDataVerification{StartBlock: startBlock, EndBlock: endBlock}
+	eligible = eligible.cached()
+	for blockNum := startBlock; blockNum <= endBlock; blockNum++ {
+		blockAndPvtData, err := lgr.GetPvtDataAndBlockByNum(blockNum, nil)
+		if err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("failed retrieving block %d and its private data", blockNum))
+		}
+		discrepancies, err := VerifyBlock(blockAndPvtData, eligible)
+		if err != nil {
+			return nil, err
+		}
+		verification.Discrepancies = append(verification.Discrepancies, discrepancies...)
+	}
+	return verification, nil
+}
+
+// collHash is the hash of the private write set of a collection
+type collHash struct {
+	namespace  string
+	collection string
+	hash       []byte
+}
+
+// VerifyBlock returns the discrepancies between the private data of a block
+// and the hashes of the private write sets its transactions record. Private
+// data is only required for the valid transactions of the block, and for the
+// collections the peer is eligible for
+func VerifyBlock(blockAndPvtData *ledger.BlockAndPvtData, eligible Eligibility) ([]*pb.PvtDataDiscrepancy, error) {
+	block := blockAndPvtData.Block
+	blockNum := block.Header.Number
+	var txsFilter util.TxValidationFlags
+	if len(block.Metadata.GetMetadata()) > int(common.BlockMetadataIndex_TRANSACTIONS_FILTER) {
+		txsFilter = util.TxValidationFlags(block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER])
+	}
+
+	var discrepancies []*pb.PvtDataDiscrepancy
+	for txNum, envBytes := range block.Data.Data {
+		valid := len(txsFilter) <= txNum || txsFilter.IsValid(txNum)
+		txID, expected, err := pvtRwSetHashes(envBytes)
+		if err != nil {
+			if !valid {
+				// invalid transactions may well be malformed
+				continue
+			}
+			return nil, errors.WithMessage(err, fmt.Sprintf("failed reading transaction %d of block %d", txNum, blockNum))
+		}
+		actual := storedPvtRwSetHashes(blockAndPvtData.BlockPvtData[uint64(txNum)])
+
+		newDiscrepancy := func(h collHash, discrepancyType pb.PvtDataDiscrepancy_Type) *pb.PvtDataDiscrepancy {
+			return &pb.PvtDataDiscrepancy{
+				BlockNum:   blockNum,
+				TxNum:      uint64(txNum),
+				TxId:       txID,
+				Namespace:  h.namespace,
+				Collection: h.collection,
+				Type:       discrepancyType,
+			}
+		}
+		for _, e := range expected {
+			a, exists := findCollHash(actual, e.namespace, e.collection)
+			switch {
+			case !exists && valid:
+				isEligible, err := eligible(e.namespace, e.collection)
+				if err != nil {
+					return nil, errors.WithMessage(err, fmt.Sprintf("failed checking eligibility for transaction %d of block %d", txNum, blockNum))
+				}
+				if !isEligible {
+					continue
+				}
+				d := newDiscrepancy(e, pb.PvtDataDiscrepancy_MISSING)
+				d.ExpectedHash = e.hash
+				discrepancies = append(discrepancies, d)
+			case exists && !bytes.Equal(a.hash, e.hash):
+				d := newDiscrepancy(e, pb.PvtDataDiscrepancy_HASH_MISMATCH)
+				d.ExpectedHash, d.ActualHash = e.hash, a.hash
+				discrepancies = append(discrepancies, d)
+			}
+		}
+		for _, a := range actual {
+			if _, exists := findCollHash(expected, a.namespace, a.collection); !exists {
+				d := newDiscrepancy(a, pb.PvtDataDiscrepancy_UNEXPECTED)
+				d.ActualHash = a.hash
+				discrepancies = append(discrepancies, d)
+			}
+		}
+	}
+
+	// private data of transactions the block doesn't have
+	var extraTxNums []uint64
+	for txNum := range blockAndPvtData.BlockPvtData {
+		if txNum >= uint64(len(block.Data.Data)) {
+			extraTxNums = append(extraTxNums, txNum)
+		}
+	}
+	sort.Slice(extraTxNums, func(i, j int) bool { return extraTxNums[i] < extraTxNums[j] })
+	for _, txNum := range extraTxNums {
+		for _, a := range storedPvtRwSetHashes(blockAndPvtData.BlockPvtData[txNum]) {
+			discrepancies = append(discrepancies, &pb.PvtDataDiscrepancy{
+				BlockNum:   blockNum,
+				TxNum:      txNum,
+				Namespace:  a.namespace,
+				Collection: a.collection,
+				Type:       pb.PvtDataDiscrepancy_UNEXPECTED,
+				ActualHash: a.hash,
+			})
+		}
+	}
+	return discrepancies, nil
+}
+
+// pvtRwSetHashes returns the ID of the transaction in the given envelope, and
+// the hashes of the private write sets its public read-write set records
+func pvtRwSetHashes(envBytes []byte) (string, []collHash, error) {
+	env, err := utils.GetEnvelopeFromBlock(envBytes)
+	if err != nil {
+		return "", nil, err
+	}
+	payload, err := utils.GetPayload(env)
+	if err != nil {
+		return "", nil, err
+	}
+	chdr, err := utils.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	if err != nil {
+		return "", nil, err
+	}
+	if common.HeaderType(chdr.Type) != common.HeaderType_ENDORSER_TRANSACTION {
+		return chdr.TxId, nil, nil
+	}
+
+	respPayload, err := utils.GetActionFromEnvelope(envBytes)
+	if err != nil {
+		return "", nil, err
+	}
+	txRWSet := &rwsetutil.TxRwSet{}
+	if err = txRWSet.FromProtoBytes(respPayload.Results); err != nil {
+		return "", nil, err
+	}
+
+	var hashes []collHash
+	for _, nsRwSet := range txRWSet.NsRwSets {
+		for _, collHashedRwSet := range nsRwSet.CollHashedRwSets {
+			if collHashedRwSet.PvtRwSetHash == nil {
+				continue
+			}
+			hashes = append(hashes, collHash{
+				namespace:  nsRwSet.NameSpace,
+				collection: collHashedRwSet.CollectionName,
+				hash:       collHashedRwSet.PvtRwSetHash,
+			})
+		}
+	}
+	return chdr.TxId, hashes, nil
+}
+
+// storedPvtRwSetHashes returns the hashes of the private write sets of the
+// given private data of a transaction
+func storedPvtRwSetHashes(txPvtData *ledger.TxPvtData) []collHash {
+	if txPvtData == nil || txPvtData.WriteSet == nil {
+		return nil
+	}
+	var hashes []collHash
+	for _, nsPvtRwSet := range txPvtData.WriteSet.NsPvtRwset {
+		for _, collPvtRwSet := range nsPvtRwSet.CollectionPvtRwset {
+			hashes = append(hashes, collHash{
+				namespace:  nsPvtRwSet.Namespace,
+				collection: collPvtRwSet.CollectionName,
+				hash:       rwsetutil.ComputePvtRwSetHash(collPvtRwSet),
+			})
+		}
+	}
+	return hashes
+}
+
+func findCollHash(hashes []collHash, namespace, collection string) (collHash, bool) {
+	for _, h := range hashes {
+		if h.namespace == namespace && h.collection == collection {
+			return h, true
+		}
+	}
+	return collHash{}, false
+}
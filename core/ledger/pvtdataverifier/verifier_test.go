@@ -0,0 +1,212 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package pvtdataverifier
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hyperledger/fabric/common/ledger/testutil"
+	"github.com/hyperledger/fabric/core/common/privdata"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	lutils "github.com/hyperledger/fabric/core/ledger/util"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockLedger struct {
+	blocks []*ledger.BlockAndPvtData
+	err    error
+}
+
+func (l *mockLedger) GetBlockchainInfo() (*common.BlockchainInfo, error) {
+	return &common.BlockchainInfo{Height: uint64(len(l.blocks))}, l.err
+}
+
+func (l *mockLedger) GetPvtDataAndBlockByNum(blockNum uint64, filter ledger.PvtNsCollFilter) (*ledger.BlockAndPvtData, error) {
+	return l.blocks[blockNum], nil
+}
+
+// eligibleFor returns an Eligibility for the given ns/collections only
+func eligibleFor(nsColls ...string) Eligibility {
+	return func(namespace, collection string) (bool, error) {
+		for i := 0; i < len(nsColls); i += 2 {
+			if nsColls[i] == namespace && nsColls[i+1] == collection {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+// simulate returns the public and private simulation results of a
+// transaction writing a key to each of the given ns/collections
+func simulate(t *testing.T, nsColls ...string) ([]byte, *rwset.TxPvtReadWriteSet) {
+	rwsetBuilder := rwsetutil.NewRWSetBuilder()
+	rwsetBuilder.AddToWriteSet("ns", "key", []byte("value"))
+	for i := 0; i < len(nsColls); i += 2 {
+		rwsetBuilder.AddToPvtAndHashedWriteSet(nsColls[i], nsColls[i+1], "key", []byte("value"))
+	}
+	simRes, err := rwsetBuilder.GetTxSimulationResults()
+	assert.NoError(t, err)
+	pubBytes, err := simRes.GetPubSimulationBytes()
+	assert.NoError(t, err)
+	return pubBytes, simRes.PvtSimulationResults
+}
+
+func TestVerify(t *testing.T) {
+	bg, gb := testutil.NewBlockGenerator(t, "testchannel", false)
+
+	// the transactions of block 1 write ns1/coll1, ns1/coll2 and ns1/coll3, only ns1/coll1,
+	// and no private data; the peer isn't eligible for ns1/coll3, hence doesn't store it
+	pub0, pvt0 := simulate(t, "ns1", "coll1", "ns1", "coll2", "ns1", "coll3")
+	pvt0.NsPvtRwset[0].CollectionPvtRwset = pvt0.NsPvtRwset[0].CollectionPvtRwset[:2]
+	eligible := eligibleFor("ns1", "coll1", "ns1", "coll2", "ns2", "coll1")
+	pub1, pvt1 := simulate(t, "ns1", "coll1")
+	pub2, _ := simulate(t)
+	block1 := bg.NextBlockWithTxid([][]byte{pub0, pub1, pub2}, []string{"tx0", "tx1", "tx2"})
+
+	// the transaction of block 2 writes ns1/coll1, and is invalid
+	pub3, _ := simulate(t, "ns1", "coll1")
+	block2 := bg.NextBlockWithTxid([][]byte{pub3}, []string{"tx3"})
+	lutils.TxValidationFlags(block2.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER]).SetFlag(0, pb.TxValidationCode_MVCC_READ_CONFLICT)
+
+	lgr := &mockLedger{
+		blocks: []*ledger.BlockAndPvtData{
+			{Block: gb},
+			{Block: block1, BlockPvtData: map[uint64]*ledger.TxPvtData{
+				0: {SeqInBlock: 0, WriteSet: pvt0},
+				1: {SeqInBlock: 1, WriteSet: pvt1},
+			}},
+			{Block: block2},
+		},
+	}
+
+	// all the private data is in place
+	verification, err := Verify(lgr, eligible, 0, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), verification.StartBlock)
+	assert.Equal(t, uint64(2), verification.EndBlock)
+	assert.Empty(t, verification.Discrepancies)
+
+	expectedHash := func(pvt *rwset.TxPvtReadWriteSet, ns, coll int) []byte {
+		return rwsetutil.ComputePvtRwSetHash(pvt.NsPvtRwset[ns].CollectionPvtRwset[coll])
+	}
+	hash01 := expectedHash(pvt0, 0, 1)
+	hash10 := expectedHash(pvt1, 0, 0)
+
+	// the private data of ns1/coll2 of tx0 is lost, the one of tx1 is
+	// corrupted, and private data has no transaction to go with
+	pvt0.NsPvtRwset[0].CollectionPvtRwset = pvt0.NsPvtRwset[0].CollectionPvtRwset[:1]
+	pvt1.NsPvtRwset[0].CollectionPvtRwset[0].Rwset = []byte("corrupted")
+	_, pvtExtra := simulate(t, "ns2", "coll1")
+	lgr.blocks[1].BlockPvtData[2] = &ledger.TxPvtData{SeqInBlock: 2, WriteSet: pvtExtra}
+	lgr.blocks[1].BlockPvtData[5] = &ledger.TxPvtData{SeqInBlock: 5, WriteSet: pvtExtra}
+	hashExtra := expectedHash(pvtExtra, 0, 0)
+
+	verification, err = Verify(lgr, eligible, 1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), verification.StartBlock)
+	assert.Equal(t, uint64(2), verification.EndBlock)
+	assert.Equal(t, []*pb.PvtDataDiscrepancy{
+		{BlockNum: 1, TxNum: 0, TxId: "tx0", Namespace: "ns1", Collection: "coll2", Type: pb.PvtDataDiscrepancy_MISSING, ExpectedHash: hash01},
+		{BlockNum: 1, TxNum: 1, TxId: "tx1", Namespace: "ns1", Collection: "coll1", Type: pb.PvtDataDiscrepancy_HASH_MISMATCH, ExpectedHash: hash10, ActualHash: rwsetutil.ComputePvtRwSetHash(pvt1.NsPvtRwset[0].CollectionPvtRwset[0])},
+		{BlockNum: 1, TxNum: 2, TxId: "tx2", Namespace: "ns2", Collection: "coll1", Type: pb.PvtDataDiscrepancy_UNEXPECTED, ActualHash: hashExtra},
+		{BlockNum: 1, TxNum: 5, Namespace: "ns2", Collection: "coll1", Type: pb.PvtDataDiscrepancy_UNEXPECTED, ActualHash: hashExtra},
+	}, verification.Discrepancies)
+
+	// bad ranges
+	_, err = Verify(lgr, eligible, 3, 10)
+	assert.EqualError(t, err, "start block 3 is past end block 2")
+	_, err = Verify(&mockLedger{}, eligible, 0, 0)
+	assert.EqualError(t, err, "the ledger has no blocks")
+	_, err = Verify(&mockLedger{err: errors.New("ledger error")}, eligible, 0, 0)
+	assert.EqualError(t, err, "failed retrieving blockchain info: ledger error")
+
+	// eligibility can't be checked
+	_, err = Verify(lgr, func(string, string) (bool, error) { return false, errors.New("store error") }, 1, 2)
+	assert.EqualError(t, err, "failed checking eligibility for transaction 0 of block 1: store error")
+}
+
+func TestVerifyBlockMalformedTx(t *testing.T) {
+	bg, _ := testutil.NewBlockGenerator(t, "testchannel", false)
+	block := bg.NextBlock([][]byte{[]byte("barf")})
+
+	// malformed transactions are only tolerated when they are invalid
+	_, err := VerifyBlock(&ledger.BlockAndPvtData{Block: block}, eligibleFor())
+	assert.Error(t, err)
+
+	lutils.TxValidationFlags(block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER]).SetFlag(0, pb.TxValidationCode_BAD_PAYLOAD)
+	discrepancies, err := VerifyBlock(&ledger.BlockAndPvtData{Block: block}, eligibleFor())
+	assert.NoError(t, err)
+	assert.Empty(t, discrepancies)
+}
+
+type mockCollectionStore struct {
+	privdata.CollectionStore
+	policies map[string]privdata.CollectionAccessPolicy
+	err      error
+}
+
+func (s *mockCollectionStore) RetrieveCollectionAccessPolicy(cc common.CollectionCriteria) (privdata.CollectionAccessPolicy, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	ap, exists := s.policies[cc.Namespace+"/"+cc.Collection]
+	if !exists {
+		return nil, privdata.NoSuchCollectionError(cc)
+	}
+	return ap, nil
+}
+
+type mockAccessPolicy struct {
+	privdata.CollectionAccessPolicy
+	filter privdata.Filter
+}
+
+func (ap *mockAccessPolicy) AccessFilter() privdata.Filter {
+	return ap.filter
+}
+
+func TestCollectionEligibility(t *testing.T) {
+	self := common.SignedData{Identity: []byte("peer0.org1")}
+	isMember := func(members ...string) privdata.Filter {
+		return func(sd common.SignedData) bool {
+			for _, member := range members {
+				if string(sd.Identity) == member {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	store := &mockCollectionStore{policies: map[string]privdata.CollectionAccessPolicy{
+		"ns1/coll1": &mockAccessPolicy{filter: isMember("peer0.org1", "peer0.org2")},
+		"ns1/coll2": &mockAccessPolicy{filter: isMember("peer0.org2")},
+		"ns1/bad":   &mockAccessPolicy{},
+	}}
+	eligible := CollectionEligibility(store, "testchannel", self)
+
+	isEligible, err := eligible("ns1", "coll1")
+	assert.NoError(t, err)
+	assert.True(t, isEligible)
+	isEligible, err = eligible("ns1", "coll2")
+	assert.NoError(t, err)
+	assert.False(t, isEligible)
+	isEligible, err = eligible("ns1", "missing")
+	assert.NoError(t, err)
+	assert.False(t, isEligible)
+	_, err = eligible("ns1", "bad")
+	assert.EqualError(t, err, "failed parsing the access policy of collection ns1/bad")
+
+	store.err = errors.New("ledger error")
+	_, err = eligible("ns1", "coll1")
+	assert.EqualError(t, err, "failed retrieving the access policy of collection ns1/coll1: ledger error")
+}
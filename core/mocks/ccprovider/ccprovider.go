@@ -27,7 +27,7 @@ import (
 )
 
 type ExecuteChaincodeResultProvider interface {
-	ExecuteChaincodeResult() (*peer.Response, *peer.ChaincodeEvent, error)
+	ExecuteChaincodeResult() (*peer.Response, []*peer.ChaincodeEvent, error)
 }
 
 // MockCcProviderFactory is a factory that returns
@@ -137,7 +137,7 @@ func (c *MockCcProviderImpl) GetCCValidationInfoFromLSCC(ctxt context.Context, t
 }
 
 // ExecuteChaincode does nothing
-func (c *MockCcProviderImpl) ExecuteChaincode(ctxt context.Context, cccid interface{}, args [][]byte) (*peer.Response, *peer.ChaincodeEvent, error) {
+func (c *MockCcProviderImpl) ExecuteChaincode(ctxt context.Context, cccid interface{}, args [][]byte) (*peer.Response, []*peer.ChaincodeEvent, error) {
 	if c.ExecuteResultProvider != nil {
 		return c.ExecuteResultProvider.ExecuteChaincodeResult()
 	}
@@ -149,12 +149,12 @@ func (c *MockCcProviderImpl) ExecuteChaincode(ctxt context.Context, cccid interf
 }
 
 // Execute executes the chaincode given context and spec (invocation or deploy)
-func (c *MockCcProviderImpl) Execute(ctxt context.Context, cccid interface{}, spec interface{}) (*peer.Response, *peer.ChaincodeEvent, error) {
+func (c *MockCcProviderImpl) Execute(ctxt context.Context, cccid interface{}, spec interface{}) (*peer.Response, []*peer.ChaincodeEvent, error) {
 	return nil, nil, nil
 }
 
 // ExecuteWithErrorFilter executes the chaincode given context and spec and returns payload
-func (c *MockCcProviderImpl) ExecuteWithErrorFilter(ctxt context.Context, cccid interface{}, spec interface{}) ([]byte, *peer.ChaincodeEvent, error) {
+func (c *MockCcProviderImpl) ExecuteWithErrorFilter(ctxt context.Context, cccid interface{}, spec interface{}) ([]byte, []*peer.ChaincodeEvent, error) {
 	return nil, nil, nil
 }
 
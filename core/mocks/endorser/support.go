@@ -22,10 +22,10 @@ type MockSupport struct {
 	IsSysCCAndNotInvokableExternalRv bool
 	IsSysCCRv                        bool
 	ExecuteCDSResp                   *pb.Response
-	ExecuteCDSEvent                  *pb.ChaincodeEvent
+	ExecuteCDSEvents                 []*pb.ChaincodeEvent
 	ExecuteCDSError                  error
 	ExecuteResp                      *pb.Response
-	ExecuteEvent                     *pb.ChaincodeEvent
+	ExecuteEvents                    []*pb.ChaincodeEvent
 	ExecuteError                     error
 	ChaincodeDefinitionRv            resourcesconfig.ChaincodeDefinition
 	ChaincodeDefinitionError         error
@@ -39,6 +39,10 @@ type MockSupport struct {
 	IsJavaErr                        error
 	GetApplicationConfigRv           channelconfig.Application
 	GetApplicationConfigBoolRv       bool
+	EndorseWithPluginErr             error
+	GetQueryExecutorErr              error
+	GetLedgerHeightRv                uint64
+	GetLedgerHeightErr               error
 }
 
 func (s *MockSupport) IsSysCCAndNotInvokableExternal(name string) bool {
@@ -58,6 +62,18 @@ func (s *MockSupport) GetHistoryQueryExecutor(ledgername string) (ledger.History
 	return nil, nil
 }
 
+func (s *MockSupport) GetQueryExecutor(ledgername string) (ledger.QueryExecutor, error) {
+	return s.GetTxSimulatorRv, s.GetQueryExecutorErr
+}
+
+func (s *MockSupport) GetHistoricalQueryExecutor(ledgername string, height uint64) (ledger.QueryExecutor, error) {
+	return s.GetTxSimulatorRv, s.GetQueryExecutorErr
+}
+
+func (s *MockSupport) GetLedgerHeight(ledgername string) (uint64, error) {
+	return s.GetLedgerHeightRv, s.GetLedgerHeightErr
+}
+
 func (s *MockSupport) GetTransactionByID(chid, txID string) (*pb.ProcessedTransaction, error) {
 	return nil, s.GetTransactionByIDErr
 }
@@ -70,14 +86,14 @@ func (s *MockSupport) IsSysCC(name string) bool {
 	return s.IsSysCCRv
 }
 
-func (s *MockSupport) Execute(ctxt context.Context, cid, name, version, txid string, syscc bool, signedProp *pb.SignedProposal, prop *pb.Proposal, spec interface{}) (*pb.Response, *pb.ChaincodeEvent, error) {
+func (s *MockSupport) Execute(ctxt context.Context, cid, name, version, txid string, syscc bool, signedProp *pb.SignedProposal, prop *pb.Proposal, spec interface{}) (*pb.Response, []*pb.ChaincodeEvent, error) {
 	if spec != nil {
 		if _, istype := spec.(*pb.ChaincodeDeploymentSpec); istype {
-			return s.ExecuteCDSResp, s.ExecuteCDSEvent, s.ExecuteCDSError
+			return s.ExecuteCDSResp, s.ExecuteCDSEvents, s.ExecuteCDSError
 		}
 	}
 
-	return s.ExecuteResp, s.ExecuteEvent, s.ExecuteError
+	return s.ExecuteResp, s.ExecuteEvents, s.ExecuteError
 }
 
 func (s *MockSupport) GetChaincodeDefinition(ctx context.Context, chainID string, txid string, signedProp *pb.SignedProposal, prop *pb.Proposal, chaincodeID string, txsim ledger.TxSimulator) (resourcesconfig.ChaincodeDefinition, error) {
@@ -103,3 +119,10 @@ func (s *MockSupport) CheckInstantiationPolicy(name, version string, cd resource
 func (s *MockSupport) GetApplicationConfig(cid string) (channelconfig.Application, bool) {
 	return s.GetApplicationConfigRv, s.GetApplicationConfigBoolRv
 }
+
+func (s *MockSupport) EndorseWithPlugin(pluginName, channelID string, prpBytes []byte, signedProposal *pb.SignedProposal) (*pb.Endorsement, []byte, error) {
+	if s.EndorseWithPluginErr != nil {
+		return nil, nil, s.EndorseWithPluginErr
+	}
+	return &pb.Endorsement{}, prpBytes, nil
+}
@@ -0,0 +1,159 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package peer
+
+import (
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/common/ccprovider"
+	"github.com/hyperledger/fabric/core/common/privdata"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/cceventmgmt"
+	gossipcommon "github.com/hyperledger/fabric/gossip/common"
+	gossipproto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/hyperledger/fabric/protos/ledger/queryresult"
+	"github.com/pkg/errors"
+)
+
+// chaincodeUpdater publishes the chaincodes installed on the peer that are
+// instantiated on the given channel, and the chaincodes instantiated on it
+type chaincodeUpdater func(installed, instantiated []*gossipproto.Chaincode, chainID gossipcommon.ChainID)
+
+// chaincodeAdvertiser advertises the chaincodes instantiated on a channel, and
+// those of them installed on the peer, and keeps them updated as chaincodes are
+// installed and deployed. Chaincodes installed on the peer but not instantiated on
+// the channel are not advertised on it, as other channels have no business knowing
+// about them. It implements cceventmgmt.ChaincodeInfoListener.
+type chaincodeAdvertiser struct {
+	sync.Mutex
+	cid          string
+	installed    []*gossipproto.Chaincode
+	instantiated []*gossipproto.Chaincode
+	update       chaincodeUpdater
+}
+
+func newChaincodeAdvertiser(cid string, l ledger.PeerLedger, update chaincodeUpdater) *chaincodeAdvertiser {
+	instantiated, err := instantiatedChaincodes(l)
+	if err != nil {
+		peerLogger.Warningf("Failed retrieving the chaincodes instantiated on channel %s: %s", cid, err)
+	}
+	return &chaincodeAdvertiser{
+		cid:          cid,
+		installed:    installedChaincodes(),
+		instantiated: instantiated,
+		update:       update,
+	}
+}
+
+// HandleChaincodesDeployed advertises the given chaincodes as instantiated,
+// instead of other versions of them
+func (a *chaincodeAdvertiser) HandleChaincodesDeployed(chaincodeDefinitions []*cceventmgmt.ChaincodeDefinition) {
+	a.Lock()
+	for _, def := range chaincodeDefinitions {
+		a.instantiated = putChaincode(a.instantiated, def, false)
+	}
+	a.Unlock()
+	a.advertise()
+}
+
+// HandleChaincodeInstalled advertises the given chaincode as installed,
+// along with the other versions of it installed on the peer
+func (a *chaincodeAdvertiser) HandleChaincodeInstalled(chaincodeDefinition *cceventmgmt.ChaincodeDefinition) {
+	a.Lock()
+	a.installed = putChaincode(a.installed, chaincodeDefinition, true)
+	a.Unlock()
+	a.advertise()
+}
+
+func (a *chaincodeAdvertiser) advertise() {
+	a.Lock()
+	var installed []*gossipproto.Chaincode
+	for _, cc := range a.installed {
+		if containsChaincode(a.instantiated, cc) {
+			installed = append(installed, cc)
+		}
+	}
+	instantiated := append([]*gossipproto.Chaincode(nil), a.instantiated...)
+	a.Unlock()
+	a.update(installed, instantiated, gossipcommon.ChainID(a.cid))
+}
+
+// putChaincode adds the given chaincode to the given chaincodes. Chaincodes with the
+// same name are replaced, unless keepVersions is set, in which case only the chaincode
+// with the same name and version is replaced.
+func putChaincode(chaincodes []*gossipproto.Chaincode, def *cceventmgmt.ChaincodeDefinition, keepVersions bool) []*gossipproto.Chaincode {
+	cc := &gossipproto.Chaincode{Name: def.Name, Version: def.Version}
+	for i, existing := range chaincodes {
+		if existing.Name == cc.Name && (!keepVersions || existing.Version == cc.Version) {
+			chaincodes[i] = cc
+			return chaincodes
+		}
+	}
+	return append(chaincodes, cc)
+}
+
+// containsChaincode returns whether the given chaincodes contain
+// a chaincode with the name and version of the given one
+func containsChaincode(chaincodes []*gossipproto.Chaincode, cc *gossipproto.Chaincode) bool {
+	for _, existing := range chaincodes {
+		if existing.Name == cc.Name && existing.Version == cc.Version {
+			return true
+		}
+	}
+	return false
+}
+
+// installedChaincodes returns the chaincodes installed on the peer
+func installedChaincodes() []*gossipproto.Chaincode {
+	res, err := ccprovider.GetInstalledChaincodes()
+	if err != nil {
+		peerLogger.Warningf("Failed retrieving the installed chaincodes: %s", err)
+		return nil
+	}
+	var chaincodes []*gossipproto.Chaincode
+	for _, cc := range res.Chaincodes {
+		chaincodes = append(chaincodes, &gossipproto.Chaincode{Name: cc.Name, Version: cc.Version})
+	}
+	return chaincodes
+}
+
+// instantiatedChaincodes returns the chaincodes instantiated on
+// the channel of the given ledger, according to the lscc state
+func instantiatedChaincodes(l ledger.PeerLedger) ([]*gossipproto.Chaincode, error) {
+	qe, err := l.NewQueryExecutor()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed creating a query executor")
+	}
+	defer qe.Done()
+	itr, err := qe.GetStateRangeScanIterator("lscc", "", "")
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed querying the lscc state")
+	}
+	defer itr.Close()
+
+	var chaincodes []*gossipproto.Chaincode
+	for {
+		res, err := itr.Next()
+		if err != nil {
+			return nil, errors.WithMessage(err, "failed querying the lscc state")
+		}
+		if res == nil {
+			return chaincodes, nil
+		}
+		kv := res.(*queryresult.KV)
+		// lscc also stores the collections of the chaincodes
+		if privdata.IsCollectionConfigKey(kv.Key) {
+			continue
+		}
+		cd := &ccprovider.ChaincodeData{}
+		if err := proto.Unmarshal(kv.Value, cd); err != nil {
+			return nil, errors.Wrapf(err, "failed parsing the definition of chaincode %s", kv.Key)
+		}
+		chaincodes = append(chaincodes, &gossipproto.Chaincode{Name: cd.Name, Version: cd.Version})
+	}
+}
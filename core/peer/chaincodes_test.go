@@ -0,0 +1,109 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package peer
+
+import (
+	"testing"
+
+	commonledger "github.com/hyperledger/fabric/common/ledger"
+	"github.com/hyperledger/fabric/core/common/ccprovider"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/cceventmgmt"
+	gossipcommon "github.com/hyperledger/fabric/gossip/common"
+	gossipproto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/hyperledger/fabric/protos/ledger/queryresult"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockLSCCLedger serves the given key-values of the lscc namespace
+type mockLSCCLedger struct {
+	ledger.PeerLedger
+	kvs []*queryresult.KV
+}
+
+func (l *mockLSCCLedger) NewQueryExecutor() (ledger.QueryExecutor, error) {
+	return &mockLSCCQueryExecutor{kvs: l.kvs}, nil
+}
+
+type mockLSCCQueryExecutor struct {
+	ledger.QueryExecutor
+	kvs []*queryresult.KV
+}
+
+func (qe *mockLSCCQueryExecutor) GetStateRangeScanIterator(namespace, startKey, endKey string) (commonledger.ResultsIterator, error) {
+	return &mockResultsIterator{kvs: qe.kvs}, nil
+}
+
+func (qe *mockLSCCQueryExecutor) Done() {
+}
+
+type mockResultsIterator struct {
+	kvs []*queryresult.KV
+}
+
+func (itr *mockResultsIterator) Next() (commonledger.QueryResult, error) {
+	if len(itr.kvs) == 0 {
+		return nil, nil
+	}
+	kv := itr.kvs[0]
+	itr.kvs = itr.kvs[1:]
+	return kv, nil
+}
+
+func (itr *mockResultsIterator) Close() {
+}
+
+func TestInstantiatedChaincodes(t *testing.T) {
+	l := &mockLSCCLedger{kvs: []*queryresult.KV{
+		{Namespace: "lscc", Key: "mycc", Value: utils.MarshalOrPanic(&ccprovider.ChaincodeData{Name: "mycc", Version: "1.0"})},
+		{Namespace: "lscc", Key: "mycc~collection", Value: []byte("collections")},
+		{Namespace: "lscc", Key: "othercc", Value: utils.MarshalOrPanic(&ccprovider.ChaincodeData{Name: "othercc", Version: "2.0"})},
+	}}
+	chaincodes, err := instantiatedChaincodes(l)
+	assert.NoError(t, err)
+	assert.Equal(t, []*gossipproto.Chaincode{{Name: "mycc", Version: "1.0"}, {Name: "othercc", Version: "2.0"}}, chaincodes)
+
+	l.kvs = append(l.kvs, &queryresult.KV{Namespace: "lscc", Key: "badcc", Value: []byte{1, 2, 3}})
+	_, err = instantiatedChaincodes(l)
+	assert.Error(t, err)
+}
+
+func TestChaincodeAdvertiser(t *testing.T) {
+	var installed, instantiated []*gossipproto.Chaincode
+	update := func(inst, instd []*gossipproto.Chaincode, chainID gossipcommon.ChainID) {
+		assert.Equal(t, gossipcommon.ChainID("mychannel"), chainID)
+		installed, instantiated = inst, instd
+	}
+	advertiser := &chaincodeAdvertiser{
+		cid:          "mychannel",
+		installed:    []*gossipproto.Chaincode{{Name: "mycc", Version: "1.0"}},
+		instantiated: []*gossipproto.Chaincode{{Name: "mycc", Version: "1.0"}},
+		update:       update,
+	}
+	advertiser.advertise()
+	assert.Equal(t, []*gossipproto.Chaincode{{Name: "mycc", Version: "1.0"}}, installed)
+	assert.Equal(t, []*gossipproto.Chaincode{{Name: "mycc", Version: "1.0"}}, instantiated)
+
+	// versions of a chaincode installed side by side are advertised once instantiated
+	advertiser.HandleChaincodeInstalled(&cceventmgmt.ChaincodeDefinition{Name: "mycc", Version: "2.0"})
+	assert.Equal(t, []*gossipproto.Chaincode{{Name: "mycc", Version: "1.0"}}, installed)
+	advertiser.HandleChaincodeInstalled(&cceventmgmt.ChaincodeDefinition{Name: "mycc", Version: "2.0"})
+	assert.Len(t, advertiser.installed, 2)
+
+	// chaincodes installed but not instantiated on the channel are not advertised
+	advertiser.HandleChaincodeInstalled(&cceventmgmt.ChaincodeDefinition{Name: "privatecc", Version: "1.0"})
+	assert.Equal(t, []*gossipproto.Chaincode{{Name: "mycc", Version: "1.0"}}, installed)
+
+	// upgrades replace the instantiated version
+	advertiser.HandleChaincodesDeployed([]*cceventmgmt.ChaincodeDefinition{
+		{Name: "mycc", Version: "2.0"},
+		{Name: "othercc", Version: "1.0"},
+	})
+	assert.Equal(t, []*gossipproto.Chaincode{{Name: "mycc", Version: "2.0"}, {Name: "othercc", Version: "1.0"}}, instantiated)
+	assert.Equal(t, []*gossipproto.Chaincode{{Name: "mycc", Version: "2.0"}}, installed)
+}
@@ -240,19 +240,24 @@ func (ta transactionActions) toFilteredActions() (*peer.FilteredTransaction_Tran
 			return nil, errors.WithMessage(err, "error unmarshal chaincode action for block event")
 		}
 
-		ccEvent, err := utils.GetChaincodeEvents(caPayload.Events)
+		ccEvents, err := utils.GetChaincodeActionEvents(caPayload)
 		if err != nil {
 			return nil, errors.WithMessage(err, "error unmarshal chaincode event for block event")
 		}
 
-		if ccEvent.GetChaincodeId() != "" {
-			filteredAction := &peer.FilteredChaincodeAction{
-				ChaincodeEvent: &peer.ChaincodeEvent{
-					TxId:        ccEvent.TxId,
-					ChaincodeId: ccEvent.ChaincodeId,
-					EventName:   ccEvent.EventName,
-				},
+		filteredAction := &peer.FilteredChaincodeAction{}
+		for _, ccEvent := range ccEvents {
+			if ccEvent.GetChaincodeId() == "" {
+				continue
 			}
+			filteredAction.ChaincodeEvents = append(filteredAction.ChaincodeEvents, &peer.ChaincodeEvent{
+				TxId:        ccEvent.TxId,
+				ChaincodeId: ccEvent.ChaincodeId,
+				EventName:   ccEvent.EventName,
+			})
+		}
+		if n := len(filteredAction.ChaincodeEvents); n > 0 {
+			filteredAction.ChaincodeEvent = filteredAction.ChaincodeEvents[n-1]
 			transactionActions.ChaincodeActions = append(transactionActions.ChaincodeActions, filteredAction)
 		}
 	}
@@ -208,6 +208,7 @@ func TestEventsServer_DeliverFiltered(t *testing.T) {
 								config.Equal(config.eventName, chaincodeActions[0].ChaincodeEvent.EventName)
 								config.Equal(config.txID, chaincodeActions[0].ChaincodeEvent.TxId)
 								config.Equal(config.chaincodeName, chaincodeActions[0].ChaincodeEvent.ChaincodeId)
+								config.Equal(1, len(chaincodeActions[0].ChaincodeEvents))
 							default:
 								config.FailNow("Unexpected response type")
 							}
@@ -510,3 +511,35 @@ func createTestBlock(data []*common.Envelope) (*common.Block, error) {
 	block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER] = make([]byte, len(data))
 	return block, nil
 }
+
+func TestToFilteredActionsMultipleChaincodeEvents(t *testing.T) {
+	ccEvents := []*peer.ChaincodeEvent{
+		{ChaincodeId: "mycc", TxId: "txid", EventName: "first", Payload: []byte("payload1")},
+		{ChaincodeId: "mycc", TxId: "txid", EventName: "second", Payload: []byte("payload2")},
+	}
+	actionBytes, err := proto.Marshal(&peer.ChaincodeAction{
+		ChaincodeId:     &peer.ChaincodeID{Name: "mycc"},
+		Events:          utils.MarshalOrPanic(ccEvents[1]),
+		ChaincodeEvents: ccEvents,
+	})
+	assert.NoError(t, err)
+	proposalResBytes, err := proto.Marshal(&peer.ProposalResponsePayload{Extension: actionBytes})
+	assert.NoError(t, err)
+	chActionBytes, err := proto.Marshal(&peer.ChaincodeActionPayload{
+		Action: &peer.ChaincodeEndorsedAction{ProposalResponsePayload: proposalResBytes},
+	})
+	assert.NoError(t, err)
+
+	filteredActions, err := transactionActions{{Payload: chActionBytes}}.toFilteredActions()
+	assert.NoError(t, err)
+	chaincodeActions := filteredActions.TransactionActions.ChaincodeActions
+	assert.Len(t, chaincodeActions, 1)
+	assert.Len(t, chaincodeActions[0].ChaincodeEvents, 2)
+	for i, ccEvent := range chaincodeActions[0].ChaincodeEvents {
+		assert.Equal(t, ccEvents[i].EventName, ccEvent.EventName)
+		assert.Equal(t, "mycc", ccEvent.ChaincodeId)
+		assert.Equal(t, "txid", ccEvent.TxId)
+		assert.Nil(t, ccEvent.Payload)
+	}
+	assert.Equal(t, "second", chaincodeActions[0].ChaincodeEvent.EventName)
+}
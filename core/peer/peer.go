@@ -31,8 +31,10 @@ import (
 	"github.com/hyperledger/fabric/core/committer/txvalidator"
 	"github.com/hyperledger/fabric/core/common/privdata"
 	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/cceventmgmt"
 	"github.com/hyperledger/fabric/core/ledger/customtx"
 	"github.com/hyperledger/fabric/core/ledger/ledgermgmt"
+	"github.com/hyperledger/fabric/core/ledger/pvtdataverifier"
 	"github.com/hyperledger/fabric/core/transientstore"
 	"github.com/hyperledger/fabric/gossip/api"
 	"github.com/hyperledger/fabric/gossip/service"
@@ -179,6 +181,10 @@ func MockInitialize() {
 
 var chainInitializer func(string)
 
+// pluginMapper maps the names of validation plugins
+// to the factories the validators of chains use
+var pluginMapper txvalidator.PluginMapper
+
 var mockMSPIDGetter func(string) []string
 
 func MockSetMSPIDGetter(mspIDGetter func(string) []string) {
@@ -191,8 +197,9 @@ var validationWorkersSemaphore *semaphore.Weighted
 
 // Initialize sets up any chains that the peer has from the persistence. This
 // function should be called at the start up when the ledger and gossip
-// ready
-func Initialize(init func(string)) {
+// ready. Transactions of the chains are validated with the validation plugins
+// of the given PluginMapper
+func Initialize(init func(string), pm txvalidator.PluginMapper) {
 	nWorkers := viper.GetInt("peer.validatorPoolSize")
 	if nWorkers <= 0 {
 		nWorkers = runtime.NumCPU()
@@ -200,6 +207,7 @@ func Initialize(init func(string)) {
 	validationWorkersSemaphore = semaphore.NewWeighted(int64(nWorkers))
 
 	chainInitializer = init
+	pluginMapper = pm
 
 	var cb *common.Block
 	var ledger ledger.PeerLedger
@@ -382,7 +390,7 @@ func createChain(cid string, ledger ledger.PeerLedger, cb *common.Block) error {
 		*semaphore.Weighted
 		Support
 	}{cs, validationWorkersSemaphore, GetSupport()}
-	validator := txvalidator.NewTxValidator(cid, vcs)
+	validator := txvalidator.NewTxValidator(cid, vcs, pluginMapper)
 	c := committer.NewLedgerCommitterReactive(ledger, func(block *common.Block) error {
 		chainID, err := utils.GetChainIDFromBlock(block)
 		if err != nil {
@@ -410,6 +418,11 @@ func createChain(cid string, ledger ledger.PeerLedger, cb *common.Block) error {
 		Store:     store,
 		Cs:        simpleCollectionStore,
 	})
+	// advertise the chaincodes installed on the peer and instantiated on the
+	// channel to the peers of the channel, and keep them updated
+	advertiser := newChaincodeAdvertiser(cid, ledger, service.GetGossipService().UpdateChaincodes)
+	cceventmgmt.GetMgr().RegisterInfoListener(cid, advertiser)
+	advertiser.advertise()
 
 	chains.Lock()
 	defer chains.Unlock()
@@ -753,6 +766,37 @@ func (*collectionSupport) GetIdentityDeserializer(chainID string) msp.IdentityDe
 	return mspmgmt.GetManagerForChain(chainID)
 }
 
+// localCollectionSupport evaluates the access policies of
+// collections with the local MSP rather than the channel MSPs
+type localCollectionSupport struct {
+	collectionSupport
+}
+
+func (*localCollectionSupport) GetIdentityDeserializer(chainID string) msp.IdentityDeserializer {
+	return mspmgmt.GetLocalMSP()
+}
+
+// PvtDataEligibility returns the eligibility of the peer for the private data of the
+// collections of the channel of the given ledger. The access policies are evaluated
+// with the local MSP, so that the ledger needn't be opened by a running peer
+func PvtDataEligibility(cid string, l ledger.PeerLedger) (pvtdataverifier.Eligibility, error) {
+	signer, err := mspmgmt.GetLocalMSP().GetDefaultSigningIdentity()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed getting local signing identity")
+	}
+	identity, err := signer.Serialize()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed serializing local signing identity")
+	}
+	msg := make([]byte, 32)
+	sig, err := signer.Sign(msg)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed signing with local signing identity")
+	}
+	store := privdata.NewSimpleCollectionStore(&localCollectionSupport{collectionSupport{PeerLedger: l}})
+	return pvtdataverifier.CollectionEligibility(store, cid, common.SignedData{Data: msg, Signature: sig, Identity: identity}), nil
+}
+
 //
 //  Deliver service support structs for the peer
 //
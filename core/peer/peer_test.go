@@ -8,6 +8,7 @@ package peer
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net"
 	"os"
 	"testing"
@@ -27,6 +28,7 @@ import (
 	"github.com/hyperledger/fabric/msp/mgmt/testtools"
 	peergossip "github.com/hyperledger/fabric/peer/gossip"
 	"github.com/hyperledger/fabric/peer/gossip/mocks"
+	"github.com/hyperledger/fabric/protos/common"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
@@ -39,6 +41,16 @@ func (ds *mockDeliveryClient) UpdateEndpoints(chainID string, endpoints []string
 	return nil
 }
 
+// FetchBlocks fetches blocks of the channel from the ordering service
+func (ds *mockDeliveryClient) FetchBlocks(chainID string, start, end uint64) ([]*common.Block, error) {
+	return nil, nil
+}
+
+// Healthy returns whether delivery of blocks for the channel doesn't fail
+func (ds *mockDeliveryClient) Healthy(chainID string) bool {
+	return true
+}
+
 // StartDeliverForChannel dynamically starts delivery of new blocks from ordering service
 // to channel peers.
 func (ds *mockDeliveryClient) StartDeliverForChannel(chainID string, ledgerInfo blocksprovider.LedgerInfo, f func()) error {
@@ -92,7 +104,7 @@ func TestInitialize(t *testing.T) {
 	ccp.RegisterChaincodeProviderFactory(&ccprovider.MockCcProviderFactory{})
 	sysccprovider.RegisterSystemChaincodeProviderFactory(&mscc.MocksccProviderFactory{})
 
-	Initialize(nil)
+	Initialize(nil, nil)
 }
 
 func TestCreateChainFromBlock(t *testing.T) {
@@ -193,7 +205,7 @@ func TestCreateChainFromBlock(t *testing.T) {
 	assert.Equal(t, true, ok, "expected Manage() to return true")
 
 	// Chaos monkey test
-	Initialize(nil)
+	Initialize(nil, nil)
 
 	SetCurrConfigBlock(block, testChainID)
 
@@ -209,6 +221,12 @@ func TestGetLocalIP(t *testing.T) {
 }
 
 func TestDeliverSupportManager(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "deliversupport")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set("peer.fileSystemPath", tempDir)
+	defer viper.Set("peer.fileSystemPath", "")
+
 	// reset chains for testing
 	MockInitialize()
 
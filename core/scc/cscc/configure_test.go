@@ -64,6 +64,16 @@ func (ds *mockDeliveryClient) UpdateEndpoints(chainID string, endpoints []string
 	return nil
 }
 
+// FetchBlocks fetches blocks of the channel from the ordering service
+func (ds *mockDeliveryClient) FetchBlocks(chainID string, start, end uint64) ([]*cb.Block, error) {
+	return nil, nil
+}
+
+// Healthy returns whether delivery of blocks for the channel doesn't fail
+func (ds *mockDeliveryClient) Healthy(chainID string) bool {
+	return true
+}
+
 // StartDeliverForChannel dynamically starts delivery of new blocks from ordering service
 // to channel peers.
 func (ds *mockDeliveryClient) StartDeliverForChannel(chainID string, ledgerInfo blocksprovider.LedgerInfo, f func()) error {
@@ -9,7 +9,6 @@ package scc
 import (
 	//import system chaincodes here
 	"github.com/hyperledger/fabric/core/scc/cscc"
-	"github.com/hyperledger/fabric/core/scc/escc"
 	"github.com/hyperledger/fabric/core/scc/lscc"
 	"github.com/hyperledger/fabric/core/scc/qscc"
 	"github.com/hyperledger/fabric/core/scc/vscc"
@@ -34,13 +33,6 @@ var systemChaincodes = []*SystemChaincode{
 		InvokableExternal: true, // lscc is invoked to deploy new chaincodes
 		InvokableCC2CC:    true, // lscc can be invoked by other chaincodes
 	},
-	{
-		Enabled:   true,
-		Name:      "escc",
-		Path:      "github.com/hyperledger/fabric/core/scc/escc",
-		InitArgs:  [][]byte{[]byte("")},
-		Chaincode: &escc.EndorserOneValidSignature{},
-	},
 	{
 		Enabled:   true,
 		Name:      "vscc",
@@ -132,10 +132,8 @@ func NewLifeCycleSysCC() *lifeCycleSysCC {
 
 //create the chaincode on the given chain
 func (lscc *lifeCycleSysCC) putChaincodeData(stub shim.ChaincodeStubInterface, cd *ccprovider.ChaincodeData) error {
-	// check that escc and vscc are real system chaincodes
-	if !lscc.sccprovider.IsSysCC(string(cd.Escc)) {
-		return fmt.Errorf("%s is not a valid endorsement system chaincode", string(cd.Escc))
-	}
+	// check that vscc is a real system chaincode; escc names an
+	// endorsement plugin, which only endorsing peers know about
 	if !lscc.sccprovider.IsSysCC(string(cd.Vscc)) {
 		return fmt.Errorf("%s is not a valid validation system chaincode", string(cd.Vscc))
 	}
@@ -25,6 +25,7 @@ import (
 	"github.com/hyperledger/fabric/core/aclmgmt"
 	"github.com/hyperledger/fabric/core/chaincode/shim"
 	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/pvtdataverifier"
 	"github.com/hyperledger/fabric/core/peer"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/hyperledger/fabric/protos/utils"
@@ -35,6 +36,8 @@ import (
 // - GetBlockByNumber returns a block
 // - GetBlockByHash returns a block
 // - GetTransactionByID returns a transaction
+// - GetMVCCConflicts returns the most recent MVCC conflicts
+// - VerifyPvtData verifies the private data against the hashes in the blocks
 type LedgerQuerier struct {
 }
 
@@ -47,8 +50,14 @@ const (
 	GetBlockByHash     string = "GetBlockByHash"
 	GetTransactionByID string = "GetTransactionByID"
 	GetBlockByTxID     string = "GetBlockByTxID"
+	GetMVCCConflicts   string = "GetMVCCConflicts"
+	VerifyPvtData      string = "VerifyPvtData"
 )
 
+// maxPvtDataVerificationBlocks is the maximum number of blocks
+// whose private data VerifyPvtData verifies in one invocation
+const maxPvtDataVerificationBlocks = 1000
+
 // Init is called once per chain when the chain is created.
 // This allows the chaincode to initialize any variables on the ledger prior
 // to any transaction execution on the chain.
@@ -65,6 +74,12 @@ func (e *LedgerQuerier) Init(stub shim.ChaincodeStubInterface) pb.Response {
 // # GetBlockByNumber: Return the block specified by block number in args[2]
 // # GetBlockByHash: Return the block specified by block hash in args[2]
 // # GetTransactionByID: Return the transaction specified by ID in args[2]
+// # GetMVCCConflicts: Return the most recent MVCC conflicts, of the namespace
+// in args[2] if specified, as an MVCCConflicts object marshalled in bytes
+// # VerifyPvtData: Return the discrepancies between the private data of the
+// peer and the hashes recorded in the blocks from args[2] to args[3], at most
+// maxPvtDataVerificationBlocks of them, as a PvtDataVerification object
+// marshalled in bytes
 func (e *LedgerQuerier) Invoke(stub shim.ChaincodeStubInterface) pb.Response {
 	args := stub.GetArgs()
 
@@ -74,7 +89,7 @@ func (e *LedgerQuerier) Invoke(stub shim.ChaincodeStubInterface) pb.Response {
 	fname := string(args[0])
 	cid := string(args[1])
 
-	if fname != GetChainInfo && len(args) < 3 {
+	if fname != GetChainInfo && fname != GetMVCCConflicts && len(args) < 3 {
 		return shim.Error(fmt.Sprintf("missing 3rd argument for %s", fname))
 	}
 
@@ -109,6 +124,18 @@ func (e *LedgerQuerier) Invoke(stub shim.ChaincodeStubInterface) pb.Response {
 		return getChainInfo(targetLedger)
 	case GetBlockByTxID:
 		return getBlockByTxID(targetLedger, args[2])
+	case GetMVCCConflicts:
+		var namespace []byte
+		if len(args) > 2 {
+			namespace = args[2]
+		}
+		return getMVCCConflicts(targetLedger, namespace)
+	case VerifyPvtData:
+		var endBlock []byte
+		if len(args) > 3 {
+			endBlock = args[3]
+		}
+		return verifyPvtData(cid, targetLedger, args[2], endBlock)
 	}
 
 	return shim.Error(fmt.Sprintf("Requested function %s not found.", fname))
@@ -208,6 +235,55 @@ func getBlockByTxID(vledger ledger.PeerLedger, rawTxID []byte) pb.Response {
 	return shim.Success(bytes)
 }
 
+func getMVCCConflicts(vledger ledger.PeerLedger, namespace []byte) pb.Response {
+	conflicts := &pb.MVCCConflicts{}
+	for _, conflict := range vledger.GetMVCCConflicts() {
+		if len(namespace) == 0 || conflict.Namespace == string(namespace) {
+			conflicts.Conflicts = append(conflicts.Conflicts, conflict)
+		}
+	}
+
+	bytes, err := utils.Marshal(conflicts)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	return shim.Success(bytes)
+}
+
+func verifyPvtData(cid string, vledger ledger.PeerLedger, start, end []byte) pb.Response {
+	if start == nil || end == nil {
+		return shim.Error("Start and end block numbers must not be nil.")
+	}
+	startBlock, err := strconv.ParseUint(string(start), 10, 64)
+	if err != nil {
+		return shim.Error(fmt.Sprintf("Failed to parse start block number with error %s", err))
+	}
+	endBlock, err := strconv.ParseUint(string(end), 10, 64)
+	if err != nil {
+		return shim.Error(fmt.Sprintf("Failed to parse end block number with error %s", err))
+	}
+	if endBlock >= startBlock && endBlock-startBlock >= maxPvtDataVerificationBlocks {
+		return shim.Error(fmt.Sprintf("Cannot verify the private data of more than %d blocks at once", maxPvtDataVerificationBlocks))
+	}
+
+	eligible, err := peer.PvtDataEligibility(cid, vledger)
+	if err != nil {
+		return shim.Error(fmt.Sprintf("Failed to verify private data, error %s", err))
+	}
+	verification, err := pvtdataverifier.Verify(vledger, eligible, startBlock, endBlock)
+	if err != nil {
+		return shim.Error(fmt.Sprintf("Failed to verify private data, error %s", err))
+	}
+
+	bytes, err := utils.Marshal(verification)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	return shim.Success(bytes)
+}
+
 func getACLResource(fname string) string {
 	return "QSCC." + fname
 }
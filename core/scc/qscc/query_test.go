@@ -20,14 +20,18 @@ import (
 	"os"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/cauthdsl"
 	"github.com/hyperledger/fabric/common/ledger/testutil"
 	"github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/core/aclmgmt"
 	"github.com/hyperledger/fabric/core/aclmgmt/mocks"
 	"github.com/hyperledger/fabric/core/aclmgmt/resources"
 	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/hyperledger/fabric/core/common/privdata"
 	ledger2 "github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/peer"
+	msptesttools "github.com/hyperledger/fabric/msp/mgmt/testtools"
 	"github.com/hyperledger/fabric/protos/common"
 	peer2 "github.com/hyperledger/fabric/protos/peer"
 	"github.com/hyperledger/fabric/protos/utils"
@@ -309,6 +313,162 @@ func TestQueryGeneratedBlock(t *testing.T) {
 	}
 }
 
+func TestQueryGetMVCCConflicts(t *testing.T) {
+	chainid := "mytestchainid9"
+	path := "/var/hyperledger/test9/"
+	stub, err := setupTestLedger(chainid, path)
+	defer os.RemoveAll(path)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	bg, _ := testutil.NewBlockGenerator(t, chainid, false)
+	ledger := peer.GetLedger(chainid)
+
+	// the first transaction reads ns1/key1 and ns2/key1 before they are written
+	// by the second transaction, which gets committed first
+	simulator, _ := ledger.NewTxSimulator(util.GenerateUUID())
+	simulator.GetState("ns1", "key1")
+	simulator.GetState("ns2", "key1")
+	simulator.SetState("ns1", "key2", []byte("value2"))
+	simulator.Done()
+	simRes1, _ := simulator.GetTxSimulationResults()
+	pubSimResBytes1, _ := simRes1.GetPubSimulationBytes()
+
+	simulator, _ = ledger.NewTxSimulator(util.GenerateUUID())
+	simulator.SetState("ns1", "key1", []byte("value1"))
+	simulator.Done()
+	simRes2, _ := simulator.GetTxSimulationResults()
+	pubSimResBytes2, _ := simRes2.GetPubSimulationBytes()
+
+	assert.NoError(t, ledger.CommitWithPvtData(&ledger2.BlockAndPvtData{Block: bg.NextBlock([][]byte{pubSimResBytes2})}))
+	assert.NoError(t, ledger.CommitWithPvtData(&ledger2.BlockAndPvtData{Block: bg.NextBlock([][]byte{pubSimResBytes1})}))
+
+	args := [][]byte{[]byte(GetMVCCConflicts), []byte(chainid)}
+	prop := resetProvider(resources.QSCC_GetMVCCConflicts, chainid, &peer2.SignedProposal{}, nil)
+	res := stub.MockInvokeWithSignedProposal("1", args, prop)
+	assert.Equal(t, int32(shim.OK), res.Status, "GetMVCCConflicts failed with err: %s", res.Message)
+	conflicts := &peer2.MVCCConflicts{}
+	assert.NoError(t, proto.Unmarshal(res.Payload, conflicts))
+	assert.Len(t, conflicts.Conflicts, 1)
+	conflict := conflicts.Conflicts[0]
+	assert.Equal(t, uint64(2), conflict.BlockNum)
+	assert.Equal(t, peer2.TxValidationCode_MVCC_READ_CONFLICT, conflict.ValidationCode)
+	assert.Equal(t, "ns1", conflict.Namespace)
+	assert.Equal(t, "key1", conflict.Key)
+	assert.Nil(t, conflict.ReadVersion)
+	assert.Equal(t, &peer2.KeyVersion{BlockNum: 1, TxNum: 0}, conflict.CommittedVersion)
+
+	// conflicts are filtered by namespace
+	args = [][]byte{[]byte(GetMVCCConflicts), []byte(chainid), []byte("ns2")}
+	prop = resetProvider(resources.QSCC_GetMVCCConflicts, chainid, &peer2.SignedProposal{}, nil)
+	res = stub.MockInvokeWithSignedProposal("2", args, prop)
+	assert.Equal(t, int32(shim.OK), res.Status, "GetMVCCConflicts failed with err: %s", res.Message)
+	conflicts = &peer2.MVCCConflicts{}
+	assert.NoError(t, proto.Unmarshal(res.Payload, conflicts))
+	assert.Empty(t, conflicts.Conflicts)
+}
+
+func TestQueryVerifyPvtData(t *testing.T) {
+	chainid := "mytestchainid10"
+	path := "/var/hyperledger/test10/"
+	stub, err := setupTestLedger(chainid, path)
+	defer os.RemoveAll(path)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	assert.NoError(t, msptesttools.LoadMSPSetupForTesting())
+	bg, _ := testutil.NewBlockGenerator(t, chainid, false)
+	ledger := peer.GetLedger(chainid)
+
+	// the organization of the peer is a member of ns1/coll1 but not of ns1/coll2
+	collectionConfig := func(name, mspID string) *common.CollectionConfig {
+		policy := &common.CollectionPolicyConfig{Payload: &common.CollectionPolicyConfig_SignaturePolicy{
+			SignaturePolicy: cauthdsl.SignedByMspMember(mspID),
+		}}
+		return &common.CollectionConfig{Payload: &common.CollectionConfig_StaticCollectionConfig{
+			StaticCollectionConfig: &common.StaticCollectionConfig{Name: name, MemberOrgsPolicy: policy},
+		}}
+	}
+	collections := &common.CollectionConfigPackage{Config: []*common.CollectionConfig{
+		collectionConfig("coll1", "DEFAULT"),
+		collectionConfig("coll2", "OtherOrg"),
+	}}
+
+	// block 1 is committed with its private data, and block 2 without it
+	simulator, _ := ledger.NewTxSimulator(util.GenerateUUID())
+	simulator.SetState("lscc", privdata.BuildCollectionKVSKey("ns1"), utils.MarshalOrPanic(collections))
+	simulator.SetPrivateData("ns1", "coll1", "key1", []byte("value1"))
+	simulator.SetPrivateData("ns1", "coll2", "key1", []byte("value1"))
+	simulator.Done()
+	simRes, _ := simulator.GetTxSimulationResults()
+	pubSimResBytes, _ := simRes.GetPubSimulationBytes()
+	blockPvtData := map[uint64]*ledger2.TxPvtData{0: {SeqInBlock: 0, WriteSet: simRes.PvtSimulationResults}}
+	// the peer only stores the private data of ns1/coll1
+	simRes.PvtSimulationResults.NsPvtRwset[0].CollectionPvtRwset = simRes.PvtSimulationResults.NsPvtRwset[0].CollectionPvtRwset[:1]
+	assert.NoError(t, ledger.CommitWithPvtData(&ledger2.BlockAndPvtData{Block: bg.NextBlock([][]byte{pubSimResBytes}), BlockPvtData: blockPvtData}))
+	assert.NoError(t, ledger.CommitWithPvtData(&ledger2.BlockAndPvtData{Block: bg.NextBlock([][]byte{pubSimResBytes})}))
+
+	verifyPvtData := func(txid string, blockRange ...string) *peer2.PvtDataVerification {
+		args := [][]byte{[]byte(VerifyPvtData), []byte(chainid)}
+		for _, blockNum := range blockRange {
+			args = append(args, []byte(blockNum))
+		}
+		prop := resetProvider(resources.QSCC_VerifyPvtData, chainid, &peer2.SignedProposal{}, nil)
+		res := stub.MockInvokeWithSignedProposal(txid, args, prop)
+		assert.Equal(t, int32(shim.OK), res.Status, "VerifyPvtData failed with err: %s", res.Message)
+		verification := &peer2.PvtDataVerification{}
+		assert.NoError(t, proto.Unmarshal(res.Payload, verification))
+		return verification
+	}
+
+	verification := verifyPvtData("1", "0", "10")
+	assert.Equal(t, uint64(0), verification.StartBlock)
+	assert.Equal(t, uint64(2), verification.EndBlock)
+	assert.Len(t, verification.Discrepancies, 1)
+	discrepancy := verification.Discrepancies[0]
+	assert.Equal(t, uint64(2), discrepancy.BlockNum)
+	assert.Equal(t, peer2.PvtDataDiscrepancy_MISSING, discrepancy.Type)
+	assert.Equal(t, "ns1", discrepancy.Namespace)
+	assert.Equal(t, "coll1", discrepancy.Collection)
+
+	verification = verifyPvtData("2", "0", "1")
+	assert.Equal(t, uint64(1), verification.EndBlock)
+	assert.Empty(t, verification.Discrepancies)
+
+	args := [][]byte{[]byte(VerifyPvtData), []byte(chainid), []byte("barf"), []byte("1")}
+	prop := resetProvider(resources.QSCC_VerifyPvtData, chainid, &peer2.SignedProposal{}, nil)
+	res := stub.MockInvokeWithSignedProposal("3", args, prop)
+	assert.Equal(t, int32(shim.ERROR), res.Status, "VerifyPvtData should have failed with an invalid start block")
+
+	args = [][]byte{[]byte(VerifyPvtData), []byte(chainid), []byte("2"), []byte("1")}
+	prop = resetProvider(resources.QSCC_VerifyPvtData, chainid, &peer2.SignedProposal{}, nil)
+	res = stub.MockInvokeWithSignedProposal("4", args, prop)
+	assert.Equal(t, int32(shim.ERROR), res.Status, "VerifyPvtData should have failed with a start block past the end block")
+
+	// the block range is mandatory, and bounded
+	args = [][]byte{[]byte(VerifyPvtData), []byte(chainid)}
+	prop = resetProvider(resources.QSCC_VerifyPvtData, chainid, &peer2.SignedProposal{}, nil)
+	res = stub.MockInvokeWithSignedProposal("5", args, prop)
+	assert.Equal(t, int32(shim.ERROR), res.Status, "VerifyPvtData should have failed without a block range")
+
+	args = [][]byte{[]byte(VerifyPvtData), []byte(chainid), []byte("0")}
+	prop = resetProvider(resources.QSCC_VerifyPvtData, chainid, &peer2.SignedProposal{}, nil)
+	res = stub.MockInvokeWithSignedProposal("6", args, prop)
+	assert.Equal(t, int32(shim.ERROR), res.Status, "VerifyPvtData should have failed without an end block")
+
+	args = [][]byte{[]byte(VerifyPvtData), []byte(chainid), []byte("0"), []byte(fmt.Sprint(maxPvtDataVerificationBlocks))}
+	prop = resetProvider(resources.QSCC_VerifyPvtData, chainid, &peer2.SignedProposal{}, nil)
+	res = stub.MockInvokeWithSignedProposal("7", args, prop)
+	assert.Equal(t, int32(shim.ERROR), res.Status, "VerifyPvtData should have failed with too many blocks")
+	assert.Equal(t, "Cannot verify the private data of more than 1000 blocks at once", res.Message)
+
+	verification = verifyPvtData("8", "1", fmt.Sprint(maxPvtDataVerificationBlocks))
+	assert.Equal(t, uint64(1), verification.StartBlock)
+	assert.Equal(t, uint64(2), verification.EndBlock)
+}
+
 func addBlockForTesting(t *testing.T, chainid string) *common.Block {
 	bg, _ := testutil.NewBlockGenerator(t, chainid, false)
 	ledger := peer.GetLedger(chainid)
@@ -177,6 +177,14 @@ func (s *mockStub) PutState(key string, value []byte) error {
 	return args.Get(0).(error)
 }
 
+func (*mockStub) GetStateMultipleKeys(keys []string) ([][]byte, error) {
+	panic("implement me")
+}
+
+func (*mockStub) PutStateMultipleKeys(kvs map[string][]byte) error {
+	panic("implement me")
+}
+
 func (*mockStub) DelState(key string) error {
 	panic("implement me")
 }
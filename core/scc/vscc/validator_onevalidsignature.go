@@ -10,7 +10,6 @@ import (
 	"fmt"
 
 	"github.com/golang/protobuf/proto"
-	"github.com/hyperledger/fabric/common/cauthdsl"
 	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/core/chaincode/shim"
@@ -18,9 +17,9 @@ import (
 	"github.com/hyperledger/fabric/core/common/ccprovider"
 	"github.com/hyperledger/fabric/core/common/privdata"
 	"github.com/hyperledger/fabric/core/common/sysccprovider"
+	"github.com/hyperledger/fabric/core/handlers/validation"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
 	"github.com/hyperledger/fabric/core/scc/lscc"
-	m "github.com/hyperledger/fabric/msp"
 	mspmgmt "github.com/hyperledger/fabric/msp/mgmt"
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
@@ -45,29 +44,11 @@ type ValidatorOneValidSignature struct {
 	// methods of the system chaincode package without
 	// import cycles
 	sccprovider sysccprovider.SystemChaincodeProvider
-
-	// collectionStore provides support to retrieve
-	// collections from the ledger
-	collectionStore privdata.CollectionStore
-}
-
-// collectionStoreSupport implements privdata.Support
-type collectionStoreSupport struct {
-	sysccprovider.SystemChaincodeProvider
-}
-
-func (c *collectionStoreSupport) GetCollectionKVSKey(cc common.CollectionCriteria) string {
-	return privdata.BuildCollectionKVSKey(cc.Namespace)
-}
-
-func (c *collectionStoreSupport) GetIdentityDeserializer(chainID string) m.IdentityDeserializer {
-	return mspmgmt.GetIdentityDeserializer(chainID)
 }
 
 // Init is called once when the chaincode started the first time
 func (vscc *ValidatorOneValidSignature) Init(stub shim.ChaincodeStubInterface) pb.Response {
 	vscc.sccprovider = sysccprovider.GetSystemChaincodeProvider()
-	vscc.collectionStore = privdata.NewSimpleCollectionStore(&collectionStoreSupport{vscc.sccprovider})
 
 	return shim.Success(nil)
 }
@@ -100,52 +81,122 @@ func (vscc *ValidatorOneValidSignature) Invoke(stub shim.ChaincodeStubInterface)
 
 	logger.Debugf("VSCC invoked")
 
-	// get the envelope...
-	env, err := utils.GetEnvelopeFromBlock(args[1])
+	// get the channel of the transaction
+	chdr, err := getChannelHeader(args[1])
 	if err != nil {
-		logger.Errorf("VSCC error: GetEnvelope failed, err %s", err)
 		return shim.Error(err.Error())
 	}
 
-	// ...and the payload...
+	ac, exists := vscc.sccprovider.GetApplicationConfig(chdr.ChannelId)
+	if !exists {
+		err = errors.Wrap(err, "failure while unmarshalling VSCCArgs")
+		logger.Errorf(err.Error())
+		return shim.Error(err.Error())
+	}
+
+	sf := &stateFetcher{chainID: chdr.ChannelId, sccprovider: vscc.sccprovider}
+	pe := &txvalidator.PolicyEvaluator{IdentityDeserializer: mspmgmt.GetManagerForChain(chdr.ChannelId)}
+	err = New(ac.Capabilities(), sf, pe).Validate(args[1], args[2])
+	if err != nil {
+		response := shim.Error(err.Error())
+		if _, ok := err.(*validation.ExecutionFailureError); ok {
+			response.Status = txvalidator.IntermittentErrorCode
+		}
+		return response
+	}
+
+	logger.Debugf("VSCC exists successfully")
+
+	return shim.Success(nil)
+}
+
+// stateFetcher fetches the state of a channel out of the
+// query executors that the system chaincode provider creates
+type stateFetcher struct {
+	chainID     string
+	sccprovider sysccprovider.SystemChaincodeProvider
+}
+
+// FetchState fetches state
+func (sf *stateFetcher) FetchState() (validation.State, error) {
+	qe, err := sf.sccprovider.GetQueryExecutorForLedger(sf.chainID)
+	if err != nil {
+		return nil, err
+	}
+	return qe, nil
+}
+
+func getChannelHeader(envBytes []byte) (*common.ChannelHeader, error) {
+	env, err := utils.GetEnvelopeFromBlock(envBytes)
+	if err != nil {
+		logger.Errorf("VSCC error: GetEnvelope failed, err %s", err)
+		return nil, err
+	}
+
 	payl, err := utils.GetPayload(env)
 	if err != nil {
 		logger.Errorf("VSCC error: GetPayload failed, err %s", err)
-		return shim.Error(err.Error())
+		return nil, err
 	}
 
-	chdr, err := utils.UnmarshalChannelHeader(payl.Header.ChannelHeader)
+	return utils.UnmarshalChannelHeader(payl.Header.ChannelHeader)
+}
+
+// Validator performs the validation that the default VSCC implements
+// in-process, evaluating policies and reading the committed state of
+// the channel through the given dependencies. It backs both this
+// system chaincode and the default validation plugin
+type Validator struct {
+	capabilities    channelconfig.ApplicationCapabilities
+	stateFetcher    validation.StateFetcher
+	policyEvaluator validation.PolicyEvaluator
+}
+
+// New creates a new Validator
+func New(c channelconfig.ApplicationCapabilities, sf validation.StateFetcher, pe validation.PolicyEvaluator) *Validator {
+	return &Validator{
+		capabilities:    c,
+		stateFetcher:    sf,
+		policyEvaluator: pe,
+	}
+}
+
+// Validate checks that the transaction in the given envelope contains
+// endorsements that satisfy the given serialized endorsement policy, and
+// performs additional validation on invocations of lscc. Returns nil if
+// the transaction is valid, a *validation.ExecutionFailureError if it
+// couldn't be validated, or an error describing why it is invalid
+func (vscc *Validator) Validate(envBytes []byte, policyBytes []byte) error {
+	// get the envelope...
+	env, err := utils.GetEnvelopeFromBlock(envBytes)
 	if err != nil {
-		return shim.Error(err.Error())
+		logger.Errorf("VSCC error: GetEnvelope failed, err %s", err)
+		return err
 	}
 
-	ac, exists := vscc.sccprovider.GetApplicationConfig(chdr.ChannelId)
-	if !exists {
-		err = errors.Wrap(err, "failure while unmarshalling VSCCArgs")
-		logger.Errorf(err.Error())
-		return shim.Error(err.Error())
+	// ...and the payload...
+	payl, err := utils.GetPayload(env)
+	if err != nil {
+		logger.Errorf("VSCC error: GetPayload failed, err %s", err)
+		return err
 	}
 
-	// get the policy
-	mgr := mspmgmt.GetManagerForChain(chdr.ChannelId)
-	pProvider := cauthdsl.NewPolicyProvider(mgr)
-	policy, _, err := pProvider.NewPolicy(args[2])
+	chdr, err := utils.UnmarshalChannelHeader(payl.Header.ChannelHeader)
 	if err != nil {
-		logger.Errorf("VSCC error: pProvider.NewPolicy failed, err %s", err)
-		return shim.Error(err.Error())
+		return err
 	}
 
 	// validate the payload type
 	if common.HeaderType(chdr.Type) != common.HeaderType_ENDORSER_TRANSACTION {
 		logger.Errorf("Only Endorser Transactions are supported, provided type %d", chdr.Type)
-		return shim.Error(fmt.Sprintf("Only Endorser Transactions are supported, provided type %d", chdr.Type))
+		return fmt.Errorf("Only Endorser Transactions are supported, provided type %d", chdr.Type)
 	}
 
 	// ...and the transaction...
 	tx, err := utils.GetTransaction(payl.Data)
 	if err != nil {
 		logger.Errorf("VSCC error: GetTransaction failed, err %s", err)
-		return shim.Error(err.Error())
+		return err
 	}
 
 	// loop through each of the actions within
@@ -153,67 +204,49 @@ func (vscc *ValidatorOneValidSignature) Invoke(stub shim.ChaincodeStubInterface)
 		cap, err := utils.GetChaincodeActionPayload(act.Payload)
 		if err != nil {
 			logger.Errorf("VSCC error: GetChaincodeActionPayload failed, err %s", err)
-			return shim.Error(err.Error())
+			return err
 		}
 
 		signatureSet, err := vscc.deduplicateIdentity(cap)
 		if err != nil {
-			return shim.Error(err.Error())
+			return err
 		}
 
 		// evaluate the signature set against the policy
-		err = policy.Evaluate(signatureSet)
+		err = vscc.policyEvaluator.Evaluate(policyBytes, signatureSet)
 		if err != nil {
 			logger.Warningf("Endorsement policy failure for transaction txid=%s, err: %s", chdr.GetTxId(), err.Error())
 			if len(signatureSet) < len(cap.Action.Endorsements) {
 				// Warning: duplicated identities exist, endorsement failure might be cause by this reason
-				return shim.Error(DUPLICATED_IDENTITY_ERROR)
+				return errors.New(DUPLICATED_IDENTITY_ERROR)
 			}
-			return shim.Error(fmt.Sprintf("VSCC error: endorsement policy failure, err: %s", err))
+			return fmt.Errorf("VSCC error: endorsement policy failure, err: %s", err)
 		}
 
 		hdrExt, err := utils.GetChaincodeHeaderExtension(payl.Header)
 		if err != nil {
 			logger.Errorf("VSCC error: GetChaincodeHeaderExtension failed, err %s", err)
-			return shim.Error(err.Error())
+			return err
 		}
 
 		// do some extra validation that is specific to lscc
 		if hdrExt.ChaincodeId.Name == "lscc" {
 			logger.Debugf("VSCC info: doing special validation for LSCC")
 
-			err = vscc.ValidateLSCCInvocation(stub, chdr.ChannelId, env, cap, payl, ac.Capabilities())
+			err = vscc.ValidateLSCCInvocation(chdr.ChannelId, env, cap, payl)
 			if err != nil {
 				logger.Errorf("VSCC error: ValidateLSCCInvocation failed, err %s", err)
-				response := shim.Error(err.Error())
-				if _, ok := err.(*intermittentError); ok {
-					response.Status = txvalidator.IntermittentErrorCode
-				}
-				return response
+				return err
 			}
 		}
 	}
 
-	logger.Debugf("VSCC exists successfully")
-
-	return shim.Success(nil)
+	return nil
 }
 
 // checkInstantiationPolicy evaluates an instantiation policy against a signed proposal
-func (vscc *ValidatorOneValidSignature) checkInstantiationPolicy(chainName string, env *common.Envelope, instantiationPolicy []byte, payl *common.Payload) error {
-	// create a policy object from the policy bytes
-	mgr := mspmgmt.GetManagerForChain(chainName)
-	if mgr == nil {
-		return fmt.Errorf("MSP manager for channel %s is nil, aborting", chainName)
-	}
-
-	npp := cauthdsl.NewPolicyProvider(mgr)
-	instPol, _, err := npp.NewPolicy(instantiationPolicy)
-	if err != nil {
-		return err
-	}
-
-	logger.Debugf("VSCC info: checkInstantiationPolicy starts, policy is %#v", instPol)
+func (vscc *Validator) checkInstantiationPolicy(chainName string, env *common.Envelope, instantiationPolicy []byte, payl *common.Payload) error {
+	logger.Debugf("VSCC info: checkInstantiationPolicy starts, policy is %#v", instantiationPolicy)
 
 	// get the signature header
 	shdr, err := utils.GetSignatureHeader(payl.Header.SignatureHeader)
@@ -227,7 +260,7 @@ func (vscc *ValidatorOneValidSignature) checkInstantiationPolicy(chainName strin
 		Identity:  shdr.Creator,
 		Signature: env.Signature,
 	}}
-	err = instPol.Evaluate(sd)
+	err = vscc.policyEvaluator.Evaluate(instantiationPolicy, sd)
 	if err != nil {
 		return fmt.Errorf("chaincode instantiation policy violated, error %s", err)
 	}
@@ -237,7 +270,7 @@ func (vscc *ValidatorOneValidSignature) checkInstantiationPolicy(chainName strin
 // validateDeployRWSetAndCollection performs validation of the rwset
 // of an LSCC deploy operation and then it validates any collection
 // configuration
-func (vscc *ValidatorOneValidSignature) validateDeployRWSetAndCollection(
+func (vscc *Validator) validateDeployRWSetAndCollection(
 	lsccrwset *kvrwset.KVRWSet,
 	cdRWSet *ccprovider.ChaincodeData,
 	lsccArgs [][]byte,
@@ -275,23 +308,22 @@ func (vscc *ValidatorOneValidSignature) validateDeployRWSetAndCollection(
 			cdRWSet.Name, cdRWSet.Version)
 	}
 
-	ccp, err := vscc.collectionStore.RetrieveCollectionConfigPackage(common.CollectionCriteria{Channel: chid, Namespace: ccid})
+	state, err := vscc.stateFetcher.FetchState()
 	if err != nil {
-		if _, ok := err.(*privdata.LedgerIOError); ok {
-			return &intermittentError{
-				msg: fmt.Sprintf("Ledger error while trying to retrieve collection config for chaincode %s:%s. Err:%s",
-					cdRWSet.Name, cdRWSet.Version, err),
-			}
+		return &validation.ExecutionFailureError{
+			Reason: fmt.Sprintf("could not retrieve state for channel %s, error %s", chid, err),
 		}
-		// fail if we get any error other than NoSuchCollectionError
-		// because it means something went wrong while looking up the
-		// older collection
-		if _, ok := err.(privdata.NoSuchCollectionError); !ok {
-			return errors.WithMessage(err, fmt.Sprintf("unable to check whether collection existed earlier for chaincode %s:%s",
-				cdRWSet.Name, cdRWSet.Version))
+	}
+	defer state.Done()
+
+	ccp, err := state.GetStateMultipleKeys("lscc", []string{privdata.BuildCollectionKVSKey(ccid)})
+	if err != nil {
+		return &validation.ExecutionFailureError{
+			Reason: fmt.Sprintf("Ledger error while trying to retrieve collection config for chaincode %s:%s. Err:%s",
+				cdRWSet.Name, cdRWSet.Version, err),
 		}
 	}
-	if ccp != nil {
+	if ccp[0] != nil {
 		return errors.Errorf("collection data should not exist for chaincode %s:%s", cdRWSet.Name, cdRWSet.Version)
 	}
 
@@ -309,13 +341,13 @@ func (vscc *ValidatorOneValidSignature) validateDeployRWSetAndCollection(
 	return nil
 }
 
-func (vscc *ValidatorOneValidSignature) ValidateLSCCInvocation(
-	stub shim.ChaincodeStubInterface,
+// ValidateLSCCInvocation performs the validation that is specific
+// to transactions that deploy or upgrade chaincodes through lscc
+func (vscc *Validator) ValidateLSCCInvocation(
 	chid string,
 	env *common.Envelope,
 	cap *pb.ChaincodeActionPayload,
 	payl *common.Payload,
-	ac channelconfig.ApplicationCapabilities,
 ) error {
 	cpp, err := utils.GetChaincodeProposalPayload(cap.ChaincodeProposalPayload)
 	if err != nil {
@@ -350,8 +382,8 @@ func (vscc *ValidatorOneValidSignature) ValidateLSCCInvocation(
 			return fmt.Errorf("Wrong number of arguments for invocation lscc(%s): expected at least 2, received %d", lsccFunc, len(lsccArgs))
 		}
 
-		if (!ac.PrivateChannelData() && len(lsccArgs) > 5) ||
-			(ac.PrivateChannelData() && len(lsccArgs) > 6) {
+		if (!vscc.capabilities.PrivateChannelData() && len(lsccArgs) > 5) ||
+			(vscc.capabilities.PrivateChannelData() && len(lsccArgs) > 6) {
 			return fmt.Errorf("Wrong number of arguments for invocation lscc(%s): received %d", lsccFunc, len(lsccArgs))
 		}
 
@@ -441,7 +473,7 @@ func (vscc *ValidatorOneValidSignature) ValidateLSCCInvocation(
 			/****************************************************************************/
 			/* security check 0.a - validation of rwset (and of collections if enabled) */
 			/****************************************************************************/
-			if ac.PrivateChannelData() {
+			if vscc.capabilities.PrivateChannelData() {
 				// do extra validation for collections
 				err = vscc.validateDeployRWSetAndCollection(lsccrwset, cdRWSet, lsccArgs, chid, cdsArgs.ChaincodeSpec.ChaincodeId.Name)
 				if err != nil {
@@ -521,7 +553,7 @@ func (vscc *ValidatorOneValidSignature) ValidateLSCCInvocation(
 			/******************************************************************/
 			/* security check 4 - check the instantiation policy in the rwset */
 			/******************************************************************/
-			if ac.V1_1Validation() {
+			if vscc.capabilities.V1_1Validation() {
 				polNew := cdRWSet.InstantiationPolicy
 				if polNew == nil {
 					return errors.New("No instantiation policy was specified")
@@ -544,22 +576,23 @@ func (vscc *ValidatorOneValidSignature) ValidateLSCCInvocation(
 	}
 }
 
-func (vscc *ValidatorOneValidSignature) getInstantiatedCC(chid, ccid string) (cd *ccprovider.ChaincodeData, exists bool, err error) {
-	qe, err := vscc.sccprovider.GetQueryExecutorForLedger(chid)
+func (vscc *Validator) getInstantiatedCC(chid, ccid string) (cd *ccprovider.ChaincodeData, exists bool, err error) {
+	qe, err := vscc.stateFetcher.FetchState()
 	if err != nil {
 		err = fmt.Errorf("Could not retrieve QueryExecutor for channel %s, error %s", chid, err)
 		return
 	}
 	defer qe.Done()
 
-	bytes, err := qe.GetState("lscc", ccid)
+	res, err := qe.GetStateMultipleKeys("lscc", []string{ccid})
 	if err != nil {
-		err = &intermittentError{
-			msg: fmt.Sprintf("Could not retrieve state for chaincode %s on channel %s, error %s", ccid, chid, err),
+		err = &validation.ExecutionFailureError{
+			Reason: fmt.Sprintf("Could not retrieve state for chaincode %s on channel %s, error %s", ccid, chid, err),
 		}
 		return
 	}
 
+	bytes := res[0]
 	if bytes == nil {
 		return
 	}
@@ -575,7 +608,7 @@ func (vscc *ValidatorOneValidSignature) getInstantiatedCC(chid, ccid string) (cd
 	return
 }
 
-func (vscc *ValidatorOneValidSignature) deduplicateIdentity(cap *pb.ChaincodeActionPayload) ([]*common.SignedData, error) {
+func (vscc *Validator) deduplicateIdentity(cap *pb.ChaincodeActionPayload) ([]*common.SignedData, error) {
 	// this is the first part of the signed message
 	prespBytes := cap.Action.ProposalResponsePayload
 
@@ -609,11 +642,3 @@ func (vscc *ValidatorOneValidSignature) deduplicateIdentity(cap *pb.ChaincodeAct
 	logger.Debugf("Signature set is of size %d out of %d endorsement(s)", len(signatureSet), len(cap.Action.Endorsements))
 	return signatureSet, nil
 }
-
-type intermittentError struct {
-	msg string
-}
-
-func (e *intermittentError) Error() string {
-	return e.msg
-}
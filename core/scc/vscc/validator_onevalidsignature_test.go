@@ -25,6 +25,7 @@ import (
 	"github.com/hyperledger/fabric/core/common/privdata"
 	"github.com/hyperledger/fabric/core/common/sysccprovider"
 	cutils "github.com/hyperledger/fabric/core/container/util"
+	"github.com/hyperledger/fabric/core/handlers/validation"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
 	per "github.com/hyperledger/fabric/core/peer"
 	"github.com/hyperledger/fabric/core/policy"
@@ -377,7 +378,7 @@ func TestInvalidFunction(t *testing.T) {
 	State := make(map[string]map[string][]byte)
 	State["lscc"] = stublccc.State
 	sysccprovider.RegisterSystemChaincodeProviderFactory(&scc.MocksccProviderFactory{
-		Qe: lm.NewMockQueryExecutor(State),
+		Qe:                    lm.NewMockQueryExecutor(State),
 		ApplicationConfigBool: true,
 		ApplicationConfigRv:   &mc.MockApplication{&mc.MockApplicationCapabilities{}},
 	})
@@ -433,7 +434,7 @@ func TestRWSetTooBig(t *testing.T) {
 	State := make(map[string]map[string][]byte)
 	State["lscc"] = stublccc.State
 	sysccprovider.RegisterSystemChaincodeProviderFactory(&scc.MocksccProviderFactory{
-		Qe: lm.NewMockQueryExecutor(State),
+		Qe:                    lm.NewMockQueryExecutor(State),
 		ApplicationConfigBool: true,
 		ApplicationConfigRv:   &mc.MockApplication{&mc.MockApplicationCapabilities{}},
 	})
@@ -502,7 +503,7 @@ func TestValidateDeployFail(t *testing.T) {
 	State := make(map[string]map[string][]byte)
 	State["lscc"] = stublccc.State
 	sysccprovider.RegisterSystemChaincodeProviderFactory(&scc.MocksccProviderFactory{
-		Qe: lm.NewMockQueryExecutor(State),
+		Qe:                    lm.NewMockQueryExecutor(State),
 		ApplicationConfigBool: true,
 		ApplicationConfigRv:   &mc.MockApplication{&mc.MockApplicationCapabilities{}},
 	})
@@ -791,7 +792,7 @@ func TestAlreadyDeployed(t *testing.T) {
 	State := make(map[string]map[string][]byte)
 	State["lscc"] = stublccc.State
 	sysccprovider.RegisterSystemChaincodeProviderFactory(&scc.MocksccProviderFactory{
-		Qe: lm.NewMockQueryExecutor(State),
+		Qe:                    lm.NewMockQueryExecutor(State),
 		ApplicationConfigBool: true,
 		ApplicationConfigRv:   &mc.MockApplication{&mc.MockApplicationCapabilities{}},
 	})
@@ -870,7 +871,7 @@ func TestValidateDeployNoLedger(t *testing.T) {
 	State := make(map[string]map[string][]byte)
 	State["lscc"] = stublccc.State
 	sysccprovider.RegisterSystemChaincodeProviderFactory(&scc.MocksccProviderFactory{
-		QErr: fmt.Errorf("Simulated error"),
+		QErr:                  fmt.Errorf("Simulated error"),
 		ApplicationConfigBool: true,
 		ApplicationConfigRv:   &mc.MockApplication{&mc.MockApplicationCapabilities{}},
 	})
@@ -928,7 +929,7 @@ func TestValidateDeployOK(t *testing.T) {
 	State := make(map[string]map[string][]byte)
 	State["lscc"] = stublccc.State
 	sysccprovider.RegisterSystemChaincodeProviderFactory(&scc.MocksccProviderFactory{
-		Qe: lm.NewMockQueryExecutor(State),
+		Qe:                    lm.NewMockQueryExecutor(State),
 		ApplicationConfigBool: true,
 		ApplicationConfigRv:   &mc.MockApplication{&mc.MockApplicationCapabilities{}},
 	})
@@ -986,7 +987,7 @@ func TestValidateDeployWithPolicies(t *testing.T) {
 	State := make(map[string]map[string][]byte)
 	State["lscc"] = stublccc.State
 	sysccprovider.RegisterSystemChaincodeProviderFactory(&scc.MocksccProviderFactory{
-		Qe: lm.NewMockQueryExecutor(State),
+		Qe:                    lm.NewMockQueryExecutor(State),
 		ApplicationConfigBool: true,
 		ApplicationConfigRv:   &mc.MockApplication{&mc.MockApplicationCapabilities{}},
 	})
@@ -1074,7 +1075,7 @@ func TestInvalidUpgrade(t *testing.T) {
 	State := make(map[string]map[string][]byte)
 	State["lscc"] = stublccc.State
 	sysccprovider.RegisterSystemChaincodeProviderFactory(&scc.MocksccProviderFactory{
-		Qe: lm.NewMockQueryExecutor(State),
+		Qe:                    lm.NewMockQueryExecutor(State),
 		ApplicationConfigBool: true,
 		ApplicationConfigRv:   &mc.MockApplication{&mc.MockApplicationCapabilities{}},
 	})
@@ -1130,7 +1131,7 @@ func TestValidateUpgradeOK(t *testing.T) {
 	State := make(map[string]map[string][]byte)
 	State["lscc"] = stublccc.State
 	sysccprovider.RegisterSystemChaincodeProviderFactory(&scc.MocksccProviderFactory{
-		Qe: lm.NewMockQueryExecutor(State),
+		Qe:                    lm.NewMockQueryExecutor(State),
 		ApplicationConfigBool: true,
 		ApplicationConfigRv:   &mc.MockApplication{&mc.MockApplicationCapabilities{}},
 	})
@@ -1211,7 +1212,7 @@ func TestInvalidateUpgradeBadVersion(t *testing.T) {
 	State := make(map[string]map[string][]byte)
 	State["lscc"] = stublccc.State
 	sysccprovider.RegisterSystemChaincodeProviderFactory(&scc.MocksccProviderFactory{
-		Qe: lm.NewMockQueryExecutor(State),
+		Qe:                    lm.NewMockQueryExecutor(State),
 		ApplicationConfigBool: true,
 		ApplicationConfigRv:   &mc.MockApplication{&mc.MockApplicationCapabilities{}},
 	})
@@ -1290,7 +1291,7 @@ func TestValidateUpgradeWithPoliciesOK(t *testing.T) {
 	State := make(map[string]map[string][]byte)
 	State["lscc"] = stublccc.State
 	sysccprovider.RegisterSystemChaincodeProviderFactory(&scc.MocksccProviderFactory{
-		Qe: lm.NewMockQueryExecutor(State),
+		Qe:                    lm.NewMockQueryExecutor(State),
 		ApplicationConfigBool: true,
 		ApplicationConfigRv:   &mc.MockApplication{&mc.MockApplicationCapabilities{}},
 	})
@@ -1388,7 +1389,7 @@ func validateUpgradeWithNewFailAllIP(t *testing.T, v11capability, expecterr bool
 	State := make(map[string]map[string][]byte)
 	State["lscc"] = stublccc.State
 	sysccprovider.RegisterSystemChaincodeProviderFactory(&scc.MocksccProviderFactory{
-		Qe: lm.NewMockQueryExecutor(State),
+		Qe:                    lm.NewMockQueryExecutor(State),
 		ApplicationConfigBool: true,
 		ApplicationConfigRv:   &mc.MockApplication{&mc.MockApplicationCapabilities{V1_1ValidationRv: v11capability}},
 	})
@@ -1485,7 +1486,7 @@ func TestValidateUpgradeWithPoliciesFail(t *testing.T) {
 	State := make(map[string]map[string][]byte)
 	State["lscc"] = stublccc.State
 	sysccprovider.RegisterSystemChaincodeProviderFactory(&scc.MocksccProviderFactory{
-		Qe: lm.NewMockQueryExecutor(State),
+		Qe:                    lm.NewMockQueryExecutor(State),
 		ApplicationConfigBool: true,
 		ApplicationConfigRv:   &mc.MockApplication{&mc.MockApplicationCapabilities{}},
 	})
@@ -1591,18 +1592,10 @@ func TestValidateDeployRWSetAndCollection(t *testing.T) {
 
 	cd := &ccprovider.ChaincodeData{Name: "mycc"}
 
-	v := new(ValidatorOneValidSignature)
-	stub := shim.NewMockStub("validatoronevalidsignature", v)
-
 	State := make(map[string]map[string][]byte)
 	State["lscc"] = make(map[string][]byte)
-	sysccprovider.RegisterSystemChaincodeProviderFactory(&scc.MocksccProviderFactory{Qe: lm.NewMockQueryExecutor(State)})
-
-	r1 := stub.MockInit("1", [][]byte{})
-	if r1.Status != shim.OK {
-		fmt.Println("Init failed", string(r1.Message))
-		t.FailNow()
-	}
+	sf := &stateFetcher{chainID: chid, sccprovider: &scc.MocksccProviderImpl{Qe: lm.NewMockQueryExecutor(State)}}
+	v := New(&mc.MockApplicationCapabilities{}, sf, &txvalidator.PolicyEvaluator{IdentityDeserializer: mspmgmt.GetManagerForChain(chid)})
 
 	rwset := &kvrwset.KVRWSet{Writes: []*kvrwset.KVWrite{{Key: "a"}, {Key: "b"}, {Key: "c"}}}
 
@@ -1652,11 +1645,11 @@ func TestValidateDeployRWSetAndCollection(t *testing.T) {
 	err = v.validateDeployRWSetAndCollection(rwset, cd, lsccargs, chid, ccid)
 	assertNonIntermittentError(t, err)
 
-	State["lscc"][(&collectionStoreSupport{v.sccprovider}).GetCollectionKVSKey(common.CollectionCriteria{Channel: chid, Namespace: ccid})] = []byte("barf")
+	State["lscc"][privdata.BuildCollectionKVSKey(ccid)] = []byte("barf")
 	err = v.validateDeployRWSetAndCollection(rwset, cd, lsccargs, chid, ccid)
 	assertNonIntermittentError(t, err)
 
-	State["lscc"][(&collectionStoreSupport{v.sccprovider}).GetCollectionKVSKey(common.CollectionCriteria{Channel: chid, Namespace: ccid})] = ccpBytes
+	State["lscc"][privdata.BuildCollectionKVSKey(ccid)] = ccpBytes
 	err = v.validateDeployRWSetAndCollection(rwset, cd, lsccargs, chid, ccid)
 	assertNonIntermittentError(t, err)
 
@@ -1734,7 +1727,7 @@ func TestIntermittentErrorResponse(t *testing.T) {
 	stublccc := shim.NewMockStub("lscc", lccc)
 
 	sysccprovider.RegisterSystemChaincodeProviderFactory(&scc.MocksccProviderFactory{
-		Qe: lm.NewMockQueryExecutor(nil), // mock query executor causes an error if supplied with an empty state
+		Qe:                    lm.NewMockQueryExecutor(nil), // mock query executor causes an error if supplied with an empty state
 		ApplicationConfigBool: true,
 		ApplicationConfigRv:   &mc.MockApplication{&mc.MockApplicationCapabilities{}},
 	})
@@ -1785,11 +1778,11 @@ func TestIntermittentErrorResponse(t *testing.T) {
 }
 
 func assertIntermittentError(t *testing.T, err error) {
-	_, ok := err.(*intermittentError)
+	_, ok := err.(*validation.ExecutionFailureError)
 	assert.True(t, ok)
 }
 
 func assertNonIntermittentError(t *testing.T, err error) {
-	_, ok := err.(*intermittentError)
+	_, ok := err.(*validation.ExecutionFailureError)
 	assert.False(t, ok)
 }
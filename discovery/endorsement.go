@@ -0,0 +1,191 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package discovery
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/protos/common"
+	discprotos "github.com/hyperledger/fabric/protos/discovery"
+	"github.com/pkg/errors"
+)
+
+// principalCombination maps indices of the principals of a
+// policy to the number of signatures needed from each of them
+type principalCombination map[int32]uint32
+
+// endorsementDescriptor computes the plans to endorse proposals to the given
+// chaincode, by the given peers of the channel that have the chaincode installed
+func (s *service) endorsementDescriptor(channel, chaincode string, peers []*discprotos.Peer) (*discprotos.EndorsementDescriptor, error) {
+	policy, err := s.EndorsementPolicy(channel, chaincode)
+	if err != nil {
+		return nil, err
+	}
+	if policy.Rule == nil {
+		return nil, errors.New("the endorsement policy has no rule")
+	}
+	combinations, err := principalCombinations(policy.Rule, len(policy.Identities))
+	if err != nil {
+		return nil, errors.WithMessage(err, "invalid endorsement policy")
+	}
+
+	// principals that are equal share a group
+	groupOf := make([]string, len(policy.Identities))
+	for i, principal := range policy.Identities {
+		groupOf[i] = fmt.Sprintf("G%d", i)
+		for j := 0; j < i; j++ {
+			if proto.Equal(principal, policy.Identities[j]) {
+				groupOf[i] = groupOf[j]
+				break
+			}
+		}
+	}
+
+	endorsers := make(map[string]*discprotos.Peers)
+	for i, principal := range policy.Identities {
+		if _, exists := endorsers[groupOf[i]]; exists {
+			continue
+		}
+		group := &discprotos.Peers{}
+		for _, peer := range peers {
+			if !hasChaincode(peer, chaincode) {
+				continue
+			}
+			if err := s.SatisfiesPrincipal(channel, peer.Identity, principal); err != nil {
+				continue
+			}
+			group.Peers = append(group.Peers, peer)
+		}
+		endorsers[groupOf[i]] = group
+	}
+
+	descriptor := &discprotos.EndorsementDescriptor{
+		Chaincode:         chaincode,
+		EndorsersByGroups: make(map[string]*discprotos.Peers),
+	}
+	layouts := make(map[string]struct{})
+	for _, combination := range combinations {
+		quantities := make(map[string]uint32)
+		for principal, count := range combination {
+			quantities[groupOf[principal]] += count
+		}
+		if !satisfiable(quantities, endorsers) {
+			continue
+		}
+		key := layoutKey(quantities)
+		if _, exists := layouts[key]; exists {
+			continue
+		}
+		layouts[key] = struct{}{}
+		descriptor.Layouts = append(descriptor.Layouts, &discprotos.Layout{QuantitiesByGroup: quantities})
+		for group := range quantities {
+			descriptor.EndorsersByGroups[group] = endorsers[group]
+		}
+	}
+	if len(descriptor.Layouts) == 0 {
+		return nil, errors.New("no combination of peers that have the chaincode installed satisfies its endorsement policy")
+	}
+	return descriptor, nil
+}
+
+// principalCombinations returns the combinations of signatures of principals satisfying the given rule.
+// As a signature satisfies a single principal of a rule, the numbers of signatures needed
+// by the sub-rules of a rule add up.
+func principalCombinations(rule *common.SignaturePolicy, principalCount int) ([]principalCombination, error) {
+	switch t := rule.Type.(type) {
+	case *common.SignaturePolicy_SignedBy:
+		if t.SignedBy < 0 || int(t.SignedBy) >= principalCount {
+			return nil, errors.Errorf("principal %d doesn't exist", t.SignedBy)
+		}
+		return []principalCombination{{t.SignedBy: 1}}, nil
+	case *common.SignaturePolicy_NOutOf_:
+		var subCombinations [][]principalCombination
+		for _, subRule := range t.NOutOf.Rules {
+			combinations, err := principalCombinations(subRule, principalCount)
+			if err != nil {
+				return nil, err
+			}
+			subCombinations = append(subCombinations, combinations)
+		}
+		var combinations []principalCombination
+		for _, subset := range chooseSubsets(len(t.NOutOf.Rules), int(t.NOutOf.N)) {
+			product := []principalCombination{{}}
+			for _, i := range subset {
+				var next []principalCombination
+				for _, partial := range product {
+					for _, combination := range subCombinations[i] {
+						next = append(next, partial.merge(combination))
+					}
+				}
+				product = next
+			}
+			combinations = append(combinations, product...)
+		}
+		return combinations, nil
+	default:
+		return nil, errors.Errorf("unknown rule type %T", rule.Type)
+	}
+}
+
+// merge returns the combination needing the signatures of both combinations
+func (c principalCombination) merge(other principalCombination) principalCombination {
+	merged := make(principalCombination, len(c)+len(other))
+	for principal, count := range c {
+		merged[principal] += count
+	}
+	for principal, count := range other {
+		merged[principal] += count
+	}
+	return merged
+}
+
+// chooseSubsets returns the subsets of size k of the indices 0..n-1
+func chooseSubsets(n, k int) [][]int {
+	if k < 0 || k > n {
+		return nil
+	}
+	if k == 0 {
+		return [][]int{{}}
+	}
+	// subsets without the last index, and subsets with it
+	subsets := chooseSubsets(n-1, k)
+	for _, subset := range chooseSubsets(n-1, k-1) {
+		subsets = append(subsets, append(append([]int{}, subset...), n-1))
+	}
+	return subsets
+}
+
+// satisfiable returns whether each group has enough peers
+func satisfiable(quantities map[string]uint32, endorsers map[string]*discprotos.Peers) bool {
+	for group, quantity := range quantities {
+		if uint32(len(endorsers[group].Peers)) < quantity {
+			return false
+		}
+	}
+	return true
+}
+
+func layoutKey(quantities map[string]uint32) string {
+	var entries []string
+	for group, quantity := range quantities {
+		entries = append(entries, fmt.Sprintf("%s:%d", group, quantity))
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
+}
+
+func hasChaincode(peer *discprotos.Peer, chaincode string) bool {
+	for _, cc := range peer.Chaincodes {
+		if cc.Name == chaincode {
+			return true
+		}
+	}
+	return false
+}
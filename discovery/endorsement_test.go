@@ -0,0 +1,85 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package discovery
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/protos/common"
+	discprotos "github.com/hyperledger/fabric/protos/discovery"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrincipalCombinations(t *testing.T) {
+	// 2 out of A, B and (C and D)
+	rule := cauthdsl.NOutOf(2, []*common.SignaturePolicy{
+		cauthdsl.SignedBy(0),
+		cauthdsl.SignedBy(1),
+		cauthdsl.And(cauthdsl.SignedBy(2), cauthdsl.SignedBy(3)),
+	})
+	combinations, err := principalCombinations(rule, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, []principalCombination{
+		{0: 1, 1: 1},
+		{0: 1, 2: 1, 3: 1},
+		{1: 1, 2: 1, 3: 1},
+	}, combinations)
+
+	// signatures satisfy a single principal of a rule
+	combinations, err = principalCombinations(cauthdsl.And(cauthdsl.SignedBy(0), cauthdsl.SignedBy(0)), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []principalCombination{{0: 2}}, combinations)
+
+	// rules that can't be satisfied have no combinations
+	combinations, err = principalCombinations(cauthdsl.NOutOf(3, []*common.SignaturePolicy{cauthdsl.SignedBy(0)}), 1)
+	assert.NoError(t, err)
+	assert.Empty(t, combinations)
+
+	_, err = principalCombinations(cauthdsl.SignedBy(1), 1)
+	assert.EqualError(t, err, "principal 1 doesn't exist")
+	_, err = principalCombinations(&common.SignaturePolicy{}, 1)
+	assert.Error(t, err)
+}
+
+func TestChooseSubsets(t *testing.T) {
+	assert.Equal(t, [][]int{{0, 1}, {0, 2}, {1, 2}}, chooseSubsets(3, 2))
+	assert.Equal(t, [][]int{{}}, chooseSubsets(2, 0))
+	assert.Nil(t, chooseSubsets(2, 3))
+}
+
+func TestEndorsementDescriptorEqualPrincipals(t *testing.T) {
+	peers := []*discprotos.Peer{
+		newPeer("Org1MSP", "p0.org1:7051", "mycc"),
+		newPeer("Org1MSP", "p1.org1:7051", "mycc"),
+		newPeer("Org2MSP", "p0.org2:7051", "mycc"),
+	}
+	svc := &service{Support: &mockSupport{
+		policies: map[string]string{
+			// equal principals share a group, which needs as many peers
+			// as the principals of the group in the combination
+			"mycc": "OR(AND('Org1MSP.member', 'Org1MSP.member'), AND('Org1MSP.member', 'Org2MSP.member'))",
+		},
+	}}
+	descriptor, err := svc.endorsementDescriptor("mychannel", "mycc", peers)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*discprotos.Peers{
+		"G0": {Peers: peers[:2]},
+		"G3": {Peers: peers[2:]},
+	}, descriptor.EndorsersByGroups)
+	assert.Equal(t, []*discprotos.Layout{
+		{QuantitiesByGroup: map[string]uint32{"G0": 2}},
+		{QuantitiesByGroup: map[string]uint32{"G0": 1, "G3": 1}},
+	}, descriptor.Layouts)
+
+	// layouts needing more peers than their groups have are discarded
+	descriptor, err = svc.endorsementDescriptor("mychannel", "mycc", peers[1:])
+	assert.NoError(t, err)
+	assert.Equal(t, []*discprotos.Layout{
+		{QuantitiesByGroup: map[string]uint32{"G0": 1, "G3": 1}},
+	}, descriptor.Layouts)
+}
@@ -0,0 +1,206 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package discovery
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/core/comm"
+	"github.com/hyperledger/fabric/protos/common"
+	discprotos "github.com/hyperledger/fabric/protos/discovery"
+	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+var logger = flogging.MustGetLogger("discovery")
+
+// Support provides the information the discovery service
+// serves about the channels the peer joined
+type Support interface {
+	// EligibleForService returns nil if the given data was signed by an identity
+	// that is allowed to query the given channel, or an error otherwise
+	EligibleForService(channel string, data common.SignedData) error
+
+	// PeersOfChannel returns the peers of the given channel,
+	// including the peer itself
+	PeersOfChannel(channel string) []*discprotos.Peer
+
+	// Config returns the MSPs and the orderers of the given channel
+	Config(channel string) (*discprotos.ConfigResult, error)
+
+	// EndorsementPolicy returns the endorsement policy of
+	// the given chaincode of the given channel
+	EndorsementPolicy(channel, chaincode string) (*common.SignaturePolicyEnvelope, error)
+
+	// SatisfiesPrincipal returns nil if the given serialized identity satisfies
+	// the principal according to the MSPs of the given channel, or an error otherwise
+	SatisfiesPrincipal(channel string, identity []byte, principal *msp.MSPPrincipal) error
+}
+
+type service struct {
+	Support
+	timeWindow       time.Duration
+	bindingInspector comm.BindingInspector
+	now              func() time.Time
+}
+
+// NewService creates a discovery service that serves the information the given
+// support provides. Requests must have been created within the given time window
+// of the current time, and if mutualTLS is set, they must be bound to the TLS
+// certificate of the client, so that they can't be replayed.
+func NewService(sup Support, timeWindow time.Duration, mutualTLS bool) discprotos.DiscoveryServer {
+	return &service{
+		Support:          sup,
+		timeWindow:       timeWindow,
+		bindingInspector: comm.NewBindingInspector(mutualTLS, extractTLSCertHash),
+		now:              time.Now,
+	}
+}
+
+func extractTLSCertHash(msg proto.Message) []byte {
+	authInfo, isAuthInfo := msg.(*discprotos.AuthInfo)
+	if !isAuthInfo {
+		return nil
+	}
+	return authInfo.ClientTlsCertHash
+}
+
+// Discover receives a signed request, and returns a response
+// with a result for each of the queries of the request
+func (s *service) Discover(ctx context.Context, request *discprotos.SignedRequest) (*discprotos.Response, error) {
+	req, err := validateStructure(request)
+	if err != nil {
+		logger.Warningf("Request is malformed: %v", err)
+		return nil, errors.WithMessage(err, "request is malformed")
+	}
+	if err := s.validateAuthentication(ctx, req.Authentication); err != nil {
+		logger.Warningf("Request failed authentication: %v", err)
+		return nil, errors.WithMessage(err, "access denied")
+	}
+	data := common.SignedData{
+		Data:      request.Payload,
+		Identity:  req.Authentication.ClientIdentity,
+		Signature: request.Signature,
+	}
+	resp := &discprotos.Response{}
+	for _, query := range req.Queries {
+		resp.Results = append(resp.Results, s.processQuery(query, data))
+	}
+	return resp, nil
+}
+
+func (s *service) processQuery(query *discprotos.Query, data common.SignedData) *discprotos.QueryResult {
+	if query.Channel == "" {
+		return wrapError(errors.New("no channel was specified"))
+	}
+	// the same error is returned for channels the peer didn't join,
+	// in order not to disclose them to clients that aren't eligible
+	if err := s.EligibleForService(query.Channel, data); err != nil {
+		logger.Warningf("Client isn't eligible to query channel %s: %v", query.Channel, err)
+		return wrapError(errors.New("access denied"))
+	}
+
+	switch q := query.Query.(type) {
+	case *discprotos.Query_ConfigQuery:
+		return s.configQuery(query.Channel)
+	case *discprotos.Query_PeerQuery:
+		return s.peerQuery(query.Channel)
+	case *discprotos.Query_CcQuery:
+		return s.chaincodeQuery(query.Channel, q.CcQuery)
+	default:
+		return wrapError(errors.New("unknown or missing query type"))
+	}
+}
+
+func (s *service) configQuery(channel string) *discprotos.QueryResult {
+	config, err := s.Config(channel)
+	if err != nil {
+		logger.Errorf("Failed retrieving the configuration of channel %s: %+v", channel, err)
+		return wrapError(errors.WithMessage(err, "failed retrieving the configuration of the channel"))
+	}
+	return &discprotos.QueryResult{
+		Result: &discprotos.QueryResult_ConfigResult{ConfigResult: config},
+	}
+}
+
+func (s *service) peerQuery(channel string) *discprotos.QueryResult {
+	peersByOrg := make(map[string]*discprotos.Peers)
+	for _, peer := range s.PeersOfChannel(channel) {
+		if _, exists := peersByOrg[peer.MspId]; !exists {
+			peersByOrg[peer.MspId] = &discprotos.Peers{}
+		}
+		peersByOrg[peer.MspId].Peers = append(peersByOrg[peer.MspId].Peers, peer)
+	}
+	return &discprotos.QueryResult{
+		Result: &discprotos.QueryResult_Members{
+			Members: &discprotos.PeerMembershipResult{PeersByOrg: peersByOrg},
+		},
+	}
+}
+
+func (s *service) chaincodeQuery(channel string, query *discprotos.ChaincodeQuery) *discprotos.QueryResult {
+	if len(query.Chaincodes) == 0 {
+		return wrapError(errors.New("no chaincodes were specified"))
+	}
+	peers := s.PeersOfChannel(channel)
+	result := &discprotos.ChaincodeQueryResult{}
+	for _, chaincode := range query.Chaincodes {
+		descriptor, err := s.endorsementDescriptor(channel, chaincode, peers)
+		if err != nil {
+			logger.Debugf("Failed constructing the endorsement plans of chaincode %s of channel %s: %v", chaincode, channel, err)
+			return wrapError(errors.WithMessage(err, "failed constructing the endorsement plans of chaincode "+chaincode))
+		}
+		result.Content = append(result.Content, descriptor)
+	}
+	return &discprotos.QueryResult{
+		Result: &discprotos.QueryResult_CcQueryRes{CcQueryRes: result},
+	}
+}
+
+// validateStructure validates that the request contains
+// queries and identifies the client that signed it
+func validateStructure(request *discprotos.SignedRequest) (*discprotos.Request, error) {
+	if request == nil {
+		return nil, errors.New("nil request")
+	}
+	req := &discprotos.Request{}
+	if err := proto.Unmarshal(request.Payload, req); err != nil {
+		return nil, errors.Wrap(err, "failed parsing the payload")
+	}
+	if req.Authentication == nil || len(req.Authentication.ClientIdentity) == 0 {
+		return nil, errors.New("no client identity was specified")
+	}
+	if len(req.Queries) == 0 {
+		return nil, errors.New("no queries were specified")
+	}
+	return req, nil
+}
+
+// validateAuthentication validates that the request was created within the time
+// window of the current time, and that it is bound to the TLS session if required
+func (s *service) validateAuthentication(ctx context.Context, authInfo *discprotos.AuthInfo) error {
+	if authInfo.Timestamp == nil {
+		return errors.New("no timestamp was specified")
+	}
+	reqTime := time.Unix(authInfo.Timestamp.Seconds, int64(authInfo.Timestamp.Nanos)).UTC()
+	serverTime := s.now()
+	if diff := serverTime.Sub(reqTime); diff > s.timeWindow || diff < -s.timeWindow {
+		return errors.Errorf("timestamp %s is more than the %s time window difference above/below server time %s. either the server and client clocks are out of sync or a relay attack has been attempted", reqTime, s.timeWindow, serverTime)
+	}
+	return s.bindingInspector(ctx, authInfo)
+}
+
+func wrapError(err error) *discprotos.QueryResult {
+	return &discprotos.QueryResult{
+		Result: &discprotos.QueryResult_Error{
+			Error: &discprotos.Error{Content: err.Error()},
+		},
+	}
+}
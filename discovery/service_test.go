@@ -0,0 +1,268 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package discovery
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/protos/common"
+	discprotos "github.com/hyperledger/fabric/protos/discovery"
+	gproto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/credentials"
+	grpcpeer "google.golang.org/grpc/peer"
+)
+
+type mockSupport struct {
+	eligible map[string][]byte
+	peers    []*discprotos.Peer
+	config   *discprotos.ConfigResult
+	policies map[string]string
+}
+
+func (ms *mockSupport) EligibleForService(channel string, data common.SignedData) error {
+	if identity, exists := ms.eligible[channel]; exists && string(identity) == string(data.Identity) {
+		return nil
+	}
+	return errors.New("not eligible")
+}
+
+func (ms *mockSupport) PeersOfChannel(channel string) []*discprotos.Peer {
+	return ms.peers
+}
+
+func (ms *mockSupport) Config(channel string) (*discprotos.ConfigResult, error) {
+	if ms.config == nil {
+		return nil, errors.New("no config")
+	}
+	return ms.config, nil
+}
+
+func (ms *mockSupport) EndorsementPolicy(channel, chaincode string) (*common.SignaturePolicyEnvelope, error) {
+	policy, exists := ms.policies[chaincode]
+	if !exists {
+		return nil, errors.Errorf("chaincode %s isn't instantiated", chaincode)
+	}
+	return cauthdsl.FromString(policy)
+}
+
+// SatisfiesPrincipal accepts the members of the MSP of role principals
+func (ms *mockSupport) SatisfiesPrincipal(channel string, identity []byte, principal *msp.MSPPrincipal) error {
+	sID := &msp.SerializedIdentity{}
+	if err := proto.Unmarshal(identity, sID); err != nil {
+		return err
+	}
+	role := &msp.MSPRole{}
+	if err := proto.Unmarshal(principal.Principal, role); err != nil {
+		return err
+	}
+	if role.MspIdentifier != sID.Mspid {
+		return errors.New("not a member")
+	}
+	return nil
+}
+
+func newPeer(mspID, endpoint string, chaincodes ...string) *discprotos.Peer {
+	peer := &discprotos.Peer{
+		MspId:        mspID,
+		Endpoint:     endpoint,
+		Identity:     utils.MarshalOrPanic(&msp.SerializedIdentity{Mspid: mspID, IdBytes: []byte(endpoint)}),
+		LedgerHeight: 10,
+	}
+	for _, cc := range chaincodes {
+		peer.Chaincodes = append(peer.Chaincodes, &gproto.Chaincode{Name: cc, Version: "1.0"})
+	}
+	return peer
+}
+
+func signedRequest(identity []byte, queries ...*discprotos.Query) *discprotos.SignedRequest {
+	return signedRequestWithAuth(&discprotos.AuthInfo{ClientIdentity: identity, Timestamp: util.CreateUtcTimestamp()}, queries...)
+}
+
+func signedRequestWithAuth(authInfo *discprotos.AuthInfo, queries ...*discprotos.Query) *discprotos.SignedRequest {
+	req := &discprotos.Request{
+		Authentication: authInfo,
+		Queries:        queries,
+	}
+	return &discprotos.SignedRequest{Payload: utils.MarshalOrPanic(req), Signature: []byte("signature")}
+}
+
+func TestDiscoverMalformedRequests(t *testing.T) {
+	svc := NewService(&mockSupport{}, time.Minute, false)
+	query := &discprotos.Query{Channel: "mychannel", Query: &discprotos.Query_PeerQuery{PeerQuery: &discprotos.PeerMembershipQuery{}}}
+
+	for _, test := range []struct {
+		name     string
+		request  *discprotos.SignedRequest
+		expected string
+	}{
+		{"NilRequest", nil, "request is malformed: nil request"},
+		{"BadPayload", &discprotos.SignedRequest{Payload: []byte{1, 2, 3}}, "request is malformed: failed parsing the payload"},
+		{"NoIdentity", signedRequest(nil, query), "request is malformed: no client identity was specified"},
+		{"NoQueries", signedRequest([]byte("client")), "request is malformed: no queries were specified"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := svc.Discover(context.Background(), test.request)
+			assert.Nil(t, resp)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.expected)
+		})
+	}
+}
+
+func TestDiscoverAuthentication(t *testing.T) {
+	client := []byte("client")
+	query := &discprotos.Query{Channel: "mychannel", Query: &discprotos.Query_PeerQuery{PeerQuery: &discprotos.PeerMembershipQuery{}}}
+	support := &mockSupport{eligible: map[string][]byte{"mychannel": client}}
+	tlsCert := []byte("TLS certificate")
+	tlsCtx := grpcpeer.NewContext(context.Background(), &grpcpeer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{{Raw: tlsCert}}}},
+	})
+	timestamp := func(offset time.Duration) *timestamp.Timestamp {
+		now := time.Now().Add(offset)
+		return &timestamp.Timestamp{Seconds: now.Unix(), Nanos: int32(now.Nanosecond())}
+	}
+
+	for _, test := range []struct {
+		name      string
+		mutualTLS bool
+		ctx       context.Context
+		authInfo  *discprotos.AuthInfo
+		expected  string
+	}{
+		{"Fresh", false, context.Background(), &discprotos.AuthInfo{ClientIdentity: client, Timestamp: timestamp(-time.Second)}, ""},
+		{"NoTimestamp", false, context.Background(), &discprotos.AuthInfo{ClientIdentity: client}, "access denied: no timestamp was specified"},
+		{"Stale", false, context.Background(), &discprotos.AuthInfo{ClientIdentity: client, Timestamp: timestamp(-2 * time.Minute)}, "time window difference"},
+		{"FromTheFuture", false, context.Background(), &discprotos.AuthInfo{ClientIdentity: client, Timestamp: timestamp(2 * time.Minute)}, "time window difference"},
+		{"Bound", true, tlsCtx, &discprotos.AuthInfo{ClientIdentity: client, Timestamp: timestamp(0), ClientTlsCertHash: util.ComputeSHA256(tlsCert)}, ""},
+		{"NotBound", true, tlsCtx, &discprotos.AuthInfo{ClientIdentity: client, Timestamp: timestamp(0)}, "access denied: client didn't include its TLS cert hash"},
+		{"BoundToAnotherClient", true, tlsCtx, &discprotos.AuthInfo{ClientIdentity: client, Timestamp: timestamp(0), ClientTlsCertHash: util.ComputeSHA256([]byte("other"))}, "access denied: claimed TLS cert hash"},
+		{"NoTLS", true, context.Background(), &discprotos.AuthInfo{ClientIdentity: client, Timestamp: timestamp(0), ClientTlsCertHash: util.ComputeSHA256(tlsCert)}, "access denied: client didn't send a TLS certificate"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			svc := NewService(support, time.Minute, test.mutualTLS)
+			resp, err := svc.Discover(test.ctx, signedRequestWithAuth(test.authInfo, query))
+			if test.expected == "" {
+				assert.NoError(t, err)
+				assert.Len(t, resp.Results, 1)
+				return
+			}
+			assert.Nil(t, resp)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.expected)
+		})
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	client := []byte("client")
+	peers := []*discprotos.Peer{
+		newPeer("Org1MSP", "p0.org1:7051", "mycc"),
+		newPeer("Org1MSP", "p1.org1:7051"),
+		newPeer("Org2MSP", "p0.org2:7051", "mycc", "othercc"),
+		newPeer("Org3MSP", "p0.org3:7051", "mycc"),
+	}
+	config := &discprotos.ConfigResult{
+		Msps:     map[string]*msp.MSPConfig{"Org1MSP": {Config: []byte("org1")}},
+		Orderers: []string{"orderer:7050"},
+	}
+	svc := NewService(&mockSupport{
+		eligible: map[string][]byte{"mychannel": client},
+		peers:    peers,
+		config:   config,
+		policies: map[string]string{
+			"mycc":    "AND('Org1MSP.member', OR('Org2MSP.member', 'Org3MSP.member'))",
+			"othercc": "AND('Org1MSP.member', 'Org2MSP.member')",
+		},
+	}, time.Minute, false)
+	discover := func(query *discprotos.Query) *discprotos.QueryResult {
+		resp, err := svc.Discover(context.Background(), signedRequest(client, query))
+		assert.NoError(t, err)
+		assert.Len(t, resp.Results, 1)
+		return resp.Results[0]
+	}
+
+	t.Run("AccessDenied", func(t *testing.T) {
+		for _, channel := range []string{"otherchannel", ""} {
+			result := discover(&discprotos.Query{Channel: channel, Query: &discprotos.Query_ConfigQuery{ConfigQuery: &discprotos.ConfigQuery{}}})
+			assert.NotNil(t, result.GetError())
+		}
+		resp, err := svc.Discover(context.Background(), signedRequest([]byte("other client"),
+			&discprotos.Query{Channel: "mychannel", Query: &discprotos.Query_ConfigQuery{ConfigQuery: &discprotos.ConfigQuery{}}}))
+		assert.NoError(t, err)
+		assert.Equal(t, "access denied", resp.Results[0].GetError().Content)
+	})
+
+	t.Run("ConfigQuery", func(t *testing.T) {
+		result := discover(&discprotos.Query{Channel: "mychannel", Query: &discprotos.Query_ConfigQuery{ConfigQuery: &discprotos.ConfigQuery{}}})
+		assert.Equal(t, config, result.GetConfigResult())
+	})
+
+	t.Run("PeerQuery", func(t *testing.T) {
+		result := discover(&discprotos.Query{Channel: "mychannel", Query: &discprotos.Query_PeerQuery{PeerQuery: &discprotos.PeerMembershipQuery{}}})
+		assert.Equal(t, map[string]*discprotos.Peers{
+			"Org1MSP": {Peers: peers[:2]},
+			"Org2MSP": {Peers: peers[2:3]},
+			"Org3MSP": {Peers: peers[3:]},
+		}, result.GetMembers().PeersByOrg)
+	})
+
+	t.Run("ChaincodeQuery", func(t *testing.T) {
+		result := discover(&discprotos.Query{Channel: "mychannel", Query: &discprotos.Query_CcQuery{
+			CcQuery: &discprotos.ChaincodeQuery{Chaincodes: []string{"mycc"}},
+		}})
+		descriptors := result.GetCcQueryRes().Content
+		assert.Len(t, descriptors, 1)
+
+		// the groups are numbered after the principals of the policy, in
+		// the order of the parser, and the peers that don't have the
+		// chaincode installed aren't endorsers
+		assert.Equal(t, &discprotos.EndorsementDescriptor{
+			Chaincode: "mycc",
+			EndorsersByGroups: map[string]*discprotos.Peers{
+				"G0": {Peers: peers[2:3]},
+				"G1": {Peers: peers[3:]},
+				"G2": {Peers: peers[:1]},
+			},
+			Layouts: []*discprotos.Layout{
+				{QuantitiesByGroup: map[string]uint32{"G0": 1, "G2": 1}},
+				{QuantitiesByGroup: map[string]uint32{"G1": 1, "G2": 1}},
+			},
+		}, descriptors[0])
+
+		// no peer of Org1MSP has othercc installed
+		result = discover(&discprotos.Query{Channel: "mychannel", Query: &discprotos.Query_CcQuery{
+			CcQuery: &discprotos.ChaincodeQuery{Chaincodes: []string{"othercc"}},
+		}})
+		assert.Contains(t, result.GetError().Content, "no combination of peers that have the chaincode installed satisfies its endorsement policy")
+	})
+
+	t.Run("ChaincodeQueryFailures", func(t *testing.T) {
+		for _, chaincodes := range [][]string{{"mycc", "unknowncc"}, nil} {
+			result := discover(&discprotos.Query{Channel: "mychannel", Query: &discprotos.Query_CcQuery{
+				CcQuery: &discprotos.ChaincodeQuery{Chaincodes: chaincodes},
+			}})
+			assert.NotNil(t, result.GetError())
+		}
+	})
+
+	t.Run("UnknownQuery", func(t *testing.T) {
+		result := discover(&discprotos.Query{Channel: "mychannel"})
+		assert.Equal(t, "unknown or missing query type", result.GetError().Content)
+	})
+}
@@ -0,0 +1,258 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package support
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/common/configtx"
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/common/policies"
+	"github.com/hyperledger/fabric/core/common/ccprovider"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/peer"
+	"github.com/hyperledger/fabric/gossip/api"
+	gcommon "github.com/hyperledger/fabric/gossip/common"
+	gdisc "github.com/hyperledger/fabric/gossip/discovery"
+	"github.com/hyperledger/fabric/protos/common"
+	discprotos "github.com/hyperledger/fabric/protos/discovery"
+	gproto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+)
+
+var logger = flogging.MustGetLogger("discovery/support")
+
+// GossipSupport provides the membership information of the channels
+type GossipSupport interface {
+	// PeersOfChannel returns the NetworkMembers considered alive
+	// and also subscribed to the channel given
+	PeersOfChannel(gcommon.ChainID) []gdisc.NetworkMember
+
+	// SelfChannelInfo returns the StateInfo message the peer
+	// publishes about the given channel, or nil if there is none
+	SelfChannelInfo(gcommon.ChainID) *gproto.SignedGossipMessage
+
+	// PeerIdentity returns the identity of the peer with the given PKI-ID
+	PeerIdentity(gcommon.PKIidType) (api.PeerIdentityType, error)
+}
+
+// ChannelSupport provides the configuration and the ledgers of the channels
+type ChannelSupport interface {
+	// GetChannelConfig returns the configuration resources of the given
+	// channel, or nil if the peer didn't join the channel
+	GetChannelConfig(cid string) channelconfig.Resources
+
+	// GetCurrConfigBlock returns the current config block of the given channel
+	GetCurrConfigBlock(cid string) *common.Block
+
+	// GetLedger returns the ledger of the given channel
+	GetLedger(cid string) ledger.PeerLedger
+}
+
+// peerChannels is the ChannelSupport of the channels the peer joined
+type peerChannels struct{}
+
+func (peerChannels) GetChannelConfig(cid string) channelconfig.Resources {
+	return peer.GetChannelConfig(cid)
+}
+
+func (peerChannels) GetCurrConfigBlock(cid string) *common.Block {
+	return peer.GetCurrConfigBlock(cid)
+}
+
+func (peerChannels) GetLedger(cid string) ledger.PeerLedger {
+	return peer.GetLedger(cid)
+}
+
+// DiscoverySupport implements the Support of the discovery service
+// with the channels the peer joined and their gossip membership
+type DiscoverySupport struct {
+	gossip       GossipSupport
+	channels     ChannelSupport
+	selfEndpoint string
+	selfIdentity []byte
+}
+
+// NewDiscoverySupport creates a DiscoverySupport of the channels the peer joined,
+// which reports the peer itself with the given endpoint and serialized identity
+func NewDiscoverySupport(gossip GossipSupport, selfEndpoint string, selfIdentity []byte) *DiscoverySupport {
+	return &DiscoverySupport{
+		gossip:       gossip,
+		channels:     peerChannels{},
+		selfEndpoint: selfEndpoint,
+		selfIdentity: selfIdentity,
+	}
+}
+
+// EligibleForService returns nil if the given data was signed by an identity that
+// satisfies the application readers policy of the given channel, or an error otherwise
+func (s *DiscoverySupport) EligibleForService(channel string, data common.SignedData) error {
+	res := s.channels.GetChannelConfig(channel)
+	if res == nil {
+		return errors.Errorf("channel %s doesn't exist", channel)
+	}
+	policy, ok := res.PolicyManager().GetPolicy(policies.ChannelApplicationReaders)
+	if !ok {
+		return errors.Errorf("policy %s of channel %s doesn't exist", policies.ChannelApplicationReaders, channel)
+	}
+	return policy.Evaluate([]*common.SignedData{&data})
+}
+
+// PeersOfChannel returns the peers of the given channel, including the peer itself.
+// Peers without an external endpoint aren't returned, as clients can't reach them.
+func (s *DiscoverySupport) PeersOfChannel(channel string) []*discprotos.Peer {
+	var peers []*discprotos.Peer
+	chainID := gcommon.ChainID(channel)
+	if self := s.gossip.SelfChannelInfo(chainID); self != nil {
+		if peer := newPeer(s.selfEndpoint, s.selfIdentity, self.GetStateInfo().Properties); peer != nil {
+			peers = append(peers, peer)
+		}
+	}
+	for _, member := range s.gossip.PeersOfChannel(chainID) {
+		if member.Endpoint == "" {
+			continue
+		}
+		identity, err := s.gossip.PeerIdentity(member.PKIid)
+		if err != nil {
+			logger.Warningf("Failed retrieving the identity of %s: %v", member.Endpoint, err)
+			continue
+		}
+		if peer := newPeer(member.Endpoint, identity, member.Properties); peer != nil {
+			peers = append(peers, peer)
+		}
+	}
+	return peers
+}
+
+func newPeer(endpoint string, identity []byte, properties *gproto.Properties) *discprotos.Peer {
+	sID := &msp.SerializedIdentity{}
+	if err := proto.Unmarshal(identity, sID); err != nil {
+		logger.Warningf("Identity of %s is malformed: %v", endpoint, err)
+		return nil
+	}
+	peer := &discprotos.Peer{
+		MspId:    sID.Mspid,
+		Endpoint: endpoint,
+		Identity: identity,
+	}
+	if properties != nil {
+		peer.LedgerHeight = properties.LedgerHeight
+		peer.Chaincodes = properties.Chaincodes
+	}
+	return peer
+}
+
+// Config returns the MSPs of the organizations and the orderer
+// addresses of the current config block of the given channel
+func (s *DiscoverySupport) Config(channel string) (*discprotos.ConfigResult, error) {
+	res := s.channels.GetChannelConfig(channel)
+	block := s.channels.GetCurrConfigBlock(channel)
+	if res == nil || block == nil {
+		return nil, errors.Errorf("channel %s doesn't exist", channel)
+	}
+	env, err := utils.ExtractEnvelope(block, 0)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed extracting the config envelope")
+	}
+	payload, err := utils.UnmarshalPayload(env.Payload)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed extracting the config envelope")
+	}
+	configEnv, err := configtx.UnmarshalConfigEnvelope(payload.Data)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed extracting the config envelope")
+	}
+	if configEnv.Config == nil || configEnv.Config.ChannelGroup == nil {
+		return nil, errors.New("the config envelope has no channel group")
+	}
+
+	// MSP IDs of the organizations, by the name of the organizations
+	orgs := make(map[string]string)
+	if appConfig, exists := res.ApplicationConfig(); exists {
+		for name, org := range appConfig.Organizations() {
+			orgs[name] = org.MSPID()
+		}
+	}
+	if ordererConfig, exists := res.OrdererConfig(); exists {
+		for name, org := range ordererConfig.Organizations() {
+			orgs[name] = org.MSPID()
+		}
+	}
+
+	result := &discprotos.ConfigResult{
+		Msps:     make(map[string]*msp.MSPConfig),
+		Orderers: res.ChannelConfig().OrdererAddresses(),
+	}
+	for _, groupKey := range []string{channelconfig.ApplicationGroupKey, channelconfig.OrdererGroupKey} {
+		group, exists := configEnv.Config.ChannelGroup.Groups[groupKey]
+		if !exists {
+			continue
+		}
+		for name, orgGroup := range group.Groups {
+			mspValue, exists := orgGroup.Values[channelconfig.MSPKey]
+			if !exists {
+				continue
+			}
+			mspID, exists := orgs[name]
+			if !exists {
+				continue
+			}
+			mspConfig := &msp.MSPConfig{}
+			if err := proto.Unmarshal(mspValue.Value, mspConfig); err != nil {
+				return nil, errors.Wrapf(err, "failed parsing the MSP of organization %s", name)
+			}
+			result.Msps[mspID] = mspConfig
+		}
+	}
+	return result, nil
+}
+
+// EndorsementPolicy returns the endorsement policy the given
+// chaincode was instantiated with on the given channel
+func (s *DiscoverySupport) EndorsementPolicy(channel, chaincode string) (*common.SignaturePolicyEnvelope, error) {
+	l := s.channels.GetLedger(channel)
+	if l == nil {
+		return nil, errors.Errorf("channel %s doesn't exist", channel)
+	}
+	qe, err := l.NewQueryExecutor()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed creating a query executor")
+	}
+	defer qe.Done()
+	ccBytes, err := qe.GetState("lscc", chaincode)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed retrieving the chaincode definition")
+	}
+	if ccBytes == nil {
+		return nil, errors.Errorf("chaincode %s isn't instantiated on channel %s", chaincode, channel)
+	}
+	cd := &ccprovider.ChaincodeData{}
+	if err := proto.Unmarshal(ccBytes, cd); err != nil {
+		return nil, errors.Wrap(err, "failed parsing the chaincode definition")
+	}
+	policy := &common.SignaturePolicyEnvelope{}
+	if err := proto.Unmarshal(cd.Policy, policy); err != nil {
+		return nil, errors.Wrap(err, "failed parsing the endorsement policy")
+	}
+	return policy, nil
+}
+
+// SatisfiesPrincipal returns nil if the given serialized identity satisfies the
+// principal according to the MSPs of the given channel, or an error otherwise
+func (s *DiscoverySupport) SatisfiesPrincipal(channel string, identity []byte, principal *msp.MSPPrincipal) error {
+	res := s.channels.GetChannelConfig(channel)
+	if res == nil {
+		return errors.Errorf("channel %s doesn't exist", channel)
+	}
+	id, err := res.MSPManager().DeserializeIdentity(identity)
+	if err != nil {
+		return errors.WithMessage(err, "failed deserializing the identity")
+	}
+	return id.SatisfiesPrincipal(principal)
+}
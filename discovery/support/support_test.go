@@ -0,0 +1,214 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package support
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	configtxtest "github.com/hyperledger/fabric/common/configtx/test"
+	mockconfig "github.com/hyperledger/fabric/common/mocks/config"
+	mockpolicies "github.com/hyperledger/fabric/common/mocks/policies"
+	"github.com/hyperledger/fabric/common/policies"
+	"github.com/hyperledger/fabric/core/common/ccprovider"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/gossip/api"
+	gcommon "github.com/hyperledger/fabric/gossip/common"
+	gdisc "github.com/hyperledger/fabric/gossip/discovery"
+	"github.com/hyperledger/fabric/protos/common"
+	gproto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockGossip struct {
+	self       *gproto.SignedGossipMessage
+	members    []gdisc.NetworkMember
+	identities map[string]api.PeerIdentityType
+}
+
+func (mg *mockGossip) PeersOfChannel(gcommon.ChainID) []gdisc.NetworkMember {
+	return mg.members
+}
+
+func (mg *mockGossip) SelfChannelInfo(gcommon.ChainID) *gproto.SignedGossipMessage {
+	return mg.self
+}
+
+func (mg *mockGossip) PeerIdentity(pkiID gcommon.PKIidType) (api.PeerIdentityType, error) {
+	identity, exists := mg.identities[string(pkiID)]
+	if !exists {
+		return nil, errors.New("unknown peer")
+	}
+	return identity, nil
+}
+
+type mockChannels struct {
+	resources channelconfig.Resources
+	block     *common.Block
+	ledger    ledger.PeerLedger
+}
+
+func (mc *mockChannels) GetChannelConfig(cid string) channelconfig.Resources {
+	return mc.resources
+}
+
+func (mc *mockChannels) GetCurrConfigBlock(cid string) *common.Block {
+	return mc.block
+}
+
+func (mc *mockChannels) GetLedger(cid string) ledger.PeerLedger {
+	return mc.ledger
+}
+
+// mockLedger serves the state of lscc from a map
+type mockLedger struct {
+	ledger.PeerLedger
+	state map[string][]byte
+}
+
+func (ml *mockLedger) NewQueryExecutor() (ledger.QueryExecutor, error) {
+	return &mockQueryExecutor{state: ml.state}, nil
+}
+
+type mockQueryExecutor struct {
+	ledger.QueryExecutor
+	state map[string][]byte
+}
+
+func (mqe *mockQueryExecutor) GetState(namespace, key string) ([]byte, error) {
+	return mqe.state[key], nil
+}
+
+func (mqe *mockQueryExecutor) Done() {
+}
+
+func identity(mspID, id string) []byte {
+	return utils.MarshalOrPanic(&msp.SerializedIdentity{Mspid: mspID, IdBytes: []byte(id)})
+}
+
+func TestPeersOfChannel(t *testing.T) {
+	chaincodes := []*gproto.Chaincode{{Name: "mycc", Version: "1.0"}}
+	gossip := &mockGossip{
+		self: &gproto.SignedGossipMessage{GossipMessage: &gproto.GossipMessage{
+			Content: &gproto.GossipMessage_StateInfo{StateInfo: &gproto.StateInfo{
+				Properties: &gproto.Properties{LedgerHeight: 5, Chaincodes: chaincodes},
+			}},
+		}},
+		members: []gdisc.NetworkMember{
+			{Endpoint: "p1.org1:7051", PKIid: gcommon.PKIidType("p1"), Properties: &gproto.Properties{LedgerHeight: 4}},
+			// peers without an external endpoint aren't reported
+			{PKIid: gcommon.PKIidType("p2")},
+			// neither are peers whose identity is unknown or malformed
+			{Endpoint: "p3.org2:7051", PKIid: gcommon.PKIidType("p3")},
+			{Endpoint: "p4.org2:7051", PKIid: gcommon.PKIidType("p4")},
+		},
+		identities: map[string]api.PeerIdentityType{
+			"p1": identity("Org1MSP", "p1"),
+			"p2": identity("Org1MSP", "p2"),
+			"p4": []byte{1, 2, 3},
+		},
+	}
+	sup := NewDiscoverySupport(gossip, "p0.org1:7051", identity("Org1MSP", "p0"))
+
+	peers := sup.PeersOfChannel("mychannel")
+	assert.Len(t, peers, 2)
+	assert.Equal(t, "Org1MSP", peers[0].MspId)
+	assert.Equal(t, "p0.org1:7051", peers[0].Endpoint)
+	assert.Equal(t, uint64(5), peers[0].LedgerHeight)
+	assert.Equal(t, chaincodes, peers[0].Chaincodes)
+	assert.Equal(t, "p1.org1:7051", peers[1].Endpoint)
+	assert.Equal(t, uint64(4), peers[1].LedgerHeight)
+	assert.Empty(t, peers[1].Chaincodes)
+
+	// the peer isn't reported about channels it didn't join
+	gossip.self = nil
+	peers = sup.PeersOfChannel("mychannel")
+	assert.Len(t, peers, 1)
+	assert.Equal(t, "p1.org1:7051", peers[0].Endpoint)
+}
+
+func TestEligibleForService(t *testing.T) {
+	sup := NewDiscoverySupport(&mockGossip{}, "", nil)
+	channels := &mockChannels{}
+	sup.channels = channels
+	data := common.SignedData{Identity: []byte("client")}
+
+	assert.EqualError(t, sup.EligibleForService("mychannel", data), "channel mychannel doesn't exist")
+
+	manager := &mockpolicies.Manager{PolicyMap: map[string]policies.Policy{}}
+	channels.resources = &mockconfig.Resources{PolicyManagerVal: manager}
+	assert.Contains(t, sup.EligibleForService("mychannel", data).Error(), "doesn't exist")
+
+	manager.PolicyMap[policies.ChannelApplicationReaders] = &mockpolicies.Policy{Err: errors.New("not a reader")}
+	assert.EqualError(t, sup.EligibleForService("mychannel", data), "not a reader")
+
+	manager.PolicyMap[policies.ChannelApplicationReaders] = &mockpolicies.Policy{}
+	assert.NoError(t, sup.EligibleForService("mychannel", data))
+}
+
+func TestConfig(t *testing.T) {
+	sup := NewDiscoverySupport(&mockGossip{}, "", nil)
+	sup.channels = &mockChannels{}
+	_, err := sup.Config("mychannel")
+	assert.EqualError(t, err, "channel mychannel doesn't exist")
+
+	block, err := configtxtest.MakeGenesisBlock("mychannel")
+	assert.NoError(t, err)
+	env, err := utils.ExtractEnvelope(block, 0)
+	assert.NoError(t, err)
+	bundle, err := channelconfig.NewBundleFromEnvelope(env)
+	assert.NoError(t, err)
+	sup.channels = &mockChannels{resources: bundle, block: block}
+
+	config, err := sup.Config("mychannel")
+	assert.NoError(t, err)
+	assert.Equal(t, bundle.ChannelConfig().OrdererAddresses(), config.Orderers)
+	appConfig, _ := bundle.ApplicationConfig()
+	ordererConfig, _ := bundle.OrdererConfig()
+	for _, org := range appConfig.Organizations() {
+		assert.Contains(t, config.Msps, org.MSPID())
+	}
+	for _, org := range ordererConfig.Organizations() {
+		assert.Contains(t, config.Msps, org.MSPID())
+	}
+	for mspID, mspConfig := range config.Msps {
+		assert.NotEmpty(t, mspConfig.Config, "MSP %s has no config", mspID)
+	}
+
+	// blocks that aren't config blocks are rejected
+	sup.channels = &mockChannels{resources: bundle, block: &common.Block{Data: &common.BlockData{}}}
+	_, err = sup.Config("mychannel")
+	assert.Error(t, err)
+}
+
+func TestEndorsementPolicy(t *testing.T) {
+	sup := NewDiscoverySupport(&mockGossip{}, "", nil)
+	sup.channels = &mockChannels{}
+	_, err := sup.EndorsementPolicy("mychannel", "mycc")
+	assert.EqualError(t, err, "channel mychannel doesn't exist")
+
+	policy := cauthdsl.SignedByMspMember("Org1MSP")
+	state := map[string][]byte{
+		"mycc":  utils.MarshalOrPanic(&ccprovider.ChaincodeData{Name: "mycc", Policy: utils.MarshalOrPanic(policy)}),
+		"badcc": []byte{1, 2, 3},
+	}
+	sup.channels = &mockChannels{ledger: &mockLedger{state: state}}
+
+	actual, err := sup.EndorsementPolicy("mychannel", "mycc")
+	assert.NoError(t, err)
+	assert.Equal(t, policy, actual)
+
+	_, err = sup.EndorsementPolicy("mychannel", "othercc")
+	assert.EqualError(t, err, "chaincode othercc isn't instantiated on channel mychannel")
+
+	_, err = sup.EndorsementPolicy("mychannel", "badcc")
+	assert.Error(t, err)
+}
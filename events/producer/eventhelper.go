@@ -82,17 +82,25 @@ func CreateBlockEvents(block *common.Block) (bevent *pb.Event, fbevent *pb.Event
 							return nil, nil, "", fmt.Errorf("error unmarshalling chaincode action for block event: %s", err)
 						}
 
-						ccEvent, err := utils.GetChaincodeEvents(caPayload.Events)
+						ccEvents, err := utils.GetChaincodeActionEvents(caPayload)
 						if err != nil {
 							return nil, nil, "", fmt.Errorf("error unmarshalling chaincode event for block event: %s", err)
 						}
 
 						chaincodeAction := &pb.FilteredChaincodeAction{}
-						if ccEvent.GetChaincodeId() != "" {
-							filteredCcEvent := ccEvent
-							// nil out ccevent payload
-							filteredCcEvent.Payload = nil
-							chaincodeAction.ChaincodeEvent = filteredCcEvent
+						for _, ccEvent := range ccEvents {
+							if ccEvent.GetChaincodeId() == "" {
+								continue
+							}
+							// copy the ccevent without its payload
+							chaincodeAction.ChaincodeEvents = append(chaincodeAction.ChaincodeEvents, &pb.ChaincodeEvent{
+								ChaincodeId: ccEvent.ChaincodeId,
+								TxId:        ccEvent.TxId,
+								EventName:   ccEvent.EventName,
+							})
+						}
+						if n := len(chaincodeAction.ChaincodeEvents); n > 0 {
+							chaincodeAction.ChaincodeEvent = chaincodeAction.ChaincodeEvents[n-1]
 						}
 						transactionActions.ChaincodeActions = append(transactionActions.ChaincodeActions, chaincodeAction)
 
@@ -102,7 +110,7 @@ func CreateBlockEvents(block *common.Block) (bevent *pb.Event, fbevent *pb.Event
 						// Dropping the read write set may cause issues for security and
 						// we will need to revist when event security is addressed
 						caPayload.Results = nil
-						chaincodeActionPayload.Action.ProposalResponsePayload, err = utils.GetBytesProposalResponsePayload(propRespPayload.ProposalHash, caPayload.Response, caPayload.Results, caPayload.Events, caPayload.ChaincodeId)
+						chaincodeActionPayload.Action.ProposalResponsePayload, err = utils.GetBytesProposalResponsePayloadForAction(propRespPayload.ProposalHash, caPayload)
 						if err != nil {
 							return nil, nil, "", fmt.Errorf("error marshalling tx proposal payload for block event: %s", err)
 						}
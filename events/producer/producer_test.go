@@ -35,6 +35,7 @@ import (
 	"github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/core/comm"
 	"github.com/hyperledger/fabric/core/config"
+	ledgerutil "github.com/hyperledger/fabric/core/ledger/util"
 	coreutil "github.com/hyperledger/fabric/core/testutil"
 	"github.com/hyperledger/fabric/events/consumer"
 	"github.com/hyperledger/fabric/msp"
@@ -271,6 +272,72 @@ func TestReceiveAnyMessage(t *testing.T) {
 	assert.Equal(t, 0, len(gEventProcessor.eventChannel))
 }
 
+func TestCreateBlockEventsMultipleChaincodeEvents(t *testing.T) {
+	ccEvents := []*pb.ChaincodeEvent{
+		{ChaincodeId: "mycc", TxId: "txid", EventName: "first", Payload: []byte("payload1")},
+		{ChaincodeId: "mycc", TxId: "txid", EventName: "second", Payload: []byte("payload2")},
+	}
+	calls := []*pb.ChaincodeCall{{ChaincodeId: &pb.ChaincodeID{Name: "callee", Version: "1"}, ChannelId: "testchainid"}}
+	cAct := &pb.ChaincodeAction{
+		Results:         []byte("rwset"),
+		Events:          utils.MarshalOrPanic(ccEvents[1]),
+		ChaincodeId:     &pb.ChaincodeID{Name: "mycc", Version: "1"},
+		Calls:           calls,
+		ChaincodeEvents: ccEvents,
+	}
+	prpBytes, err := utils.GetBytesProposalResponsePayloadForAction([]byte("hash"), cAct)
+	assert.NoError(t, err)
+	capBytes, err := utils.GetBytesChaincodeActionPayload(&pb.ChaincodeActionPayload{
+		Action: &pb.ChaincodeEndorsedAction{ProposalResponsePayload: prpBytes},
+	})
+	assert.NoError(t, err)
+	payload := &common.Payload{
+		Header: &common.Header{
+			ChannelHeader: utils.MarshalOrPanic(&common.ChannelHeader{
+				ChannelId: "testchainid",
+				TxId:      "txid",
+				Type:      int32(common.HeaderType_ENDORSER_TRANSACTION),
+			}),
+		},
+		Data: utils.MarshalOrPanic(&pb.Transaction{Actions: []*pb.TransactionAction{{Payload: capBytes}}}),
+	}
+	block := common.NewBlock(1, []byte("prevhash"))
+	block.Data.Data = [][]byte{utils.MarshalOrPanic(&common.Envelope{Payload: utils.MarshalOrPanic(payload)})}
+	block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER] = ledgerutil.NewTxValidationFlags(1)
+
+	bevent, fbevent, channelID, err := CreateBlockEvents(block)
+	assert.NoError(t, err)
+	assert.Equal(t, "testchainid", channelID)
+
+	// all the events are in the filtered block, without their payload
+	filteredTxs := fbevent.GetFilteredBlock().FilteredTransactions
+	assert.Len(t, filteredTxs, 1)
+	chaincodeActions := filteredTxs[0].GetTransactionActions().ChaincodeActions
+	assert.Len(t, chaincodeActions, 1)
+	assert.Len(t, chaincodeActions[0].ChaincodeEvents, 2)
+	for i, ccEvent := range chaincodeActions[0].ChaincodeEvents {
+		assert.Equal(t, ccEvents[i].EventName, ccEvent.EventName)
+		assert.Equal(t, "mycc", ccEvent.ChaincodeId)
+		assert.Equal(t, "txid", ccEvent.TxId)
+		assert.Nil(t, ccEvent.Payload)
+	}
+	assert.Equal(t, "second", chaincodeActions[0].ChaincodeEvent.EventName)
+
+	// the block event only drops the read-write set of the action
+	env, err := utils.GetEnvelopeFromBlock(bevent.GetBlock().Data.Data[0])
+	assert.NoError(t, err)
+	eventPayload, err := utils.GetPayload(env)
+	assert.NoError(t, err)
+	tx, err := utils.GetTransaction(eventPayload.Data)
+	assert.NoError(t, err)
+	_, eventAct, err := utils.GetPayloads(tx.Actions[0])
+	assert.NoError(t, err)
+	assert.Nil(t, eventAct.Results)
+	assert.True(t, proto.Equal(&pb.ChaincodeEvents{Events: ccEvents}, &pb.ChaincodeEvents{Events: eventAct.ChaincodeEvents}))
+	assert.Len(t, eventAct.Calls, 1)
+	assert.True(t, proto.Equal(calls[0], eventAct.Calls[0]))
+}
+
 func TestReceiveEventsBlockingSend(t *testing.T) {
 	recvChan := make(chan *streamEvent)
 	defer close(recvChan)
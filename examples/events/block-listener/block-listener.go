@@ -90,7 +90,7 @@ func getTxPayload(tdata []byte) (*common.Payload, error) {
 }
 
 // getChainCodeEvents parses block events for chaincode events associated with individual transactions
-func getChainCodeEvents(tdata []byte) (*pb.ChaincodeEvent, error) {
+func getChainCodeEvents(tdata []byte) ([]*pb.ChaincodeEvent, error) {
 	if tdata == nil {
 		return nil, errors.New("Cannot extract payload from nil transaction")
 	}
@@ -126,10 +126,10 @@ func getChainCodeEvents(tdata []byte) (*pb.ChaincodeEvent, error) {
 			if err != nil {
 				return nil, fmt.Errorf("Error unmarshalling chaincode action for block event: %s", err)
 			}
-			ccEvent, err := utils.GetChaincodeEvents(caPayload.Events)
+			ccEvents, err := utils.GetChaincodeActionEvents(caPayload)
 
-			if ccEvent != nil {
-				return ccEvent, nil
+			if len(ccEvents) > 0 {
+				return ccEvents, nil
 			}
 		}
 	}
@@ -201,13 +201,15 @@ func main() {
 						fmt.Printf("Transaction invalid: TxID: %s\n", chdr.TxId)
 					} else {
 						fmt.Printf("Received transaction from channel '%s': \n\t[%v]\n", chdr.ChannelId, tx)
-						if event, err := getChainCodeEvents(r); err == nil {
-							if len(chaincodeID) != 0 && event.ChaincodeId == chaincodeID {
-								fmt.Println("")
-								fmt.Println("")
-								fmt.Printf("Received chaincode event from channel '%s'\n", chdr.ChannelId)
-								fmt.Println("------------------------")
-								fmt.Printf("Chaincode Event:%+v\n", event)
+						if events, err := getChainCodeEvents(r); err == nil {
+							for _, event := range events {
+								if len(chaincodeID) != 0 && event.ChaincodeId == chaincodeID {
+									fmt.Println("")
+									fmt.Println("")
+									fmt.Printf("Received chaincode event from channel '%s'\n", chdr.ChannelId)
+									fmt.Println("------------------------")
+									fmt.Printf("Chaincode Event:%+v\n", event)
+								}
 							}
 						}
 					}
@@ -47,6 +47,10 @@ type Comm interface {
 	// CloseConn closes a connection to a certain endpoint
 	CloseConn(peer *RemotePeer)
 
+	// Connections returns the connections to remote peers,
+	// along with the bytes sent and received over them
+	Connections() []ConnectionStats
+
 	// Stop stops the module
 	Stop()
 }
@@ -57,6 +61,15 @@ type RemotePeer struct {
 	PKIID    common.PKIidType
 }
 
+// ConnectionStats reports a connection to a remote peer
+// and the bytes sent and received over it
+type ConnectionStats struct {
+	RemotePeer
+	Outbound      bool // whether the connection was initiated by this peer
+	BytesSent     uint64
+	BytesReceived uint64
+}
+
 // SendResult defines a result of a send to a remote peer
 type SendResult struct {
 	error
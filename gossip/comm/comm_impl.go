@@ -83,6 +83,7 @@ func NewCommInstanceWithServer(port int, idMapper identity.Mapper, peerIdentity
 		dialTimeout:    util.GetDurationOrDefault("peer.gossip.dialTimeout", defDialTimeout),
 		tlsCerts:       certs,
 	}
+	commInst.transmission = newTransmissionConfig(commInst.logger)
 	commInst.connStore = newConnStore(commInst, commInst.logger)
 
 	if port > 0 {
@@ -135,6 +136,7 @@ type commImpl struct {
 	port           int
 	stopping       int32
 	dialTimeout    time.Duration
+	transmission   transmissionConfig
 }
 
 func (c *commImpl) createConnection(endpoint string, expectedPKIID common.PKIidType) (*connection, error) {
@@ -143,6 +145,7 @@ func (c *commImpl) createConnection(endpoint string, expectedPKIID common.PKIidT
 	var stream proto.Gossip_GossipStreamClient
 	var pkiID common.PKIidType
 	var connInfo *proto.ConnectionInfo
+	var transmission transmissionConfig
 	var dialOpts []grpc.DialOption
 
 	c.logger.Debug("Entering", endpoint, expectedPKIID)
@@ -172,7 +175,7 @@ func (c *commImpl) createConnection(endpoint string, expectedPKIID common.PKIidT
 
 	ctx, cf := context.WithCancel(context.Background())
 	if stream, err = cl.GossipStream(ctx); err == nil {
-		connInfo, err = c.authenticateRemotePeer(stream, true)
+		connInfo, transmission, err = c.authenticateRemotePeer(stream, true)
 		if err == nil {
 			pkiID = connInfo.ID
 			if expectedPKIID != nil && !bytes.Equal(pkiID, expectedPKIID) {
@@ -186,6 +189,7 @@ func (c *commImpl) createConnection(endpoint string, expectedPKIID common.PKIidT
 			conn.info = connInfo
 			conn.logger = c.logger
 			conn.cancel = cf
+			conn.transmission = transmission
 
 			h := func(m *proto.SignedGossipMessage) {
 				c.logger.Debug("Got message:", m)
@@ -294,7 +298,7 @@ func (c *commImpl) Handshake(remotePeer *RemotePeer) (api.PeerIdentityType, erro
 	if err != nil {
 		return nil, err
 	}
-	connInfo, err := c.authenticateRemotePeer(stream, true)
+	connInfo, _, err := c.authenticateRemotePeer(stream, true)
 	if err != nil {
 		c.logger.Warningf("Authentication failed: %v", err)
 		return nil, err
@@ -349,6 +353,10 @@ func (c *commImpl) CloseConn(peer *RemotePeer) {
 	c.connStore.closeConn(peer)
 }
 
+func (c *commImpl) Connections() []ConnectionStats {
+	return c.connStore.connStats()
+}
+
 func (c *commImpl) emptySubscriptions() {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -395,7 +403,9 @@ func extractRemoteAddress(stream stream) string {
 	return remoteAddress
 }
 
-func (c *commImpl) authenticateRemotePeer(stream stream, initiator bool) (*proto.ConnectionInfo, error) {
+// authenticateRemotePeer handshakes with the remote peer, and returns its connection info
+// along with the transmission configuration negotiated with it
+func (c *commImpl) authenticateRemotePeer(stream stream, initiator bool) (*proto.ConnectionInfo, transmissionConfig, error) {
 	ctx := stream.Context()
 	remoteAddress := extractRemoteAddress(stream)
 	remoteCertHash := extractCertificateHashFromContext(ctx)
@@ -419,12 +429,12 @@ func (c *commImpl) authenticateRemotePeer(stream stream, initiator bool) (*proto
 	// TLS enabled but not detected on other side
 	if useTLS && len(remoteCertHash) == 0 {
 		c.logger.Warningf("%s didn't send TLS certificate", remoteAddress)
-		return nil, fmt.Errorf("No TLS certificate")
+		return nil, transmissionConfig{}, fmt.Errorf("No TLS certificate")
 	}
 
 	cMsg, err = c.createConnectionMsg(c.PKIID, selfCertHash, c.peerIdentity, signer)
 	if err != nil {
-		return nil, err
+		return nil, transmissionConfig{}, err
 	}
 
 	c.logger.Debug("Sending", cMsg, "to", remoteAddress)
@@ -432,24 +442,24 @@ func (c *commImpl) authenticateRemotePeer(stream stream, initiator bool) (*proto
 	m, err := readWithTimeout(stream, util.GetDurationOrDefault("peer.gossip.connTimeout", defConnTimeout), remoteAddress)
 	if err != nil {
 		c.logger.Warningf("Failed reading messge from %s, reason: %v", remoteAddress, err)
-		return nil, err
+		return nil, transmissionConfig{}, err
 	}
 	receivedMsg := m.GetConn()
 	if receivedMsg == nil {
 		c.logger.Warning("Expected connection message from", remoteAddress, "but got", receivedMsg)
-		return nil, fmt.Errorf("Wrong type")
+		return nil, transmissionConfig{}, fmt.Errorf("Wrong type")
 	}
 
 	if receivedMsg.PkiId == nil {
 		c.logger.Warning("%s didn't send a pkiID", remoteAddress)
-		return nil, fmt.Errorf("No PKI-ID")
+		return nil, transmissionConfig{}, fmt.Errorf("No PKI-ID")
 	}
 
 	c.logger.Debug("Received", receivedMsg, "from", remoteAddress)
 	err = c.idMapper.Put(receivedMsg.PkiId, receivedMsg.Identity)
 	if err != nil {
 		c.logger.Warningf("Identity store rejected %s : %v", remoteAddress, err)
-		return nil, err
+		return nil, transmissionConfig{}, err
 	}
 
 	connInfo := &proto.ConnectionInfo{
@@ -467,7 +477,7 @@ func (c *commImpl) authenticateRemotePeer(stream stream, initiator bool) (*proto
 		// If the remote peer sent its TLS certificate, make sure it actually matches the TLS cert
 		// that the peer used.
 		if !bytes.Equal(remoteCertHash, receivedMsg.TlsCertHash) {
-			return nil, errors.Errorf("Expected %v in remote hash of TLS cert, but got %v", remoteCertHash, receivedMsg.TlsCertHash)
+			return nil, transmissionConfig{}, errors.Errorf("Expected %v in remote hash of TLS cert, but got %v", remoteCertHash, receivedMsg.TlsCertHash)
 		}
 	}
 	// Final step - verify the signature on the connection message itself
@@ -478,12 +488,12 @@ func (c *commImpl) authenticateRemotePeer(stream stream, initiator bool) (*proto
 	err = m.Verify(receivedMsg.Identity, verifier)
 	if err != nil {
 		c.logger.Errorf("Failed verifying signature from %s : %v", remoteAddress, err)
-		return nil, err
+		return nil, transmissionConfig{}, err
 	}
 
 	c.logger.Debug("Authenticated", remoteAddress)
 
-	return connInfo, nil
+	return connInfo, c.transmission.negotiate(receivedMsg), nil
 }
 
 // SendWithAck sends a message to remote peers, waiting for acknowledgement from minAck of them, or until a certain timeout expires
@@ -547,14 +557,14 @@ func (c *commImpl) GossipStream(stream proto.Gossip_GossipStreamServer) error {
 	if c.isStopping() {
 		return fmt.Errorf("Shutting down")
 	}
-	connInfo, err := c.authenticateRemotePeer(stream, false)
+	connInfo, transmission, err := c.authenticateRemotePeer(stream, false)
 	if err != nil {
 		c.logger.Errorf("Authentication failed: %v", err)
 		return err
 	}
 	c.logger.Debug("Servicing", extractRemoteAddress(stream))
 
-	conn := c.connStore.onConnected(stream, connInfo)
+	conn := c.connStore.onConnected(stream, connInfo, transmission)
 
 	// if connStore denied the connection, it means we already have a connection to that peer
 	// so close this stream
@@ -636,9 +646,12 @@ func (c *commImpl) createConnectionMsg(pkiID common.PKIidType, certHash []byte,
 		Nonce: 0,
 		Content: &proto.GossipMessage_Conn{
 			Conn: &proto.ConnEstablish{
-				TlsCertHash: certHash,
-				Identity:    cert,
-				PkiId:       pkiID,
+				TlsCertHash:  certHash,
+				Identity:     cert,
+				PkiId:        pkiID,
+				Compression:  supportedCompression,
+				Batching:     c.transmission.batching(),
+				MaxBatchSize: maxReceivedBatchSize,
 			},
 		},
 	}
@@ -449,6 +449,35 @@ func TestCloseConn(t *testing.T) {
 	assert.True(t, gotErr, "Should have failed because connection is closed")
 }
 
+func TestConnections(t *testing.T) {
+	t.Parallel()
+	comm1, _ := newCommInstance(10613, naiveSec)
+	comm2, _ := newCommInstance(10614, naiveSec)
+	defer comm1.Stop()
+	defer comm2.Stop()
+	assert.Empty(t, comm1.Connections())
+
+	m2 := comm2.Accept(acceptAll)
+	comm1.Send(createGossipMsg(), remotePeer(10614))
+	select {
+	case <-m2:
+	case <-time.After(time.Second * 5):
+		t.Fatal("Didn't receive a message within a timely period")
+	}
+
+	conns := comm1.Connections()
+	assert.Len(t, conns, 1)
+	assert.Equal(t, comm2.GetPKIid(), conns[0].PKIID)
+	assert.True(t, conns[0].Outbound)
+	assert.True(t, conns[0].BytesSent > 0)
+
+	conns = comm2.Connections()
+	assert.Len(t, conns, 1)
+	assert.Equal(t, comm1.GetPKIid(), conns[0].PKIID)
+	assert.False(t, conns[0].Outbound)
+	assert.True(t, conns[0].BytesReceived > 0)
+}
+
 func TestParallelSend(t *testing.T) {
 	t.Parallel()
 	comm1, _ := newCommInstance(5411, naiveSec)
@@ -495,6 +524,59 @@ type nonResponsivePeer struct {
 	port int
 }
 
+func TestCompressionAndBatching(t *testing.T) {
+	t.Parallel()
+	// Scenario: Both peers compress big payloads and batch small messages.
+	// A peer sends a big data message and several alive messages to the other peer.
+	// Expected outcome: All messages are received intact
+	comm1, _ := newCommInstance(10611, naiveSec)
+	comm2, _ := newCommInstance(10612, naiveSec)
+	defer comm1.Stop()
+	defer comm2.Stop()
+	for _, c := range []Comm{comm1, comm2} {
+		c.(*commImpl).transmission = transmissionConfig{
+			compression:        proto.CompressionAlgorithm_GZIP,
+			compressionMinSize: 100,
+			maxBatchSize:       10,
+			batchLinger:        time.Millisecond * 100,
+		}
+	}
+
+	data := bytes.Repeat([]byte("block "), 10000)
+	dataMsg, _ := (&proto.GossipMessage{
+		Tag:   proto.GossipMessage_EMPTY,
+		Nonce: uint64(rand.Int()),
+		Content: &proto.GossipMessage_DataMsg{
+			DataMsg: &proto.DataMessage{Payload: &proto.Payload{SeqNum: 1, Data: data}},
+		},
+	}).NoopSign()
+	aliveMsgCount := 5
+
+	m2 := comm2.Accept(acceptAll)
+	out := make(chan uint64, aliveMsgCount+1)
+	go func() {
+		for m := range m2 {
+			if m.GetGossipMessage().IsDataMsg() {
+				assert.Equal(t, data, m.GetGossipMessage().GetDataMsg().Payload.Data)
+			}
+			out <- m.GetGossipMessage().Nonce
+		}
+	}()
+
+	comm1.Send(dataMsg, remotePeer(10612))
+	for i := 0; i < aliveMsgCount; i++ {
+		aliveMsg, _ := (&proto.GossipMessage{
+			Tag:   proto.GossipMessage_EMPTY,
+			Nonce: uint64(i),
+			Content: &proto.GossipMessage_AliveMsg{
+				AliveMsg: &proto.AliveMessage{Membership: &proto.Member{Endpoint: "localhost:10611"}},
+			},
+		}).NoopSign()
+		comm1.Send(aliveMsg, remotePeer(10612))
+	}
+	waitForMessages(t, out, aliveMsgCount+1, "Didn't receive all messages")
+}
+
 func newNonResponsivePeer() *nonResponsivePeer {
 	rand.Seed(time.Now().UnixNano())
 	port := 50000 + rand.Intn(1000)
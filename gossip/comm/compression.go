@@ -0,0 +1,227 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package comm
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/hyperledger/fabric/gossip/util"
+	proto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/op/go-logging"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+)
+
+const (
+	defCompressionMinSize = 1024
+	defMaxBatchSize       = 10
+	defBatchLinger        = time.Millisecond * 5
+	// maxReceivedBatchSize is the maximum number of envelopes a batch received
+	// from a remote peer may carry, which the peer advertises in its handshake
+	maxReceivedBatchSize = 100
+	// maxDecompressedSize is the maximum total size of the decompressed payloads
+	// of a received envelope, which equals the default maximum size of a gRPC
+	// message a peer receives
+	maxDecompressedSize = 100 * 1024 * 1024
+)
+
+// supportedCompression are the compression algorithms
+// payloads received from remote peers can be decompressed with
+var supportedCompression = []proto.CompressionAlgorithm{
+	proto.CompressionAlgorithm_SNAPPY,
+	proto.CompressionAlgorithm_GZIP,
+}
+
+// transmissionConfig defines how envelopes are transmitted to a remote peer
+type transmissionConfig struct {
+	// compression is the algorithm payloads are compressed with
+	compression proto.CompressionAlgorithm
+	// compressionMinSize is the size of the smallest payload that is compressed
+	compressionMinSize int
+	// maxBatchSize is the maximum number of envelopes sent together,
+	// batching is disabled if it is less than 2. It never exceeds the
+	// maximum size of the batches the remote peer receives
+	maxBatchSize int
+	// batchLinger is the maximum time to wait for more envelopes to send together
+	batchLinger time.Duration
+}
+
+// newTransmissionConfig returns the transmission configuration of the peer,
+// before it is negotiated with any remote peer
+func newTransmissionConfig(logger *logging.Logger) transmissionConfig {
+	conf := transmissionConfig{
+		compression:        proto.CompressionAlgorithm_NO_COMPRESSION,
+		compressionMinSize: util.GetIntOrDefault("peer.gossip.compression.minSize", defCompressionMinSize),
+		maxBatchSize:       1,
+		batchLinger:        util.GetDurationOrDefault("peer.gossip.batching.linger", defBatchLinger),
+	}
+	algorithm := strings.ToUpper(viper.GetString("peer.gossip.compression.algorithm"))
+	if algorithm != "" && algorithm != "NONE" {
+		if alg, exists := proto.CompressionAlgorithm_value[algorithm]; exists {
+			conf.compression = proto.CompressionAlgorithm(alg)
+		} else {
+			logger.Warning("Unknown compression algorithm", algorithm, ", messages won't be compressed")
+		}
+	}
+	if viper.GetBool("peer.gossip.batching.enabled") {
+		conf.maxBatchSize = util.GetIntOrDefault("peer.gossip.batching.maxSize", defMaxBatchSize)
+	}
+	if conf.maxBatchSize < 1 {
+		conf.maxBatchSize = 1
+	}
+	return conf
+}
+
+// negotiate returns the transmission configuration that suits
+// the capabilities the remote peer advertised in its handshake
+func (tc transmissionConfig) negotiate(remote *proto.ConnEstablish) transmissionConfig {
+	if !remote.Batching || remote.MaxBatchSize < 2 {
+		tc.maxBatchSize = 1
+	} else if int(remote.MaxBatchSize) < tc.maxBatchSize {
+		tc.maxBatchSize = int(remote.MaxBatchSize)
+	}
+	supported := false
+	for _, alg := range remote.Compression {
+		if alg == tc.compression {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		tc.compression = proto.CompressionAlgorithm_NO_COMPRESSION
+	}
+	return tc
+}
+
+// batching returns whether envelopes are sent together
+func (tc transmissionConfig) batching() bool {
+	return tc.maxBatchSize > 1
+}
+
+// compress returns an envelope with the payload of the given envelope compressed,
+// or the given envelope itself if its payload isn't worth compressing.
+// The given envelope isn't modified, as it may be sent to other peers as well
+func (tc transmissionConfig) compress(envelope *proto.Envelope) *proto.Envelope {
+	if tc.compression == proto.CompressionAlgorithm_NO_COMPRESSION || len(envelope.Payload) < tc.compressionMinSize {
+		return envelope
+	}
+	payload, err := compress(tc.compression, envelope.Payload)
+	// Fall back to sending the payload uncompressed
+	if err != nil || len(payload) >= len(envelope.Payload) {
+		return envelope
+	}
+	return &proto.Envelope{
+		Payload:        payload,
+		Signature:      envelope.Signature,
+		SecretEnvelope: envelope.SecretEnvelope,
+		Compression:    tc.compression,
+	}
+}
+
+// pack returns an envelope that carries the given envelopes
+func (tc transmissionConfig) pack(envelopes []*proto.Envelope) *proto.Envelope {
+	if len(envelopes) == 1 {
+		return tc.compress(envelopes[0])
+	}
+	batch := &proto.Envelope{}
+	for _, envelope := range envelopes {
+		batch.Batch = append(batch.Batch, tc.compress(envelope))
+	}
+	return batch
+}
+
+// unpack returns the envelopes an envelope received from a remote peer carries,
+// with their payloads decompressed. A batch may carry at most maxReceivedBatchSize
+// envelopes, and the payloads of all envelopes carried by the received envelope
+// may be decompressed into at most maxDecompressedSize bytes altogether
+func unpack(envelope *proto.Envelope) ([]*proto.Envelope, error) {
+	envelopes := envelope.Batch
+	if len(envelopes) == 0 {
+		envelopes = []*proto.Envelope{envelope}
+	}
+	if len(envelopes) > maxReceivedBatchSize {
+		return nil, errors.Errorf("batch contains %d envelopes, but at most %d are allowed", len(envelopes), maxReceivedBatchSize)
+	}
+	budget := maxDecompressedSize
+	res := make([]*proto.Envelope, 0, len(envelopes))
+	for _, e := range envelopes {
+		if len(e.Batch) > 0 {
+			return nil, errors.New("batch contains an envelope that carries a batch")
+		}
+		if e.Compression == proto.CompressionAlgorithm_NO_COMPRESSION {
+			res = append(res, e)
+			continue
+		}
+		payload, err := decompress(e.Compression, e.Payload, budget)
+		if err != nil {
+			return nil, errors.WithMessage(err, "failed decompressing payload")
+		}
+		budget -= len(payload)
+		res = append(res, &proto.Envelope{
+			Payload:        payload,
+			Signature:      e.Signature,
+			SecretEnvelope: e.SecretEnvelope,
+		})
+	}
+	return res, nil
+}
+
+func compress(algorithm proto.CompressionAlgorithm, data []byte) ([]byte, error) {
+	switch algorithm {
+	case proto.CompressionAlgorithm_SNAPPY:
+		return snappy.Encode(nil, data), nil
+	case proto.CompressionAlgorithm_GZIP:
+		buff := &bytes.Buffer{}
+		w := gzip.NewWriter(buff)
+		if _, err := w.Write(data); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if err := w.Close(); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return buff.Bytes(), nil
+	}
+	return nil, errors.Errorf("unsupported compression algorithm %s", algorithm)
+}
+
+// decompress decompresses the given data, failing if it
+// decompresses into more than maxSize bytes
+func decompress(algorithm proto.CompressionAlgorithm, data []byte, maxSize int) ([]byte, error) {
+	switch algorithm {
+	case proto.CompressionAlgorithm_SNAPPY:
+		size, err := snappy.DecodedLen(data)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if size > maxSize {
+			return nil, errors.Errorf("decompressed payload is too big (%d bytes)", size)
+		}
+		decoded, err := snappy.Decode(nil, data)
+		return decoded, errors.WithStack(err)
+	case proto.CompressionAlgorithm_GZIP:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		defer r.Close()
+		decoded, err := ioutil.ReadAll(io.LimitReader(r, int64(maxSize)+1))
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if len(decoded) > maxSize {
+			return nil, errors.New("decompressed payload is too big")
+		}
+		return decoded, nil
+	}
+	return nil, errors.Errorf("unsupported compression algorithm %s", algorithm)
+}
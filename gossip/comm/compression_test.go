@@ -0,0 +1,191 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package comm
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	proto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompressDecompress(t *testing.T) {
+	t.Parallel()
+	data := bytes.Repeat([]byte("compressible payload "), 1000)
+	for _, alg := range supportedCompression {
+		compressed, err := compress(alg, data)
+		assert.NoError(t, err)
+		assert.True(t, len(compressed) < len(data), "%s didn't compress the payload", alg)
+		decompressed, err := decompress(alg, compressed, len(data))
+		assert.NoError(t, err)
+		assert.Equal(t, data, decompressed)
+		// Corrupted data fails being decompressed
+		_, err = decompress(alg, compressed[:len(compressed)/2], len(data))
+		assert.Error(t, err, "%s decompressed corrupted data", alg)
+		// Data that decompresses into more than the maximum size is rejected
+		_, err = decompress(alg, compressed, len(data)-1)
+		assert.Error(t, err, "%s decompressed data bigger than the maximum size", alg)
+	}
+
+	_, err := compress(proto.CompressionAlgorithm(100), data)
+	assert.Error(t, err)
+	_, err = decompress(proto.CompressionAlgorithm(100), data, maxDecompressedSize)
+	assert.Error(t, err)
+}
+
+func TestTransmissionConfigNegotiation(t *testing.T) {
+	t.Parallel()
+	tc := transmissionConfig{
+		compression:  proto.CompressionAlgorithm_GZIP,
+		maxBatchSize: 10,
+	}
+	// The remote peer supports everything
+	negotiated := tc.negotiate(&proto.ConnEstablish{Compression: supportedCompression, Batching: true, MaxBatchSize: maxReceivedBatchSize})
+	assert.Equal(t, proto.CompressionAlgorithm_GZIP, negotiated.compression)
+	assert.Equal(t, 10, negotiated.maxBatchSize)
+	// The remote peer receives smaller batches than the ones the peer sends
+	negotiated = tc.negotiate(&proto.ConnEstablish{Batching: true, MaxBatchSize: 4})
+	assert.Equal(t, 4, negotiated.maxBatchSize)
+	// The remote peer doesn't batch, or doesn't advertise the size of the batches it receives
+	negotiated = tc.negotiate(&proto.ConnEstablish{MaxBatchSize: maxReceivedBatchSize})
+	assert.False(t, negotiated.batching())
+	negotiated = tc.negotiate(&proto.ConnEstablish{Batching: true})
+	assert.False(t, negotiated.batching())
+	// The remote peer supports neither compression nor batching, such as peers of older versions
+	negotiated = tc.negotiate(&proto.ConnEstablish{})
+	assert.Equal(t, proto.CompressionAlgorithm_NO_COMPRESSION, negotiated.compression)
+	assert.False(t, negotiated.batching())
+	// The remote peer supports only a different compression algorithm
+	negotiated = tc.negotiate(&proto.ConnEstablish{Compression: []proto.CompressionAlgorithm{proto.CompressionAlgorithm_SNAPPY}})
+	assert.Equal(t, proto.CompressionAlgorithm_NO_COMPRESSION, negotiated.compression)
+	// The original configuration isn't modified
+	assert.Equal(t, proto.CompressionAlgorithm_GZIP, tc.compression)
+	assert.True(t, tc.batching())
+}
+
+func TestConnectionMsgAdvertisesBatching(t *testing.T) {
+	t.Parallel()
+	noopSigner := func(msg []byte) ([]byte, error) {
+		return msg, nil
+	}
+	// A peer that batches advertises it, along with the size of the batches it receives
+	c := &commImpl{transmission: transmissionConfig{maxBatchSize: 10}}
+	msg, err := c.createConnectionMsg(nil, nil, nil, noopSigner)
+	assert.NoError(t, err)
+	assert.True(t, msg.GetConn().Batching)
+	assert.Equal(t, uint32(maxReceivedBatchSize), msg.GetConn().MaxBatchSize)
+	// A peer that doesn't batch doesn't advertise batching
+	c = &commImpl{transmission: transmissionConfig{maxBatchSize: 1}}
+	msg, err = c.createConnectionMsg(nil, nil, nil, noopSigner)
+	assert.NoError(t, err)
+	assert.False(t, msg.GetConn().Batching)
+}
+
+func TestPackUnpack(t *testing.T) {
+	t.Parallel()
+	tc := transmissionConfig{
+		compression:        proto.CompressionAlgorithm_SNAPPY,
+		compressionMinSize: 100,
+		maxBatchSize:       10,
+	}
+	small := &proto.Envelope{Payload: []byte("small payload"), Signature: []byte("sig1")}
+	big := &proto.Envelope{
+		Payload:        bytes.Repeat([]byte("big payload "), 100),
+		Signature:      []byte("sig2"),
+		SecretEnvelope: &proto.SecretEnvelope{Payload: []byte("secret")},
+	}
+
+	// A single envelope is sent as is, but compressed if it is big enough
+	packed := tc.pack([]*proto.Envelope{small})
+	assert.Equal(t, small, packed)
+	packed = tc.pack([]*proto.Envelope{big})
+	assert.Equal(t, proto.CompressionAlgorithm_SNAPPY, packed.Compression)
+	assert.True(t, len(packed.Payload) < len(big.Payload))
+	assert.Equal(t, proto.CompressionAlgorithm_NO_COMPRESSION, big.Compression, "the original envelope shouldn't have been modified")
+	unpacked, err := unpack(packed)
+	assert.NoError(t, err)
+	assert.Equal(t, []*proto.Envelope{big}, unpacked)
+
+	// Several envelopes are sent as a batch
+	packed = tc.pack([]*proto.Envelope{small, big})
+	assert.Empty(t, packed.Payload)
+	assert.Len(t, packed.Batch, 2)
+	unpacked, err = unpack(packed)
+	assert.NoError(t, err)
+	assert.Equal(t, []*proto.Envelope{small, big}, unpacked)
+
+	// Nested batches are rejected
+	_, err = unpack(&proto.Envelope{Batch: []*proto.Envelope{packed}})
+	assert.Error(t, err)
+	// Payloads that can't be decompressed are rejected
+	_, err = unpack(&proto.Envelope{Payload: []byte{1, 2, 3}, Compression: proto.CompressionAlgorithm_GZIP})
+	assert.Error(t, err)
+	// Batches are accepted up to the advertised maximum size,
+	// regardless of the size of the batches the peer sends
+	batch := &proto.Envelope{}
+	for i := 0; i < maxReceivedBatchSize; i++ {
+		batch.Batch = append(batch.Batch, small)
+	}
+	unpacked, err = unpack(batch)
+	assert.NoError(t, err)
+	assert.Len(t, unpacked, maxReceivedBatchSize)
+	// Bigger batches are rejected
+	batch.Batch = append(batch.Batch, small)
+	_, err = unpack(batch)
+	assert.Error(t, err)
+}
+
+func TestUnpackDecompressedSizeBudget(t *testing.T) {
+	t.Parallel()
+	// Each payload decompresses into more than a third of the budget,
+	// hence only two of them fit into a single received envelope
+	data := make([]byte, maxDecompressedSize/3+1)
+	compressed, err := compress(proto.CompressionAlgorithm_SNAPPY, data)
+	assert.NoError(t, err)
+	env := &proto.Envelope{Payload: compressed, Compression: proto.CompressionAlgorithm_SNAPPY}
+
+	unpacked, err := unpack(&proto.Envelope{Batch: []*proto.Envelope{env, env}})
+	assert.NoError(t, err)
+	assert.Len(t, unpacked, 2)
+	_, err = unpack(&proto.Envelope{Batch: []*proto.Envelope{env, env, env}})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "decompressed payload is too big")
+}
+
+func TestCollectBatch(t *testing.T) {
+	t.Parallel()
+	conn := newConnection(nil, nil, nil, nil)
+	conn.transmission = transmissionConfig{maxBatchSize: 3, batchLinger: time.Millisecond * 100}
+	msg := func(batchable bool) *msgSending {
+		return &msgSending{envelope: &proto.Envelope{}, batchable: batchable}
+	}
+
+	// The batch is limited by the maximum batch size
+	for i := 0; i < 4; i++ {
+		conn.outBuff <- msg(true)
+	}
+	batch, pending := conn.collectBatch([]*msgSending{<-conn.outBuff})
+	assert.Len(t, batch, 3)
+	assert.Nil(t, pending)
+
+	// A message that can't be batched ends the batch
+	conn.outBuff <- msg(false)
+	conn.outBuff <- msg(true)
+	batch, pending = conn.collectBatch([]*msgSending{<-conn.outBuff})
+	assert.Len(t, batch, 1)
+	assert.NotNil(t, pending)
+	assert.False(t, pending.batchable)
+
+	// The batch is sent once the linger expires, even if it isn't full
+	start := time.Now()
+	batch, pending = conn.collectBatch([]*msgSending{<-conn.outBuff})
+	assert.Len(t, batch, 1)
+	assert.Nil(t, pending)
+	assert.True(t, time.Since(start) >= conn.transmission.batchLinger)
+}
@@ -10,7 +10,9 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	pb "github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/gossip/common"
 	"github.com/hyperledger/fabric/gossip/util"
 	proto "github.com/hyperledger/fabric/protos/gossip"
@@ -129,6 +131,16 @@ func (cs *connectionStore) connNum() int {
 	return len(cs.pki2Conn)
 }
 
+func (cs *connectionStore) connStats() []ConnectionStats {
+	cs.RLock()
+	defer cs.RUnlock()
+	stats := make([]ConnectionStats, 0, len(cs.pki2Conn))
+	for _, conn := range cs.pki2Conn {
+		stats = append(stats, conn.stats())
+	}
+	return stats
+}
+
 func (cs *connectionStore) closeConn(peer *RemotePeer) {
 	cs.Lock()
 	defer cs.Unlock()
@@ -161,7 +173,7 @@ func (cs *connectionStore) shutdown() {
 	wg.Wait()
 }
 
-func (cs *connectionStore) onConnected(serverStream proto.Gossip_GossipStreamServer, connInfo *proto.ConnectionInfo) *connection {
+func (cs *connectionStore) onConnected(serverStream proto.Gossip_GossipStreamServer, connInfo *proto.ConnectionInfo, transmission transmissionConfig) *connection {
 	cs.Lock()
 	defer cs.Unlock()
 
@@ -169,13 +181,14 @@ func (cs *connectionStore) onConnected(serverStream proto.Gossip_GossipStreamSer
 		c.close()
 	}
 
-	return cs.registerConn(connInfo, serverStream)
+	return cs.registerConn(connInfo, serverStream, transmission)
 }
 
-func (cs *connectionStore) registerConn(connInfo *proto.ConnectionInfo, serverStream proto.Gossip_GossipStreamServer) *connection {
+func (cs *connectionStore) registerConn(connInfo *proto.ConnectionInfo, serverStream proto.Gossip_GossipStreamServer, transmission transmissionConfig) *connection {
 	conn := newConnection(nil, nil, nil, serverStream)
 	conn.pkiID = connInfo.ID
 	conn.info = connInfo
+	conn.transmission = transmission
 	conn.logger = cs.logger
 	cs.pki2Conn[string(connInfo.ID)] = conn
 	return conn
@@ -199,24 +212,28 @@ func newConnection(cl proto.GossipClient, c *grpc.ClientConn, cs proto.Gossip_Go
 		serverStream: ss,
 		stopFlag:     int32(0),
 		stopChan:     make(chan struct{}, 1),
+		transmission: transmissionConfig{maxBatchSize: 1},
 	}
 	return connection
 }
 
 type connection struct {
-	cancel       context.CancelFunc
-	info         *proto.ConnectionInfo
-	outBuff      chan *msgSending
-	logger       *logging.Logger                 // logger
-	pkiID        common.PKIidType                // pkiID of the remote endpoint
-	handler      handler                         // function to invoke upon a message reception
-	conn         *grpc.ClientConn                // gRPC connection to remote endpoint
-	cl           proto.GossipClient              // gRPC stub of remote endpoint
-	clientStream proto.Gossip_GossipStreamClient // client-side stream to remote endpoint
-	serverStream proto.Gossip_GossipStreamServer // server-side stream to remote endpoint
-	stopFlag     int32                           // indicates whether this connection is in process of stopping
-	stopChan     chan struct{}                   // a method to stop the server-side gRPC call from a different go-routine
-	sync.RWMutex                                 // synchronizes access to shared variables
+	bytesSent     uint64 // bytes sent to the remote endpoint, accessed atomically
+	bytesReceived uint64 // bytes received from the remote endpoint, accessed atomically
+	cancel        context.CancelFunc
+	info          *proto.ConnectionInfo
+	outBuff       chan *msgSending
+	logger        *logging.Logger                 // logger
+	pkiID         common.PKIidType                // pkiID of the remote endpoint
+	handler       handler                         // function to invoke upon a message reception
+	conn          *grpc.ClientConn                // gRPC connection to remote endpoint
+	cl            proto.GossipClient              // gRPC stub of remote endpoint
+	clientStream  proto.Gossip_GossipStreamClient // client-side stream to remote endpoint
+	serverStream  proto.Gossip_GossipStreamServer // server-side stream to remote endpoint
+	stopFlag      int32                           // indicates whether this connection is in process of stopping
+	stopChan      chan struct{}                   // a method to stop the server-side gRPC call from a different go-routine
+	transmission  transmissionConfig              // how envelopes are transmitted to the remote endpoint
+	sync.RWMutex                                  // synchronizes access to shared variables
 }
 
 func (conn *connection) close() {
@@ -260,8 +277,9 @@ func (conn *connection) send(msg *proto.SignedGossipMessage, onErr func(error),
 	defer conn.Unlock()
 
 	m := &msgSending{
-		envelope: msg.Envelope,
-		onErr:    onErr,
+		envelope:  msg.Envelope,
+		onErr:     onErr,
+		batchable: msg.IsAliveMsg() || msg.IsStateInfoMsg(),
 	}
 
 	if len(conn.outBuff) == util.GetIntOrDefault("peer.gossip.sendBuffSize", defSendBuffSize) {
@@ -305,25 +323,66 @@ func (conn *connection) serviceConnection() error {
 }
 
 func (conn *connection) writeToStream() {
+	// pending is a message taken out of the output buffer
+	// while collecting a batch, that can't be part of the batch
+	var pending *msgSending
 	for !conn.toDie() {
 		stream := conn.getStream()
 		if stream == nil {
 			conn.logger.Error(conn.pkiID, "Stream is nil, aborting!")
 			return
 		}
+		m := pending
+		pending = nil
+		if m == nil {
+			select {
+			case m = <-conn.outBuff:
+			case stop := <-conn.stopChan:
+				conn.logger.Debug("Closing writing to stream")
+				conn.stopChan <- stop
+				return
+			}
+		}
+		batch := []*msgSending{m}
+		if m.batchable && conn.transmission.batching() {
+			batch, pending = conn.collectBatch(batch)
+		}
+		envelopes := make([]*proto.Envelope, len(batch))
+		for i, m := range batch {
+			envelopes[i] = m.envelope
+		}
+		envelope := conn.transmission.pack(envelopes)
+		err := stream.Send(envelope)
+		if err != nil {
+			go m.onErr(err)
+			return
+		}
+		atomic.AddUint64(&conn.bytesSent, uint64(pb.Size(envelope)))
+	}
+}
+
+// collectBatch adds to the batch messages from the output buffer that can be sent
+// together with it, waiting up to the batch linger for them.
+// It returns the batch, and a message that was taken out of the output buffer
+// but can't be sent together with the batch, if there is such a message
+func (conn *connection) collectBatch(batch []*msgSending) ([]*msgSending, *msgSending) {
+	linger := time.NewTimer(conn.transmission.batchLinger)
+	defer linger.Stop()
+	for len(batch) < conn.transmission.maxBatchSize {
 		select {
 		case m := <-conn.outBuff:
-			err := stream.Send(m.envelope)
-			if err != nil {
-				go m.onErr(err)
-				return
+			if !m.batchable {
+				return batch, m
 			}
+			batch = append(batch, m)
+		case <-linger.C:
+			return batch, nil
 		case stop := <-conn.stopChan:
-			conn.logger.Debug("Closing writing to stream")
 			conn.stopChan <- stop
-			return
+			return batch, nil
 		}
 	}
+	return batch, nil
 }
 
 func (conn *connection) drainOutputBuffer() {
@@ -354,13 +413,38 @@ func (conn *connection) readFromStream(errChan chan error, msgChan chan *proto.S
 			conn.logger.Debugf("%v Got error, aborting: %v", err)
 			return
 		}
-		msg, err := envelope.ToGossipMessage()
+		atomic.AddUint64(&conn.bytesReceived, uint64(pb.Size(envelope)))
+		envelopes, err := unpack(envelope)
 		if err != nil {
 			errChan <- err
-			conn.logger.Warning("%v Got error, aborting: %v", err)
+			conn.logger.Warningf("%v Got malformed envelope, aborting: %v", conn.pkiID, err)
+			return
 		}
-		msgChan <- msg
+		for _, envelope := range envelopes {
+			msg, err := envelope.ToGossipMessage()
+			if err != nil {
+				errChan <- err
+				conn.logger.Warning("%v Got error, aborting: %v", err)
+			}
+			msgChan <- msg
+		}
+	}
+}
+
+func (conn *connection) stats() ConnectionStats {
+	conn.RLock()
+	outbound := conn.clientStream != nil
+	conn.RUnlock()
+	stats := ConnectionStats{
+		RemotePeer:    RemotePeer{PKIID: conn.pkiID},
+		Outbound:      outbound,
+		BytesSent:     atomic.LoadUint64(&conn.bytesSent),
+		BytesReceived: atomic.LoadUint64(&conn.bytesReceived),
+	}
+	if conn.info != nil {
+		stats.Endpoint = conn.info.Endpoint
 	}
+	return stats
 }
 
 func (conn *connection) getStream() stream {
@@ -384,6 +468,7 @@ func (conn *connection) getStream() stream {
 }
 
 type msgSending struct {
-	envelope *proto.Envelope
-	onErr    func(error)
+	envelope  *proto.Envelope
+	onErr     func(error)
+	batchable bool
 }
@@ -185,6 +185,12 @@ func (mock *commMock) CloseConn(peer *comm.RemotePeer) {
 	// NOOP
 }
 
+// Connections returns the connections to remote peers,
+// along with the bytes sent and received over them
+func (mock *commMock) Connections() []comm.ConnectionStats {
+	return nil
+}
+
 // Stop stops the module
 func (mock *commMock) Stop() {
 	logger.Debug("Stopping communication module, closing all accepting channels.")
@@ -20,6 +20,10 @@ type CryptoService interface {
 
 	// SignMessage signs a message
 	SignMessage(m *proto.GossipMessage, internalEndpoint string) *proto.Envelope
+
+	// ValidateMembershipResponse validates that a membership response
+	// is signed by the peer it was received from, if it is signed
+	ValidateMembershipResponse(msg proto.ReceivedMessage) bool
 }
 
 // EnvelopeFilter may or may not remove part of the Envelope
@@ -123,6 +127,9 @@ type Discovery interface {
 	// GetMembership returns the alive members in the view
 	GetMembership() []NetworkMember
 
+	// GetDeadMembers returns the members in the view that are considered dead
+	GetDeadMembers() []NetworkMember
+
 	// InitiateSync makes the instance ask a given number of peers
 	// for their membership information
 	InitiateSync(peerNum int)
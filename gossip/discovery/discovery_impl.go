@@ -163,7 +163,7 @@ func (d *gossipDiscoveryImpl) Connect(member NetworkMember, id identifier) {
 				Endpoint:         member.Endpoint,
 				PKIid:            id.ID,
 			}
-			m, err := d.createMembershipRequest(id.SelfOrg)
+			m, err := d.createMembershipRequest(peer, id.SelfOrg)
 			if err != nil {
 				d.logger.Warningf("Failed creating membership request: %+v", errors.WithStack(err))
 				continue
@@ -230,16 +230,6 @@ func (d *gossipDiscoveryImpl) InitiateSync(peerNum int) {
 		return
 	}
 	var peers2SendTo []*NetworkMember
-	m, err := d.createMembershipRequest(true)
-	if err != nil {
-		d.logger.Warningf("Failed creating membership request: %+v", errors.WithStack(err))
-		return
-	}
-	memReq, err := m.NoopSign()
-	if err != nil {
-		d.logger.Warningf("Failed creating SignedGossipMessage: %+v", errors.WithStack(err))
-		return
-	}
 	d.lock.RLock()
 
 	n := d.aliveMembership.Size()
@@ -266,8 +256,10 @@ func (d *gossipDiscoveryImpl) InitiateSync(peerNum int) {
 
 	d.lock.RUnlock()
 
+	// Each peer is sent a membership request of its own,
+	// since the known peers it's told about depend on the peer
 	for _, netMember := range peers2SendTo {
-		d.comm.SendToPeer(netMember, memReq)
+		d.sendMembershipRequest(netMember, true)
 	}
 }
 
@@ -345,7 +337,7 @@ func (d *gossipDiscoveryImpl) handleMsgFromComm(msg proto.ReceivedMessage) {
 		// Sending a membership response to a peer may block this routine
 		// in case the sending is deliberately slow (i.e attack).
 		// will keep this async until I'll write a timeout detector in the comm layer
-		go d.sendMemResponse(selfInfoGossipMsg.GetAliveMsg().Membership, internalEndpoint, m.Nonce)
+		go d.sendMemResponse(selfInfoGossipMsg.GetAliveMsg().Membership, internalEndpoint, m.Nonce, memReq.Known)
 		return
 	}
 
@@ -365,6 +357,9 @@ func (d *gossipDiscoveryImpl) handleMsgFromComm(msg proto.ReceivedMessage) {
 	}
 
 	if memResp := m.GetMemRes(); memResp != nil {
+		if !d.crypt.ValidateMembershipResponse(msg) {
+			return
+		}
 		d.pubsub.Publish(fmt.Sprintf("%d", m.Nonce), m.Nonce)
 		for _, env := range memResp.Alive {
 			am, err := env.ToGossipMessage()
@@ -405,7 +400,7 @@ func (d *gossipDiscoveryImpl) handleMsgFromComm(msg proto.ReceivedMessage) {
 	}
 }
 
-func (d *gossipDiscoveryImpl) sendMemResponse(targetMember *proto.Member, internalEndpoint string, nonce uint64) {
+func (d *gossipDiscoveryImpl) sendMemResponse(targetMember *proto.Member, internalEndpoint string, nonce uint64, known [][]byte) {
 	d.logger.Debug("Entering", targetMember)
 
 	targetPeer := &NetworkMember{
@@ -420,7 +415,7 @@ func (d *gossipDiscoveryImpl) sendMemResponse(targetMember *proto.Member, intern
 		d.logger.Warningf("Failed creating alive message: %+v", errors.WithStack(err))
 		return
 	}
-	memResp := d.createMembershipResponse(aliveMsg, targetPeer)
+	memResp := d.createMembershipResponse(aliveMsg, targetPeer, known)
 	if memResp == nil {
 		errMsg := `Got a membership request from a peer that shouldn't have sent one: %v, closing connection to the peer as a result.`
 		d.logger.Warningf(errMsg, targetMember)
@@ -430,22 +425,28 @@ func (d *gossipDiscoveryImpl) sendMemResponse(targetMember *proto.Member, intern
 
 	defer d.logger.Debug("Exiting, replying with", memResp)
 
-	msg, err := (&proto.GossipMessage{
+	gMsg := &proto.GossipMessage{
 		Tag:   proto.GossipMessage_EMPTY,
 		Nonce: nonce,
 		Content: &proto.GossipMessage_MemRes{
 			MemRes: memResp,
 		},
-	}).NoopSign()
-	if err != nil {
-		err = errors.WithStack(err)
-		d.logger.Warningf("Failed creating SignedGossipMessage: %+v", errors.WithStack(err))
+	}
+	// The membership response is signed, so the remote peer can be sure
+	// the peers disclosed to it were disclosed by us
+	envelope := d.crypt.SignMessage(gMsg, "")
+	if envelope == nil {
+		d.logger.Warning("Failed signing membership response to", targetMember)
 		return
 	}
+	msg := &proto.SignedGossipMessage{
+		GossipMessage: gMsg,
+		Envelope:      envelope,
+	}
 	d.comm.SendToPeer(targetPeer, msg)
 }
 
-func (d *gossipDiscoveryImpl) createMembershipResponse(aliveMsg *proto.SignedGossipMessage, targetMember *NetworkMember) *proto.MembershipResponse {
+func (d *gossipDiscoveryImpl) createMembershipResponse(aliveMsg *proto.SignedGossipMessage, targetMember *NetworkMember, known [][]byte) *proto.MembershipResponse {
 	shouldBeDisclosed, omitConcealedFields := d.disclosurePolicy(targetMember)
 
 	if !shouldBeDisclosed(aliveMsg) {
@@ -465,11 +466,20 @@ func (d *gossipDiscoveryImpl) createMembershipResponse(aliveMsg *proto.SignedGos
 		deadPeers = append(deadPeers, omitConcealedFields(dm))
 	}
 
+	knownPeers := make(map[string]struct{}, len(known))
+	for _, pkiID := range known {
+		knownPeers[string(pkiID)] = struct{}{}
+	}
+
 	var aliveSnapshot []*proto.Envelope
 	for _, am := range d.aliveMembership.ToSlice() {
 		if !shouldBeDisclosed(am) {
 			continue
 		}
+		// Skip peers the remote peer already knows about
+		if _, isKnown := knownPeers[string(am.GetAliveMsg().Membership.PkiId)]; isKnown {
+			continue
+		}
 		aliveSnapshot = append(aliveSnapshot, omitConcealedFields(am))
 	}
 
@@ -618,7 +628,7 @@ func (d *gossipDiscoveryImpl) periodicalReconnectToDead() {
 }
 
 func (d *gossipDiscoveryImpl) sendMembershipRequest(member *NetworkMember, includeInternalEndpoint bool) {
-	m, err := d.createMembershipRequest(includeInternalEndpoint)
+	m, err := d.createMembershipRequest(member, includeInternalEndpoint)
 	if err != nil {
 		d.logger.Warningf("Failed creating membership request: %+v", errors.WithStack(err))
 		return
@@ -631,17 +641,14 @@ func (d *gossipDiscoveryImpl) sendMembershipRequest(member *NetworkMember, inclu
 	d.comm.SendToPeer(member, req)
 }
 
-func (d *gossipDiscoveryImpl) createMembershipRequest(includeInternalEndpoint bool) (*proto.GossipMessage, error) {
+func (d *gossipDiscoveryImpl) createMembershipRequest(targetMember *NetworkMember, includeInternalEndpoint bool) (*proto.GossipMessage, error) {
 	am, err := d.createAliveMessage(includeInternalEndpoint)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 	req := &proto.MembershipRequest{
 		SelfInformation: am.Envelope,
-		// TODO: sending the known peers is not secure because the remote peer might shouldn't know
-		// TODO: about the known peers. I'm deprecating this until a secure mechanism will be implemented.
-		// TODO: See FAB-2570 for tracking this issue.
-		Known: [][]byte{},
+		Known:           d.knownPeersFor(targetMember),
 	}
 	return &proto.GossipMessage{
 		Tag:   proto.GossipMessage_EMPTY,
@@ -652,6 +659,31 @@ func (d *gossipDiscoveryImpl) createMembershipRequest(includeInternalEndpoint bo
 	}, nil
 }
 
+// knownPeersFor returns the PKI-IDs of the alive peers that the given remote
+// peer is eligible of knowing about, so that it could omit them from its
+// membership response. Peers the remote peer isn't eligible of knowing about
+// aren't included, as that would disclose them to it.
+func (d *gossipDiscoveryImpl) knownPeersFor(remotePeer *NetworkMember) [][]byte {
+	known := [][]byte{}
+	// The PKI-IDs of bootstrap peers aren't known before connecting to them,
+	// hence the disclosure policy can't be applied to them
+	if len(remotePeer.PKIid) == 0 {
+		return known
+	}
+	shouldBeDisclosed, _ := d.disclosurePolicy(remotePeer)
+
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	for _, am := range d.aliveMembership.ToSlice() {
+		pkiID := am.GetAliveMsg().Membership.PkiId
+		if bytes.Equal(pkiID, remotePeer.PKIid) || !shouldBeDisclosed(am) {
+			continue
+		}
+		known = append(known, pkiID)
+	}
+	return known
+}
+
 func (d *gossipDiscoveryImpl) copyLastSeen(lastSeenMap map[string]*timestamp) []NetworkMember {
 	d.lock.RLock()
 	defer d.lock.RUnlock()
@@ -913,8 +945,24 @@ func (d *gossipDiscoveryImpl) GetMembership() []NetworkMember {
 	d.lock.RLock()
 	defer d.lock.RUnlock()
 
+	return d.membersOf(d.aliveMembership)
+}
+
+func (d *gossipDiscoveryImpl) GetDeadMembers() []NetworkMember {
+	if d.toDie() {
+		return []NetworkMember{}
+	}
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	return d.membersOf(d.deadMembership)
+}
+
+// membersOf returns the members whose alive messages are in the given store.
+// Must be called while holding the lock
+func (d *gossipDiscoveryImpl) membersOf(store *util.MembershipStore) []NetworkMember {
 	response := []NetworkMember{}
-	for _, m := range d.aliveMembership.ToSlice() {
+	for _, m := range store.ToSlice() {
 		member := m.GetAliveMsg()
 		response = append(response, NetworkMember{
 			PKIid:            member.Membership.PkiId,
@@ -924,7 +972,6 @@ func (d *gossipDiscoveryImpl) GetMembership() []NetworkMember {
 		})
 	}
 	return response
-
 }
 
 func tsToTime(ts uint64) time.Time {
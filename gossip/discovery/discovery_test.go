@@ -82,6 +82,7 @@ type dummyCommModule struct {
 	incMsgs           chan proto.ReceivedMessage
 	lastSeqs          map[string]uint64
 	shouldGossip      bool
+	rejectMemResps    bool
 	mock              *mock.Mock
 }
 
@@ -131,6 +132,12 @@ func (comm *dummyCommModule) SignMessage(am *proto.GossipMessage, internalEndpoi
 	return env
 }
 
+func (comm *dummyCommModule) ValidateMembershipResponse(msg proto.ReceivedMessage) bool {
+	comm.lock.RLock()
+	defer comm.lock.RUnlock()
+	return !comm.rejectMemResps
+}
+
 func (comm *dummyCommModule) Gossip(msg *proto.SignedGossipMessage) {
 	if !comm.shouldGossip {
 		return
@@ -480,10 +487,10 @@ func TestConnect(t *testing.T) {
 	waitUntilOrFail(t, fullMembership)
 
 	discInst := instances[rand.Intn(len(instances))].Discovery.(*gossipDiscoveryImpl)
-	mr, _ := discInst.createMembershipRequest(true)
+	mr, _ := discInst.createMembershipRequest(&NetworkMember{}, true)
 	am, _ := mr.GetMemReq().SelfInformation.ToGossipMessage()
 	assert.NotNil(t, am.SecretEnvelope)
-	mr2, _ := discInst.createMembershipRequest(false)
+	mr2, _ := discInst.createMembershipRequest(&NetworkMember{}, false)
 	am, _ = mr2.GetMemReq().SelfInformation.ToGossipMessage()
 	assert.Nil(t, am.SecretEnvelope)
 	stopInstances(t, instances)
@@ -780,6 +787,9 @@ func TestExpiration(t *testing.T) {
 	waitUntilOrFailBlocking(t, instances[nodeNum-2].Stop)
 
 	assertMembership(t, instances[:len(instances)-2], nodeNum-3)
+	for _, inst := range instances[:len(instances)-2] {
+		assert.Len(t, inst.GetDeadMembers(), 2)
+	}
 
 	stopAction := &sync.WaitGroup{}
 	for i, inst := range instances {
@@ -1179,7 +1189,7 @@ func TestMsgStoreExpirationWithMembershipMessages(t *testing.T) {
 
 	// Creating MembershipRequest messages
 	for i := 0; i < peersNum; i++ {
-		memReqMsg, _ := instances[i].discoveryImpl().createMembershipRequest(true)
+		memReqMsg, _ := instances[i].discoveryImpl().createMembershipRequest(&NetworkMember{}, true)
 		sMsg, _ := memReqMsg.NoopSign()
 		memReqMsgs = append(memReqMsgs, sMsg)
 	}
@@ -1254,7 +1264,7 @@ func TestMsgStoreExpirationWithMembershipMessages(t *testing.T) {
 			},
 			func(k int) {
 				aliveMsg, _ := instances[k].discoveryImpl().createAliveMessage(true)
-				memResp := instances[k].discoveryImpl().createMembershipResponse(aliveMsg, peerToResponse)
+				memResp := instances[k].discoveryImpl().createMembershipResponse(aliveMsg, peerToResponse, nil)
 				memRespMsgs[i] = append(memRespMsgs[i], memResp)
 			})
 	}
@@ -1388,7 +1398,7 @@ func TestAliveMsgStore(t *testing.T) {
 
 	// Creating MembershipRequest messages
 	for i := 0; i < peersNum; i++ {
-		memReqMsg, _ := instances[i].discoveryImpl().createMembershipRequest(true)
+		memReqMsg, _ := instances[i].discoveryImpl().createMembershipRequest(&NetworkMember{}, true)
 		sMsg, _ := memReqMsg.NoopSign()
 		memReqMsgs = append(memReqMsgs, sMsg)
 	}
@@ -1443,6 +1453,122 @@ func TestMemRespDisclosurePol(t *testing.T) {
 	assert.NotZero(t, d2.sentMsgCount())
 }
 
+func TestMemReqKnownPeers(t *testing.T) {
+	t.Parallel()
+	// Scenario: d2, d3 and d4 use d1 as their bootstrap peer.
+	// d4's disclosure policy forbids disclosing d3 to anyone.
+	// Ensure membership requests tell the remote peer only about the known peers
+	// it's eligible of knowing about, and that membership responses omit them.
+	hideD3 := func(remotePeer *NetworkMember) (Sieve, EnvelopeFilter) {
+		return func(msg *proto.SignedGossipMessage) bool {
+				return string(msg.GetAliveMsg().Membership.PkiId) != "localhost:7883"
+			}, func(m *proto.SignedGossipMessage) *proto.Envelope {
+				return m.Envelope
+			}
+	}
+	d1 := createDiscoveryInstance(7881, "d1", []string{})
+	defer d1.Stop()
+	d2 := createDiscoveryInstance(7882, "d2", []string{"localhost:7881"})
+	defer d2.Stop()
+	d3 := createDiscoveryInstance(7883, "d3", []string{"localhost:7881"})
+	defer d3.Stop()
+	d4 := createDiscoveryInstanceThatGossips(7884, "d4", []string{"localhost:7881"}, true, hideD3)
+	defer d4.Stop()
+	assertMembership(t, []*gossipInstance{d1, d2, d3, d4}, 3)
+
+	d1Member := d1.Self()
+	knownPKIIDs := func(req *proto.GossipMessage) []string {
+		var known []string
+		for _, pkiID := range req.GetMemReq().Known {
+			known = append(known, string(pkiID))
+		}
+		sort.Strings(known)
+		return known
+	}
+
+	// The remote peer isn't told about itself
+	req, err := d2.discoveryImpl().createMembershipRequest(&d1Member, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"localhost:7883", "localhost:7884"}, knownPKIIDs(req))
+
+	// Known peers that the disclosure policy forbids disclosing aren't told about
+	req, err = d4.discoveryImpl().createMembershipRequest(&d1Member, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"localhost:7882"}, knownPKIIDs(req))
+
+	// Bootstrap peers aren't told about any peer, since their PKI-ID isn't known
+	req, err = d2.discoveryImpl().createMembershipRequest(&NetworkMember{Endpoint: "localhost:7881"}, true)
+	assert.NoError(t, err)
+	assert.Empty(t, req.GetMemReq().Known)
+
+	// The membership response omits the peers the remote peer knows about,
+	// but not the alive message of the responding peer itself
+	aliveMsg, err := d1.discoveryImpl().createAliveMessage(true)
+	assert.NoError(t, err)
+	d2Member := d2.Self()
+	memResp := d1.discoveryImpl().createMembershipResponse(aliveMsg, &d2Member, [][]byte{[]byte("localhost:7883"), []byte("localhost:7881")})
+	var disclosed []string
+	for _, env := range memResp.Alive {
+		am, err := env.ToGossipMessage()
+		assert.NoError(t, err)
+		disclosed = append(disclosed, string(am.GetAliveMsg().Membership.PkiId))
+	}
+	sort.Strings(disclosed)
+	assert.Equal(t, []string{"localhost:7881", "localhost:7882", "localhost:7884"}, disclosed)
+}
+
+func TestMemRespValidation(t *testing.T) {
+	t.Parallel()
+	// Scenario: d2 receives a membership response that discloses d1.
+	// Ensure d2 learns about d1 only if the membership response is valid.
+	d1 := createDiscoveryInstanceWithNoGossip(7885, "d1", []string{})
+	defer d1.Stop()
+	d2 := createDiscoveryInstanceWithNoGossip(7886, "d2", []string{})
+	defer d2.Stop()
+	validatedMessages := make(chan *proto.SignedGossipMessage, 10)
+	d2.comm.recordValidation(validatedMessages)
+
+	aliveMsg, err := d1.discoveryImpl().createAliveMessage(true)
+	assert.NoError(t, err)
+	memResp, err := (&proto.GossipMessage{
+		Tag:   proto.GossipMessage_EMPTY,
+		Nonce: uint64(1),
+		Content: &proto.GossipMessage_MemRes{
+			MemRes: &proto.MembershipResponse{Alive: []*proto.Envelope{aliveMsg.Envelope}},
+		},
+	}).NoopSign()
+	assert.NoError(t, err)
+	receivedMemResp := &dummyReceivedMessage{
+		msg:  memResp,
+		info: &proto.ConnectionInfo{ID: common.PKIidType("localhost:7885")},
+	}
+
+	d2.comm.lock.Lock()
+	d2.comm.rejectMemResps = true
+	d2.comm.lock.Unlock()
+	d2.comm.incMsgs <- receivedMemResp
+	waitUntilOrFail(t, func() bool {
+		return len(d2.comm.incMsgs) == 0
+	})
+	time.Sleep(time.Millisecond * 500)
+	assert.Empty(t, validatedMessages)
+	assert.Empty(t, d2.GetMembership())
+
+	d2.comm.lock.Lock()
+	d2.comm.rejectMemResps = false
+	d2.comm.lock.Unlock()
+	d2.comm.incMsgs <- receivedMemResp
+	select {
+	case validatedMsg := <-validatedMessages:
+		assert.Equal(t, aliveMsg.GetAliveMsg().Membership.PkiId, validatedMsg.GetAliveMsg().Membership.PkiId)
+	case <-time.After(timeout):
+		assert.Fail(t, "Alive message of the membership response wasn't validated")
+	}
+	waitUntilTimeoutOrFail(t, func() bool {
+		return len(d2.GetMembership()) == 1
+	}, time.Second)
+}
+
 func waitUntilOrFail(t *testing.T, pred func() bool) {
 	waitUntilTimeoutOrFail(t, pred, timeout)
 }
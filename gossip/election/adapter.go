@@ -34,6 +34,15 @@ func (mi *msgImpl) IsDeclaration() bool {
 	return mi.msg.GetLeadershipMsg().IsDeclaration
 }
 
+func (mi *msgImpl) Candidacy() Candidacy {
+	leadershipMsg := mi.msg.GetLeadershipMsg()
+	return Candidacy{
+		Priority:       leadershipMsg.Priority,
+		LedgerHeight:   leadershipMsg.LedgerHeight,
+		DeliverHealthy: !leadershipMsg.DeliverUnhealthy,
+	}
+}
+
 type peerImpl struct {
 	member discovery.NetworkMember
 }
@@ -56,6 +65,17 @@ type gossip interface {
 	Gossip(msg *proto.GossipMessage)
 }
 
+// HealthProvider provides the health of the peer in the channel,
+// which determines how fit the peer is for being the leader
+type HealthProvider interface {
+	// LedgerHeight returns the height of the ledger of the channel
+	LedgerHeight() (uint64, error)
+
+	// DeliverHealthy returns whether the peer doesn't fail
+	// receiving blocks from the ordering service
+	DeliverHealthy() bool
+}
+
 type adapterImpl struct {
 	gossip    gossip
 	selfPKIid common.PKIidType
@@ -64,6 +84,7 @@ type adapterImpl struct {
 	seqNum  uint64
 
 	channel common.ChainID
+	health  HealthProvider
 
 	logger *logging.Logger
 
@@ -71,8 +92,9 @@ type adapterImpl struct {
 	stopOnce *sync.Once
 }
 
-// NewAdapter creates new leader election adapter
-func NewAdapter(gossip gossip, pkiid common.PKIidType, channel common.ChainID) LeaderElectionAdapter {
+// NewAdapter creates new leader election adapter.
+// The health provider may be nil, in which case the peer is always considered healthy
+func NewAdapter(gossip gossip, pkiid common.PKIidType, channel common.ChainID, health HealthProvider) LeaderElectionAdapter {
 	return &adapterImpl{
 		gossip:    gossip,
 		selfPKIid: pkiid,
@@ -81,6 +103,7 @@ func NewAdapter(gossip gossip, pkiid common.PKIidType, channel common.ChainID) L
 		seqNum:  uint64(0),
 
 		channel: channel,
+		health:  health,
 
 		logger: util.GetLogger(util.LoggingElectionModule, ""),
 
@@ -124,6 +147,7 @@ func (ai *adapterImpl) CreateMessage(isDeclaration bool) Msg {
 	ai.seqNum++
 	seqNum := ai.seqNum
 
+	candidacy := ai.Candidacy()
 	leadershipMsg := &proto.LeadershipMessage{
 		PkiId:         ai.selfPKIid,
 		IsDeclaration: isDeclaration,
@@ -131,6 +155,9 @@ func (ai *adapterImpl) CreateMessage(isDeclaration bool) Msg {
 			IncNum: ai.incTime,
 			SeqNum: seqNum,
 		},
+		Priority:         candidacy.Priority,
+		LedgerHeight:     candidacy.LedgerHeight,
+		DeliverUnhealthy: !candidacy.DeliverHealthy,
 	}
 
 	msg := &proto.GossipMessage{
@@ -153,6 +180,23 @@ func (ai *adapterImpl) Peers() []Peer {
 	return res
 }
 
+func (ai *adapterImpl) Candidacy() Candidacy {
+	candidacy := Candidacy{
+		Priority:       getPriority(),
+		DeliverHealthy: true,
+	}
+	if ai.health == nil {
+		return candidacy
+	}
+	if height, err := ai.health.LedgerHeight(); err != nil {
+		ai.logger.Warning("Failed obtaining ledger height of channel", string(ai.channel), ":", err)
+	} else {
+		candidacy.LedgerHeight = height
+	}
+	candidacy.DeliverHealthy = ai.health.DeliverHealthy()
+	return candidacy
+}
+
 func (ai *adapterImpl) Stop() {
 	stopFunc := func() {
 		close(ai.doneCh)
@@ -35,7 +35,7 @@ func TestNewAdapter(t *testing.T) {
 	peersCluster := newClusterOfPeers("0")
 	peersCluster.addPeer("peer0", mockGossip)
 
-	NewAdapter(mockGossip, selfNetworkMember.PKIid, []byte("channel0"))
+	NewAdapter(mockGossip, selfNetworkMember.PKIid, []byte("channel0"), nil)
 }
 
 func TestAdapterImpl_CreateMessage(t *testing.T) {
@@ -46,7 +46,7 @@ func TestAdapterImpl_CreateMessage(t *testing.T) {
 	}
 	mockGossip := newGossip("peer0", selfNetworkMember)
 
-	adapter := NewAdapter(mockGossip, selfNetworkMember.PKIid, []byte("channel0"))
+	adapter := NewAdapter(mockGossip, selfNetworkMember.PKIid, []byte("channel0"), nil)
 	msg := adapter.CreateMessage(true)
 
 	if !msg.(*msgImpl).msg.IsLeadershipMsg() {
@@ -68,6 +68,52 @@ func TestAdapterImpl_CreateMessage(t *testing.T) {
 	}
 }
 
+type mockHealthProvider struct {
+	height  uint64
+	err     error
+	healthy bool
+}
+
+func (hp *mockHealthProvider) LedgerHeight() (uint64, error) {
+	return hp.height, hp.err
+}
+
+func (hp *mockHealthProvider) DeliverHealthy() bool {
+	return hp.healthy
+}
+
+func TestAdapterImpl_Candidacy(t *testing.T) {
+	selfNetworkMember := &discovery.NetworkMember{
+		Endpoint: "p0",
+		Metadata: []byte{},
+		PKIid:    []byte{byte(0)},
+	}
+	mockGossip := newGossip("peer0", selfNetworkMember)
+
+	// Without a health provider, the peer is considered healthy
+	adapter := NewAdapter(mockGossip, selfNetworkMember.PKIid, []byte("channel0"), nil)
+	if candidacy := adapter.CreateMessage(true).Candidacy(); !candidacy.DeliverHealthy || candidacy.LedgerHeight != 0 {
+		t.Error("Peer without a health provider should be healthy, but got", candidacy)
+	}
+
+	health := &mockHealthProvider{height: 10, healthy: false}
+	adapter = NewAdapter(mockGossip, selfNetworkMember.PKIid, []byte("channel0"), health)
+	msg := adapter.CreateMessage(true)
+	if !msg.(*msgImpl).msg.GetLeadershipMsg().DeliverUnhealthy {
+		t.Error("Leadership message of an unhealthy peer should be marked as such")
+	}
+	if candidacy := msg.Candidacy(); candidacy.DeliverHealthy || candidacy.LedgerHeight != 10 {
+		t.Error("Unexpected candidacy", candidacy)
+	}
+
+	// A failure to obtain the ledger height is reflected as a zero height
+	health.err = fmt.Errorf("ledger unavailable")
+	health.healthy = true
+	if candidacy := adapter.Candidacy(); !candidacy.DeliverHealthy || candidacy.LedgerHeight != 0 {
+		t.Error("Unexpected candidacy", candidacy)
+	}
+}
+
 func TestAdapterImpl_Peers(t *testing.T) {
 	_, adapters := createCluster(0, 1, 2, 3, 4, 5)
 
@@ -281,7 +327,7 @@ func createCluster(peers ...int) (*clusterOfPeers, map[string]*adapterImpl) {
 		}
 
 		mockGossip := newGossip(peerEndpoint, peerMember)
-		adapter := NewAdapter(mockGossip, peerMember.PKIid, []byte("channel0"))
+		adapter := NewAdapter(mockGossip, peerMember.PKIid, []byte("channel0"), nil)
 		adapters[peerEndpoint] = adapter.(*adapterImpl)
 		cluster.addPeer(peerEndpoint, mockGossip)
 	}
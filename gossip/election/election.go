@@ -19,7 +19,10 @@ import (
 
 // Gossip leader election module
 // Algorithm properties:
-// - Peers break symmetry by comparing IDs
+// - Peers break symmetry by comparing their candidacies: peers whose
+//   connection to the ordering service is healthy are preferred, then peers
+//   whose ledger isn't lagging behind, then peers of higher priority,
+//   and lastly peers with lower IDs
 // - Each peer is either a leader or a follower,
 //   and the aim is to have exactly 1 leader if the membership view
 //   is the same for all peers
@@ -51,8 +54,10 @@ import (
 //		If you are the leader:
 //			Broadcast leadership declaration
 //			If a leadership declaration was received from
-// 			a peer with a lower ID,
+// 			a fitter peer,
 //			become a follower
+//			If blocks fail being received from the ordering service,
+//			yield the leadership
 //		Else, you're a follower:
 //			If haven't received a leadership declaration within
 // 			a time threshold:
@@ -64,7 +69,7 @@ import (
 //	If received a leadership declaration:
 //		return
 //	Iterate over all proposal messages collected.
-// 	If a proposal message from a peer with an ID lower
+// 	If a proposal message from a peer fitter
 // 	than yourself was received, return.
 //	Else, declare yourself a leader
 
@@ -82,6 +87,9 @@ type LeaderElectionAdapter interface {
 
 	// Peers returns a list of peers considered alive
 	Peers() []Peer
+
+	// Candidacy returns how fit this peer currently is for being the leader
+	Candidacy() Candidacy
 }
 
 type leadershipCallback func(isLeader bool)
@@ -115,6 +123,75 @@ type Msg interface {
 	IsProposal() bool
 	// IsDeclaration returns whether this message is a leadership declaration
 	IsDeclaration() bool
+	// Candidacy returns how fit the peer that sent the message is for being the leader
+	Candidacy() Candidacy
+}
+
+// Candidacy describes how fit a peer is for being the leader
+type Candidacy struct {
+	// Priority is the leadership priority configured for the peer,
+	// peers with a higher priority are preferred
+	Priority uint32
+	// LedgerHeight is the height of the peer's ledger
+	LedgerHeight uint64
+	// DeliverHealthy is whether the peer doesn't fail
+	// receiving blocks from the ordering service
+	DeliverHealthy bool
+}
+
+// candidate is a peer competing for the leadership
+type candidate struct {
+	id peerID
+	Candidacy
+}
+
+// ranking orders a set of candidates by how fit they are for being the leader
+type ranking struct {
+	// maxHeight is the highest ledger height among the candidates
+	maxHeight uint64
+	// byIDOnly is whether the candidates are ranked by their IDs only
+	byIDOnly bool
+}
+
+// rankingOf returns the ranking of the given candidates. Peers of older versions
+// don't advertise their candidacy, and rank peers by their IDs only, hence so are
+// all the candidates ranked if the ledger height of any of them is unknown, so that
+// all peers agree on the same leader
+func rankingOf(candidates ...candidate) ranking {
+	r := ranking{}
+	for _, c := range candidates {
+		if c.LedgerHeight == 0 {
+			r.byIDOnly = true
+		}
+		if c.LedgerHeight > r.maxHeight {
+			r.maxHeight = c.LedgerHeight
+		}
+	}
+	return r
+}
+
+// isFitter returns whether the candidate is fitter for being the leader than the other candidate
+func (r ranking) isFitter(c, other candidate) bool {
+	if r.byIDOnly {
+		return bytes.Compare(c.id, other.id) < 0
+	}
+	if c.DeliverHealthy != other.DeliverHealthy {
+		return c.DeliverHealthy
+	}
+	lags, otherLags := c.lagsBehind(r.maxHeight), other.lagsBehind(r.maxHeight)
+	if lags != otherLags {
+		return !lags
+	}
+	if c.Priority != other.Priority {
+		return c.Priority > other.Priority
+	}
+	return bytes.Compare(c.id, other.id) < 0
+}
+
+// lagsBehind returns whether the ledger of the candidate lags behind
+// the given ledger height more than the maximum ledger lag
+func (c candidate) lagsBehind(height uint64) bool {
+	return c.LedgerHeight+getMaxLedgerLag() < height
 }
 
 func noopCallback(_ bool) {
@@ -127,7 +204,7 @@ func NewLeaderElectionService(adapter LeaderElectionAdapter, id string, callback
 	}
 	le := &leaderElectionSvcImpl{
 		id:            peerID(id),
-		proposals:     util.NewSet(),
+		proposals:     make(map[string]Candidacy),
 		adapter:       adapter,
 		stopChan:      make(chan struct{}, 1),
 		interruptChan: make(chan struct{}, 1),
@@ -146,7 +223,7 @@ func NewLeaderElectionService(adapter LeaderElectionAdapter, id string, callback
 // leaderElectionSvcImpl is an implementation of a LeaderElectionService
 type leaderElectionSvcImpl struct {
 	id        peerID
-	proposals *util.Set
+	proposals map[string]Candidacy
 	sync.Mutex
 	stopChan      chan struct{}
 	interruptChan chan struct{}
@@ -199,13 +276,18 @@ func (le *leaderElectionSvcImpl) handleMessage(msg Msg) {
 	defer le.Unlock()
 
 	if msg.IsProposal() {
-		le.proposals.Add(string(msg.SenderID()))
+		le.proposals[string(msg.SenderID())] = msg.Candidacy()
 	} else if msg.IsDeclaration() {
 		atomic.StoreInt32(&le.leaderExists, int32(1))
 		if le.sleeping && len(le.interruptChan) == 0 {
 			le.interruptChan <- struct{}{}
 		}
-		if bytes.Compare(msg.SenderID(), le.id) < 0 && le.IsLeader() {
+		if !le.IsLeader() {
+			return
+		}
+		declarer := candidate{id: msg.SenderID(), Candidacy: msg.Candidacy()}
+		self := le.self()
+		if rankingOf(declarer, self).isFitter(declarer, self) {
 			le.stopBeingLeader()
 		}
 	} else {
@@ -286,9 +368,15 @@ func (le *leaderElectionSvcImpl) leaderElection() {
 	}
 	// Leader doesn't exist, let's see if there is a better candidate than us
 	// for being a leader
-	for _, o := range le.proposals.ToArray() {
-		id := o.(string)
-		if bytes.Compare(peerID(id), le.id) < 0 {
+	candidates := []candidate{le.self()}
+	le.Lock()
+	for id, candidacy := range le.proposals {
+		candidates = append(candidates, candidate{id: peerID(id), Candidacy: candidacy})
+	}
+	le.Unlock()
+	r := rankingOf(candidates...)
+	for _, c := range candidates[1:] {
+		if r.isFitter(c, candidates[0]) {
 			return
 		}
 	}
@@ -310,7 +398,9 @@ func (le *leaderElectionSvcImpl) follower() {
 	le.logger.Debug(le.id, ": Entering")
 	defer le.logger.Debug(le.id, ": Exiting")
 
-	le.proposals.Clear()
+	le.Lock()
+	le.proposals = make(map[string]Candidacy)
+	le.Unlock()
 	atomic.StoreInt32(&le.leaderExists, int32(0))
 	select {
 	case <-time.After(getLeaderAliveThreshold()):
@@ -320,6 +410,13 @@ func (le *leaderElectionSvcImpl) follower() {
 }
 
 func (le *leaderElectionSvcImpl) leader() {
+	// A leader that fails receiving blocks from the ordering service
+	// relinquishes the leadership, so that a healthier peer would take over
+	if !le.adapter.Candidacy().DeliverHealthy {
+		le.logger.Warning(le.id, ": Failing to receive blocks from the ordering service, relinquishing leadership")
+		le.Yield()
+		return
+	}
 	leaderDeclaration := le.adapter.CreateMessage(true)
 	le.adapter.Gossip(leaderDeclaration)
 	le.waitForInterrupt(getLeadershipDeclarationInterval())
@@ -360,6 +457,11 @@ func (le *leaderElectionSvcImpl) isAlive(id peerID) bool {
 	return false
 }
 
+// self returns this peer as a candidate for the leadership
+func (le *leaderElectionSvcImpl) self() candidate {
+	return candidate{id: le.id, Candidacy: le.adapter.Candidacy()}
+}
+
 func (le *leaderElectionSvcImpl) isLeaderExists() bool {
 	return atomic.LoadInt32(&le.leaderExists) == int32(1)
 }
@@ -451,6 +553,17 @@ func SetLeaderElectionDuration(t time.Duration) {
 	viper.Set("peer.gossip.election.leaderElectionDuration", t)
 }
 
+// SetMaxLedgerLag configures the number of blocks the ledger of a peer may lag
+// behind the ledgers of other peers, without the peer being less fit for being the leader
+func SetMaxLedgerLag(blocks int) {
+	viper.Set("peer.gossip.election.maxLedgerLag", blocks)
+}
+
+// SetPriority configures the leadership priority of the peer
+func SetPriority(priority int) {
+	viper.Set("peer.gossip.election.priority", priority)
+}
+
 func getStartupGracePeriod() time.Duration {
 	return util.GetDurationOrDefault("peer.gossip.election.startupGracePeriod", time.Second*15)
 }
@@ -475,3 +588,11 @@ func getLeaderElectionDuration() time.Duration {
 func GetMsgExpirationTimeout() time.Duration {
 	return getLeaderAliveThreshold() * 10
 }
+
+func getMaxLedgerLag() uint64 {
+	return uint64(util.GetIntOrDefault("peer.gossip.election.maxLedgerLag", 10))
+}
+
+func getPriority() uint32 {
+	return uint32(util.GetIntOrDefault("peer.gossip.election.priority", 0))
+}
@@ -35,8 +35,9 @@ func init() {
 }
 
 type msg struct {
-	sender   string
-	proposal bool
+	sender    string
+	proposal  bool
+	candidacy Candidacy
 }
 
 func (m *msg) SenderID() peerID {
@@ -51,6 +52,10 @@ func (m *msg) IsDeclaration() bool {
 	return !m.proposal
 }
 
+func (m *msg) Candidacy() Candidacy {
+	return m.candidacy
+}
+
 type peer struct {
 	mockedMethods map[string]struct{}
 	mock.Mock
@@ -60,6 +65,7 @@ type peer struct {
 	msgChan            chan Msg
 	leaderFromCallback bool
 	callbackInvoked    bool
+	candidacy          Candidacy
 	lock               sync.RWMutex
 	LeaderElectionService
 }
@@ -104,7 +110,19 @@ func (p *peer) Accept() <-chan Msg {
 }
 
 func (p *peer) CreateMessage(isDeclaration bool) Msg {
-	return &msg{proposal: !isDeclaration, sender: p.id}
+	return &msg{proposal: !isDeclaration, sender: p.id, candidacy: p.Candidacy()}
+}
+
+func (p *peer) Candidacy() Candidacy {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.candidacy
+}
+
+func (p *peer) setCandidacy(candidacy Candidacy) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.candidacy = candidacy
 }
 
 func (p *peer) Peers() []Peer {
@@ -157,9 +175,13 @@ func createPeers(spawnInterval time.Duration, ids ...int) []*peer {
 }
 
 func createPeer(id int, peerMap map[string]*peer, l *sync.RWMutex) *peer {
+	return createPeerWithCandidacy(id, Candidacy{LedgerHeight: 1, DeliverHealthy: true}, peerMap, l)
+}
+
+func createPeerWithCandidacy(id int, candidacy Candidacy, peerMap map[string]*peer, l *sync.RWMutex) *peer {
 	idStr := fmt.Sprintf("p%d", id)
 	c := make(chan Msg, 100)
-	p := &peer{id: idStr, peers: peerMap, sharedLock: l, msgChan: c, mockedMethods: make(map[string]struct{}), leaderFromCallback: false, callbackInvoked: false}
+	p := &peer{id: idStr, peers: peerMap, sharedLock: l, msgChan: c, mockedMethods: make(map[string]struct{}), leaderFromCallback: false, callbackInvoked: false, candidacy: candidacy}
 	p.LeaderElectionService = NewLeaderElectionService(p, idStr, p.leaderCallback)
 	l.Lock()
 	peerMap[idStr] = p
@@ -417,6 +439,143 @@ func TestPartition(t *testing.T) {
 
 }
 
+func TestElectionByPriority(t *testing.T) {
+	t.Parallel()
+	// Scenario: peers spawn together, and one of the peers with a higher ID
+	// has a higher priority than the rest
+	// Expected outcome: the peer with the higher priority is the leader
+	peerMap := make(map[string]*peer)
+	l := &sync.RWMutex{}
+	var peers []*peer
+	for _, id := range []int{0, 1, 2} {
+		peers = append(peers, createPeer(id, peerMap, l))
+	}
+	peers = append(peers, createPeerWithCandidacy(3, Candidacy{Priority: 1, LedgerHeight: 1, DeliverHealthy: true}, peerMap, l))
+	leaders := waitForLeaderElection(t, peers)
+	assert.Len(t, leaders, 1, "Only 1 leader should have been elected")
+	assert.Equal(t, "p3", leaders[0])
+}
+
+func TestElectionLaggingLedger(t *testing.T) {
+	t.Parallel()
+	// Scenario: peers spawn together, and the peer with the lowest ID
+	// has a ledger that lags behind the ledgers of the rest of the peers
+	// more than the maximum ledger lag, although it has a higher priority.
+	// Expected outcome: the peer with the lowest ID among the peers that don't lag is the leader
+	peerMap := make(map[string]*peer)
+	l := &sync.RWMutex{}
+	peers := []*peer{
+		createPeerWithCandidacy(0, Candidacy{Priority: 1, LedgerHeight: 5, DeliverHealthy: true}, peerMap, l),
+		createPeerWithCandidacy(1, Candidacy{LedgerHeight: 100, DeliverHealthy: true}, peerMap, l),
+		createPeerWithCandidacy(2, Candidacy{LedgerHeight: 95, DeliverHealthy: true}, peerMap, l),
+	}
+	leaders := waitForLeaderElection(t, peers)
+	assert.Len(t, leaders, 1, "Only 1 leader should have been elected")
+	assert.Equal(t, "p1", leaders[0])
+}
+
+func TestElectionMixedVersions(t *testing.T) {
+	t.Parallel()
+	// Scenario: peers spawn together, and the peer with the lowest ID is of an older version,
+	// so it advertises neither a priority nor a ledger height, and elects a leader by ID only.
+	// Its messages are read as if it is healthy, since they don't say it is unhealthy.
+	// One of the peers of the newer version has a higher priority than the rest.
+	// Expected outcome: the peers of the newer version compare with the peer of the older version
+	// by ID too, so the peer with the lowest ID is the only leader
+	peerMap := make(map[string]*peer)
+	l := &sync.RWMutex{}
+	peers := []*peer{
+		createPeerWithCandidacy(0, Candidacy{DeliverHealthy: true}, peerMap, l),
+		createPeerWithCandidacy(1, Candidacy{LedgerHeight: 100, DeliverHealthy: true}, peerMap, l),
+		createPeerWithCandidacy(2, Candidacy{Priority: 1, LedgerHeight: 100, DeliverHealthy: true}, peerMap, l),
+	}
+	leaders := waitForLeaderElection(t, peers)
+	assert.Len(t, leaders, 1, "Only 1 leader should have been elected")
+	assert.Equal(t, "p0", leaders[0])
+	time.Sleep(getLeaderAliveThreshold() * 2)
+	leaders = waitForLeaderElection(t, peers)
+	assert.Len(t, leaders, 1, "Only 1 leader should remain")
+	assert.Equal(t, "p0", leaders[0])
+}
+
+func TestElectionMixedVersionsAndHeights(t *testing.T) {
+	t.Parallel()
+	// Scenario: peers spawn together. The peer with the highest ID has the highest ledger,
+	// the peer with the lowest ID has a ledger that lags behind it, and the remaining peer
+	// is of an older version, so it advertises no ledger height.
+	// Expected outcome: since a ledger height is unknown, all peers are ranked by ID only,
+	// and the peer with the lowest ID is the only leader, although its ledger lags behind
+	peerMap := make(map[string]*peer)
+	l := &sync.RWMutex{}
+	peers := []*peer{
+		createPeerWithCandidacy(1, Candidacy{LedgerHeight: 80, DeliverHealthy: true}, peerMap, l),
+		createPeerWithCandidacy(2, Candidacy{DeliverHealthy: true}, peerMap, l),
+		createPeerWithCandidacy(3, Candidacy{LedgerHeight: 100, DeliverHealthy: true}, peerMap, l),
+	}
+	leaders := waitForLeaderElection(t, peers)
+	assert.Len(t, leaders, 1, "Only 1 leader should have been elected")
+	assert.Equal(t, "p1", leaders[0])
+	time.Sleep(getLeaderAliveThreshold() * 2)
+	leaders = waitForLeaderElection(t, peers)
+	assert.Len(t, leaders, 1, "Only 1 leader should remain")
+	assert.Equal(t, "p1", leaders[0])
+}
+
+func TestUnhealthyLeaderStepsDown(t *testing.T) {
+	t.Parallel()
+	// Scenario: peers spawn together and a leader is elected.
+	// After a while, the leader fails receiving blocks from the ordering service.
+	// Expected outcome: the leader relinquishes its leadership, and a healthy peer takes over
+	peers := createPeers(0, 0, 1, 2)
+	leaders := waitForLeaderElection(t, peers)
+	assert.Len(t, leaders, 1, "Only 1 leader should have been elected")
+	assert.Equal(t, "p0", leaders[0])
+	peers[0].setCandidacy(Candidacy{LedgerHeight: 1, DeliverHealthy: false})
+
+	isP1leader := func() bool {
+		leaders := waitForLeaderElection(t, peers)
+		return len(leaders) == 1 && leaders[0] == "p1"
+	}
+	waitForBoolFunc(t, isP1leader, true)
+	waitForBoolFunc(t, peers[0].isLeaderFromCallback, false, "Leadership callback result is wrong for ", peers[0].id)
+	time.Sleep(getLeaderAliveThreshold() * 2)
+	// p0 doesn't restore its leadership status as long as it is unhealthy
+	waitForBoolFunc(t, isP1leader, true)
+}
+
+func TestCandidateFitness(t *testing.T) {
+	t.Parallel()
+	healthy := candidate{id: peerID("p1"), Candidacy: Candidacy{DeliverHealthy: true, LedgerHeight: 100}}
+	unhealthy := candidate{id: peerID("p0"), Candidacy: Candidacy{Priority: 5, LedgerHeight: 100}}
+	lagging := candidate{id: peerID("p0"), Candidacy: Candidacy{Priority: 5, DeliverHealthy: true, LedgerHeight: 50}}
+	prioritized := candidate{id: peerID("p2"), Candidacy: Candidacy{Priority: 1, DeliverHealthy: true, LedgerHeight: 95}}
+
+	r := ranking{maxHeight: 100}
+	assert.True(t, r.isFitter(healthy, unhealthy))
+	assert.False(t, r.isFitter(unhealthy, healthy))
+	assert.True(t, r.isFitter(healthy, lagging))
+	assert.False(t, r.isFitter(lagging, healthy))
+	assert.True(t, r.isFitter(prioritized, healthy))
+	assert.False(t, r.isFitter(healthy, prioritized))
+	// Among equal candidacies, the lower ID is fitter
+	assert.True(t, r.isFitter(unhealthy, candidate{id: peerID("p1"), Candidacy: unhealthy.Candidacy}))
+	// Lagging is relative to the highest ledger height
+	assert.Equal(t, ranking{maxHeight: 100}, rankingOf(healthy, lagging, prioritized))
+	slightlyAhead := candidate{id: peerID("p1"), Candidacy: Candidacy{DeliverHealthy: true, LedgerHeight: 55}}
+	assert.True(t, rankingOf(lagging, slightlyAhead).isFitter(lagging, slightlyAhead))
+	// Peers of older versions advertise neither a priority nor a ledger height,
+	// so all candidates are ranked by ID only along with them, as they rank other peers
+	legacy := candidate{id: peerID("p3"), Candidacy: Candidacy{DeliverHealthy: true}}
+	r = rankingOf(healthy, unhealthy, legacy)
+	assert.True(t, r.byIDOnly)
+	assert.True(t, r.isFitter(unhealthy, healthy))
+	assert.True(t, r.isFitter(healthy, legacy))
+	assert.False(t, r.isFitter(legacy, healthy))
+	legacy.id = peerID("p")
+	assert.True(t, rankingOf(legacy, prioritized).isFitter(legacy, prioritized))
+	assert.False(t, rankingOf(legacy, prioritized).isFitter(prioritized, legacy))
+}
+
 func TestConfigFromFile(t *testing.T) {
 	preStartupGracePeriod := getStartupGracePeriod()
 	preMembershipSampleInterval := getMembershipSampleInterval()
@@ -438,6 +597,8 @@ func TestConfigFromFile(t *testing.T) {
 	assert.Equal(t, time.Second*10, getLeaderAliveThreshold())
 	assert.Equal(t, time.Second*5, getLeaderElectionDuration())
 	assert.Equal(t, getLeaderAliveThreshold()/2, getLeadershipDeclarationInterval())
+	assert.Equal(t, uint32(0), getPriority())
+	assert.Equal(t, uint64(10), getMaxLedgerLag())
 
 	//Verify reading the values from config file
 	viper.Reset()
@@ -453,6 +614,8 @@ func TestConfigFromFile(t *testing.T) {
 	assert.Equal(t, time.Second*10, getLeaderAliveThreshold())
 	assert.Equal(t, time.Second*5, getLeaderElectionDuration())
 	assert.Equal(t, getLeaderAliveThreshold()/2, getLeadershipDeclarationInterval())
+	assert.Equal(t, uint32(0), getPriority())
+	assert.Equal(t, uint64(10), getMaxLedgerLag())
 }
 
 func waitForBoolFunc(t *testing.T, f func() bool, expectedValue bool, msgAndArgs ...interface{}) {
@@ -0,0 +1,183 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package election
+
+import (
+	"bytes"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hyperledger/fabric/gossip/util"
+	"github.com/op/go-logging"
+)
+
+// Static leadership:
+// A peer statically configured to be the leader (peer.gossip.orgLeader) is always
+// the leader, and it periodically declares its leadership along with its health.
+//
+// A peer configured as a backup of the static leader (peer.gossip.orgLeaderBackup)
+// follows the declarations of the leader:
+//	If no healthy leadership declaration was received within
+//	the leader alive threshold, become the leader
+//	If a healthy leadership declaration was received while being the leader,
+//	become a follower
+//	If blocks fail being received from the ordering service while being the leader,
+//	yield the leadership and don't take it over again for a while
+
+// NewStaticLeaderService returns a LeaderElectionService of a peer that is
+// statically configured to be the leader
+func NewStaticLeaderService(adapter LeaderElectionAdapter, id string, callback leadershipCallback) LeaderElectionService {
+	return newStaticLeaderService(adapter, id, callback, false)
+}
+
+// NewBackupLeaderService returns a LeaderElectionService of a peer that takes over
+// the leadership when the statically configured leader is either unavailable or unhealthy
+func NewBackupLeaderService(adapter LeaderElectionAdapter, id string, callback leadershipCallback) LeaderElectionService {
+	return newStaticLeaderService(adapter, id, callback, true)
+}
+
+func newStaticLeaderService(adapter LeaderElectionAdapter, id string, callback leadershipCallback, backup bool) LeaderElectionService {
+	if len(id) == 0 {
+		panic("Empty id")
+	}
+	sl := &staticLeaderSvcImpl{
+		id:                     peerID(id),
+		backup:                 backup,
+		adapter:                adapter,
+		stopChan:               make(chan struct{}),
+		logger:                 util.GetLogger(util.LoggingElectionModule, ""),
+		callback:               noopCallback,
+		lastHealthyDeclaration: time.Now(),
+	}
+	if callback != nil {
+		sl.callback = callback
+	}
+	if !backup {
+		sl.beLeader()
+	}
+	sl.stopWG.Add(1)
+	go sl.run()
+	return sl
+}
+
+// staticLeaderSvcImpl is an implementation of a LeaderElectionService
+// of either a static leader or its backup
+type staticLeaderSvcImpl struct {
+	sync.Mutex
+	id                     peerID
+	backup                 bool
+	isLeader               int32
+	adapter                LeaderElectionAdapter
+	logger                 *logging.Logger
+	callback               leadershipCallback
+	lastHealthyDeclaration time.Time
+	yieldedUntil           time.Time
+	stopChan               chan struct{}
+	stopOnce               sync.Once
+	stopWG                 sync.WaitGroup
+}
+
+func (sl *staticLeaderSvcImpl) run() {
+	defer sl.stopWG.Done()
+	msgChan := sl.adapter.Accept()
+	ticker := time.NewTicker(getLeadershipDeclarationInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-sl.stopChan:
+			return
+		case msg := <-msgChan:
+			sl.handleMessage(msg)
+		case <-ticker.C:
+			sl.tick()
+		}
+	}
+}
+
+func (sl *staticLeaderSvcImpl) handleMessage(msg Msg) {
+	// Only a backup cares about the leadership declarations of other peers
+	if !sl.backup || !msg.IsDeclaration() || bytes.Equal(msg.SenderID(), sl.id) {
+		return
+	}
+	if !msg.Candidacy().DeliverHealthy {
+		sl.logger.Debug(sl.id, ":", msg.SenderID(), "declared its leadership but it is unhealthy")
+		return
+	}
+	sl.Lock()
+	defer sl.Unlock()
+	sl.lastHealthyDeclaration = time.Now()
+	if sl.IsLeader() {
+		sl.logger.Info(sl.id, ":", msg.SenderID(), "declared its leadership and is healthy, stepping down")
+		sl.stopBeingLeader()
+	}
+}
+
+func (sl *staticLeaderSvcImpl) tick() {
+	if !sl.backup {
+		sl.adapter.Gossip(sl.adapter.CreateMessage(true))
+		return
+	}
+	if sl.IsLeader() {
+		// A backup that fails receiving blocks from the ordering service
+		// relinquishes the leadership, hoping the static leader recovers
+		if !sl.adapter.Candidacy().DeliverHealthy {
+			sl.logger.Warning(sl.id, ": Failing to receive blocks from the ordering service, relinquishing leadership")
+			sl.Yield()
+		}
+		return
+	}
+	sl.Lock()
+	defer sl.Unlock()
+	now := time.Now()
+	if now.Before(sl.yieldedUntil) || now.Sub(sl.lastHealthyDeclaration) < getLeaderAliveThreshold() {
+		return
+	}
+	sl.logger.Warning(sl.id, ": No healthy leader declared its leadership for", now.Sub(sl.lastHealthyDeclaration), ", taking over")
+	sl.beLeader()
+}
+
+// IsLeader returns whether this peer is a leader
+func (sl *staticLeaderSvcImpl) IsLeader() bool {
+	return atomic.LoadInt32(&sl.isLeader) == int32(1)
+}
+
+func (sl *staticLeaderSvcImpl) beLeader() {
+	sl.logger.Info(sl.id, ": Becoming a leader")
+	atomic.StoreInt32(&sl.isLeader, int32(1))
+	sl.callback(true)
+}
+
+func (sl *staticLeaderSvcImpl) stopBeingLeader() {
+	sl.logger.Info(sl.id, ": Stopped being a leader")
+	atomic.StoreInt32(&sl.isLeader, int32(0))
+	sl.callback(false)
+}
+
+// Yield relinquishes the leadership of a backup for a while.
+// The static leader never relinquishes its leadership
+func (sl *staticLeaderSvcImpl) Yield() {
+	if !sl.backup {
+		sl.logger.Debug(sl.id, ": Static leader doesn't yield its leadership")
+		return
+	}
+	sl.Lock()
+	defer sl.Unlock()
+	if !sl.IsLeader() {
+		return
+	}
+	sl.yieldedUntil = time.Now().Add(getLeaderAliveThreshold() * 6)
+	sl.stopBeingLeader()
+}
+
+// Stop stops the LeaderElectionService
+func (sl *staticLeaderSvcImpl) Stop() {
+	sl.stopOnce.Do(func() {
+		close(sl.stopChan)
+	})
+	sl.stopWG.Wait()
+}
@@ -0,0 +1,96 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package election
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createStaticPeer(id int, backup bool, peerMap map[string]*peer, l *sync.RWMutex) *peer {
+	idStr := fmt.Sprintf("p%d", id)
+	c := make(chan Msg, 100)
+	p := &peer{id: idStr, peers: peerMap, sharedLock: l, msgChan: c, mockedMethods: make(map[string]struct{}), candidacy: Candidacy{DeliverHealthy: true}}
+	l.Lock()
+	peerMap[idStr] = p
+	l.Unlock()
+	if backup {
+		p.LeaderElectionService = NewBackupLeaderService(p, idStr, p.leaderCallback)
+	} else {
+		p.LeaderElectionService = NewStaticLeaderService(p, idStr, p.leaderCallback)
+	}
+	return p
+}
+
+func TestStaticLeader(t *testing.T) {
+	t.Parallel()
+	// Scenario: a static leader is created
+	// Expected outcome: it is the leader right away, and it doesn't yield its leadership
+	peerMap := make(map[string]*peer)
+	leader := createStaticPeer(0, false, peerMap, &sync.RWMutex{})
+	defer leader.Stop()
+	assert.True(t, leader.IsLeader())
+	assert.True(t, leader.isLeaderFromCallback())
+	leader.Yield()
+	assert.True(t, leader.IsLeader())
+	assert.True(t, leader.isLeaderFromCallback())
+}
+
+func TestStaticLeaderBackup(t *testing.T) {
+	t.Parallel()
+	// Scenario: a static leader and its backup are created.
+	// Expected outcome:
+	// (1) The backup doesn't take over as long as the leader is healthy
+	// (2) The backup takes over once the leader is unhealthy, and steps down once it recovers
+	// (3) The backup takes over once the leader is gone
+	peerMap := make(map[string]*peer)
+	l := &sync.RWMutex{}
+	leader := createStaticPeer(0, false, peerMap, l)
+	backup := createStaticPeer(1, true, peerMap, l)
+	defer backup.Stop()
+
+	time.Sleep(getLeaderAliveThreshold() * 3)
+	assert.True(t, leader.IsLeader())
+	assert.False(t, backup.IsLeader())
+	assert.False(t, backup.isCallbackInvoked())
+
+	leader.setCandidacy(Candidacy{DeliverHealthy: false})
+	waitForBoolFunc(t, backup.isLeaderFromCallback, true, "Backup should have taken over from the unhealthy leader")
+	assert.True(t, backup.IsLeader())
+	assert.True(t, leader.IsLeader())
+
+	leader.setCandidacy(Candidacy{DeliverHealthy: true})
+	waitForBoolFunc(t, backup.isLeaderFromCallback, false, "Backup should have stepped down once the leader recovered")
+	assert.False(t, backup.IsLeader())
+
+	leader.Stop()
+	waitForBoolFunc(t, backup.isLeaderFromCallback, true, "Backup should have taken over from the stopped leader")
+	assert.True(t, backup.IsLeader())
+}
+
+func TestStaticLeaderBackupYield(t *testing.T) {
+	t.Parallel()
+	// Scenario: a backup without a leader takes over, and then fails
+	// receiving blocks from the ordering service.
+	// Expected outcome: it yields its leadership, and doesn't take it over again for a while
+	peerMap := make(map[string]*peer)
+	backup := createStaticPeer(0, true, peerMap, &sync.RWMutex{})
+	defer backup.Stop()
+
+	waitForBoolFunc(t, backup.isLeaderFromCallback, true, "Backup should have taken over")
+	backup.setCandidacy(Candidacy{DeliverHealthy: false})
+	waitForBoolFunc(t, backup.isLeaderFromCallback, false, "Backup should have yielded its leadership")
+	backup.setCandidacy(Candidacy{DeliverHealthy: true})
+	time.Sleep(getLeaderAliveThreshold() * 2)
+	assert.False(t, backup.IsLeader())
+	// Eventually, it takes over again
+	waitForBoolFunc(t, backup.isLeaderFromCallback, true, "Backup should have taken over again")
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hyperledger/fabric/gossip/comm"
 	"github.com/hyperledger/fabric/gossip/discovery"
 	"github.com/hyperledger/fabric/gossip/util"
+	proto "github.com/hyperledger/fabric/protos/gossip"
 )
 
 // RoutingFilter defines a predicate on a NetworkMember
@@ -39,6 +40,51 @@ func CombineRoutingFilters(filters ...RoutingFilter) RoutingFilter {
 	}
 }
 
+// The following routing filters select members according to the properties they publish
+// about a channel. Members whose properties aren't known are never selected.
+
+// SelectLeaders selects members that pull blocks from the ordering service
+var SelectLeaders = func(member discovery.NetworkMember) bool {
+	return member.Properties != nil && member.Properties.Leader
+}
+
+// SelectUpToDate selects members that don't pull missing blocks from other peers
+var SelectUpToDate = func(member discovery.NetworkMember) bool {
+	return member.Properties != nil && !member.Properties.CatchingUp
+}
+
+// MinLedgerHeight selects members whose ledger height is at least the given height
+func MinLedgerHeight(height uint64) RoutingFilter {
+	return func(member discovery.NetworkMember) bool {
+		return member.Properties != nil && member.Properties.LedgerHeight >= height
+	}
+}
+
+// InstalledChaincode selects members that have the given chaincode installed.
+// If the given version is empty, any version of the chaincode is accepted.
+func InstalledChaincode(name, version string) RoutingFilter {
+	return func(member discovery.NetworkMember) bool {
+		return member.Properties != nil && hasChaincode(member.Properties.Chaincodes, name, version)
+	}
+}
+
+// InstantiatedChaincode selects members that report the given chaincode instantiated on the channel.
+// If the given version is empty, any version of the chaincode is accepted.
+func InstantiatedChaincode(name, version string) RoutingFilter {
+	return func(member discovery.NetworkMember) bool {
+		return member.Properties != nil && hasChaincode(member.Properties.InstantiatedChaincodes, name, version)
+	}
+}
+
+func hasChaincode(chaincodes []*proto.Chaincode, name, version string) bool {
+	for _, cc := range chaincodes {
+		if cc.Name == name && (version == "" || cc.Version == version) {
+			return true
+		}
+	}
+	return false
+}
+
 // SelectPeers returns a slice of peers that match the routing filter
 func SelectPeers(k int, peerPool []discovery.NetworkMember, filter RoutingFilter) []*comm.RemotePeer {
 	var filteredPeers []*comm.RemotePeer
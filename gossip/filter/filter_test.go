@@ -12,6 +12,7 @@ import (
 	"github.com/hyperledger/fabric/gossip/comm"
 	"github.com/hyperledger/fabric/gossip/common"
 	"github.com/hyperledger/fabric/gossip/discovery"
+	proto "github.com/hyperledger/fabric/protos/gossip"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,6 +21,39 @@ func TestSelectPolicies(t *testing.T) {
 	assert.False(t, SelectNonePolicy(discovery.NetworkMember{}))
 }
 
+func TestPropertiesFilters(t *testing.T) {
+	unknown := discovery.NetworkMember{}
+	leader := discovery.NetworkMember{Properties: &proto.Properties{
+		LedgerHeight:           10,
+		Leader:                 true,
+		Chaincodes:             []*proto.Chaincode{{Name: "mycc", Version: "1.0"}, {Name: "mycc", Version: "2.0"}},
+		InstantiatedChaincodes: []*proto.Chaincode{{Name: "mycc", Version: "1.0"}},
+	}}
+	catchingUp := discovery.NetworkMember{Properties: &proto.Properties{
+		LedgerHeight: 5,
+		CatchingUp:   true,
+	}}
+
+	for _, f := range []RoutingFilter{SelectLeaders, SelectUpToDate, MinLedgerHeight(0), InstalledChaincode("mycc", ""), InstantiatedChaincode("mycc", "")} {
+		assert.False(t, f(unknown))
+	}
+
+	assert.True(t, SelectLeaders(leader))
+	assert.False(t, SelectLeaders(catchingUp))
+	assert.True(t, SelectUpToDate(leader))
+	assert.False(t, SelectUpToDate(catchingUp))
+	assert.True(t, MinLedgerHeight(10)(leader))
+	assert.False(t, MinLedgerHeight(10)(catchingUp))
+
+	assert.True(t, InstalledChaincode("mycc", "")(leader))
+	assert.True(t, InstalledChaincode("mycc", "2.0")(leader))
+	assert.False(t, InstalledChaincode("mycc", "3.0")(leader))
+	assert.False(t, InstalledChaincode("mycc", "")(catchingUp))
+	assert.True(t, InstantiatedChaincode("mycc", "1.0")(leader))
+	assert.False(t, InstantiatedChaincode("mycc", "2.0")(leader))
+	assert.False(t, InstantiatedChaincode("othercc", "")(leader))
+}
+
 func TestCombineRoutingFilters(t *testing.T) {
 	nm := discovery.NetworkMember{
 		Endpoint:         "a",
@@ -160,7 +160,7 @@ func memResp(nonce uint64, endpoint string) *proto.SignedGossipMessage {
 	}
 
 	m, _ := fakePeerAliveMsg.Sign((&configurableCryptoService{}).Sign)
-	sMsg, _ := (&proto.SignedGossipMessage{
+	sMsg := &proto.SignedGossipMessage{
 		GossipMessage: &proto.GossipMessage{
 			Tag:   proto.GossipMessage_EMPTY,
 			Nonce: nonce,
@@ -171,7 +171,9 @@ func memResp(nonce uint64, endpoint string) *proto.SignedGossipMessage {
 				},
 			},
 		},
-	}).NoopSign()
+	}
+	// Membership responses are verified against the identity of the peer that sent them
+	sMsg.Sign((&configurableCryptoService{}).Sign)
 	return sMsg
 }
 
@@ -44,7 +44,8 @@ type pullerMock struct {
 }
 
 type sentMsg struct {
-	msg *proto.SignedGossipMessage
+	msg      *proto.SignedGossipMessage
+	connInfo *proto.ConnectionInfo
 	mock.Mock
 }
 
@@ -68,7 +69,7 @@ func (s *sentMsg) GetGossipMessage() *proto.SignedGossipMessage {
 }
 
 func (s *sentMsg) GetConnectionInfo() *proto.ConnectionInfo {
-	return nil
+	return s.connInfo
 }
 
 type senderMock struct {
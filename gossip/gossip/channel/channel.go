@@ -59,6 +59,14 @@ type GossipChannel interface {
 	// that is periodically published
 	UpdateStateInfo(msg *proto.SignedGossipMessage)
 
+	// Self returns this channel's StateInfo message
+	// that is periodically published
+	Self() *proto.SignedGossipMessage
+
+	// BlockDigests returns the sequence numbers of the blocks
+	// that are available to other peers through pull
+	BlockDigests() []uint64
+
 	// IsOrgInChannel returns whether the given organization is in the channel
 	IsOrgInChannel(membersOrg api.OrgIdentityType) bool
 
@@ -788,6 +796,29 @@ func (gc *gossipChannel) UpdateStateInfo(msg *proto.SignedGossipMessage) {
 	atomic.StoreInt32(&gc.shouldGossipStateInfo, int32(1))
 }
 
+// Self returns this channel's StateInfo message
+// that is periodically published
+func (gc *gossipChannel) Self() *proto.SignedGossipMessage {
+	gc.RLock()
+	defer gc.RUnlock()
+	return gc.stateInfoMsg
+}
+
+// BlockDigests returns the sequence numbers of the blocks
+// that are available to other peers through pull
+func (gc *gossipChannel) BlockDigests() []uint64 {
+	var seqNums []uint64
+	for _, digest := range gc.blocksPuller.Digests() {
+		seqNum, err := strconv.ParseUint(digest, 10, 64)
+		if err != nil {
+			gc.logger.Warning("Block puller holds a malformed digest", digest, ":", err)
+			continue
+		}
+		seqNums = append(seqNums, seqNum)
+	}
+	return seqNums
+}
+
 func newStateInfoCache(sweepInterval time.Duration, hasExpired func(interface{}) bool, verifyFunc membershipPredicate) *stateInfoCache {
 	membershipStore := util.NewMembershipStore()
 	pol := proto.NewGossipMessageComparator(0)
@@ -906,6 +906,11 @@ func TestChannelAddToMessageStore(t *testing.T) {
 	case <-demuxedMsgs:
 		t.Fatal("Demultiplexing detected, even though it wasn't supposed to happen")
 	}
+	// Both blocks are available through pull
+	digests := gc.BlockDigests()
+	assert.Len(t, digests, 2)
+	assert.Contains(t, digests, uint64(11))
+	assert.Contains(t, digests, uint64(12))
 
 	gc.AddToMsgStore(createStateInfoMsg(10, pkiIDInOrg1, channelA))
 	helloMsg := createHelloMsg(pkiIDInOrg1)
@@ -109,6 +109,25 @@ func (cs *channelState) joinChannel(joinMsg api.JoinChannelMessage, chainID comm
 	}
 }
 
+// orgsShareChannel returns whether both given organizations
+// are members of some channel this peer has joined
+func (cs *channelState) orgsShareChannel(org1, org2 api.OrgIdentityType) bool {
+	cs.RLock()
+	channels := make([]channel.GossipChannel, 0, len(cs.channels))
+	for _, gc := range cs.channels {
+		channels = append(channels, gc)
+	}
+	// The channels are queried after the lock is released because the disclosure
+	// policy consults this while the discovery layer holds its own lock
+	cs.RUnlock()
+	for _, gc := range channels {
+		if gc.IsOrgInChannel(org1) && gc.IsOrgInChannel(org2) {
+			return true
+		}
+	}
+	return false
+}
+
 type gossipAdapterImpl struct {
 	*gossipServiceImpl
 	discovery.Discovery
@@ -34,6 +34,21 @@ type Gossip interface {
 	// and also subscribed to the channel given
 	PeersOfChannel(common.ChainID) []discovery.NetworkMember
 
+	// DeadPeers returns the NetworkMembers considered dead
+	DeadPeers() []discovery.NetworkMember
+
+	// Connections returns the connections to remote peers,
+	// along with the bytes sent and received over them
+	Connections() []comm.ConnectionStats
+
+	// IdentityDigests returns the PKI-IDs of the peers whose identities
+	// are available to other peers through pull
+	IdentityDigests() []common.PKIidType
+
+	// BlockDigests returns the sequence numbers of the blocks of the given
+	// channel that are available to other peers through pull
+	BlockDigests(chainID common.ChainID) []uint64
+
 	// UpdateMetadata updates the self metadata of the discovery layer
 	// the peer publishes to other peers
 	UpdateMetadata(metadata []byte)
@@ -42,6 +57,25 @@ type Gossip interface {
 	// publishes to other peers about its channel-related state
 	UpdateChannelMetadata(metadata []byte, chainID common.ChainID)
 
+	// UpdateChaincodes updates the chaincodes installed on the peer and instantiated
+	// on the channel, that the peer publishes to other peers in the channel
+	UpdateChaincodes(installed, instantiated []*proto.Chaincode, chainID common.ChainID)
+
+	// UpdateLeaderStatus updates whether the peer publishes to other peers
+	// in the channel that it pulls blocks from the ordering service
+	UpdateLeaderStatus(isLeader bool, chainID common.ChainID)
+
+	// UpdateCatchUpStatus updates whether the peer publishes to other peers
+	// in the channel that it pulls missing blocks from other peers
+	UpdateCatchUpStatus(catchingUp bool, chainID common.ChainID)
+
+	// SelfChannelInfo returns the StateInfo message the peer
+	// publishes about the given channel, or nil if there is none
+	SelfChannelInfo(chainID common.ChainID) *proto.SignedGossipMessage
+
+	// PeerIdentity returns the identity of the peer with the given PKI-ID
+	PeerIdentity(pkiID common.PKIidType) (api.PeerIdentityType, error)
+
 	// Gossip sends a message to other peers to the network
 	Gossip(msg *proto.GossipMessage)
 
@@ -61,6 +61,9 @@ type gossipServiceImpl struct {
 	mcs               api.MessageCryptoService
 	stateInfoMsgStore msgstore.MessageStore
 	certPuller        pull.Mediator
+	// stateInfoLock serializes the updates of the
+	// StateInfo messages the peer publishes
+	stateInfoLock sync.Mutex
 }
 
 // NewGossipService creates a gossip instance attached to a gRPC server
@@ -194,7 +197,7 @@ func (g *gossipServiceImpl) LeaveChan(chainID common.ChainID) {
 		return
 	}
 	b, _ := (&common.NodeMetastate{}).Bytes()
-	stateInfMsg, err := g.createStateInfoMsg(b, chainID, true)
+	stateInfMsg, err := g.createStateInfoMsg(b, chainID, &proto.Properties{LeftChannel: true})
 	if err != nil {
 		g.logger.Errorf("Failed creating StateInfo message: %+v", errors.WithStack(err))
 		return
@@ -723,6 +726,38 @@ func (g *gossipServiceImpl) PeersOfChannel(channel common.ChainID) []discovery.N
 	return gc.GetPeers()
 }
 
+// DeadPeers returns the NetworkMembers considered dead
+func (g *gossipServiceImpl) DeadPeers() []discovery.NetworkMember {
+	return g.disc.GetDeadMembers()
+}
+
+// Connections returns the connections to remote peers,
+// along with the bytes sent and received over them
+func (g *gossipServiceImpl) Connections() []comm.ConnectionStats {
+	return g.comm.Connections()
+}
+
+// IdentityDigests returns the PKI-IDs of the peers whose identities
+// are available to other peers through pull
+func (g *gossipServiceImpl) IdentityDigests() []common.PKIidType {
+	var pkiIDs []common.PKIidType
+	for _, digest := range g.certPuller.Digests() {
+		pkiIDs = append(pkiIDs, common.PKIidType(digest))
+	}
+	return pkiIDs
+}
+
+// BlockDigests returns the sequence numbers of the blocks of the given
+// channel that are available to other peers through pull
+func (g *gossipServiceImpl) BlockDigests(chainID common.ChainID) []uint64 {
+	gc := g.chanState.getGossipChannelByChainID(chainID)
+	if gc == nil {
+		g.logger.Debug("No such channel", chainID)
+		return nil
+	}
+	return gc.BlockDigests()
+}
+
 // PeerFilter receives a SubChannelSelectionCriteria and returns a RoutingFilter that selects
 // only peer identities that match the given criteria, and that they published their channel participation
 func (g *gossipServiceImpl) PeerFilter(channel common.ChainID, messagePredicate api.SubChannelSelectionCriteria) (filter.RoutingFilter, error) {
@@ -765,12 +800,63 @@ func (g *gossipServiceImpl) UpdateMetadata(md []byte) {
 // UpdateChannelMetadata updates the self metadata the peer
 // publishes to other peers about its channel-related state
 func (g *gossipServiceImpl) UpdateChannelMetadata(md []byte, chainID common.ChainID) {
+	g.updateStateInfo(chainID, md, func(*proto.Properties) {})
+}
+
+// UpdateChaincodes updates the chaincodes installed on the peer and instantiated
+// on the channel, that the peer publishes to other peers in the channel
+func (g *gossipServiceImpl) UpdateChaincodes(installed, instantiated []*proto.Chaincode, chainID common.ChainID) {
+	g.updateStateInfo(chainID, nil, func(props *proto.Properties) {
+		props.Chaincodes = installed
+		props.InstantiatedChaincodes = instantiated
+	})
+}
+
+// UpdateLeaderStatus updates whether the peer publishes to other peers
+// in the channel that it pulls blocks from the ordering service
+func (g *gossipServiceImpl) UpdateLeaderStatus(isLeader bool, chainID common.ChainID) {
+	g.updateStateInfo(chainID, nil, func(props *proto.Properties) {
+		props.Leader = isLeader
+	})
+}
+
+// UpdateCatchUpStatus updates whether the peer publishes to other peers
+// in the channel that it pulls missing blocks from other peers
+func (g *gossipServiceImpl) UpdateCatchUpStatus(catchingUp bool, chainID common.ChainID) {
+	g.updateStateInfo(chainID, nil, func(props *proto.Properties) {
+		props.CatchingUp = catchingUp
+	})
+}
+
+// updateStateInfo publishes a new StateInfo message for the given channel, with the
+// properties published so far modified by the given function. If the given metadata
+// is nil, the metadata published so far is kept.
+func (g *gossipServiceImpl) updateStateInfo(chainID common.ChainID, md []byte, update func(*proto.Properties)) {
 	gc := g.chanState.getGossipChannelByChainID(chainID)
 	if gc == nil {
 		g.logger.Debug("No such channel", chainID)
 		return
 	}
-	stateInfMsg, err := g.createStateInfoMsg(md, chainID, false)
+	g.stateInfoLock.Lock()
+	defer g.stateInfoLock.Unlock()
+
+	props := &proto.Properties{}
+	if self := gc.Self(); self != nil {
+		if md == nil {
+			md = self.GetStateInfo().Metadata
+		}
+		if prev := self.GetStateInfo().Properties; prev != nil {
+			props.Chaincodes = prev.Chaincodes
+			props.InstantiatedChaincodes = prev.InstantiatedChaincodes
+			props.Leader = prev.Leader
+			props.CatchingUp = prev.CatchingUp
+		}
+	}
+	if md == nil {
+		md, _ = (&common.NodeMetastate{}).Bytes()
+	}
+	update(props)
+	stateInfMsg, err := g.createStateInfoMsg(md, chainID, props)
 	if err != nil {
 		g.logger.Errorf("Failed creating StateInfo message: %+v", errors.WithStack(err))
 		return
@@ -778,6 +864,22 @@ func (g *gossipServiceImpl) UpdateChannelMetadata(md []byte, chainID common.Chai
 	gc.UpdateStateInfo(stateInfMsg)
 }
 
+// SelfChannelInfo returns the StateInfo message the peer
+// publishes about the given channel, or nil if there is none
+func (g *gossipServiceImpl) SelfChannelInfo(chainID common.ChainID) *proto.SignedGossipMessage {
+	gc := g.chanState.getGossipChannelByChainID(chainID)
+	if gc == nil {
+		g.logger.Debug("No such channel", chainID)
+		return nil
+	}
+	return gc.Self()
+}
+
+// PeerIdentity returns the identity of the peer with the given PKI-ID
+func (g *gossipServiceImpl) PeerIdentity(pkiID common.PKIidType) (api.PeerIdentityType, error) {
+	return g.idMapper.Get(pkiID)
+}
+
 // Accept returns a dedicated read-only channel for messages sent by other nodes that match a certain predicate.
 // If passThrough is false, the messages are processed by the gossip layer beforehand.
 // If passThrough is true, the gossip layer doesn't intervene and the messages
@@ -1034,6 +1136,40 @@ func (sa *discoverySecurityAdapter) SignMessage(m *proto.GossipMessage, internal
 	return e
 }
 
+// ValidateMembershipResponse validates that a membership response
+// is signed by the peer it was received from, if it is signed at all.
+// Peers of older versions send unsigned membership responses, which are
+// accepted as before, since the alive messages they carry are validated
+// on their own.
+func (sa *discoverySecurityAdapter) ValidateMembershipResponse(msg proto.ReceivedMessage) bool {
+	m := msg.GetGossipMessage()
+	connInfo := msg.GetConnectionInfo()
+	if m.GetMemRes() == nil {
+		sa.logger.Warning("Invalid membership response from", connInfo)
+		return false
+	}
+	if !m.IsSigned() {
+		sa.logger.Debug("Got an unsigned membership response from", connInfo)
+		return true
+	}
+	identity := connInfo.Identity
+	if identity == nil {
+		identity, _ = sa.idMapper.Get(connInfo.ID)
+	}
+	if identity == nil {
+		sa.logger.Debug("Don't have certificate for", connInfo)
+		return false
+	}
+	verifier := func(peerIdentity []byte, signature, message []byte) error {
+		return sa.mcs.Verify(api.PeerIdentityType(peerIdentity), signature, message)
+	}
+	if err := m.Verify(identity, verifier); err != nil {
+		sa.logger.Warningf("Failed verifying membership response from %v: %+v", connInfo, errors.WithStack(err))
+		return false
+	}
+	return true
+}
+
 func (sa *discoverySecurityAdapter) validateAliveMsgSignature(m *proto.SignedGossipMessage, identity api.PeerIdentityType) bool {
 	am := m.GetAliveMsg()
 	// At this point we got the certificate of the peer, proceed to verifying the AliveMessage
@@ -1117,8 +1253,9 @@ func (g *gossipServiceImpl) sameOrgOrOurOrgPullFilter(msg proto.ReceivedMessage)
 		if !g.hasExternalEndpoint(pkiID) {
 			return false
 		}
-		// Peer from our org or identity from our org or identity from peer's org
-		return bytes.Equal(msgsOrg, g.selfOrg) || bytes.Equal(msgsOrg, peersOrg)
+		// Peer from our org or identity from our org or identity from peer's org,
+		// or identity from an org that shares a channel with the peer's org
+		return bytes.Equal(msgsOrg, g.selfOrg) || bytes.Equal(msgsOrg, peersOrg) || g.chanState.orgsShareChannel(msgsOrg, peersOrg)
 	}
 }
 
@@ -1148,12 +1285,15 @@ func (g *gossipServiceImpl) connect2BootstrapPeers() {
 
 }
 
-func (g *gossipServiceImpl) createStateInfoMsg(metadata []byte, chainID common.ChainID, leftChannel bool) (*proto.SignedGossipMessage, error) {
+// createStateInfoMsg creates a StateInfo message with the given properties,
+// and the ledger height of the given metadata
+func (g *gossipServiceImpl) createStateInfoMsg(metadata []byte, chainID common.ChainID, props *proto.Properties) (*proto.SignedGossipMessage, error) {
 	metaState, err := common.FromBytes(metadata)
 	if err != nil {
 		return nil, err
 	}
 	pkiID := g.comm.GetPKIid()
+	props.LedgerHeight = metaState.LedgerHeight
 	stateInfMsg := &proto.StateInfo{
 		Channel_MAC: channel.GenerateMAC(pkiID, chainID),
 		Metadata:    metadata,
@@ -1162,12 +1302,7 @@ func (g *gossipServiceImpl) createStateInfoMsg(metadata []byte, chainID common.C
 			IncNum: uint64(g.incTime.UnixNano()),
 			SeqNum: uint64(time.Now().UnixNano()),
 		},
-		Properties: &proto.Properties{
-			LedgerHeight: metaState.LedgerHeight,
-		},
-	}
-	if leftChannel {
-		stateInfMsg.Properties.LeftChannel = true
+		Properties: props,
 	}
 	m := &proto.GossipMessage{
 		Nonce: 0,
@@ -1268,8 +1403,11 @@ func (g *gossipServiceImpl) disclosurePolicy(remotePeer *discovery.NetworkMember
 			fromSameForeignOrg := bytes.Equal(remotePeerOrg, org)
 			// The message is from my org
 			fromMyOrg := bytes.Equal(g.selfOrg, org)
-			// Forward to target org only messages from our org, or from the target org itself.
-			if !(fromSameForeignOrg || fromMyOrg) {
+			// The message is from an org that shares a channel with the target org
+			fromChannelPeerOrg := g.chanState.orgsShareChannel(org, remotePeerOrg)
+			// Forward to target org only messages from our org, from the target org itself,
+			// or from orgs the target org shares a channel with.
+			if !(fromSameForeignOrg || fromMyOrg || fromChannelPeerOrg) {
 				return false
 			}
 
@@ -1301,14 +1439,15 @@ func (g *gossipServiceImpl) peersByOriginOrgPolicy(peer discovery.NetworkMember)
 	}
 
 	// Else, select peers from the origin's organization,
-	// and also peers from our own organization
+	// peers from our own organization, and peers from organizations
+	// that share a channel with the origin's organization
 	return func(member discovery.NetworkMember) bool {
 		memberOrg := g.getOrgOfPeer(member.PKIid)
 		if len(memberOrg) == 0 {
 			return false
 		}
 		isFromMyOrg := bytes.Equal(g.selfOrg, memberOrg)
-		return isFromMyOrg || bytes.Equal(memberOrg, peersOrg)
+		return isFromMyOrg || bytes.Equal(memberOrg, peersOrg) || g.chanState.orgsShareChannel(memberOrg, peersOrg)
 	}
 }
 
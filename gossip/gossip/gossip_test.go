@@ -24,6 +24,7 @@ import (
 	"github.com/hyperledger/fabric/gossip/comm"
 	"github.com/hyperledger/fabric/gossip/common"
 	"github.com/hyperledger/fabric/gossip/discovery"
+	"github.com/hyperledger/fabric/gossip/filter"
 	"github.com/hyperledger/fabric/gossip/gossip/algo"
 	"github.com/hyperledger/fabric/gossip/util"
 	proto "github.com/hyperledger/fabric/protos/gossip"
@@ -43,11 +44,13 @@ var tests = []func(t *testing.T){
 	TestMembershipRequestSpoofing,
 	TestDataLeakage,
 	TestLeaveChannel,
+	TestChaincodesOfChannel,
 	//TestDisseminateAll2All: {},
 	TestIdentityExpiration,
 	TestSendByCriteria,
 	TestMultipleOrgEndpointLeakage,
 	TestConfidentiality,
+	TestMultipleOrgPeerExchange,
 	TestAnchorPeer,
 	TestBootstrapPeerMisConfiguration,
 	TestNoMessagesSelfLoop,
@@ -318,6 +321,62 @@ func TestLeaveChannel(t *testing.T) {
 
 }
 
+func TestChaincodesOfChannel(t *testing.T) {
+	t.Parallel()
+	defer testWG.Done()
+	portPrefix := 9610
+	// Scenario: Have 2 peers in a channel, and make one of them publish its chaincodes,
+	// leadership and catch-up status. Ensure the other peer learns them, and that they
+	// are kept when the ledger height and the other properties change
+
+	p0 := newGossipInstance(portPrefix, 0, 100)
+	p0.JoinChan(&joinChanMsg{}, common.ChainID("A"))
+	p0.UpdateChannelMetadata(createMetadata(1), common.ChainID("A"))
+	defer p0.Stop()
+
+	p1 := newGossipInstance(portPrefix, 1, 100, 0)
+	p1.JoinChan(&joinChanMsg{}, common.ChainID("A"))
+	p1.UpdateChannelMetadata(createMetadata(1), common.ChainID("A"))
+	defer p1.Stop()
+
+	installed := []*proto.Chaincode{{Name: "mycc", Version: "1.0"}, {Name: "mycc", Version: "2.0"}}
+	instantiated := []*proto.Chaincode{{Name: "mycc", Version: "1.0"}}
+	p0.UpdateChaincodes(installed, instantiated, common.ChainID("A"))
+	p0.UpdateLeaderStatus(true, common.ChainID("A"))
+	self := p0.SelfChannelInfo(common.ChainID("A")).GetStateInfo().Properties
+	assert.Equal(t, installed, self.Chaincodes)
+	assert.Equal(t, instantiated, self.InstantiatedChaincodes)
+	assert.True(t, self.Leader)
+	assert.False(t, self.CatchingUp)
+	assert.Nil(t, p0.SelfChannelInfo(common.ChainID("B")))
+
+	publishedProperties := func(expectedHeight uint64, catchingUp bool) func() bool {
+		return func() bool {
+			peers := p1.PeersOfChannel(common.ChainID("A"))
+			if len(peers) != 1 || peers[0].Properties == nil {
+				return false
+			}
+			props := peers[0].Properties
+			return props.LedgerHeight == expectedHeight && len(props.Chaincodes) == 2 &&
+				len(props.InstantiatedChaincodes) == 1 && props.Leader && props.CatchingUp == catchingUp
+		}
+	}
+	waitUntilOrFail(t, publishedProperties(1, false))
+	p0.UpdateCatchUpStatus(true, common.ChainID("A"))
+	waitUntilOrFail(t, publishedProperties(1, true))
+	p0.UpdateChannelMetadata(createMetadata(2), common.ChainID("A"))
+	waitUntilOrFail(t, publishedProperties(2, true))
+
+	// the properties can be used to route messages to peers
+	routingFilter := filter.CombineRoutingFilters(filter.SelectLeaders, filter.InstantiatedChaincode("mycc", "1.0"))
+	assert.Len(t, filter.SelectPeers(1, p1.PeersOfChannel(common.ChainID("A")), routingFilter), 1)
+	assert.Empty(t, filter.SelectPeers(1, p1.PeersOfChannel(common.ChainID("A")), filter.SelectUpToDate))
+
+	identity, err := p1.PeerIdentity(p1.PeersOfChannel(common.ChainID("A"))[0].PKIid)
+	assert.NoError(t, err)
+	assert.Equal(t, api.PeerIdentityType(fmt.Sprintf("localhost:%d", portPrefix)), identity)
+}
+
 func TestPull(t *testing.T) {
 	t.Parallel()
 	defer testWG.Done()
@@ -407,6 +466,24 @@ func TestPull(t *testing.T) {
 	}
 	waitUntilOrFail(t, receivedAll)
 
+	// All blocks and the identities of all peers are available through pull
+	holdAllDigests := func() bool {
+		for i := 0; i < n; i++ {
+			if len(peers[i].BlockDigests(common.ChainID("A"))) != msgsCount2Send {
+				return false
+			}
+			if len(peers[i].IdentityDigests()) != n+1 {
+				return false
+			}
+		}
+		return true
+	}
+	waitUntilOrFail(t, holdAllDigests)
+	for i := 0; i < n; i++ {
+		assert.NotEmpty(t, peers[i].Connections())
+		assert.Empty(t, peers[i].DeadPeers())
+	}
+
 	stop := func() {
 		stopPeers(append(peers, boot))
 	}
@@ -915,6 +992,52 @@ func TestMembershipRequestSpoofing(t *testing.T) {
 	}
 }
 
+func TestMembershipResponseMixedVersions(t *testing.T) {
+	t.Parallel()
+	// Scenario: membership responses are received both from peers of older versions,
+	// which don't sign them, and from peers that do sign them.
+	// Expected output: unsigned membership responses are accepted as before,
+	// but signed membership responses are accepted only if their signature is valid.
+	sa := &discoverySecurityAdapter{
+		mcs:    &naiveCryptoService{},
+		logger: util.GetLogger(util.LoggingGossipModule, "mixedVersions"),
+	}
+	memRespFrom := func(peer string, sign func(msg []byte) ([]byte, error)) proto.ReceivedMessage {
+		gMsg := &proto.GossipMessage{
+			Tag:   proto.GossipMessage_EMPTY,
+			Nonce: uint64(1),
+			Content: &proto.GossipMessage_MemRes{
+				MemRes: &proto.MembershipResponse{},
+			},
+		}
+		sMsg := &proto.SignedGossipMessage{GossipMessage: gMsg}
+		var err error
+		if sign == nil {
+			sMsg, err = gMsg.NoopSign()
+		} else {
+			_, err = sMsg.Sign(sign)
+		}
+		assert.NoError(t, err)
+		return &sentMsg{
+			msg: sMsg,
+			connInfo: &proto.ConnectionInfo{
+				ID:       common.PKIidType(peer),
+				Identity: api.PeerIdentityType(peer),
+			},
+		}
+	}
+
+	// A peer of an older version doesn't sign its membership response
+	assert.True(t, sa.ValidateMembershipResponse(memRespFrom("localhost:2100", nil)))
+	// A peer of a newer version signs its membership response
+	assert.True(t, sa.ValidateMembershipResponse(memRespFrom("localhost:2101", (&naiveCryptoService{}).Sign)))
+	// A membership response with an invalid signature is rejected
+	forge := func(msg []byte) ([]byte, error) {
+		return []byte("forged"), nil
+	}
+	assert.False(t, sa.ValidateMembershipResponse(memRespFrom("localhost:2102", forge)))
+}
+
 func TestDataLeakage(t *testing.T) {
 	t.Parallel()
 	defer testWG.Done()
@@ -437,6 +437,103 @@ func TestConfidentiality(t *testing.T) {
 	atomic.StoreInt32(&finished, int32(1))
 }
 
+func TestMultipleOrgPeerExchange(t *testing.T) {
+	t.Parallel()
+	defer testWG.Done()
+	// Scenario: create 4 organizations: {A, B, C, D}, each with 3 peers.
+	// Make only the first 2 peers have an external endpoint.
+	// Add the peers to the following channels:
+	// Channel C0: { orgA, orgB, orgC }
+	// Channel C1: { orgB, orgD }
+	// Only orgB has an anchor peer, so orgA and orgC can't find each other via anchor peers.
+	// Ensure that after membership is stabilized:
+	// - Peers of orgA and orgC with external endpoints know each other,
+	//   since their organizations share channel C0.
+	// - No peer of orgA or orgC knows peers of orgD, and vice versa.
+
+	portPrefix := 14610
+	peersInOrg := 3
+	externalEndpointsInOrg := 2
+
+	orgs := []string{"A", "B", "C", "D"}
+	channels := map[string][]string{
+		"C0": {"A", "B", "C"},
+		"C1": {"B", "D"},
+	}
+
+	cs := &configurableCryptoService{m: make(map[string]api.OrgIdentityType)}
+	for i, org := range orgs {
+		for j := 0; j < peersInOrg; j++ {
+			cs.putInOrg(portPrefix+i*peersInOrg+j, org)
+		}
+	}
+
+	var peers []Gossip
+	orgs2Peers := map[string][]Gossip{}
+	for i, org := range orgs {
+		for j := 0; j < peersInOrg; j++ {
+			id := i*peersInOrg + j
+			externalEndpoint := ""
+			if j < externalEndpointsInOrg {
+				externalEndpoint = fmt.Sprintf("localhost:%d", portPrefix+id)
+			}
+			// The peers of each org bootstrap from the first peer of the org
+			peer := newGossipInstanceWithExternalEndpoint(portPrefix, id, cs, externalEndpoint, i*peersInOrg)
+			peers = append(peers, peer)
+			orgs2Peers[org] = append(orgs2Peers[org], peer)
+		}
+	}
+
+	anchorPeerOfB := api.AnchorPeer{Host: "localhost", Port: portPrefix + peersInOrg}
+	for ch, orgsInChan := range channels {
+		jcm := &joinChanMsg{members2AnchorPeers: map[string][]api.AnchorPeer{}}
+		for _, org := range orgsInChan {
+			jcm.members2AnchorPeers[org] = []api.AnchorPeer{}
+		}
+		jcm.members2AnchorPeers["B"] = []api.AnchorPeer{anchorPeerOfB}
+		for _, org := range orgsInChan {
+			for _, p := range orgs2Peers[org] {
+				p.JoinChan(jcm, common.ChainID(ch))
+				p.UpdateChannelMetadata(createMetadata(1), common.ChainID(ch))
+			}
+		}
+	}
+
+	// The organizations each organization should know the peers with external endpoints of
+	foreignOrgsKnown := map[string][]string{
+		"A": {"B", "C"},
+		"B": {"A", "C", "D"},
+		"C": {"A", "B"},
+		"D": {"B"},
+	}
+
+	assertMembership := func() bool {
+		for _, org := range orgs {
+			for i, p := range orgs2Peers[org] {
+				expMemberSize := peersInOrg - 1
+				if i < externalEndpointsInOrg {
+					expMemberSize += externalEndpointsInOrg * len(foreignOrgsKnown[org])
+				}
+				members := p.Peers()
+				for _, member := range members {
+					memberOrg := string(cs.OrgByPeerIdentity(api.PeerIdentityType(member.PKIid)))
+					if memberOrg == org {
+						continue
+					}
+					assert.Contains(t, foreignOrgsKnown[org], memberOrg, "peer of org %s knows peer %s of org %s", org, member.PKIid, memberOrg)
+				}
+				if len(members) != expMemberSize {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	waitUntilOrFail(t, assertMembership)
+	stopPeers(peers)
+}
+
 func expectedMembershipSize(peersInOrg, externalEndpointsInOrg int, org string, hasExternalEndpoint bool) int {
 	// x <-- peersInOrg
 	// y <-- externalEndpointsInOrg
@@ -103,6 +103,9 @@ type Mediator interface {
 	// if such a message exits
 	Remove(digest string)
 
+	// Digests returns the digests of the GossipMessages in the Mediator
+	Digests() []string
+
 	// HandleMessage handles a message from some remote peer
 	HandleMessage(msg proto.ReceivedMessage)
 }
@@ -239,6 +242,17 @@ func (p *pullMediatorImpl) Remove(digest string) {
 	p.engine.Remove(digest)
 }
 
+// Digests returns the digests of the GossipMessages in the Mediator
+func (p *pullMediatorImpl) Digests() []string {
+	p.RLock()
+	defer p.RUnlock()
+	digests := make([]string, 0, len(p.itemID2Msg))
+	for itemID := range p.itemID2Msg {
+		digests = append(digests, itemID)
+	}
+	return digests
+}
+
 // SelectPeers returns a slice of peers which the engine will initiate the protocol with
 func (p *pullMediatorImpl) SelectPeers() []string {
 	remotePeers := SelectEndpoints(p.config.PeerCountToSelect, p.MemSvc.GetMembership())
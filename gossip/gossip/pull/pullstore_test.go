@@ -8,6 +8,7 @@ package pull
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"sync/atomic"
 	"testing"
@@ -260,6 +261,10 @@ func TestAddAndRemove(t *testing.T) {
 
 	// Ensure instance 2 doesn't have message 0
 	assert.False(t, inst2.items.Exists(uint64(0)), "Instance 2 has message 0 but shouldn't have")
+
+	digests := inst1.mediator.Digests()
+	sort.Strings(digests)
+	assert.Equal(t, []string{"1", "10", "2"}, digests)
 }
 
 func TestDigestsFilters(t *testing.T) {
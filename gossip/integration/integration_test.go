@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package integration
 
 import (
+	"bytes"
 	"fmt"
 	"net"
 	"strings"
@@ -16,10 +17,13 @@ import (
 
 	"github.com/hyperledger/fabric/core/config"
 	"github.com/hyperledger/fabric/gossip/api"
+	"github.com/hyperledger/fabric/gossip/comm"
 	"github.com/hyperledger/fabric/gossip/common"
+	"github.com/hyperledger/fabric/gossip/gossip"
 	"github.com/hyperledger/fabric/gossip/util"
 	"github.com/hyperledger/fabric/msp/mgmt"
 	"github.com/hyperledger/fabric/msp/mgmt/testtools"
+	proto "github.com/hyperledger/fabric/protos/gossip"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
@@ -70,6 +74,115 @@ func TestNewGossipCryptoService(t *testing.T) {
 	go s3.Serve(ll3)
 }
 
+func BenchmarkDataMessages(b *testing.B) {
+	// Big payloads, such as blocks, that compress well
+	data := bytes.Repeat([]byte("block of transactions "), 5000)
+	createMsg := func(seq int) *proto.GossipMessage {
+		return &proto.GossipMessage{
+			Tag:   proto.GossipMessage_EMPTY,
+			Nonce: uint64(seq),
+			Content: &proto.GossipMessage_DataMsg{
+				DataMsg: &proto.DataMessage{Payload: &proto.Payload{SeqNum: uint64(seq), Data: data}},
+			},
+		}
+	}
+	b.Run("NoCompression", func(b *testing.B) {
+		benchmarkMessages(b, 5620, createMsg, "none", false)
+	})
+	b.Run("Snappy", func(b *testing.B) {
+		benchmarkMessages(b, 5622, createMsg, "snappy", false)
+	})
+	b.Run("Gzip", func(b *testing.B) {
+		benchmarkMessages(b, 5624, createMsg, "gzip", false)
+	})
+}
+
+func BenchmarkAliveMessages(b *testing.B) {
+	// Small messages, that may be batched together
+	createMsg := func(seq int) *proto.GossipMessage {
+		return &proto.GossipMessage{
+			Tag:   proto.GossipMessage_EMPTY,
+			Nonce: uint64(seq),
+			Content: &proto.GossipMessage_AliveMsg{
+				AliveMsg: &proto.AliveMessage{
+					Membership: &proto.Member{Endpoint: "localhost:5630", PkiId: []byte("peer1")},
+					Timestamp:  &proto.PeerTime{IncNum: uint64(time.Now().UnixNano()), SeqNum: uint64(seq)},
+				},
+			},
+		}
+	}
+	b.Run("NoBatching", func(b *testing.B) {
+		benchmarkMessages(b, 5630, createMsg, "none", false)
+	})
+	b.Run("Batching", func(b *testing.B) {
+		benchmarkMessages(b, 5632, createMsg, "none", true)
+	})
+}
+
+// benchmarkMessages measures sending messages between two gossip components
+// that are configured with the given compression algorithm and batching
+func benchmarkMessages(b *testing.B, port int, createMsg func(seq int) *proto.GossipMessage, compression string, batching bool) {
+	// The number of messages sent before waiting for them to be received,
+	// which is below the size of the send buffer of a connection
+	const window = 10
+	setupTestEnv()
+	viper.Set("peer.gossip.compression.algorithm", compression)
+	viper.Set("peer.gossip.batching.enabled", batching)
+	defer viper.Reset()
+
+	newComponent := func(port int, bootPeers ...string) (gossip.Gossip, func()) {
+		s := grpc.NewServer()
+		ll, err := net.Listen("tcp", fmt.Sprintf("%s:%d", "", port))
+		if err != nil {
+			b.Fatal(err)
+		}
+		endpoint := fmt.Sprintf("localhost:%d", port)
+		g, err := NewGossipComponent([]byte(endpoint), endpoint, s, secAdv, cryptSvc, defaultSecureDialOpts, nil, bootPeers...)
+		if err != nil {
+			b.Fatal(err)
+		}
+		go s.Serve(ll)
+		return g, func() {
+			g.Stop()
+			s.Stop()
+		}
+	}
+	g1, stop1 := newComponent(port)
+	defer stop1()
+	endpoint2 := fmt.Sprintf("localhost:%d", port+1)
+	g2, stop2 := newComponent(port+1, fmt.Sprintf("localhost:%d", port))
+	defer stop2()
+
+	// Wait for the components to know each other,
+	// so that messages aren't lost while they connect
+	for start := time.Now(); len(g1.Peers()) == 0 || len(g2.Peers()) == 0; time.Sleep(time.Millisecond * 100) {
+		if time.Since(start) > time.Second*10 {
+			b.Fatal("Components didn't learn about each other")
+		}
+	}
+
+	_, received := g2.Accept(func(msg interface{}) bool {
+		return msg.(proto.ReceivedMessage).GetGossipMessage().Tag == proto.GossipMessage_EMPTY
+	}, true)
+	remotePeer := &comm.RemotePeer{Endpoint: endpoint2, PKIID: common.PKIidType(endpoint2)}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i += window {
+		sent := 0
+		for j := i; j < i+window && j < b.N; j++ {
+			g1.Send(createMsg(j), remotePeer)
+			sent++
+		}
+		for j := 0; j < sent; j++ {
+			select {
+			case <-received:
+			case <-time.After(time.Second * 10):
+				b.Fatal("Didn't receive all messages")
+			}
+		}
+	}
+}
+
 func setupTestEnv() {
 	viper.SetConfigName("core")
 	viper.SetEnvPrefix("CORE")
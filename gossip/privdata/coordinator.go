@@ -36,6 +36,7 @@ const (
 	pullRetrySleepInterval           = time.Second
 	transientBlockRetentionConfigKey = "peer.gossip.pvtData.transientstoreMaxBlockRetention"
 	transientBlockRetentionDefault   = 1000
+	commitPipelineDepthConfigKey     = "peer.commitPipelineDepth"
 )
 
 var logger *logging.Logger // package-level logger
@@ -70,7 +71,9 @@ type TransientStore interface {
 // to complete missing parts of transient data for given block.
 type Coordinator interface {
 	// StoreBlock deliver new block with underlined private data
-	// returns missing transaction ids
+	// returns missing transaction ids. If blocks are committed in a
+	// pipeline, it returns once the block is validated and queued for
+	// commit, and a failure to commit it is returned by the next call
 	StoreBlock(block *common.Block, data util.PvtDataCollections) error
 
 	// StorePvtData used to persist private data into transient store
@@ -120,6 +123,7 @@ type coordinator struct {
 	selfSignedData common.SignedData
 	Support
 	transientBlockRetention uint64
+	pipeline                *committer.PipelinedCommitter
 }
 
 // NewCoordinator creates a new instance of coordinator
@@ -129,7 +133,12 @@ func NewCoordinator(support Support, selfSignedData common.SignedData) Coordinat
 		logger.Warning("Configuration key", transientBlockRetentionConfigKey, "isn't set, defaulting to", transientBlockRetentionDefault)
 		transientBlockRetention = transientBlockRetentionDefault
 	}
-	return &coordinator{Support: support, selfSignedData: selfSignedData, transientBlockRetention: transientBlockRetention}
+	c := &coordinator{Support: support, selfSignedData: selfSignedData, transientBlockRetention: transientBlockRetention}
+	if depth := viper.GetInt(commitPipelineDepthConfigKey); depth > 0 {
+		logger.Infof("[%s] Validating blocks while up to %d blocks are being committed", support.ChainID, depth)
+		c.pipeline = committer.NewPipelinedCommitter(support.Committer, support.Validator, depth)
+	}
+	return c
 }
 
 // StorePvtData used to persist private date into transient store
@@ -152,6 +161,28 @@ func (c *coordinator) StoreBlock(block *common.Block, privateDataSets util.PvtDa
 
 	logger.Infof("[%s] Received block [%d] from buffer", c.ChainID, block.Header.Number)
 
+	var purgedTxns txns
+	prepare := func(block *common.Block) (*ledger.BlockAndPvtData, error) {
+		var blockAndPvtData *ledger.BlockAndPvtData
+		var err error
+		blockAndPvtData, purgedTxns, err = c.prepareBlock(block, privateDataSets)
+		return blockAndPvtData, err
+	}
+	onCommit := func() {
+		c.purgeTransientStore(block.Header.Number, purgedTxns)
+	}
+
+	if c.pipeline != nil {
+		// the commit proceeds in the background, while the blocks that
+		// follow this one are validated; if it fails, the submission of
+		// the next block returns the error, and so do all that follow it
+		if err := c.pipeline.Submit(block, prepare, onCommit); err != nil {
+			logger.Errorf("Validation or commit failed: %+v", err)
+			return err
+		}
+		return nil
+	}
+
 	logger.Debugf("[%s] Validating block [%d]", c.ChainID, block.Header.Number)
 	err := c.Validator.Validate(block)
 	if err != nil {
@@ -159,6 +190,25 @@ func (c *coordinator) StoreBlock(block *common.Block, privateDataSets util.PvtDa
 		return err
 	}
 
+	blockAndPvtData, err := prepare(block)
+	if err != nil {
+		return err
+	}
+
+	// commit block and private data
+	err = c.CommitWithPvtData(blockAndPvtData)
+	if err != nil {
+		return errors.Wrap(err, "commit failed")
+	}
+
+	onCommit()
+	return nil
+}
+
+// prepareBlock assembles the given validated block with its private data, and
+// returns it along with the transactions whose private data are to be purged
+// from the transient store once it is committed
+func (c *coordinator) prepareBlock(block *common.Block, privateDataSets util.PvtDataCollections) (*ledger.BlockAndPvtData, txns, error) {
 	blockAndPvtData := &ledger.BlockAndPvtData{
 		Block:        block,
 		BlockPvtData: make(map[uint64]*ledger.TxPvtData),
@@ -167,13 +217,13 @@ func (c *coordinator) StoreBlock(block *common.Block, privateDataSets util.PvtDa
 	ownedRWsets, err := computeOwnedRWsets(block, privateDataSets)
 	if err != nil {
 		logger.Warning("Failed computing owned RWSets", err)
-		return err
+		return nil, nil, err
 	}
 
 	privateInfo, err := c.listMissingPrivateData(block, ownedRWsets)
 	if err != nil {
 		logger.Warning(err)
-		return err
+		return nil, nil, err
 	}
 
 	retryThresh := viper.GetDuration("peer.gossip.pvtData.pullRetryThreshold")
@@ -223,28 +273,37 @@ func (c *coordinator) StoreBlock(block *common.Block, privateDataSets util.PvtDa
 		})
 	}
 
-	// commit block and private data
-	err = c.CommitWithPvtData(blockAndPvtData)
-	if err != nil {
-		return errors.Wrap(err, "commit failed")
+	if len(blockAndPvtData.BlockPvtData) == 0 {
+		return blockAndPvtData, nil, nil
 	}
+	return blockAndPvtData, privateInfo.txns, nil
+}
 
-	if len(blockAndPvtData.BlockPvtData) > 0 {
+// purgeTransientStore purges the private data of the given transactions, and
+// the private data that are retained beyond the given committed block
+func (c *coordinator) purgeTransientStore(seq uint64, txns txns) {
+	if len(txns) > 0 {
 		// Finally, purge all transactions in block - valid or not valid.
-		if err := c.PurgeByTxids(privateInfo.txns); err != nil {
-			logger.Error("Purging transactions", privateInfo.txns, "failed:", err)
+		if err := c.PurgeByTxids(txns); err != nil {
+			logger.Error("Purging transactions", txns, "failed:", err)
 		}
 	}
 
-	seq := block.Header.Number
 	if seq%c.transientBlockRetention == 0 && seq > c.transientBlockRetention {
 		err := c.PurgeByHeight(seq - c.transientBlockRetention)
 		if err != nil {
 			logger.Error("Failed purging data from transient store at block", seq, ":", err)
 		}
 	}
+}
 
-	return nil
+// Close closes the coordinator, after the blocks
+// that are being validated or committed are committed
+func (c *coordinator) Close() {
+	if c.pipeline != nil {
+		c.pipeline.Close()
+	}
+	c.Committer.Close()
 }
 
 func (c *coordinator) fetchFromPeers(blockSeq uint64, ownedRWsets map[rwSetKey][]byte, privateInfo *privateDataInfo) {
@@ -27,6 +27,7 @@ import (
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
 	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
 	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/hyperledger/fabric/protos/peer"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -286,6 +287,7 @@ type collectionStore struct {
 	expectedSignedData common.SignedData
 	acceptsAll         bool
 	lenient            bool
+	disseminationMode  common.DisseminationMode
 	store              map[common.CollectionCriteria]collectionAccessPolicy
 	policies           map[collectionAccessPolicy]common.CollectionCriteria
 }
@@ -300,6 +302,11 @@ func (cs *collectionStore) andIsLenient() *collectionStore {
 	return cs
 }
 
+func (cs *collectionStore) withDisseminationMode(mode common.DisseminationMode) *collectionStore {
+	cs.disseminationMode = mode
+	return cs
+}
+
 func (cs *collectionStore) thatAccepts(cc common.CollectionCriteria) *collectionStore {
 	sp := collectionAccessPolicy{
 		cs: cs,
@@ -331,6 +338,10 @@ func (cs *collectionStore) RetrieveCollectionConfigPackage(common.CollectionCrit
 	panic("implement me")
 }
 
+func (cs *collectionStore) RetrieveReadWritePermission(common.CollectionCriteria, *peer.SignedProposal, ledger.QueryExecutor) (bool, bool, error) {
+	panic("implement me")
+}
+
 type collectionAccessPolicy struct {
 	cs *collectionStore
 	n  uint64
@@ -348,6 +359,18 @@ func (cap *collectionAccessPolicy) MaximumPeerCount() int {
 	return 2
 }
 
+func (cap *collectionAccessPolicy) DisseminationMode() common.DisseminationMode {
+	return cap.cs.disseminationMode
+}
+
+func (cap *collectionAccessPolicy) IsMemberOnlyRead() bool {
+	return false
+}
+
+func (cap *collectionAccessPolicy) IsMemberOnlyWrite() bool {
+	return false
+}
+
 func (cap *collectionAccessPolicy) AccessFilter() privdata.Filter {
 	return func(sd common.SignedData) bool {
 		that, _ := asn1.Marshal(sd)
@@ -1200,6 +1223,55 @@ func TestPurgeByHeight(t *testing.T) {
 	}
 }
 
+func TestPipelinedStoreBlock(t *testing.T) {
+	// Scenario: blocks are committed in the background while the blocks that follow them are
+	// validated, and the transient store is purged only once they are committed
+	viper.Set("peer.commitPipelineDepth", 2)
+	defer viper.Set("peer.commitPipelineDepth", 0)
+
+	peerSelfSignedData := common.SignedData{}
+	cs := createcollectionStore(peerSelfSignedData).thatAcceptsAll()
+	release := make(chan struct{})
+	committed := make(chan uint64, 2)
+	committer := &committerMock{}
+	committer.On("CommitWithPvtData", mock.Anything).Run(func(args mock.Arguments) {
+		<-release
+		committed <- args.Get(0).(*ledger.BlockAndPvtData).Block.Header.Number
+	}).Return(nil)
+	committer.On("Close")
+	purged := make(chan uint64, 1)
+	store := &mockTransientStore{t: t}
+	store.On("PurgeByHeight", uint64(1000)).Return(nil).Once().Run(func(_ mock.Arguments) {
+		purged <- 1000
+	})
+
+	bf := &blockFactory{
+		channelID: "test",
+	}
+	coordinator := NewCoordinator(Support{
+		CollectionStore: cs,
+		Committer:       committer,
+		Fetcher:         &fetcherMock{t: t},
+		TransientStore:  store,
+		Validator:       &validatorMock{},
+	}, peerSelfSignedData)
+
+	for _, seq := range []uint64{1999, 2000} {
+		block := bf.create()
+		block.Header.Number = seq
+		assert.NoError(t, coordinator.StoreBlock(block, nil))
+	}
+	assert.Len(t, committed, 0)
+	assert.Len(t, purged, 0)
+
+	close(release)
+	coordinator.Close()
+	assert.Equal(t, uint64(1999), <-committed)
+	assert.Equal(t, uint64(2000), <-committed)
+	assert.Equal(t, uint64(1000), <-purged)
+	committer.AssertCalled(t, "Close")
+}
+
 func TestCoordinatorStorePvtData(t *testing.T) {
 	cs := createcollectionStore(common.SignedData{}).thatAcceptsAll()
 	committer := &committerMock{}
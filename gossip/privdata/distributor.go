@@ -7,10 +7,15 @@ SPDX-License-Identifier: Apache-2.0
 package privdata
 
 import (
+	"bytes"
 	"fmt"
+	"math/rand"
+	"sort"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	pb "github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/core/common/privdata"
 	"github.com/hyperledger/fabric/gossip/api"
 	gossipCommon "github.com/hyperledger/fabric/gossip/common"
@@ -21,10 +26,19 @@ import (
 	"github.com/hyperledger/fabric/protos/common"
 	proto "github.com/hyperledger/fabric/protos/gossip"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	"github.com/hyperledger/fabric/protos/msp"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 )
 
+const (
+	pushAckTimeoutConfigKey   = "peer.gossip.pvtData.pushAckTimeout"
+	pushRetriesConfigKey      = "peer.gossip.pvtData.pushRetries"
+	pushRetriesDefault        = 2
+	pushRetryBackoffConfigKey = "peer.gossip.pvtData.pushRetryBackoff"
+	pushRetryBackoffDefault   = 500 * time.Millisecond
+)
+
 // gossipAdapter an adapter for API's required from gossip module
 type gossipAdapter interface {
 	// SendByCriteria sends a given message to all peers that match the given SendCriteria
@@ -33,6 +47,13 @@ type gossipAdapter interface {
 	// PeerFilter receives a SubChannelSelectionCriteria and returns a RoutingFilter that selects
 	// only peer identities that match the given criteria, and that they published their channel participation
 	PeerFilter(channel gossipCommon.ChainID, messagePredicate api.SubChannelSelectionCriteria) (filter.RoutingFilter, error)
+
+	// PeersOfChannel returns the NetworkMembers considered alive
+	// and also subscribed to the channel given
+	PeersOfChannel(gossipCommon.ChainID) []discovery.NetworkMember
+
+	// PeerIdentity returns the identity of the peer with the given PKI-ID
+	PeerIdentity(pkiID gossipCommon.PKIidType) (api.PeerIdentityType, error)
 }
 
 // PvtDataDistributor interface to defines API of distributing private data
@@ -45,14 +66,28 @@ type PvtDataDistributor interface {
 type distributorImpl struct {
 	chainID string
 	gossipAdapter
+	ackTimeout   time.Duration
+	retries      int
+	retryBackoff time.Duration
 }
 
 // NewDistributor a constructor for private data distributor capable to send
 // private read write sets for underlying collection
 func NewDistributor(chainID string, gossip gossipAdapter) PvtDataDistributor {
+	retries := pushRetriesDefault
+	if viper.IsSet(pushRetriesConfigKey) {
+		retries = viper.GetInt(pushRetriesConfigKey)
+	}
+	retryBackoff := viper.GetDuration(pushRetryBackoffConfigKey)
+	if retryBackoff == 0 {
+		retryBackoff = pushRetryBackoffDefault
+	}
 	return &distributorImpl{
 		chainID:       chainID,
 		gossipAdapter: gossip,
+		ackTimeout:    viper.GetDuration(pushAckTimeoutConfigKey),
+		retries:       retries,
+		retryBackoff:  retryBackoff,
 	}
 }
 
@@ -65,9 +100,15 @@ func (d *distributorImpl) Distribute(txID string, privData *rwset.TxPvtReadWrite
 	return d.disseminate(disseminationPlan)
 }
 
+// dissemination is the sending of a private RWSet to the peers eligible for its collection
 type dissemination struct {
-	msg      *proto.SignedGossipMessage
-	criteria gossip2.SendCriteria
+	msg        *proto.SignedGossipMessage
+	isEligible filter.RoutingFilter
+	// maxPeers is the number of peers the private RWSet is sent to, 0 meaning all eligible peers
+	maxPeers int
+	// minAck is the number of peers that need to acknowledge the private RWSet
+	minAck int
+	mode   common.DisseminationMode
 }
 
 func (d *distributorImpl) computeDisseminationPlan(txID string, privData *rwset.TxPvtReadWriteSet, cs privdata.CollectionStore) ([]*dissemination, error) {
@@ -124,18 +165,12 @@ func (d *distributorImpl) disseminationPlanForMsg(colAP privdata.CollectionAcces
 		return nil, err
 	}
 
-	sc := gossip2.SendCriteria{
-		Timeout:  viper.GetDuration("peer.gossip.pvtData.pushAckTimeout"),
-		Channel:  gossipCommon.ChainID(d.chainID),
-		MaxPeers: colAP.MaximumPeerCount(),
-		MinAck:   colAP.RequiredPeerCount(),
-		IsEligible: func(member discovery.NetworkMember) bool {
-			return routingFilter(member)
-		},
-	}
 	disseminationPlan = append(disseminationPlan, &dissemination{
-		criteria: sc,
-		msg:      pvtDataMsg,
+		msg:        pvtDataMsg,
+		isEligible: routingFilter,
+		maxPeers:   colAP.MaximumPeerCount(),
+		minAck:     colAP.RequiredPeerCount(),
+		mode:       colAP.DisseminationMode(),
 	})
 	return disseminationPlan, nil
 }
@@ -147,12 +182,18 @@ func (d *distributorImpl) disseminate(disseminationPlan []*dissemination) error
 	for _, dis := range disseminationPlan {
 		go func(dis *dissemination) {
 			defer wg.Done()
-			err := d.SendByCriteria(dis.msg, dis.criteria)
-			if err != nil {
-				atomic.AddUint32(&failures, 1)
-				m := dis.msg.GetPrivateData().Payload
-				logger.Error("Failed disseminating private RWSet for TxID", m.TxId, ", namespace", m.Namespace, "collection", m.CollectionName, ":", err)
+			err := d.disseminateWithRetry(dis)
+			if err == nil {
+				return
+			}
+			m := dis.msg.GetPrivateData().Payload
+			if dis.mode == common.DisseminationMode_BEST_EFFORT {
+				logger.Warning("Failed disseminating private RWSet for TxID", m.TxId, ", namespace", m.Namespace, "collection", m.CollectionName,
+					"to enough peers, proceeding as its dissemination mode is best effort:", err)
+				return
 			}
+			atomic.AddUint32(&failures, 1)
+			logger.Error("Failed disseminating private RWSet for TxID", m.TxId, ", namespace", m.Namespace, "collection", m.CollectionName, ":", err)
 		}(dis)
 	}
 	wg.Wait()
@@ -163,6 +204,168 @@ func (d *distributorImpl) disseminate(disseminationPlan []*dissemination) error
 	return nil
 }
 
+// disseminateWithRetry sends the private RWSet of the dissemination to its eligible peers,
+// and as long as less than the required number of peers acknowledged it, retries with
+// exponential backoff by sending it to peers that didn't acknowledge it yet,
+// preferring peers it wasn't sent to before.
+func (d *distributorImpl) disseminateWithRetry(dis *dissemination) error {
+	acked := make(map[string]struct{})
+	failed := make(map[string]struct{})
+	backoff := d.retryBackoff
+	for attempt := 0; ; attempt++ {
+		peers := d.eligiblePeers(dis.isEligible)
+		// The first attempt sends to as many peers as the collection allows,
+		// subsequent attempts only make up for the missing acknowledgements
+		count := dis.minAck - len(acked)
+		if attempt == 0 {
+			count = dis.maxPeers
+			if count == 0 {
+				count = len(peers)
+			}
+		}
+		targets := d.prioritizePeers(peers, acked, failed)
+		if len(targets) > count {
+			targets = targets[:count]
+		}
+		if len(acked)+len(targets) < dis.minAck {
+			logger.Warning("Requested to send to at least", dis.minAck, "peers, but know only of", len(acked)+len(targets), "suitable peers")
+		} else {
+			for pkiID, err := range d.sendToPeers(dis, targets, dis.minAck-len(acked)) {
+				if err != nil {
+					logger.Debug("Failed sending private RWSet to", pkiID, ":", err)
+					failed[pkiID] = struct{}{}
+					continue
+				}
+				delete(failed, pkiID)
+				acked[pkiID] = struct{}{}
+			}
+		}
+		if len(acked) >= dis.minAck {
+			return nil
+		}
+		if attempt >= d.retries {
+			return errors.Errorf("only %d out of %d required peers acknowledged the private RWSet after %d attempts", len(acked), dis.minAck, attempt+1)
+		}
+		logger.Debug("Only", len(acked), "out of", dis.minAck, "required peers acknowledged the private RWSet, retrying in", backoff)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// sendToPeers sends the private RWSet of the dissemination to each of the given peers
+// separately, and returns the error of each send by the PKI-ID of its peer. It returns
+// as soon as the given number of peers acknowledged, without waiting for the rest
+// of the sends, which complete in the background and aren't part of the result
+func (d *distributorImpl) sendToPeers(dis *dissemination, peers []discovery.NetworkMember, requiredAcks int) map[string]error {
+	minAck := 1
+	if dis.minAck == 0 {
+		// Nobody needs to acknowledge, so don't wait for it
+		minAck = 0
+	}
+	type sendResult struct {
+		pkiID string
+		err   error
+	}
+	// The channel is big enough for all sends, so that the ones
+	// that complete after this function returns don't block
+	resultChan := make(chan sendResult, len(peers))
+	for _, peer := range peers {
+		go func(peer discovery.NetworkMember) {
+			err := d.SendByCriteria(dis.msg, gossip2.SendCriteria{
+				Timeout:  d.ackTimeout,
+				Channel:  gossipCommon.ChainID(d.chainID),
+				MaxPeers: 1,
+				MinAck:   minAck,
+				IsEligible: func(member discovery.NetworkMember) bool {
+					return bytes.Equal(member.PKIid, peer.PKIid)
+				},
+			})
+			resultChan <- sendResult{pkiID: string(peer.PKIid), err: err}
+		}(peer)
+	}
+	results := make(map[string]error)
+	acks := 0
+	for len(results) < len(peers) && acks < requiredAcks {
+		res := <-resultChan
+		results[res.pkiID] = res.err
+		if res.err == nil {
+			acks++
+		}
+	}
+	return results
+}
+
+func (d *distributorImpl) eligiblePeers(isEligible filter.RoutingFilter) []discovery.NetworkMember {
+	var peers []discovery.NetworkMember
+	for _, peer := range d.PeersOfChannel(gossipCommon.ChainID(d.chainID)) {
+		if isEligible(peer) {
+			peers = append(peers, peer)
+		}
+	}
+	return peers
+}
+
+// prioritizePeers orders the given peers that didn't acknowledge yet so that peers which
+// weren't sent to before come first, and within each of these groups, consecutive peers
+// are of different orgs as much as possible, with orgs that acknowledged less coming first.
+func (d *distributorImpl) prioritizePeers(peers []discovery.NetworkMember, acked, failed map[string]struct{}) []discovery.NetworkMember {
+	ackedByOrg := make(map[string]int)
+	var untried, retried []discovery.NetworkMember
+	for _, peer := range peers {
+		if _, exists := acked[string(peer.PKIid)]; exists {
+			ackedByOrg[d.orgOf(peer)]++
+			continue
+		}
+		if _, exists := failed[string(peer.PKIid)]; exists {
+			retried = append(retried, peer)
+			continue
+		}
+		untried = append(untried, peer)
+	}
+	return append(d.diversifyOrgs(untried, ackedByOrg), d.diversifyOrgs(retried, ackedByOrg)...)
+}
+
+// diversifyOrgs shuffles the given peers and interleaves them by their orgs
+func (d *distributorImpl) diversifyOrgs(peers []discovery.NetworkMember, ackedByOrg map[string]int) []discovery.NetworkMember {
+	peersByOrg := make(map[string][]discovery.NetworkMember)
+	var orgs []string
+	for _, i := range rand.Perm(len(peers)) {
+		org := d.orgOf(peers[i])
+		if _, exists := peersByOrg[org]; !exists {
+			orgs = append(orgs, org)
+		}
+		peersByOrg[org] = append(peersByOrg[org], peers[i])
+	}
+	sort.SliceStable(orgs, func(i, j int) bool {
+		return ackedByOrg[orgs[i]] < ackedByOrg[orgs[j]]
+	})
+	var res []discovery.NetworkMember
+	for len(res) < len(peers) {
+		for _, org := range orgs {
+			if len(peersByOrg[org]) == 0 {
+				continue
+			}
+			res = append(res, peersByOrg[org][0])
+			peersByOrg[org] = peersByOrg[org][1:]
+		}
+	}
+	return res
+}
+
+func (d *distributorImpl) orgOf(peer discovery.NetworkMember) string {
+	identity, err := d.PeerIdentity(peer.PKIid)
+	if err != nil {
+		logger.Debug("Failed obtaining identity of", peer.PKIid, ":", err)
+		return ""
+	}
+	sID := &msp.SerializedIdentity{}
+	if err := pb.Unmarshal(identity, sID); err != nil {
+		logger.Warning("Failed unmarshalling identity of", peer.PKIid, ":", err)
+		return ""
+	}
+	return sID.Mspid
+}
+
 func (d *distributorImpl) createPrivateDataMessage(txID, namespace, collectionName string, rwset []byte) (*proto.SignedGossipMessage, error) {
 	msg := &proto.GossipMessage{
 		Channel: []byte(d.chainID),
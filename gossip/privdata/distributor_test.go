@@ -9,6 +9,7 @@ package privdata
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/hyperledger/fabric/gossip/api"
 	gcommon "github.com/hyperledger/fabric/gossip/common"
@@ -17,6 +18,8 @@ import (
 	gossip2 "github.com/hyperledger/fabric/gossip/gossip"
 	"github.com/hyperledger/fabric/protos/common"
 	proto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/hyperledger/fabric/protos/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -24,7 +27,9 @@ import (
 type gossipMock struct {
 	err error
 	mock.Mock
-	api.PeerSignature
+	signature  api.PeerSignature
+	members    []discovery.NetworkMember
+	identities map[string]api.PeerIdentityType
 }
 
 func (g *gossipMock) SendByCriteria(message *proto.SignedGossipMessage, criteria gossip2.SendCriteria) error {
@@ -40,79 +45,125 @@ func (g *gossipMock) PeerFilter(channel gcommon.ChainID, messagePredicate api.Su
 		return nil, g.err
 	}
 	return func(member discovery.NetworkMember) bool {
-		return messagePredicate(g.PeerSignature)
+		return messagePredicate(g.signature)
 	}, nil
 }
 
-func TestDistributor(t *testing.T) {
+func (g *gossipMock) PeersOfChannel(gcommon.ChainID) []discovery.NetworkMember {
+	return g.members
+}
+
+func (g *gossipMock) PeerIdentity(pkiID gcommon.PKIidType) (api.PeerIdentityType, error) {
+	identity, exists := g.identities[string(pkiID)]
+	if !exists {
+		return nil, errors.New("unknown peer")
+	}
+	return identity, nil
+}
+
+// recipient returns the member the given SendCriteria selects
+func (g *gossipMock) recipient(sc gossip2.SendCriteria) string {
+	for _, member := range g.members {
+		if sc.IsEligible(member) {
+			return string(member.PKIid)
+		}
+	}
+	return ""
+}
+
+type sending struct {
+	*proto.PrivatePayload
+	gossip2.SendCriteria
+	recipient string
+}
+
+func newGossipMock() *gossipMock {
 	g := &gossipMock{
 		Mock: mock.Mock{},
-		PeerSignature: api.PeerSignature{
+		signature: api.PeerSignature{
 			Signature:    []byte{3, 4, 5},
 			Message:      []byte{6, 7, 8},
 			PeerIdentity: []byte{0, 1, 2},
 		},
+		identities: make(map[string]api.PeerIdentityType),
 	}
-	sendings := make(chan struct {
-		*proto.PrivatePayload
-		gossip2.SendCriteria
-	}, 8)
-	g.On("SendByCriteria", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+	for _, peer := range []struct{ pkiID, org string }{{"p1", "org1"}, {"p2", "org2"}, {"p3", "org1"}} {
+		g.members = append(g.members, discovery.NetworkMember{PKIid: gcommon.PKIidType(peer.pkiID), Endpoint: peer.pkiID})
+		g.identities[peer.pkiID] = utils.MarshalOrPanic(&msp.SerializedIdentity{Mspid: peer.org, IdBytes: []byte(peer.pkiID)})
+	}
+	return g
+}
+
+func (g *gossipMock) recordSendings(sendings chan sending) func(mock.Arguments) {
+	return func(args mock.Arguments) {
 		msg := args.Get(0).(*proto.SignedGossipMessage)
 		sendCriteria := args.Get(1).(gossip2.SendCriteria)
-		sendings <- struct {
-			*proto.PrivatePayload
-			gossip2.SendCriteria
-		}{
+		sendings <- sending{
 			PrivatePayload: msg.GetPrivateData().Payload,
 			SendCriteria:   sendCriteria,
+			recipient:      g.recipient(sendCriteria),
 		}
-	}).Return(nil)
-	d := NewDistributor("test", g)
-	pdFactory := &pvtDataFactory{}
-	peerSelfSignedData := common.SignedData{
-		Identity:  []byte{0, 1, 2},
-		Signature: []byte{3, 4, 5},
-		Data:      []byte{6, 7, 8},
 	}
-	cs := createcollectionStore(peerSelfSignedData).thatAccepts(common.CollectionCriteria{
-		TxId:       "tx1",
-		Namespace:  "ns1",
-		Channel:    "test",
-		Collection: "c1",
-	}).thatAccepts(common.CollectionCriteria{
-		TxId:       "tx2",
-		Namespace:  "ns2",
-		Channel:    "test",
-		Collection: "c2",
-	}).andIsLenient()
-	pvtData := pdFactory.addRWSet().addNSRWSet("ns1", "c1", "c2").addRWSet().addNSRWSet("ns2", "c1", "c2").create()
+}
+
+func newTestDistributor(g *gossipMock) *distributorImpl {
+	d := NewDistributor("test", g).(*distributorImpl)
+	d.retryBackoff = time.Millisecond
+	return d
+}
+
+var peerSelfSignedData = common.SignedData{
+	Identity:  []byte{0, 1, 2},
+	Signature: []byte{3, 4, 5},
+	Data:      []byte{6, 7, 8},
+}
+
+func TestDistributor(t *testing.T) {
+	g := newGossipMock()
+	sendings := make(chan sending, 8)
+	g.On("SendByCriteria", mock.Anything, mock.Anything).Run(g.recordSendings(sendings)).Return(nil)
+	d := newTestDistributor(g)
+	assert.Equal(t, pushRetriesDefault, d.retries)
+	cs := createcollectionStore(peerSelfSignedData).thatAcceptsAll()
+	pvtData := (&pvtDataFactory{}).addRWSet().addNSRWSet("ns1", "c1", "c2").addRWSet().addNSRWSet("ns2", "c1", "c2").create()
 	err := d.Distribute("tx1", pvtData[0].WriteSet, cs)
 	assert.NoError(t, err)
 	err = d.Distribute("tx2", pvtData[1].WriteSet, cs)
 	assert.NoError(t, err)
 
-	assertACL := func(pp *proto.PrivatePayload, sc gossip2.SendCriteria) {
-		eligible := pp.Namespace == "ns1" && pp.CollectionName == "c1"
-		eligible = eligible || (pp.Namespace == "ns2" && pp.CollectionName == "c2")
-		// The mock collection store returns policies that for ns1 and c1, or ns2 and c2 return true regardless
-		// of the network member, and for any other collection and namespace combination - return false
-
-		// Ensure MaxPeers is maxInternalPeers which is 2
-		//and MinAck is minInternalPeers which is 1
-		assert.Equal(t, 2, sc.MaxPeers)
-		assert.Equal(t, 1, sc.MinAck)
-	}
-	i := 0
-	for dis := range sendings {
-		assertACL(dis.PrivatePayload, dis.SendCriteria)
-		i++
-		if i == 4 {
-			break
-		}
+	// Each private RWSet is sent separately to MaxPeers which is 2 peers,
+	// each of which needs to acknowledge it, and since the first peer to
+	// acknowledge suffices, there are no retries
+	recipients := make(map[string][]string)
+	for i := 0; i < 8; i++ {
+		dis := <-sendings
+		assert.Equal(t, 1, dis.MaxPeers)
+		assert.Equal(t, 1, dis.MinAck)
+		key := dis.TxId + dis.Namespace + dis.CollectionName
+		recipients[key] = append(recipients[key], dis.recipient)
 	}
-	// Channel is empty after we read 8 times from it
 	assert.Len(t, sendings, 0)
+	assert.Len(t, recipients, 4)
+	for _, peers := range recipients {
+		// The private RWSets are sent to peers of different orgs
+		assert.Len(t, peers, 2)
+		assert.Contains(t, peers, "p2")
+	}
+
+	// Private RWSets of collections no peer is eligible for can't be disseminated
+	cs = createcollectionStore(peerSelfSignedData).thatAccepts(common.CollectionCriteria{
+		TxId:       "tx1",
+		Namespace:  "ns1",
+		Channel:    "test",
+		Collection: "c1",
+	}).andIsLenient()
+	err = d.Distribute("tx1", pvtData[0].WriteSet, cs)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Failed disseminating 1 out of 2 private RWSets")
+	for i := 0; i < 2; i++ {
+		dis := <-sendings
+		assert.Equal(t, "c1", dis.CollectionName)
+	}
 
 	// Bad path: dependencies (gossip and others) don't work properly
 	g.err = errors.New("failed obtaining filter")
@@ -120,10 +171,106 @@ func TestDistributor(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed obtaining filter")
 
+	cs = createcollectionStore(peerSelfSignedData).thatAcceptsAll()
 	g.Mock = mock.Mock{}
 	g.On("SendByCriteria", mock.Anything, mock.Anything).Return(errors.New("failed sending"))
 	g.err = nil
 	err = d.Distribute("tx1", pvtData[0].WriteSet, cs)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "Failed disseminating 2 out of 2 private RWSets")
+	// Each private RWSet was sent to both of its peers, and then
+	// once for each of the retries, to make up for the missing acknowledgement
+	g.AssertNumberOfCalls(t, "SendByCriteria", 2*(2+pushRetriesDefault))
+}
+
+func TestDistributorRetry(t *testing.T) {
+	g := newGossipMock()
+	sendings := make(chan sending, 4)
+	g.On("SendByCriteria", mock.Anything, mock.Anything).Run(g.recordSendings(sendings)).Return(errors.New("timed out")).Times(2)
+	g.On("SendByCriteria", mock.Anything, mock.Anything).Run(g.recordSendings(sendings)).Return(nil)
+	d := newTestDistributor(g)
+	cs := createcollectionStore(peerSelfSignedData).thatAcceptsAll()
+	pvtData := (&pvtDataFactory{}).addRWSet().addNSRWSet("ns1", "c1").create()
+	err := d.Distribute("tx1", pvtData[0].WriteSet, cs)
+	assert.NoError(t, err)
+
+	// Both peers the private RWSet was sent to at first didn't acknowledge
+	// it, so it's sent to the peer it wasn't sent to before
+	var firstAttempt []string
+	for i := 0; i < 2; i++ {
+		firstAttempt = append(firstAttempt, (<-sendings).recipient)
+	}
+	assert.Contains(t, firstAttempt, "p2")
+	assert.NotContains(t, firstAttempt, (<-sendings).recipient)
+	assert.Len(t, sendings, 0)
+
+	// With no peer left that it wasn't sent to, the private RWSet is sent
+	// again to the peers that didn't acknowledge it
+	g.members = g.members[:2]
+	g.Mock = mock.Mock{}
+	g.On("SendByCriteria", mock.Anything, mock.Anything).Run(g.recordSendings(sendings)).Return(errors.New("timed out")).Times(2)
+	g.On("SendByCriteria", mock.Anything, mock.Anything).Run(g.recordSendings(sendings)).Return(nil)
+	err = d.Distribute("tx1", pvtData[0].WriteSet, cs)
+	assert.NoError(t, err)
+	g.AssertNumberOfCalls(t, "SendByCriteria", 3)
+
+	// The private RWSet isn't sent at all while no eligible peer is known
+	g.members = nil
+	g.Mock = mock.Mock{}
+	g.On("SendByCriteria", mock.Anything, mock.Anything).Return(nil)
+	err = d.Distribute("tx1", pvtData[0].WriteSet, cs)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Failed disseminating 1 out of 1 private RWSets")
+	g.AssertNotCalled(t, "SendByCriteria", mock.Anything, mock.Anything)
+}
+
+func TestDistributorDoesntWaitForSlowPeers(t *testing.T) {
+	g := newGossipMock()
+	release := make(chan struct{})
+	slowSendDone := make(chan struct{})
+	g.On("SendByCriteria", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		// p2 acknowledges only after the distribution is over
+		if g.recipient(args.Get(1).(gossip2.SendCriteria)) == "p2" {
+			<-release
+			close(slowSendDone)
+		}
+	}).Return(nil)
+	d := newTestDistributor(g)
+	cs := createcollectionStore(peerSelfSignedData).thatAcceptsAll()
+	pvtData := (&pvtDataFactory{}).addRWSet().addNSRWSet("ns1", "c1").create()
+
+	// A single acknowledgement is required, and the peer of org1 suffices
+	done := make(chan error, 1)
+	go func() {
+		done <- d.Distribute("tx1", pvtData[0].WriteSet, cs)
+	}()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second * 5):
+		t.Fatal("Distribution waited for the slow peer")
+	}
+	// The send to the slow peer still completes in the background
+	close(release)
+	<-slowSendDone
+	g.AssertNumberOfCalls(t, "SendByCriteria", 2)
+}
+
+func TestDistributorDisseminationModes(t *testing.T) {
+	g := newGossipMock()
+	g.On("SendByCriteria", mock.Anything, mock.Anything).Return(errors.New("timed out"))
+	d := newTestDistributor(g)
+	d.retries = 0
+	pvtData := (&pvtDataFactory{}).addRWSet().addNSRWSet("ns1", "c1").create()
+
+	cs := createcollectionStore(peerSelfSignedData).thatAcceptsAll()
+	err := d.Distribute("tx1", pvtData[0].WriteSet, cs)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Failed disseminating 1 out of 1 private RWSets")
+
+	// Failing to disseminate private RWSets of best effort collections doesn't fail the distribution
+	cs = createcollectionStore(peerSelfSignedData).thatAcceptsAll().withDisseminationMode(common.DisseminationMode_BEST_EFFORT)
+	err = d.Distribute("tx1", pvtData[0].WriteSet, cs)
+	assert.NoError(t, err)
+	g.AssertNumberOfCalls(t, "SendByCriteria", 4)
 }
@@ -13,6 +13,7 @@ import (
 	"testing"
 
 	"github.com/hyperledger/fabric/core/common/privdata"
+	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/gossip/api"
 	"github.com/hyperledger/fabric/gossip/comm"
 	"github.com/hyperledger/fabric/gossip/common"
@@ -21,6 +22,7 @@ import (
 	"github.com/hyperledger/fabric/gossip/util"
 	fcommon "github.com/hyperledger/fabric/protos/common"
 	proto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/hyperledger/fabric/protos/peer"
 	"github.com/op/go-logging"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -63,6 +65,10 @@ func (cs mockCollectionStore) RetrieveCollectionConfigPackage(fcommon.Collection
 	panic("implement me")
 }
 
+func (cs mockCollectionStore) RetrieveReadWritePermission(fcommon.CollectionCriteria, *peer.SignedProposal, ledger.QueryExecutor) (bool, bool, error) {
+	panic("implement me")
+}
+
 type mockCollectionAccess struct {
 	cs *mockCollectionStore
 }
@@ -99,6 +105,18 @@ func (mc *mockCollectionAccess) MaximumPeerCount() int {
 	return 0
 }
 
+func (mc *mockCollectionAccess) DisseminationMode() fcommon.DisseminationMode {
+	return fcommon.DisseminationMode_STRICT
+}
+
+func (mc *mockCollectionAccess) IsMemberOnlyRead() bool {
+	return false
+}
+
+func (mc *mockCollectionAccess) IsMemberOnlyWrite() bool {
+	return false
+}
+
 type dataRetrieverMock struct {
 	mock.Mock
 }
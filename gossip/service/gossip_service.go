@@ -246,7 +246,6 @@ func (g *gossipServiceImpl) InitializeChannel(chainID string, endpoints []string
 		coordinator: coordinator,
 		distributor: privdata2.NewDistributor(chainID, g),
 	}
-	g.chains[chainID] = state.NewGossipStateProvider(chainID, servicesAdapter, coordinator)
 	if g.deliveryService[chainID] == nil {
 		var err error
 		g.deliveryService[chainID], err = g.deliveryFactory.Service(g, endpoints, g.mcs)
@@ -254,6 +253,11 @@ func (g *gossipServiceImpl) InitializeChannel(chainID string, endpoints []string
 			logger.Warningf("Cannot create delivery client, due to %+v", errors.WithStack(err))
 		}
 	}
+	if g.deliveryService[chainID] != nil {
+		// Blocks no peer has are fetched from the ordering service
+		servicesAdapter.BlocksFetcher = g.deliveryService[chainID]
+	}
+	g.chains[chainID] = state.NewGossipStateProvider(chainID, servicesAdapter, coordinator)
 
 	// Delivery service might be nil only if it was not able to get connected
 	// to the ordering service
@@ -261,22 +265,32 @@ func (g *gossipServiceImpl) InitializeChannel(chainID string, endpoints []string
 		// Parameters:
 		//              - peer.gossip.useLeaderElection
 		//              - peer.gossip.orgLeader
+		//              - peer.gossip.orgLeaderBackup
 		//
-		// are mutual exclusive, setting both to true is not defined, hence
+		// are mutual exclusive, setting more than one of them to true is not defined, hence
 		// peer will panic and terminate
 		leaderElection := viper.GetBool("peer.gossip.useLeaderElection")
 		isStaticOrgLeader := viper.GetBool("peer.gossip.orgLeader")
+		isStaticOrgLeaderBackup := viper.GetBool("peer.gossip.orgLeaderBackup")
 
 		if leaderElection && isStaticOrgLeader {
 			logger.Panic("Setting both orgLeader and useLeaderElection to true isn't supported, aborting execution")
 		}
+		if isStaticOrgLeaderBackup && (leaderElection || isStaticOrgLeader) {
+			logger.Panic("Setting orgLeaderBackup together with orgLeader or useLeaderElection to true isn't supported, aborting execution")
+		}
 
 		if leaderElection {
 			logger.Debug("Delivery uses dynamic leader election mechanism, channel", chainID)
 			g.leaderElection[chainID] = g.newLeaderElectionComponent(chainID, g.onStatusChangeFactory(chainID, support.Committer))
 		} else if isStaticOrgLeader {
 			logger.Debug("This peer is configured to connect to ordering service for blocks delivery, channel", chainID)
-			g.deliveryService[chainID].StartDeliverForChannel(chainID, support.Committer, func() {})
+			adapter, id := g.newElectionAdapter(chainID)
+			g.leaderElection[chainID] = election.NewStaticLeaderService(adapter, id, g.onStatusChangeFactory(chainID, support.Committer))
+		} else if isStaticOrgLeaderBackup {
+			logger.Debug("This peer is configured to connect to ordering service for blocks delivery when the leader is unavailable, channel", chainID)
+			adapter, id := g.newElectionAdapter(chainID)
+			g.leaderElection[chainID] = election.NewBackupLeaderService(adapter, id, g.onStatusChangeFactory(chainID, support.Committer))
 		} else {
 			logger.Debug("This peer is not configured to connect to ordering service for blocks delivery, channel", chainID)
 		}
@@ -364,9 +378,41 @@ func (g *gossipServiceImpl) Stop() {
 }
 
 func (g *gossipServiceImpl) newLeaderElectionComponent(chainID string, callback func(bool)) election.LeaderElectionService {
+	adapter, id := g.newElectionAdapter(chainID)
+	return election.NewLeaderElectionService(adapter, id, callback)
+}
+
+// newElectionAdapter creates a leader election adapter for the channel,
+// and returns it along with the ID of the peer in the leader election
+func (g *gossipServiceImpl) newElectionAdapter(chainID string) (election.LeaderElectionAdapter, string) {
 	PKIid := g.mcs.GetPKIidOfCert(g.peerIdentity)
-	adapter := election.NewAdapter(g, PKIid, gossipCommon.ChainID(chainID))
-	return election.NewLeaderElectionService(adapter, string(PKIid), callback)
+	var health election.HealthProvider
+	if committer := g.privateHandlers[chainID].support.Committer; committer != nil && g.deliveryService[chainID] != nil {
+		health = &healthProvider{
+			chainID:   chainID,
+			committer: committer,
+			deliverer: g.deliveryService[chainID],
+		}
+	}
+	return election.NewAdapter(g, PKIid, gossipCommon.ChainID(chainID), health), string(PKIid)
+}
+
+// healthProvider provides the health of the peer in a channel to the leader election
+type healthProvider struct {
+	chainID   string
+	committer committer.Committer
+	deliverer deliverclient.DeliverService
+}
+
+// LedgerHeight returns the height of the ledger of the channel
+func (hp *healthProvider) LedgerHeight() (uint64, error) {
+	return hp.committer.LedgerHeight()
+}
+
+// DeliverHealthy returns whether the peer doesn't fail
+// receiving blocks of the channel from the ordering service
+func (hp *healthProvider) DeliverHealthy() bool {
+	return hp.deliverer.Healthy(hp.chainID)
 }
 
 func (g *gossipServiceImpl) amIinChannel(myOrg string, config Config) bool {
@@ -380,6 +426,7 @@ func (g *gossipServiceImpl) amIinChannel(myOrg string, config Config) bool {
 
 func (g *gossipServiceImpl) onStatusChangeFactory(chainID string, committer blocksprovider.LedgerInfo) func(bool) {
 	return func(isLeader bool) {
+		g.UpdateLeaderStatus(isLeader, gossipCommon.ChainID(chainID))
 		if isLeader {
 			yield := func() {
 				g.lock.RLock()
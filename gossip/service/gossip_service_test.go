@@ -317,6 +317,14 @@ func (ds *mockDeliverService) UpdateEndpoints(chainID string, endpoints []string
 	panic("implement me")
 }
 
+func (ds *mockDeliverService) FetchBlocks(chainID string, start, end uint64) ([]*common.Block, error) {
+	return nil, nil
+}
+
+func (ds *mockDeliverService) Healthy(chainID string) bool {
+	return true
+}
+
 func (ds *mockDeliverService) StartDeliverForChannel(chainID string, ledgerInfo blocksprovider.LedgerInfo, finalizer func()) error {
 	ds.running[chainID] = true
 	return nil
@@ -60,6 +60,22 @@ func (*gossipMock) PeersOfChannel(common.ChainID) []discovery.NetworkMember {
 	panic("implement me")
 }
 
+func (*gossipMock) DeadPeers() []discovery.NetworkMember {
+	panic("implement me")
+}
+
+func (*gossipMock) Connections() []comm.ConnectionStats {
+	panic("implement me")
+}
+
+func (*gossipMock) IdentityDigests() []common.PKIidType {
+	panic("implement me")
+}
+
+func (*gossipMock) BlockDigests(chainID common.ChainID) []uint64 {
+	panic("implement me")
+}
+
 func (*gossipMock) UpdateMetadata(metadata []byte) {
 	panic("implement me")
 }
@@ -68,6 +84,26 @@ func (*gossipMock) UpdateChannelMetadata(metadata []byte, chainID common.ChainID
 	panic("implement me")
 }
 
+func (*gossipMock) UpdateChaincodes(installed, instantiated []*proto.Chaincode, chainID common.ChainID) {
+	panic("implement me")
+}
+
+func (*gossipMock) UpdateLeaderStatus(isLeader bool, chainID common.ChainID) {
+	panic("implement me")
+}
+
+func (*gossipMock) UpdateCatchUpStatus(catchingUp bool, chainID common.ChainID) {
+	panic("implement me")
+}
+
+func (*gossipMock) SelfChannelInfo(chainID common.ChainID) *proto.SignedGossipMessage {
+	panic("implement me")
+}
+
+func (*gossipMock) PeerIdentity(pkiID common.PKIidType) (api.PeerIdentityType, error) {
+	panic("implement me")
+}
+
 func (*gossipMock) Gossip(msg *proto.GossipMessage) {
 	panic("implement me")
 }
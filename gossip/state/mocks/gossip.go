@@ -47,6 +47,22 @@ func (g *GossipMock) PeersOfChannel(chainID common.ChainID) []discovery.NetworkM
 	return args.Get(0).([]discovery.NetworkMember)
 }
 
+func (g *GossipMock) DeadPeers() []discovery.NetworkMember {
+	panic("implement me")
+}
+
+func (g *GossipMock) Connections() []comm.ConnectionStats {
+	panic("implement me")
+}
+
+func (g *GossipMock) IdentityDigests() []common.PKIidType {
+	panic("implement me")
+}
+
+func (g *GossipMock) BlockDigests(chainID common.ChainID) []uint64 {
+	panic("implement me")
+}
+
 func (g *GossipMock) UpdateMetadata(metadata []byte) {
 	g.Called(metadata)
 }
@@ -54,6 +70,23 @@ func (g *GossipMock) UpdateMetadata(metadata []byte) {
 func (g *GossipMock) UpdateChannelMetadata(metadata []byte, chainID common.ChainID) {
 }
 
+func (g *GossipMock) UpdateChaincodes(installed, instantiated []*proto.Chaincode, chainID common.ChainID) {
+}
+
+func (g *GossipMock) UpdateLeaderStatus(isLeader bool, chainID common.ChainID) {
+}
+
+func (g *GossipMock) UpdateCatchUpStatus(catchingUp bool, chainID common.ChainID) {
+}
+
+func (g *GossipMock) SelfChannelInfo(chainID common.ChainID) *proto.SignedGossipMessage {
+	panic("implement me")
+}
+
+func (g *GossipMock) PeerIdentity(pkiID common.PKIidType) (api.PeerIdentityType, error) {
+	panic("implement me")
+}
+
 func (g *GossipMock) Gossip(msg *proto.GossipMessage) {
 	g.Called(msg)
 }
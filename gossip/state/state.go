@@ -8,6 +8,7 @@ package state
 
 import (
 	"bytes"
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -50,6 +51,14 @@ const (
 	nonBlocking = false
 
 	enqueueRetryInterval = time.Millisecond * 100
+
+	defOrdererBatchSize        = 100
+	defOrdererFallbackInterval = 60 * time.Second
+
+	batchSizeConfigKey               = "peer.gossip.state.batchSize"
+	ordererBatchSizeConfigKey        = "peer.gossip.state.ordererBatchSize"
+	maxBufferedBlocksConfigKey       = "peer.gossip.state.maxBufferedBlocks"
+	ordererFallbackIntervalConfigKey = "peer.gossip.state.ordererFallbackInterval"
 )
 
 // GossipAdapter defines gossip/communication required interface for state provider
@@ -67,6 +76,10 @@ type GossipAdapter interface {
 	// publishes to other peers about its channel-related state
 	UpdateChannelMetadata(metadata []byte, chainID common2.ChainID)
 
+	// UpdateCatchUpStatus updates whether the peer publishes to other peers
+	// in the channel that it pulls missing blocks from other peers
+	UpdateCatchUpStatus(catchingUp bool, chainID common2.ChainID)
+
 	// PeersOfChannel returns the NetworkMembers considered alive
 	// and also subscribed to the channel given
 	PeersOfChannel(common2.ChainID) []discovery.NetworkMember
@@ -109,11 +122,22 @@ type ledgerResources interface {
 	Close()
 }
 
+// BlocksFetcher fetches blocks straight from the ordering service,
+// for when no peer of the channel has the blocks the peer is missing
+type BlocksFetcher interface {
+	// FetchBlocks returns the blocks in the range [start...end] of the channel, in their order.
+	// It returns less blocks than requested if the ordering service doesn't have them all yet.
+	FetchBlocks(chainID string, start, end uint64) ([]*common.Block, error)
+}
+
 // ServicesMediator aggregated adapter to compound all mediator
 // required by state transfer into single struct
 type ServicesMediator struct {
 	GossipAdapter
 	MCSAdapter
+	// BlocksFetcher is optional, and if it's nil,
+	// missing blocks are only pulled from other peers
+	BlocksFetcher
 }
 
 // GossipStateProviderImpl the implementation of the GossipStateProvider interface
@@ -146,10 +170,45 @@ type GossipStateProviderImpl struct {
 	once sync.Once
 
 	stateTransferActive int32
+
+	// catchingUp is whether the peer pulls missing blocks from other
+	// peers, and is only accessed by the anti entropy procedure
+	catchingUp bool
+
+	// batchSize is the number of blocks requested from a peer at a time
+	batchSize uint64
+
+	// ordererBatchSize is the number of blocks fetched from the ordering service at a time
+	ordererBatchSize uint64
+
+	// maxBufferedBlocks is the number of blocks in the payloads buffer
+	// above which no more blocks are requested or enqueued
+	maxBufferedBlocks int
+
+	// ordererFallbackInterval is the time the ledger height needs to stay the same
+	// while no peer has newer blocks, before the ordering service is asked for them
+	ordererFallbackInterval time.Duration
+
+	// lastHeight and lastHeightChange are the ledger height and the time it was last
+	// observed to change, and are only accessed by the anti entropy procedure
+	lastHeight       uint64
+	lastHeightChange time.Time
 }
 
 var logger = util.GetLogger(util.LoggingStateModule, "")
 
+// positiveIntOrDefault returns the int value of the given key from the config,
+// or the given default value if it isn't set or isn't positive, since
+// block ranges can't be requested in batches of no blocks at all
+func positiveIntOrDefault(key string, defVal int) int {
+	val := util.GetIntOrDefault(key, defVal)
+	if val <= 0 {
+		logger.Warningf("Invalid value %d for %s (should be positive); using the default %d", val, key, defVal)
+		return defVal
+	}
+	return val
+}
+
 // NewGossipStateProvider creates state provider with coordinator instance
 // to orchestrate arrival of private rwsets and blocks before committing them into the ledger.
 func NewGossipStateProvider(chainID string, services *ServicesMediator, ledger ledgerResources) GossipStateProvider {
@@ -223,6 +282,18 @@ func NewGossipStateProvider(chainID string, services *ServicesMediator, ledger l
 		stateTransferActive: 0,
 
 		once: sync.Once{},
+
+		batchSize: uint64(positiveIntOrDefault(batchSizeConfigKey, defAntiEntropyBatchSize)),
+
+		ordererBatchSize: uint64(positiveIntOrDefault(ordererBatchSizeConfigKey, defOrdererBatchSize)),
+
+		maxBufferedBlocks: positiveIntOrDefault(maxBufferedBlocksConfigKey, defMaxBlockDistance*2),
+
+		ordererFallbackInterval: util.GetDurationOrDefault(ordererFallbackIntervalConfigKey, defOrdererFallbackInterval),
+
+		lastHeight: height,
+
+		lastHeightChange: time.Now(),
 	}
 
 	nodeMetastate := common2.NewNodeMetastate(height - 1)
@@ -590,14 +661,106 @@ func (s *GossipStateProviderImpl) antiEntropy() {
 			max := s.maxAvailableLedgerHeight()
 
 			if current-1 >= max {
+				s.updateCatchUpStatus(false)
+				s.fetchBlocksIfStalled(current)
 				continue
 			}
 
+			s.updateCatchUpStatus(true)
 			s.requestBlocksInRange(uint64(current), uint64(max))
 		}
 	}
 }
 
+// updateCatchUpStatus publishes whether the peer pulls missing
+// blocks from other peers, when it changes
+func (s *GossipStateProviderImpl) updateCatchUpStatus(catchingUp bool) {
+	if s.catchingUp == catchingUp {
+		return
+	}
+	s.catchingUp = catchingUp
+	s.mediator.UpdateCatchUpStatus(catchingUp, common2.ChainID(s.chainID))
+}
+
+// fetchBlocksIfStalled fetches blocks from the ordering service if the ledger height
+// didn't change for longer than the orderer fallback interval, while no peer has blocks
+// the peer doesn't have, since the peers of the organization might have fallen behind
+// the ordering service together.
+func (s *GossipStateProviderImpl) fetchBlocksIfStalled(height uint64) {
+	if height != s.lastHeight {
+		s.lastHeight = height
+		s.lastHeightChange = time.Now()
+		return
+	}
+	if s.mediator.BlocksFetcher == nil || time.Since(s.lastHeightChange) < s.ordererFallbackInterval {
+		return
+	}
+	// Don't ask the ordering service again before another interval passes
+	s.lastHeightChange = time.Now()
+	logger.Debugf("[%s] Ledger height is at %d for more than %v, fetching newer blocks from the ordering service",
+		s.chainID, height, s.ordererFallbackInterval)
+	s.fetchBlocksFromOrderer(height, math.MaxUint64)
+}
+
+// fetchBlocksFromOrderer fetches blocks with sequence numbers in the range [start...end]
+// from the ordering service in batches, and returns once the ordering service has no
+// more blocks in the range.
+func (s *GossipStateProviderImpl) fetchBlocksFromOrderer(start uint64, end uint64) {
+	if s.mediator.BlocksFetcher == nil {
+		return
+	}
+	for prev := start; prev <= end; {
+		if !s.waitForBufferSpace() {
+			return
+		}
+		next := end
+		if end-prev >= s.ordererBatchSize {
+			next = prev + s.ordererBatchSize - 1
+		}
+		logger.Debugf("[%s] Fetching blocks in range [%d...%d] from the ordering service", s.chainID, prev, next)
+		blocks, err := s.mediator.FetchBlocks(s.chainID, prev, next)
+		if err != nil {
+			logger.Warningf("Wasn't able to fetch blocks in range [%d...%d] from the ordering service, due to %+v",
+				prev, next, errors.WithStack(err))
+			return
+		}
+		for _, block := range blocks {
+			seqNum := block.Header.Number
+			b, err := pb.Marshal(block)
+			if err != nil {
+				logger.Errorf("Error serializing block with sequence number %d, due to %+v", seqNum, errors.WithStack(err))
+				return
+			}
+			if err := s.mediator.VerifyBlock(common2.ChainID(s.chainID), seqNum, b); err != nil {
+				logger.Warningf("Error verifying block with sequence number %d, due to %+v", seqNum, errors.WithStack(err))
+				return
+			}
+			if err := s.addPayload(&proto.Payload{SeqNum: seqNum, Data: b}, blocking); err != nil {
+				logger.Warningf("Block [%d] fetched from the ordering service wasn't added to payload buffer: %v", seqNum, err)
+			}
+		}
+		if uint64(len(blocks)) < next-prev+1 {
+			// The ordering service has no more blocks
+			return
+		}
+		prev = next + 1
+	}
+}
+
+// waitForBufferSpace waits until the payloads buffer has at most maxBufferedBlocks blocks,
+// and returns false if the state provider was stopped meanwhile
+func (s *GossipStateProviderImpl) waitForBufferSpace() bool {
+	for s.payloads.Size() > s.maxBufferedBlocks {
+		select {
+		case <-s.stopCh:
+			s.stopCh <- struct{}{}
+			return false
+		case <-time.After(enqueueRetryInterval):
+		}
+	}
+	return true
+}
+
 // Iterate over all available peers and check advertised meta state to
 // find maximum available ledger height across peers
 func (s *GossipStateProviderImpl) maxAvailableLedgerHeight() uint64 {
@@ -618,13 +781,17 @@ func (s *GossipStateProviderImpl) maxAvailableLedgerHeight() uint64 {
 }
 
 // GetBlocksInRange capable to acquire blocks with sequence
-// numbers in the range [start...end].
+// numbers in the range [start...end]. Blocks no peer
+// provides are fetched from the ordering service.
 func (s *GossipStateProviderImpl) requestBlocksInRange(start uint64, end uint64) {
 	atomic.StoreInt32(&s.stateTransferActive, 1)
 	defer atomic.StoreInt32(&s.stateTransferActive, 0)
 
 	for prev := start; prev <= end; {
-		next := min(end, prev+defAntiEntropyBatchSize)
+		if !s.waitForBufferSpace() {
+			return
+		}
+		next := min(end, prev+s.batchSize-1)
 
 		gossipMsg := s.stateRequestMessage(prev, next)
 
@@ -635,6 +802,7 @@ func (s *GossipStateProviderImpl) requestBlocksInRange(start uint64, end uint64)
 			if tryCounts > defAntiEntropyMaxRetries {
 				logger.Warningf("Wasn't  able to get blocks in range [%d...%d], after %d retries",
 					prev, next, tryCounts)
+				s.fetchBlocksFromOrderer(prev, end)
 				return
 			}
 			// Select peers to ask for blocks
@@ -642,9 +810,9 @@ func (s *GossipStateProviderImpl) requestBlocksInRange(start uint64, end uint64)
 			if err != nil {
 				logger.Warningf("Cannot send state request for blocks in range [%d...%d], due to %+v",
 					prev, next, errors.WithStack(err))
+				s.fetchBlocksFromOrderer(prev, end)
 				return
 			}
-
 			logger.Debugf("State transfer, with peer %s, requesting blocks in range [%d...%d], "+
 				"for chainID %s", peer.Endpoint, prev, next, s.chainID)
 
@@ -761,7 +929,7 @@ func (s *GossipStateProviderImpl) addPayload(payload *proto.Payload, blockingMod
 		return errors.Errorf("Ledger height is at %d, cannot enqueue block with sequence of %d", height, payload.SeqNum)
 	}
 
-	for blockingMode && s.payloads.Size() > defMaxBlockDistance*2 {
+	for blockingMode && s.payloads.Size() > s.maxBufferedBlocks {
 		time.Sleep(enqueueRetryInterval)
 	}
 
@@ -437,6 +437,108 @@ func TestLargeBlockGap(t *testing.T) {
 	}
 }
 
+// blocksFetcherMock serves the blocks below height as the ordering service
+type blocksFetcherMock struct {
+	height   uint64
+	requests chan [2]uint64
+}
+
+func (bf *blocksFetcherMock) FetchBlocks(chainID string, start, end uint64) ([]*pcomm.Block, error) {
+	bf.requests <- [2]uint64{start, end}
+	var blocks []*pcomm.Block
+	for seq := start; seq <= end && seq < bf.height; seq++ {
+		blocks = append(blocks, pcomm.NewBlock(seq, []byte{}))
+	}
+	return blocks, nil
+}
+
+func TestOrdererFallback(t *testing.T) {
+	// Scenario: no peer has the blocks the peer is missing,
+	// so it fetches them from the ordering service in batches.
+	mc := &mockCommitter{}
+	blocksPassedToLedger := make(chan uint64, 10)
+	mc.On("CommitWithPvtData", mock.Anything).Run(func(arg mock.Arguments) {
+		blocksPassedToLedger <- arg.Get(0).(*pcomm.Block).Header.Number
+	})
+	mc.On("LedgerHeight", mock.Anything).Return(uint64(1), nil)
+	g := &mocks.GossipMock{}
+	g.On("PeersOfChannel", mock.Anything).Return([]discovery.NetworkMember{})
+	g.On("Accept", mock.Anything, false).Return(make(<-chan *proto.GossipMessage), nil)
+	g.On("Accept", mock.Anything, true).Return(nil, make(chan proto.ReceivedMessage))
+	p := newPeerNodeWithGossip(newGossipConfig(0), mc, noopPeerIdentityAcceptor, g)
+	defer p.shutdown()
+	fetcher := &blocksFetcherMock{height: 8, requests: make(chan [2]uint64, 10)}
+	p.s.mediator.BlocksFetcher = fetcher
+	p.s.ordererBatchSize = 3
+
+	assertCommitted := func(from, to uint64) {
+		for seq := from; seq <= to; seq++ {
+			select {
+			case committed := <-blocksPassedToLedger:
+				assert.Equal(t, seq, committed)
+			case <-time.After(time.Second * 5):
+				t.Fatalf("Block [%d] wasn't committed", seq)
+			}
+		}
+	}
+
+	// The payloads buffer is full, so no blocks are fetched until it has room
+	p.s.maxBufferedBlocks = 0
+	p.s.payloads.Push(&proto.Payload{SeqNum: 100})
+	fetched := make(chan struct{})
+	go func() {
+		p.s.requestBlocksInRange(1, 5)
+		close(fetched)
+	}()
+	select {
+	case <-fetcher.requests:
+		t.Fatal("Blocks were fetched while the payloads buffer was full")
+	case <-time.After(time.Millisecond * 500):
+	}
+	p.s.maxBufferedBlocks = 1
+	<-fetched
+	assert.Equal(t, [2]uint64{1, 3}, <-fetcher.requests)
+	assert.Equal(t, [2]uint64{4, 5}, <-fetcher.requests)
+	assertCommitted(1, 5)
+
+	// Blocks are fetched only once the ledger height stays the same for long enough,
+	// and then until the ordering service has no more blocks
+	p.s.fetchBlocksIfStalled(6)
+	p.s.fetchBlocksIfStalled(6)
+	assert.Len(t, fetcher.requests, 0)
+	p.s.lastHeightChange = time.Now().Add(-defOrdererFallbackInterval)
+	p.s.fetchBlocksIfStalled(6)
+	assert.Equal(t, [2]uint64{6, 8}, <-fetcher.requests)
+	assertCommitted(6, 7)
+	// Another interval needs to pass until blocks are fetched again
+	p.s.fetchBlocksIfStalled(6)
+	assert.Len(t, fetcher.requests, 0)
+}
+
+func TestInvalidBatchSizes(t *testing.T) {
+	// Scenario: the batch sizes and the maximum number of buffered blocks are
+	// configured to values that aren't positive, so the defaults are used instead.
+	viper.Set(batchSizeConfigKey, -1)
+	viper.Set(ordererBatchSizeConfigKey, -100)
+	viper.Set(maxBufferedBlocksConfigKey, -5)
+	defer func() {
+		viper.Set(batchSizeConfigKey, nil)
+		viper.Set(ordererBatchSizeConfigKey, nil)
+		viper.Set(maxBufferedBlocksConfigKey, nil)
+	}()
+	mc := &mockCommitter{}
+	mc.On("LedgerHeight", mock.Anything).Return(uint64(1), nil)
+	g := &mocks.GossipMock{}
+	g.On("PeersOfChannel", mock.Anything).Return([]discovery.NetworkMember{})
+	g.On("Accept", mock.Anything, false).Return(make(<-chan *proto.GossipMessage), nil)
+	g.On("Accept", mock.Anything, true).Return(nil, make(chan proto.ReceivedMessage))
+	p := newPeerNodeWithGossip(newGossipConfig(0), mc, noopPeerIdentityAcceptor, g)
+	defer p.shutdown()
+	assert.Equal(t, uint64(defAntiEntropyBatchSize), p.s.batchSize)
+	assert.Equal(t, uint64(defOrdererBatchSize), p.s.ordererBatchSize)
+	assert.Equal(t, defMaxBlockDistance*2, p.s.maxBufferedBlocks)
+}
+
 func TestOverPopulation(t *testing.T) {
 	// Scenario: Add to the state provider blocks
 	// with a gap in between, and ensure that the payload buffer
@@ -54,6 +54,9 @@ var (
 	chaincodeUsr          string // Not used
 	chaincodeQueryRaw     bool
 	chaincodeQueryHex     bool
+	chaincodeQueryMeta    bool
+	chaincodeQueryHeight  uint64
+	chaincodeQueryRO      bool
 	customIDGenAlg        string
 	channelID             string
 	chaincodeVersion      string
@@ -102,7 +105,7 @@ func resetFlags() {
 	flags.StringVarP(&policy, "policy", "P", common.UndefinedParamValue,
 		fmt.Sprint("The endorsement policy associated to this chaincode"))
 	flags.StringVarP(&escc, "escc", "E", common.UndefinedParamValue,
-		fmt.Sprint("The name of the endorsement system chaincode to be used for this chaincode"))
+		fmt.Sprint("The name of the endorsement plugin to be used for this chaincode"))
 	flags.StringVarP(&vscc, "vscc", "V", common.UndefinedParamValue,
 		fmt.Sprint("The name of the verification system chaincode to be used for this chaincode"))
 	flags.BoolVarP(&getInstalledChaincodes, "installed", "", false,
@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package chaincode
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -150,6 +151,12 @@ func chaincodeInvokeOrQuery(cmd *cobra.Command, args []string, invoke bool, cf *
 		} else {
 			if chaincodeQueryHex {
 				fmt.Printf("Query Result: %x\n", proposalResp.Response.Payload)
+			} else if chaincodeQueryMeta {
+				var metadata bytes.Buffer
+				if err := json.Indent(&metadata, proposalResp.Response.Payload, "", "  "); err != nil {
+					return fmt.Errorf("Chaincode metadata is not valid JSON: %s", err)
+				}
+				fmt.Printf("Query Result: %s\n", metadata.String())
 			} else {
 				fmt.Printf("Query Result: %s\n", string(proposalResp.Response.Payload))
 			}
@@ -163,6 +170,12 @@ type collectionConfigJson struct {
 	Policy        string `json:"policy"`
 	RequiredCount int32  `json:"requiredPeerCount"`
 	MaxPeerCount  int32  `json:"maxPeerCount"`
+	// DisseminationMode is either STRICT, the default, or BEST_EFFORT
+	DisseminationMode string `json:"disseminationMode"`
+	// MemberOnlyRead and MemberOnlyWrite restrict reading and writing
+	// the collection's private data to clients of its member orgs
+	MemberOnlyRead  bool `json:"memberOnlyRead"`
+	MemberOnlyWrite bool `json:"memberOnlyWrite"`
 }
 
 // getCollectionConfig retrieves the collection configuration
@@ -194,6 +207,15 @@ func getCollectionConfigFromBytes(cconfBytes []byte) ([]byte, error) {
 			return nil, errors.WithMessage(err, fmt.Sprintf("invalid policy %s", cconfitem.Policy))
 		}
 
+		mode := pcommon.DisseminationMode_STRICT
+		if cconfitem.DisseminationMode != "" {
+			m, exists := pcommon.DisseminationMode_value[cconfitem.DisseminationMode]
+			if !exists {
+				return nil, errors.Errorf("invalid dissemination mode %s", cconfitem.DisseminationMode)
+			}
+			mode = pcommon.DisseminationMode(m)
+		}
+
 		cpc := &pcommon.CollectionPolicyConfig{
 			Payload: &pcommon.CollectionPolicyConfig_SignaturePolicy{
 				SignaturePolicy: p,
@@ -207,6 +229,9 @@ func getCollectionConfigFromBytes(cconfBytes []byte) ([]byte, error) {
 					MemberOrgsPolicy:  cpc,
 					RequiredPeerCount: cconfitem.RequiredCount,
 					MaximumPeerCount:  cconfitem.MaxPeerCount,
+					DisseminationMode: mode,
+					MemberOnlyRead:    cconfitem.MemberOnlyRead,
+					MemberOnlyWrite:   cconfitem.MemberOnlyWrite,
 				},
 			},
 		}
@@ -389,6 +414,20 @@ func ChaincodeInvokeOrQuery(
 		return nil, fmt.Errorf("Error creating proposal  %s: %s", funcName, err)
 	}
 
+	// queries are not submitted to the orderer, so the peer may serve
+	// them against a past state of the ledger if requested, or without
+	// a transaction simulator if they are marked read-only
+	if !invoke {
+		if chaincodeQueryHeight != 0 {
+			err = putils.SetProposalBlockHeight(prop, chaincodeQueryHeight)
+		} else if chaincodeQueryRO {
+			err = putils.MarkProposalReadOnly(prop)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Error creating proposal  %s: %s", funcName, err)
+		}
+	}
+
 	var signedProp *pb.SignedProposal
 	signedProp, err = putils.GetSignedProposal(prop, signer)
 	if err != nil {
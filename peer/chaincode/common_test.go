@@ -183,6 +183,25 @@ const sampleCollectionConfigGood = `[
 		"policy": "OR('A.member', 'B.member')",
 		"requiredPeerCount": 3,
 		"maxPeerCount": 483279847
+	},
+	{
+		"name": "bar",
+		"policy": "OR('A.member', 'B.member')",
+		"requiredPeerCount": 1,
+		"maxPeerCount": 2,
+		"disseminationMode": "BEST_EFFORT",
+		"memberOnlyRead": true,
+		"memberOnlyWrite": true
+	}
+]`
+
+const sampleCollectionConfigBadMode = `[
+	{
+		"name": "foo",
+		"policy": "OR('A.member', 'B.member')",
+		"requiredPeerCount": 3,
+		"maxPeerCount": 483279847,
+		"disseminationMode": "SOMETIMES"
 	}
 ]`
 
@@ -207,6 +226,16 @@ func TestCollectionParsing(t *testing.T) {
 	assert.Equal(t, 483279847, int(conf.MaximumPeerCount))
 	assert.Equal(t, "foo", conf.Name)
 	assert.Equal(t, pol, conf.MemberOrgsPolicy.GetSignaturePolicy())
+	assert.Equal(t, common2.DisseminationMode_STRICT, conf.DisseminationMode)
+	assert.False(t, conf.MemberOnlyRead)
+	assert.False(t, conf.MemberOnlyWrite)
+	assert.Equal(t, common2.DisseminationMode_BEST_EFFORT, ccp.Config[1].GetStaticCollectionConfig().DisseminationMode)
+	assert.True(t, ccp.Config[1].GetStaticCollectionConfig().MemberOnlyRead)
+	assert.True(t, ccp.Config[1].GetStaticCollectionConfig().MemberOnlyWrite)
+
+	cc, err = getCollectionConfigFromBytes([]byte(sampleCollectionConfigBadMode))
+	assert.EqualError(t, err, "invalid dissemination mode SOMETIMES")
+	assert.Nil(t, cc)
 
 	cc, err = getCollectionConfigFromBytes([]byte(sampleCollectionConfigBad))
 	assert.Error(t, err)
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/hyperledger/fabric/core/chaincode/shim/ext/contractapi"
 	"github.com/spf13/cobra"
 )
 
@@ -48,6 +49,12 @@ func queryCmd(cf *ChaincodeCmdFactory) *cobra.Command {
 		"If true, output the query value as raw bytes, otherwise format as a printable string")
 	chaincodeQueryCmd.Flags().BoolVarP(&chaincodeQueryHex, "hex", "x", false,
 		"If true, output the query value byte array in hexadecimal. Incompatible with --raw")
+	chaincodeQueryCmd.Flags().BoolVarP(&chaincodeQueryMeta, "metadata", "m", false,
+		"If true, query the function metadata of a chaincode written with the contract API. Incompatible with --ctor")
+	chaincodeQueryCmd.Flags().Uint64VarP(&chaincodeQueryHeight, "height", "", 0,
+		"If set, query the state of the ledger as of the given block height, i.e., after the commit of the blocks numbered below it")
+	chaincodeQueryCmd.Flags().BoolVarP(&chaincodeQueryRO, "readonly", "", false,
+		"If true, mark the query as read-only, so that the peer may serve it from a cache without simulating a transaction. Functions that write to the ledger fail")
 
 	return chaincodeQueryCmd
 }
@@ -56,6 +63,12 @@ func chaincodeQuery(cmd *cobra.Command, args []string, cf *ChaincodeCmdFactory)
 	if channelID == "" {
 		return errors.New("The required parameter 'channelID' is empty. Rerun the command with -C flag")
 	}
+	if chaincodeQueryMeta {
+		if chaincodeCtorJSON != "{}" {
+			return errors.New("Options --metadata (-m) and --ctor (-c) are not compatible")
+		}
+		chaincodeCtorJSON = fmt.Sprintf(`{"Args":["%s"]}`, contractapi.MetadataFunction)
+	}
 	var err error
 	if cf == nil {
 		cf, err = InitCmdFactory(true, false)
@@ -19,7 +19,13 @@ package chaincode
 import (
 	"testing"
 
+	"github.com/hyperledger/fabric/core/chaincode/shim/ext/contractapi"
+	"github.com/hyperledger/fabric/peer/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	putils "github.com/hyperledger/fabric/protos/utils"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
 )
 
 func TestQueryCmd(t *testing.T) {
@@ -81,3 +87,125 @@ func TestQueryCmd(t *testing.T) {
 	err = cmd.Execute()
 	assert.Error(t, err, "Expected error executing query command")
 }
+
+func TestQueryCmdMetadata(t *testing.T) {
+	InitMSP()
+
+	signer, err := common.GetDefaultSigner()
+	assert.NoError(t, err, "Error getting default signer")
+	mockResponse := &pb.ProposalResponse{
+		Response:    &pb.Response{Status: 200, Payload: []byte(`{"defaultContract":"math","contracts":{}}`)},
+		Endorsement: &pb.Endorsement{},
+	}
+	mockCF := &ChaincodeCmdFactory{
+		EndorserClient:  common.GetMockEndorserClient(mockResponse, nil),
+		Signer:          signer,
+		BroadcastClient: common.GetMockBroadcastClient(nil),
+	}
+	// reset the ctor, it might have been set by previous tests
+	chaincodeCtorJSON = "{}"
+	defer func() {
+		chaincodeQueryMeta = false
+		chaincodeCtorJSON = "{}"
+	}()
+
+	// Success case: run query command with -m option
+	cmd := queryCmd(mockCF)
+	addFlags(cmd)
+	args := []string{"-m", "-C", "mychannel", "-n", "contracts"}
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	assert.NoError(t, err, "Run chaincode query cmd error")
+	assert.Equal(t, `{"Args":["`+contractapi.MetadataFunction+`"]}`, chaincodeCtorJSON)
+
+	// Failure case: run query command with both -m and -c options
+	cmd = queryCmd(mockCF)
+	addFlags(cmd)
+	args = []string{"-m", "-C", "mychannel", "-n", "contracts", "-c", "{\"Args\": [\"query\",\"a\"]}"}
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	assert.Error(t, err, "Expected error executing query command with both -m and -c options")
+	chaincodeCtorJSON = "{}"
+
+	// Failure case: the metadata returned by the chaincode is not valid JSON
+	mockResponse.Response.Payload = []byte("not JSON")
+	cmd = queryCmd(mockCF)
+	addFlags(cmd)
+	args = []string{"-m", "-C", "mychannel", "-n", "contracts"}
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	assert.Error(t, err, "Expected error executing query command returning invalid metadata")
+}
+
+// proposalRecorder is an endorser client recording the proposals it receives
+type proposalRecorder struct {
+	pb.EndorserClient
+	proposals []*pb.SignedProposal
+}
+
+func (r *proposalRecorder) ProcessProposal(ctx context.Context, in *pb.SignedProposal, opts ...grpc.CallOption) (*pb.ProposalResponse, error) {
+	r.proposals = append(r.proposals, in)
+	return r.EndorserClient.ProcessProposal(ctx, in, opts...)
+}
+
+func TestQueryCmdHeight(t *testing.T) {
+	InitMSP()
+
+	signer, err := common.GetDefaultSigner()
+	assert.NoError(t, err, "Error getting default signer")
+	mockResponse := &pb.ProposalResponse{
+		Response:    &pb.Response{Status: 200, Payload: []byte("100")},
+		Endorsement: &pb.Endorsement{},
+	}
+	recorder := &proposalRecorder{EndorserClient: common.GetMockEndorserClient(mockResponse, nil)}
+	mockCF := &ChaincodeCmdFactory{
+		EndorserClient:  recorder,
+		Signer:          signer,
+		BroadcastClient: common.GetMockBroadcastClient(nil),
+	}
+	defer func() {
+		chaincodeQueryHeight = 0
+		chaincodeQueryRO = false
+	}()
+
+	headerExtension := func() *pb.ChaincodeHeaderExtension {
+		prop, err := putils.GetProposal(recorder.proposals[len(recorder.proposals)-1].ProposalBytes)
+		assert.NoError(t, err)
+		hdr, err := putils.GetHeader(prop.Header)
+		assert.NoError(t, err)
+		hdrExt, err := putils.GetChaincodeHeaderExtension(hdr)
+		assert.NoError(t, err)
+		return hdrExt
+	}
+
+	// Success case: run query command with --height option
+	cmd := queryCmd(mockCF)
+	addFlags(cmd)
+	args := []string{"--height", "3", "-C", "mychannel", "-n", "example02", "-c", "{\"Args\": [\"query\",\"a\"]}"}
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	assert.NoError(t, err, "Run chaincode query cmd error")
+	assert.True(t, headerExtension().ReadOnly)
+	assert.Equal(t, uint64(3), headerExtension().BlockHeight)
+	chaincodeQueryHeight = 0
+
+	// Success case: queries without --height read the latest state,
+	// and are simulated as transactions unless marked read-only
+	cmd = queryCmd(mockCF)
+	addFlags(cmd)
+	args = []string{"-C", "mychannel", "-n", "example02", "-c", "{\"Args\": [\"query\",\"a\"]}"}
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	assert.NoError(t, err, "Run chaincode query cmd error")
+	assert.False(t, headerExtension().ReadOnly)
+	assert.Equal(t, uint64(0), headerExtension().BlockHeight)
+
+	cmd = queryCmd(mockCF)
+	addFlags(cmd)
+	args = []string{"--readonly", "-C", "mychannel", "-n", "example02", "-c", "{\"Args\": [\"query\",\"a\"]}"}
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	assert.NoError(t, err, "Run chaincode query cmd error")
+	assert.True(t, headerExtension().ReadOnly)
+	assert.Equal(t, uint64(0), headerExtension().BlockHeight)
+}
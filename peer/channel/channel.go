@@ -30,8 +30,8 @@ import (
 
 const (
 	channelFuncName = "channel"
-	shortDes        = "Operate a channel: create|fetch|join|list|update|signconfigtx|getinfo."
-	longDes         = "Operate a channel: create|fetch|join|list|update|signconfigtx|getinfo."
+	shortDes        = "Operate a channel: create|fetch|join|list|update|signconfigtx|getinfo|conflicts."
+	longDes         = "Operate a channel: create|fetch|join|list|update|signconfigtx|getinfo|conflicts."
 )
 
 var logger = flogging.MustGetLogger("channelCmd")
@@ -54,6 +54,9 @@ var (
 	channelID     string
 	channelTxFile string
 	timeout       int
+
+	// conflicts related variables
+	chaincodeName string
 )
 
 // Cmd returns the cobra command for Node
@@ -67,6 +70,7 @@ func Cmd(cf *ChannelCmdFactory) *cobra.Command {
 	channelCmd.AddCommand(updateCmd(cf))
 	channelCmd.AddCommand(signconfigtxCmd(cf))
 	channelCmd.AddCommand(getinfoCmd(cf))
+	channelCmd.AddCommand(conflictsCmd(cf))
 
 	return channelCmd
 }
@@ -90,6 +94,7 @@ func resetFlags() {
 	flags.StringVarP(&channelID, "channelID", "c", common.UndefinedParamValue, "In case of a newChain command, the channel ID to create.")
 	flags.StringVarP(&channelTxFile, "file", "f", "", "Configuration transaction file generated by a tool such as configtxgen for submitting to orderer")
 	flags.IntVarP(&timeout, "timeout", "t", 5, "Channel creation timeout")
+	flags.StringVarP(&chaincodeName, "name", "n", "", "Name of the chaincode whose MVCC conflicts are listed")
 }
 
 func attachFlags(cmd *cobra.Command, names []string) {
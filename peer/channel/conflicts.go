@@ -0,0 +1,115 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package channel
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/scc/qscc"
+	"github.com/hyperledger/fabric/peer/common"
+	cb "github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/net/context"
+)
+
+func conflictsCmd(cf *ChannelCmdFactory) *cobra.Command {
+	conflictsCmd := &cobra.Command{
+		Use:   "conflicts",
+		Short: "get the most recent MVCC conflicts of a specified channel.",
+		Long:  "get the most recent MVCC conflicts of a specified channel, optionally of a single chaincode. Requires '-c'.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return conflicts(cf)
+		},
+	}
+	flagList := []string{
+		"channelID",
+		"name",
+	}
+	attachFlags(conflictsCmd, flagList)
+
+	return conflictsCmd
+}
+
+func (cc *endorserClient) getMVCCConflicts(namespace string) (*pb.MVCCConflicts, error) {
+	args := [][]byte{[]byte(qscc.GetMVCCConflicts), []byte(channelID)}
+	if namespace != "" {
+		args = append(args, []byte(namespace))
+	}
+	invocation := &pb.ChaincodeInvocationSpec{
+		ChaincodeSpec: &pb.ChaincodeSpec{
+			Type:        pb.ChaincodeSpec_Type(pb.ChaincodeSpec_Type_value["GOLANG"]),
+			ChaincodeId: &pb.ChaincodeID{Name: "qscc"},
+			Input:       &pb.ChaincodeInput{Args: args},
+		},
+	}
+
+	c, _ := cc.cf.Signer.Serialize()
+	prop, _, err := utils.CreateProposalFromCIS(cb.HeaderType_ENDORSER_TRANSACTION, "", invocation, c)
+	if err != nil {
+		return nil, errors.WithMessage(err, "cannot create proposal")
+	}
+	if err = utils.MarkProposalReadOnly(prop); err != nil {
+		return nil, errors.WithMessage(err, "cannot mark proposal as read-only")
+	}
+
+	signedProp, err := utils.GetSignedProposal(prop, cc.cf.Signer)
+	if err != nil {
+		return nil, errors.WithMessage(err, "cannot create signed proposal")
+	}
+
+	proposalResp, err := cc.cf.EndorserClient.ProcessProposal(context.Background(), signedProp)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed sending proposal")
+	}
+
+	if proposalResp.Response == nil || proposalResp.Response.Status != 200 {
+		return nil, errors.Errorf("received bad response, status %d", proposalResp.Response.Status)
+	}
+
+	conflicts := &pb.MVCCConflicts{}
+	if err = proto.Unmarshal(proposalResp.Response.Payload, conflicts); err != nil {
+		return nil, errors.Wrap(err, "cannot read qscc response")
+	}
+
+	return conflicts, nil
+}
+
+func conflicts(cf *ChannelCmdFactory) error {
+	//the global chainID filled by the "-c" command
+	if channelID == common.UndefinedParamValue {
+		return errors.New("Must supply channel ID")
+	}
+
+	var err error
+	if cf == nil {
+		cf, err = InitCmdFactory(EndorserRequired, OrdererNotRequired)
+		if err != nil {
+			return err
+		}
+	}
+
+	client := &endorserClient{cf}
+
+	conflicts, err := client.getMVCCConflicts(chaincodeName)
+	if err != nil {
+		return err
+	}
+	for _, conflict := range conflicts.Conflicts {
+		jsonBytes, err := json.Marshal(conflict)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("MVCC conflict: %s\n", string(jsonBytes))
+	}
+
+	return nil
+}
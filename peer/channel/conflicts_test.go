@@ -0,0 +1,87 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package channel
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/peer/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMVCCConflicts(t *testing.T) {
+	InitMSP()
+	resetFlags()
+
+	mockConflicts := &pb.MVCCConflicts{
+		Conflicts: []*pb.MVCCConflict{
+			{
+				TxId:             "txid",
+				BlockNum:         2,
+				ValidationCode:   pb.TxValidationCode_MVCC_READ_CONFLICT,
+				Namespace:        "mycc",
+				Key:              "key1",
+				CommittedVersion: &pb.KeyVersion{BlockNum: 1},
+			},
+		},
+	}
+	mockPayload, err := proto.Marshal(mockConflicts)
+	assert.NoError(t, err)
+
+	mockResponse := &pb.ProposalResponse{
+		Response: &pb.Response{
+			Status:  200,
+			Payload: mockPayload,
+		},
+		Endorsement: &pb.Endorsement{},
+	}
+
+	signer, err := common.GetDefaultSigner()
+	assert.NoError(t, err)
+
+	mockCF := &ChannelCmdFactory{
+		EndorserClient:   common.GetMockEndorserClient(mockResponse, nil),
+		BroadcastFactory: mockBroadcastClientFactory,
+		Signer:           signer,
+	}
+
+	cmd := conflictsCmd(mockCF)
+	AddFlags(cmd)
+
+	args := []string{"-c", mockChannel, "-n", "mycc"}
+	cmd.SetArgs(args)
+
+	assert.NoError(t, cmd.Execute())
+
+	// a bad response fails the command
+	mockCF.EndorserClient = common.GetMockEndorserClient(&pb.ProposalResponse{Response: &pb.Response{Status: 500}}, nil)
+	cmd = conflictsCmd(mockCF)
+	AddFlags(cmd)
+	cmd.SetArgs(args)
+
+	assert.EqualError(t, cmd.Execute(), "received bad response, status 500")
+}
+
+func TestGetMVCCConflictsMissingChannelID(t *testing.T) {
+	InitMSP()
+	resetFlags()
+
+	signer, err := common.GetDefaultSigner()
+	assert.NoError(t, err)
+
+	mockCF := &ChannelCmdFactory{
+		Signer: signer,
+	}
+
+	cmd := conflictsCmd(mockCF)
+	AddFlags(cmd)
+	cmd.SetArgs([]string{})
+
+	assert.EqualError(t, cmd.Execute(), "Must supply channel ID")
+}
@@ -94,3 +94,27 @@ func (m *mockAdminClient) SetModuleLogLevel(ctx context.Context, in *pb.LogLevel
 func (m *mockAdminClient) RevertLogLevels(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return &empty.Empty{}, m.err
 }
+
+func (m *mockAdminClient) GetHandlers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*pb.Handlers, error) {
+	return &pb.Handlers{}, m.err
+}
+
+func (m *mockAdminClient) GetHotKeys(ctx context.Context, in *pb.HotKeysRequest, opts ...grpc.CallOption) (*pb.HotKeys, error) {
+	return &pb.HotKeys{}, m.err
+}
+
+func (m *mockAdminClient) CheckEndorsementDivergence(ctx context.Context, in *pb.DivergenceRequest, opts ...grpc.CallOption) (*pb.DivergenceReport, error) {
+	return &pb.DivergenceReport{}, m.err
+}
+
+func (m *mockAdminClient) GetGossipMembership(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*pb.GossipMembership, error) {
+	return &pb.GossipMembership{}, m.err
+}
+
+func (m *mockAdminClient) GetGossipChannels(ctx context.Context, in *pb.GossipChannelsRequest, opts ...grpc.CallOption) (*pb.GossipChannels, error) {
+	return &pb.GossipChannels{}, m.err
+}
+
+func (m *mockAdminClient) GetGossipConnections(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*pb.GossipConnections, error) {
+	return &pb.GossipConnections{}, m.err
+}
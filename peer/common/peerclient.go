@@ -6,10 +6,12 @@ SPDX-License-Identifier: Apache-2.0
 package common
 
 import (
+	"crypto/tls"
 	"fmt"
 	"time"
 
 	"github.com/hyperledger/fabric/core/comm"
+	discprotos "github.com/hyperledger/fabric/protos/discovery"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/pkg/errors"
 )
@@ -62,6 +64,21 @@ func (pc *PeerClient) Admin() (pb.AdminClient, error) {
 	return pb.NewAdminClient(conn), nil
 }
 
+// Discovery returns a client for the Discovery service
+func (pc *PeerClient) Discovery() (discprotos.DiscoveryClient, error) {
+	conn, err := pc.commonClient.NewConnection(pc.address, pc.sn)
+	if err != nil {
+		return nil, errors.WithMessage(err,
+			fmt.Sprintf("discovery client failed to connect to %s", pc.address))
+	}
+	return discprotos.NewDiscoveryClient(conn), nil
+}
+
+// Certificate returns the TLS client certificate (if available)
+func (pc *PeerClient) Certificate() tls.Certificate {
+	return pc.commonClient.Certificate()
+}
+
 // GetEndorserClient returns a new endorser client.  The target address for
 // the client is taken from the configuration setting "peer.address"
 func GetEndorserClient() (pb.EndorserClient, error) {
@@ -81,3 +98,13 @@ func GetAdminClient() (pb.AdminClient, error) {
 	}
 	return peerClient.Admin()
 }
+
+// GetDiscoveryClient returns a new discovery client.  The target address for
+// the client is taken from the configuration setting "peer.address"
+func GetDiscoveryClient() (discprotos.DiscoveryClient, error) {
+	peerClient, err := NewPeerClientFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return peerClient.Discovery()
+}
@@ -105,6 +105,13 @@ func TestPeerClient(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, aClient)
 
+	dClient, err := pClient1.Discovery()
+	assert.NoError(t, err)
+	assert.NotNil(t, dClient)
+	dClient, err = common.GetDiscoveryClient()
+	assert.NoError(t, err)
+	assert.NotNil(t, dClient)
+
 	viper.Set("peer.address", "")
 	t.Run("PeerClient.GetEndorser() timeout", func(t *testing.T) {
 		t.Parallel()
@@ -134,6 +141,11 @@ func TestPeerClient(t *testing.T) {
 		_, err5 := common.GetAdminClient()
 		assert.Contains(t, err5.Error(), "admin client failed to connect")
 	})
+	t.Run("GetDiscoveryClient() timeout", func(t *testing.T) {
+		t.Parallel()
+		_, err6 := common.GetDiscoveryClient()
+		assert.Contains(t, err6.Error(), "discovery client failed to connect")
+	})
 
 	viper.Reset()
 	os.Unsetenv("FABRIC_CFG_PATH")
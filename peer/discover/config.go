@@ -0,0 +1,36 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package discover
+
+import (
+	discprotos "github.com/hyperledger/fabric/protos/discovery"
+	"github.com/spf13/cobra"
+)
+
+func configCmd(cf *DiscoverCmdFactory) *cobra.Command {
+	configCmd := &cobra.Command{
+		Use:   "config",
+		Short: "Get the MSPs and the orderers of a channel.",
+		Long:  "Get the MSP configurations of the organizations and the orderer endpoints of a channel. Requires '-c'.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return config(cf)
+		},
+	}
+	attachFlags(configCmd, []string{"channelID"})
+
+	return configCmd
+}
+
+func config(cf *DiscoverCmdFactory) error {
+	result, err := query(cf, &discprotos.Query{
+		Query: &discprotos.Query_ConfigQuery{ConfigQuery: &discprotos.ConfigQuery{}},
+	})
+	if err != nil {
+		return err
+	}
+	return printJSON(result.GetConfigResult())
+}
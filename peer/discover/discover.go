@@ -0,0 +1,172 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package discover
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/msp"
+	"github.com/hyperledger/fabric/peer/common"
+	discprotos "github.com/hyperledger/fabric/protos/discovery"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"golang.org/x/net/context"
+)
+
+const (
+	discoverFuncName = "discover"
+	discoverCmdDes   = "Query the discovery service of a peer: peers|config|endorsers."
+)
+
+var logger = flogging.MustGetLogger("discoverCmd")
+
+var (
+	channelID  string
+	chaincodes []string
+)
+
+var flags *pflag.FlagSet
+
+func init() {
+	resetFlags()
+}
+
+// Explicitly define a method to facilitate tests
+func resetFlags() {
+	flags = &pflag.FlagSet{}
+
+	flags.StringVarP(&channelID, "channelID", "c", common.UndefinedParamValue, "The channel to query")
+	flags.StringSliceVarP(&chaincodes, "name", "n", nil, "Names of the chaincodes whose endorsement plans are queried")
+}
+
+func attachFlags(cmd *cobra.Command, names []string) {
+	cmdFlags := cmd.Flags()
+	for _, name := range names {
+		if flag := flags.Lookup(name); flag != nil {
+			cmdFlags.AddFlag(flag)
+		} else {
+			logger.Fatalf("Could not find flag '%s' to attach to command '%s'", name, cmd.Name())
+		}
+	}
+}
+
+// Cmd returns the cobra command for Discover
+func Cmd(cf *DiscoverCmdFactory) *cobra.Command {
+	discoverCmd := &cobra.Command{
+		Use:   discoverFuncName,
+		Short: discoverCmdDes,
+		Long:  discoverCmdDes,
+	}
+	discoverCmd.AddCommand(peersCmd(cf))
+	discoverCmd.AddCommand(configCmd(cf))
+	discoverCmd.AddCommand(endorsersCmd(cf))
+
+	return discoverCmd
+}
+
+// DiscoverCmdFactory holds the clients used by DiscoverCmd
+type DiscoverCmdFactory struct {
+	DiscoveryClient discprotos.DiscoveryClient
+	Signer          msp.SigningIdentity
+	// TLSCertHash is the hash of the TLS client certificate, if any,
+	// which requests are bound to
+	TLSCertHash []byte
+}
+
+// InitCmdFactory init the DiscoverCmdFactory with the default
+// discovery client and signer
+func InitCmdFactory() (*DiscoverCmdFactory, error) {
+	signer, err := common.GetDefaultSignerFnc()
+	if err != nil {
+		return nil, errors.WithMessage(err, "error getting default signer")
+	}
+	peerClient, err := common.NewPeerClientFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	client, err := peerClient.Discovery()
+	if err != nil {
+		return nil, err
+	}
+	var tlsCertHash []byte
+	// check for client certificate and create hash if present
+	if len(peerClient.Certificate().Certificate) > 0 {
+		tlsCertHash = util.ComputeSHA256(peerClient.Certificate().Certificate[0])
+	}
+	return &DiscoverCmdFactory{
+		DiscoveryClient: client,
+		Signer:          signer,
+		TLSCertHash:     tlsCertHash,
+	}, nil
+}
+
+// query sends the given query to the discovery service of the
+// peer, in a request signed by the signer of the factory
+func query(cf *DiscoverCmdFactory, q *discprotos.Query) (*discprotos.QueryResult, error) {
+	if channelID == common.UndefinedParamValue {
+		return nil, errors.New("must supply channel ID")
+	}
+	q.Channel = channelID
+
+	var err error
+	if cf == nil {
+		cf, err = InitCmdFactory()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	identity, err := cf.Signer.Serialize()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed serializing the identity of the signer")
+	}
+	payload, err := utils.Marshal(&discprotos.Request{
+		Authentication: &discprotos.AuthInfo{
+			ClientIdentity:    identity,
+			ClientTlsCertHash: cf.TLSCertHash,
+			Timestamp:         util.CreateUtcTimestamp(),
+		},
+		Queries: []*discprotos.Query{q},
+	})
+	if err != nil {
+		return nil, err
+	}
+	signature, err := cf.Signer.Sign(payload)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed signing the request")
+	}
+
+	resp, err := cf.DiscoveryClient.Discover(context.Background(), &discprotos.SignedRequest{
+		Payload:   payload,
+		Signature: signature,
+	})
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed sending the request")
+	}
+	if len(resp.Results) != 1 {
+		return nil, errors.Errorf("expected a single result, received %d", len(resp.Results))
+	}
+	result := resp.Results[0]
+	if result.GetError() != nil {
+		return nil, errors.Errorf("query failed: %s", result.GetError().Content)
+	}
+	return result, nil
+}
+
+func printJSON(v interface{}) error {
+	jsonBytes, err := json.MarshalIndent(v, "", "\t")
+	if err != nil {
+		return errors.Wrap(err, "failed marshaling the result")
+	}
+	fmt.Println(string(jsonBytes))
+	return nil
+}
@@ -0,0 +1,137 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package discover
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/msp/mgmt/testtools"
+	"github.com/hyperledger/fabric/peer/common"
+	discprotos "github.com/hyperledger/fabric/protos/discovery"
+	gproto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+type mockDiscoveryClient struct {
+	request  *discprotos.Request
+	response *discprotos.Response
+	err      error
+}
+
+func (mdc *mockDiscoveryClient) Discover(ctx context.Context, in *discprotos.SignedRequest, opts ...grpc.CallOption) (*discprotos.Response, error) {
+	mdc.request = &discprotos.Request{}
+	if err := proto.Unmarshal(in.Payload, mdc.request); err != nil {
+		return nil, err
+	}
+	return mdc.response, mdc.err
+}
+
+func newCmdFactory(t *testing.T, client *mockDiscoveryClient) *DiscoverCmdFactory {
+	err := msptesttools.LoadMSPSetupForTesting()
+	assert.NoError(t, err)
+	signer, err := common.GetDefaultSigner()
+	assert.NoError(t, err)
+	return &DiscoverCmdFactory{DiscoveryClient: client, Signer: signer, TLSCertHash: []byte{1, 2, 3}}
+}
+
+func execute(cmd *cobra.Command, args ...string) error {
+	cmd.SetArgs(args)
+	return cmd.Execute()
+}
+
+func response(result *discprotos.QueryResult) *discprotos.Response {
+	return &discprotos.Response{Results: []*discprotos.QueryResult{result}}
+}
+
+func TestPeers(t *testing.T) {
+	resetFlags()
+	client := &mockDiscoveryClient{response: response(&discprotos.QueryResult{
+		Result: &discprotos.QueryResult_Members{Members: &discprotos.PeerMembershipResult{
+			PeersByOrg: map[string]*discprotos.Peers{
+				"Org1MSP": {Peers: []*discprotos.Peer{{
+					MspId:        "Org1MSP",
+					Endpoint:     "peer0.org1:7051",
+					LedgerHeight: 5,
+					Chaincodes:   []*gproto.Chaincode{{Name: "mycc", Version: "1.0"}},
+				}}},
+			},
+		}},
+	})}
+	cf := newCmdFactory(t, client)
+
+	assert.NoError(t, execute(peersCmd(cf), "-c", "mychannel"))
+	identity, err := cf.Signer.Serialize()
+	assert.NoError(t, err)
+	assert.Equal(t, identity, client.request.Authentication.ClientIdentity)
+	assert.Equal(t, []byte{1, 2, 3}, client.request.Authentication.ClientTlsCertHash)
+	assert.NotNil(t, client.request.Authentication.Timestamp)
+	assert.Len(t, client.request.Queries, 1)
+	assert.Equal(t, "mychannel", client.request.Queries[0].Channel)
+	assert.NotNil(t, client.request.Queries[0].GetPeerQuery())
+}
+
+func TestConfig(t *testing.T) {
+	resetFlags()
+	client := &mockDiscoveryClient{response: response(&discprotos.QueryResult{
+		Result: &discprotos.QueryResult_ConfigResult{ConfigResult: &discprotos.ConfigResult{
+			Orderers: []string{"orderer:7050"},
+		}},
+	})}
+	cf := newCmdFactory(t, client)
+
+	assert.NoError(t, execute(configCmd(cf), "-c", "mychannel"))
+	assert.NotNil(t, client.request.Queries[0].GetConfigQuery())
+}
+
+func TestEndorsers(t *testing.T) {
+	resetFlags()
+	client := &mockDiscoveryClient{response: response(&discprotos.QueryResult{
+		Result: &discprotos.QueryResult_CcQueryRes{CcQueryRes: &discprotos.ChaincodeQueryResult{
+			Content: []*discprotos.EndorsementDescriptor{{
+				Chaincode:         "mycc",
+				EndorsersByGroups: map[string]*discprotos.Peers{"G0": {Peers: []*discprotos.Peer{{MspId: "Org1MSP"}}}},
+				Layouts:           []*discprotos.Layout{{QuantitiesByGroup: map[string]uint32{"G0": 1}}},
+			}},
+		}},
+	})}
+	cf := newCmdFactory(t, client)
+
+	err := execute(endorsersCmd(cf), "-c", "mychannel")
+	assert.EqualError(t, err, "must supply chaincode names")
+
+	assert.NoError(t, execute(endorsersCmd(cf), "-c", "mychannel", "-n", "mycc,othercc"))
+	assert.Equal(t, []string{"mycc", "othercc"}, client.request.Queries[0].GetCcQuery().Chaincodes)
+}
+
+func TestQueryFailures(t *testing.T) {
+	resetFlags()
+	client := &mockDiscoveryClient{}
+	cf := newCmdFactory(t, client)
+
+	err := execute(peersCmd(cf))
+	assert.EqualError(t, err, "must supply channel ID")
+
+	client.err = errors.New("connection refused")
+	err = execute(peersCmd(cf), "-c", "mychannel")
+	assert.Contains(t, err.Error(), "failed sending the request")
+
+	client.err = nil
+	client.response = response(&discprotos.QueryResult{
+		Result: &discprotos.QueryResult_Error{Error: &discprotos.Error{Content: "access denied"}},
+	})
+	err = execute(peersCmd(cf), "-c", "mychannel")
+	assert.EqualError(t, err, "query failed: access denied")
+
+	client.response = &discprotos.Response{}
+	err = execute(peersCmd(cf), "-c", "mychannel")
+	assert.EqualError(t, err, "expected a single result, received 0")
+}
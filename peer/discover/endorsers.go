@@ -0,0 +1,42 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package discover
+
+import (
+	discprotos "github.com/hyperledger/fabric/protos/discovery"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func endorsersCmd(cf *DiscoverCmdFactory) *cobra.Command {
+	endorsersCmd := &cobra.Command{
+		Use:   "endorsers",
+		Short: "Get the endorsement plans of chaincodes.",
+		Long: "Get the endorsement plans of chaincodes of a channel: the groups of peers that can endorse " +
+			"proposals to each chaincode, and the layouts of the numbers of peers of each group that " +
+			"satisfy its endorsement policy. Requires '-c' and '-n'.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return endorsers(cf)
+		},
+	}
+	attachFlags(endorsersCmd, []string{"channelID", "name"})
+
+	return endorsersCmd
+}
+
+func endorsers(cf *DiscoverCmdFactory) error {
+	if len(chaincodes) == 0 {
+		return errors.New("must supply chaincode names")
+	}
+	result, err := query(cf, &discprotos.Query{
+		Query: &discprotos.Query_CcQuery{CcQuery: &discprotos.ChaincodeQuery{Chaincodes: chaincodes}},
+	})
+	if err != nil {
+		return err
+	}
+	return printJSON(result.GetCcQueryRes().Content)
+}
@@ -0,0 +1,36 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package discover
+
+import (
+	discprotos "github.com/hyperledger/fabric/protos/discovery"
+	"github.com/spf13/cobra"
+)
+
+func peersCmd(cf *DiscoverCmdFactory) *cobra.Command {
+	peersCmd := &cobra.Command{
+		Use:   "peers",
+		Short: "List the peers of a channel.",
+		Long:  "List the peers of a channel, grouped by the MSP of their organization. Requires '-c'.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return peers(cf)
+		},
+	}
+	attachFlags(peersCmd, []string{"channelID"})
+
+	return peersCmd
+}
+
+func peers(cf *DiscoverCmdFactory) error {
+	result, err := query(cf, &discprotos.Query{
+		Query: &discprotos.Query_PeerQuery{PeerQuery: &discprotos.PeerMembershipQuery{}},
+	})
+	if err != nil {
+		return err
+	}
+	return printJSON(result.GetMembers().PeersByOrg)
+}
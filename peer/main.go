@@ -20,6 +20,7 @@ import (
 	"github.com/hyperledger/fabric/peer/channel"
 	"github.com/hyperledger/fabric/peer/clilogging"
 	"github.com/hyperledger/fabric/peer/common"
+	"github.com/hyperledger/fabric/peer/discover"
 	"github.com/hyperledger/fabric/peer/node"
 	"github.com/hyperledger/fabric/peer/version"
 	"github.com/spf13/cobra"
@@ -83,6 +84,7 @@ func main() {
 	mainCmd.AddCommand(chaincode.Cmd(nil))
 	mainCmd.AddCommand(clilogging.Cmd(nil))
 	mainCmd.AddCommand(channel.Cmd(nil))
+	mainCmd.AddCommand(discover.Cmd(nil))
 
 	err := common.InitConfig(cmdRoot)
 	if err != nil { // Handle errors reading the config file
@@ -0,0 +1,151 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/hyperledger/fabric/peer/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/spf13/cobra"
+	"golang.org/x/net/context"
+)
+
+var gossipChannelID string
+
+func gossipCmd() *cobra.Command {
+	flags := nodeGossipCmd.Flags()
+	flags.StringVarP(&gossipChannelID, "channelID", "c", "", "The channel whose gossip state is listed")
+
+	return nodeGossipCmd
+}
+
+var nodeGossipCmd = &cobra.Command{
+	Use:   "gossip",
+	Short: "Lists the gossip state of the node.",
+	Long:  `Lists the gossip membership, connections and channels of the running node, along with the blocks and identities it makes available through pull. The channels are restricted to a single one with '-c'.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return gossipState()
+	},
+}
+
+func gossipState() error {
+	adminClient, err := common.GetAdminClient()
+	if err != nil {
+		return err
+	}
+
+	membership, err := adminClient.GetGossipMembership(context.Background(), &empty.Empty{})
+	if err != nil {
+		return fmt.Errorf("Error trying to get gossip membership from local peer: %s", err)
+	}
+	connections, err := adminClient.GetGossipConnections(context.Background(), &empty.Empty{})
+	if err != nil {
+		return fmt.Errorf("Error trying to get gossip connections from local peer: %s", err)
+	}
+	channels, err := adminClient.GetGossipChannels(context.Background(), &pb.GossipChannelsRequest{ChannelId: gossipChannelID})
+	if err != nil {
+		return fmt.Errorf("Error trying to get gossip channels from local peer: %s", err)
+	}
+
+	fmt.Printf("Alive peers: %d\n", len(membership.Alive))
+	for _, p := range sortedGossipPeers(membership.Alive) {
+		fmt.Printf("\t%s\n", describeGossipPeer(p))
+	}
+	fmt.Printf("Dead peers: %d\n", len(membership.Dead))
+	for _, p := range sortedGossipPeers(membership.Dead) {
+		fmt.Printf("\t%s\n", describeGossipPeer(p))
+	}
+	fmt.Printf("Identities available through pull: %d\n", len(membership.IdentityDigests))
+	for _, pkiID := range membership.IdentityDigests {
+		fmt.Printf("\t%s\n", hex.EncodeToString(pkiID))
+	}
+	fmt.Printf("Connections: %d\n", len(connections.Connections))
+	for _, conn := range connections.Connections {
+		fmt.Printf("\t%s\n", describeGossipConnection(conn))
+	}
+	for _, channel := range channels.Channels {
+		fmt.Printf("Channel %s:\n", channel.ChannelId)
+		fmt.Printf("\tLeader: %s\n", describeGossipLeaders(channel))
+		fmt.Printf("\tPeers: %d\n", len(channel.Peers))
+		for _, p := range sortedGossipPeers(channel.Peers) {
+			fmt.Printf("\t\t%s\n", describeGossipPeer(p))
+		}
+		fmt.Printf("\tBlocks available through pull: %s\n", describeBlockDigests(channel.BlockDigests))
+	}
+	return nil
+}
+
+func sortedGossipPeers(peers []*pb.GossipPeer) []*pb.GossipPeer {
+	sorted := append([]*pb.GossipPeer(nil), peers...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Endpoint < sorted[j].Endpoint
+	})
+	return sorted
+}
+
+func describeGossipPeer(p *pb.GossipPeer) string {
+	desc := p.Endpoint
+	if p.InternalEndpoint != "" && p.InternalEndpoint != p.Endpoint {
+		desc += fmt.Sprintf(" (internal %s)", p.InternalEndpoint)
+	}
+	if p.Mspid != "" {
+		desc += fmt.Sprintf(" of %s", p.Mspid)
+	}
+	desc += fmt.Sprintf(", PKI-ID %s", hex.EncodeToString(p.PkiId))
+	if p.LedgerHeight > 0 {
+		desc += fmt.Sprintf(", height %d", p.LedgerHeight)
+	}
+	if p.Leader {
+		desc += ", leader"
+	}
+	return desc
+}
+
+func describeGossipConnection(conn *pb.GossipConnection) string {
+	direction := "inbound"
+	if conn.Outbound {
+		direction = "outbound"
+	}
+	return fmt.Sprintf("%s, PKI-ID %s, %s: %d bytes sent, %d bytes received",
+		conn.Endpoint, hex.EncodeToString(conn.PkiId), direction, conn.BytesSent, conn.BytesReceived)
+}
+
+func describeGossipLeaders(channel *pb.GossipChannel) string {
+	var leaders []string
+	if channel.Leader {
+		leaders = append(leaders, "this peer")
+	}
+	for _, p := range sortedGossipPeers(channel.Peers) {
+		if p.Leader {
+			leaders = append(leaders, p.Endpoint)
+		}
+	}
+	if len(leaders) == 0 {
+		return "none"
+	}
+	return strings.Join(leaders, ", ")
+}
+
+func describeBlockDigests(seqNums []uint64) string {
+	if len(seqNums) == 0 {
+		return "none"
+	}
+	sorted := append([]uint64(nil), seqNums...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	digests := make([]string, len(sorted))
+	for i, seqNum := range sorted {
+		digests[i] = fmt.Sprintf("%d", seqNum)
+	}
+	return strings.Join(digests, ", ")
+}
@@ -0,0 +1,72 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/core"
+	"github.com/hyperledger/fabric/core/comm"
+	"github.com/hyperledger/fabric/core/peer"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeGossipPeer(t *testing.T) {
+	assert.Equal(t, "peer0:7051, PKI-ID 0102", describeGossipPeer(&pb.GossipPeer{Endpoint: "peer0:7051", PkiId: []byte{0x01, 0x02}}))
+	assert.Equal(t, "peer0:7051 (internal peer0.org1:7051) of Org1MSP, PKI-ID 0102, height 10, leader",
+		describeGossipPeer(&pb.GossipPeer{
+			Endpoint:         "peer0:7051",
+			InternalEndpoint: "peer0.org1:7051",
+			Mspid:            "Org1MSP",
+			PkiId:            []byte{0x01, 0x02},
+			LedgerHeight:     10,
+			Leader:           true,
+		}))
+}
+
+func TestDescribeGossipConnection(t *testing.T) {
+	assert.Equal(t, "peer0:7051, PKI-ID 0102, outbound: 100 bytes sent, 200 bytes received",
+		describeGossipConnection(&pb.GossipConnection{Endpoint: "peer0:7051", PkiId: []byte{0x01, 0x02}, Outbound: true, BytesSent: 100, BytesReceived: 200}))
+	assert.Equal(t, "peer0:7051, PKI-ID 0102, inbound: 0 bytes sent, 0 bytes received",
+		describeGossipConnection(&pb.GossipConnection{Endpoint: "peer0:7051", PkiId: []byte{0x01, 0x02}}))
+}
+
+func TestDescribeGossipChannel(t *testing.T) {
+	channel := &pb.GossipChannel{
+		Peers: []*pb.GossipPeer{
+			{Endpoint: "peer1:7051", Leader: true},
+			{Endpoint: "peer2:7051"},
+			{Endpoint: "peer0:7051", Leader: true},
+		},
+	}
+	assert.Equal(t, "peer0:7051, peer1:7051", describeGossipLeaders(channel))
+	channel.Leader = true
+	assert.Equal(t, "this peer, peer0:7051, peer1:7051", describeGossipLeaders(channel))
+	assert.Equal(t, "none", describeGossipLeaders(&pb.GossipChannel{}))
+
+	assert.Equal(t, "none", describeBlockDigests(nil))
+	assert.Equal(t, "3, 5, 12", describeBlockDigests([]uint64{12, 3, 5}))
+}
+
+func TestGossipCmd(t *testing.T) {
+	viper.Set("peer.address", "localhost:7077")
+	peerServer, err := peer.CreatePeerServer("localhost:7077", comm.ServerConfig{})
+	if err != nil {
+		t.Fatalf("Failed to create peer server (%s)", err)
+	}
+	pb.RegisterAdminServer(peerServer.Server(), core.NewAdminServer(nil))
+	go peerServer.Start()
+	defer peerServer.Stop()
+
+	// gossip isn't available on the peer
+	assert.Error(t, gossipState())
+
+	viper.Set("peer.address", "")
+	assert.Error(t, gossipState())
+}
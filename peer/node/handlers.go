@@ -0,0 +1,45 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/hyperledger/fabric/peer/common"
+	"github.com/spf13/cobra"
+	"golang.org/x/net/context"
+)
+
+func handlersCmd() *cobra.Command {
+	return nodeHandlersCmd
+}
+
+var nodeHandlersCmd = &cobra.Command{
+	Use:   "handlers",
+	Short: "Lists the handlers of the node.",
+	Long:  `Lists the handlers loaded by the running node, along with the names of their configuration parameters, whose values are redacted.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handlers()
+	},
+}
+
+func handlers() error {
+	adminClient, err := common.GetAdminClient()
+	if err != nil {
+		return err
+	}
+
+	handlers, err := adminClient.GetHandlers(context.Background(), &empty.Empty{})
+	if err != nil {
+		return fmt.Errorf("Error trying to get handlers from local peer: %s", err)
+	}
+	for _, handler := range handlers.Handlers {
+		fmt.Println(handler)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/core"
+	"github.com/hyperledger/fabric/core/comm"
+	testpb "github.com/hyperledger/fabric/core/comm/testdata/grpc"
+	"github.com/hyperledger/fabric/core/handlers/config"
+	"github.com/hyperledger/fabric/core/handlers/library"
+	"github.com/hyperledger/fabric/core/peer"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockRegistry struct {
+	handlers []library.LoadedHandler
+}
+
+func (r *mockRegistry) Lookup(library.HandlerType) interface{} {
+	return nil
+}
+
+func (r *mockRegistry) Handlers() []library.LoadedHandler {
+	return r.handlers
+}
+
+func TestHandlerInfos(t *testing.T) {
+	infos := handlerInfos(&mockRegistry{handlers: []library.LoadedHandler{
+		{Type: library.Auth, HandlerConfig: library.HandlerConfig{Name: "DefaultAuth"}},
+		{Type: library.Auth, HandlerConfig: library.HandlerConfig{Name: "RateLimit", Config: config.Params{"perClient": map[string]interface{}{"rate": 10}}}},
+		{Type: library.Endorsement, Key: "custom", HandlerConfig: library.HandlerConfig{Library: "/opt/lib/endorsement.so"}},
+	}})
+	assert.Equal(t, []*pb.HandlerInfo{
+		{Type: "auth", Name: "DefaultAuth"},
+		{Type: "auth", Name: "RateLimit", Config: `{"perClient":{"rate":10}}`},
+		{Type: "endorsement", Key: "custom", Library: "/opt/lib/endorsement.so"},
+	}, infos)
+}
+
+func TestHandlersCmd(t *testing.T) {
+	viper.Set("peer.address", "localhost:7074")
+	peerServer, err := peer.CreatePeerServer("localhost:7074", comm.ServerConfig{})
+	if err != nil {
+		t.Fatalf("Failed to create peer server (%s)", err)
+	}
+	pb.RegisterAdminServer(peerServer.Server(), core.NewAdminServer(nil, &pb.HandlerInfo{Type: "auth", Name: "DefaultAuth"}))
+	go peerServer.Start()
+	defer peerServer.Stop()
+
+	assert.NoError(t, handlersCmd().Execute())
+}
+
+func TestHandlersWithGetHandlersError(t *testing.T) {
+	viper.Set("peer.address", "localhost:7075")
+	peerServer, err := peer.CreatePeerServer(":7075", comm.ServerConfig{})
+	if err != nil {
+		t.Fatalf("Failed to create peer server (%s)", err)
+	}
+	testpb.RegisterTestServiceServer(peerServer.Server(), &testServiceServer{})
+	go peerServer.Start()
+	defer peerServer.Stop()
+	assert.Error(t, handlers())
+
+	viper.Set("peer.address", "")
+	assert.Error(t, handlers())
+}
@@ -0,0 +1,72 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hyperledger/fabric/peer/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/net/context"
+)
+
+var (
+	hotKeysChannelID string
+	hotKeysNamespace string
+)
+
+func hotKeysCmd() *cobra.Command {
+	flags := nodeHotKeysCmd.Flags()
+	flags.StringVarP(&hotKeysChannelID, "channelID", "c", "", "The channel whose hot keys are listed")
+	flags.StringVarP(&hotKeysNamespace, "name", "n", "", "Name of the chaincode whose hot keys are listed")
+
+	return nodeHotKeysCmd
+}
+
+var nodeHotKeysCmd = &cobra.Command{
+	Use:   "hotkeys",
+	Short: "Lists the keys with the most MVCC conflicts.",
+	Long:  `Lists the keys of a channel with the most MVCC and phantom read conflicts, for each chaincode or for a single one. Requires '-c'.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return hotKeys()
+	},
+}
+
+func hotKeys() error {
+	if hotKeysChannelID == "" {
+		return errors.New("Must supply channel ID")
+	}
+
+	adminClient, err := common.GetAdminClient()
+	if err != nil {
+		return err
+	}
+
+	request := &pb.HotKeysRequest{ChannelId: hotKeysChannelID, Namespace: hotKeysNamespace}
+	hotKeys, err := adminClient.GetHotKeys(context.Background(), request)
+	if err != nil {
+		return fmt.Errorf("Error trying to get hot keys from local peer: %s", err)
+	}
+	for _, hotKey := range hotKeys.HotKeys {
+		fmt.Printf("%s %s: %d conflicts (max error %d)\n", hotKey.Namespace, describeHotKey(hotKey), hotKey.Conflicts, hotKey.MaxError)
+	}
+	return nil
+}
+
+func describeHotKey(hotKey *pb.HotKey) string {
+	switch {
+	case hotKey.Collection != "":
+		return fmt.Sprintf("private key with hash %s in collection %s", hex.EncodeToString(hotKey.KeyHash), hotKey.Collection)
+	case hotKey.StartKey != "" || hotKey.EndKey != "":
+		return fmt.Sprintf("range [%s, %s)", hotKey.StartKey, hotKey.EndKey)
+	default:
+		return fmt.Sprintf("key %s", hotKey.Key)
+	}
+}
@@ -0,0 +1,47 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/core"
+	"github.com/hyperledger/fabric/core/comm"
+	"github.com/hyperledger/fabric/core/peer"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeHotKey(t *testing.T) {
+	assert.Equal(t, "key key1", describeHotKey(&pb.HotKey{Key: "key1"}))
+	assert.Equal(t, "range [a, z)", describeHotKey(&pb.HotKey{StartKey: "a", EndKey: "z"}))
+	assert.Equal(t, "private key with hash 0102 in collection coll1",
+		describeHotKey(&pb.HotKey{Collection: "coll1", KeyHash: []byte{0x01, 0x02}}))
+}
+
+func TestHotKeysCmd(t *testing.T) {
+	hotKeysChannelID = ""
+	assert.EqualError(t, hotKeys(), "Must supply channel ID")
+
+	viper.Set("peer.address", "localhost:7076")
+	peerServer, err := peer.CreatePeerServer("localhost:7076", comm.ServerConfig{})
+	if err != nil {
+		t.Fatalf("Failed to create peer server (%s)", err)
+	}
+	pb.RegisterAdminServer(peerServer.Server(), core.NewAdminServer(nil))
+	go peerServer.Start()
+	defer peerServer.Stop()
+
+	// the channel doesn't exist on the peer
+	hotKeysChannelID = "mychannel"
+	defer func() { hotKeysChannelID = "" }()
+	assert.Error(t, hotKeys())
+
+	viper.Set("peer.address", "")
+	assert.Error(t, hotKeys())
+}
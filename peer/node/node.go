@@ -25,8 +25,8 @@ import (
 
 const (
 	nodeFuncName = "node"
-	shortDes     = "Operate a peer node: start|status."
-	longDes      = "Operate a peer node: start|status."
+	shortDes     = "Operate a peer node: start|status|handlers|hotkeys|gossip|verify-pvtdata."
+	longDes      = "Operate a peer node: start|status|handlers|hotkeys|gossip|verify-pvtdata."
 )
 
 var logger = flogging.MustGetLogger("nodeCmd")
@@ -35,6 +35,10 @@ var logger = flogging.MustGetLogger("nodeCmd")
 func Cmd() *cobra.Command {
 	nodeCmd.AddCommand(startCmd())
 	nodeCmd.AddCommand(statusCmd())
+	nodeCmd.AddCommand(handlersCmd())
+	nodeCmd.AddCommand(hotKeysCmd())
+	nodeCmd.AddCommand(gossipCmd())
+	nodeCmd.AddCommand(verifyPvtDataCmd())
 
 	return nodeCmd
 }
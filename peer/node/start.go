@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package node
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net"
@@ -22,20 +23,26 @@ import (
 	"github.com/hyperledger/fabric/common/deliver"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/localmsp"
+	"github.com/hyperledger/fabric/common/metrics"
 	"github.com/hyperledger/fabric/common/viperutil"
 	"github.com/hyperledger/fabric/core"
 	"github.com/hyperledger/fabric/core/aclmgmt"
 	"github.com/hyperledger/fabric/core/chaincode"
 	"github.com/hyperledger/fabric/core/chaincode/accesscontrol"
 	"github.com/hyperledger/fabric/core/comm"
+	"github.com/hyperledger/fabric/core/committer/txvalidator"
 	"github.com/hyperledger/fabric/core/common/ccprovider"
 	"github.com/hyperledger/fabric/core/config"
 	"github.com/hyperledger/fabric/core/endorser"
 	authHandler "github.com/hyperledger/fabric/core/handlers/auth"
+	"github.com/hyperledger/fabric/core/handlers/endorsement"
 	"github.com/hyperledger/fabric/core/handlers/library"
+	"github.com/hyperledger/fabric/core/handlers/validation"
 	"github.com/hyperledger/fabric/core/ledger/ledgermgmt"
 	"github.com/hyperledger/fabric/core/peer"
 	"github.com/hyperledger/fabric/core/scc"
+	"github.com/hyperledger/fabric/discovery"
+	"github.com/hyperledger/fabric/discovery/support"
 	"github.com/hyperledger/fabric/events/producer"
 	common2 "github.com/hyperledger/fabric/gossip/common"
 	"github.com/hyperledger/fabric/gossip/service"
@@ -45,6 +52,7 @@ import (
 	peergossip "github.com/hyperledger/fabric/peer/gossip"
 	"github.com/hyperledger/fabric/peer/version"
 	cb "github.com/hyperledger/fabric/protos/common"
+	discprotos "github.com/hyperledger/fabric/protos/discovery"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/pkg/errors"
@@ -113,6 +121,13 @@ func serve(args []string) error {
 	//Users can pass in their own ACLProvider to RegisterACLProvider (currently unit tests do this)
 	aclmgmt.RegisterACLProvider(nil)
 
+	// initialize the metrics before the ledgers, which report
+	// the keys of the most MVCC conflicts of their channel
+	metricsOpts := metrics.NewOpts()
+	if err := metrics.Init(metricsOpts); err != nil {
+		return errors.WithMessage(err, "failed to initialize metrics")
+	}
+
 	//initialize resource management exit
 	ledgermgmt.Initialize(peer.ConfigTxProcessors)
 
@@ -199,19 +214,32 @@ func serve(args []string) error {
 
 	logger.Debugf("Running peer")
 
-	// Register the Admin server
-	pb.RegisterAdminServer(peerServer.Server(), core.NewAdminServer())
-
 	privDataDist := func(channel string, txID string, privateData *rwset.TxPvtReadWriteSet) error {
 		return service.GetGossipService().DistributePrivateData(channel, txID, privateData)
 	}
 
-	serverEndorser := endorser.NewEndorserServer(privDataDist, &endorser.SupportImpl{})
 	libConf := library.Config{}
 	if err = viperutil.EnhancedExactUnmarshalKey("peer.handlers", &libConf); err != nil {
 		return errors.WithMessage(err, "could not load YAML config")
 	}
-	authFilters := library.InitRegistry(libConf).Lookup(library.Auth).([]authHandler.Filter)
+	reg, err := library.LoadRegistry(libConf)
+	if err != nil {
+		return err
+	}
+
+	endorsementPlugins := reg.Lookup(library.Endorsement).(map[string]endorsement.PluginFactory)
+	pluginEndorser := endorser.NewPluginEndorser(&endorser.PluginSupport{
+		SigningIdentityFetcher: &endorser.LocalSigningIdentityFetcher{},
+		PluginMapper:           endorser.MapBasedPluginMapper(endorsementPlugins),
+	})
+	serverEndorser := endorser.NewEndorserServer(privDataDist, &endorser.SupportImpl{PluginEndorser: pluginEndorser})
+
+	// Register the Admin server, which checks the divergence of
+	// endorsements by simulating proposals with the endorser
+	adminServer := core.NewAdminServer(serverEndorser.(core.DivergenceChecker), handlerInfos(reg)...)
+	pb.RegisterAdminServer(peerServer.Server(), adminServer)
+
+	authFilters := reg.Lookup(library.Auth).([]authHandler.Filter)
 	auth := authHandler.ChainFilters(serverEndorser, authFilters...)
 	// Register the Endorser server
 	pb.RegisterEndorserServer(peerServer.Server(), auth)
@@ -272,15 +300,32 @@ func serve(args []string) error {
 		return err
 	}
 	defer service.GetGossipService().Stop()
+	adminServer.SetGossip(service.GetGossipService())
+
+	if viper.GetBool("peer.discovery.enabled") {
+		// Register the Discovery server, which serves the peers, the configuration and
+		// the endorsement plans of the channels the peer joined, to fresh requests
+		// bound to the TLS session of the client, as for the deliver service
+		timeWindow := viper.GetDuration("peer.authentication.timewindow")
+		if timeWindow == 0 {
+			defaultTimeWindow := 15 * time.Minute
+			logger.Warningf("`peer.authentication.timewindow` not set; defaulting to %s", defaultTimeWindow)
+			timeWindow = defaultTimeWindow
+		}
+		discoverySupport := support.NewDiscoverySupport(service.GetGossipService(), peerEndpoint.Address, serializedIdentity)
+		discprotos.RegisterDiscoveryServer(peerServer.Server(), discovery.NewService(discoverySupport, timeWindow, mutualTLS))
+	}
 
 	//initialize system chaincodes
 	initSysCCs()
 
+	validationPlugins := reg.Lookup(library.Validation).(map[string]validation.PluginFactory)
+
 	//this brings up all the chains (including testchainid)
 	peer.Initialize(func(cid string) {
 		logger.Debugf("Deploying system CC, for chain <%s>", cid)
 		scc.DeploySysCCs(cid)
-	})
+	}, txvalidator.MapBasedPluginMapper(validationPlugins))
 
 	logger.Infof("Starting peer with ID=[%s], network ID=[%s], address=[%s]",
 		peerEndpoint.Id, viper.GetString("peer.networkId"), peerEndpoint.Address)
@@ -327,6 +372,17 @@ func serve(args []string) error {
 		}()
 	}
 
+	// Start the metrics server if enabled
+	if metricsOpts.Enabled {
+		go func() {
+			logger.Infof("Starting metrics server with reporter = %s", metricsOpts.Reporter)
+			if metricsErr := metrics.Start(); metricsErr != nil {
+				logger.Errorf("Error starting metrics server: %s", metricsErr)
+			}
+		}()
+		defer metrics.Shutdown()
+	}
+
 	logger.Infof("Started peer with ID=[%s], network ID=[%s], address=[%s]",
 		peerEndpoint.Id, viper.GetString("peer.networkId"), peerEndpoint.Address)
 
@@ -586,3 +642,25 @@ func writePid(fileName string, pid int) error {
 
 	return nil
 }
+
+// handlerInfos describes the handlers loaded by the given registry
+func handlerInfos(reg library.Registry) []*pb.HandlerInfo {
+	var infos []*pb.HandlerInfo
+	for _, handler := range reg.Handlers() {
+		info := &pb.HandlerInfo{
+			Type:    handler.Type.String(),
+			Key:     handler.Key,
+			Name:    handler.Name,
+			Library: handler.Library,
+		}
+		if len(handler.Config) > 0 {
+			config, err := json.Marshal(handler.Config)
+			if err != nil {
+				logger.Warningf("Failed marshaling the configuration of handler %s: %s", handler.Name, err)
+			}
+			info.Config = string(config)
+		}
+		infos = append(infos, info)
+	}
+	return infos
+}
@@ -42,7 +42,7 @@ func TestStatusCmd(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to create peer server (%s)", err)
 	} else {
-		pb.RegisterAdminServer(peerServer.Server(), core.NewAdminServer())
+		pb.RegisterAdminServer(peerServer.Server(), core.NewAdminServer(nil))
 		go peerServer.Start()
 		defer peerServer.Stop()
 
@@ -84,7 +84,7 @@ func TestStatus(t *testing.T) {
 			if err != nil {
 				t.Fatalf("Failed to create peer server (%s)", err)
 			} else {
-				pb.RegisterAdminServer(peerServer.Server(), core.NewAdminServer())
+				pb.RegisterAdminServer(peerServer.Server(), core.NewAdminServer(nil))
 				go peerServer.Start()
 				defer peerServer.Stop()
 				if test.expected {
@@ -0,0 +1,103 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+
+	"github.com/hyperledger/fabric/core/ledger/ledgermgmt"
+	"github.com/hyperledger/fabric/core/ledger/pvtdataverifier"
+	"github.com/hyperledger/fabric/core/peer"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	verifyPvtDataChannelID  string
+	verifyPvtDataStartBlock uint64
+	verifyPvtDataEndBlock   int64
+)
+
+func verifyPvtDataCmd() *cobra.Command {
+	flags := nodeVerifyPvtDataCmd.Flags()
+	flags.StringVarP(&verifyPvtDataChannelID, "channelID", "c", "", "The channel whose private data is verified")
+	flags.Uint64VarP(&verifyPvtDataStartBlock, "start", "s", 0, "The first block whose private data is verified")
+	flags.Int64VarP(&verifyPvtDataEndBlock, "end", "e", -1, "The last block whose private data is verified, the last block of the channel if negative")
+
+	return nodeVerifyPvtDataCmd
+}
+
+var nodeVerifyPvtDataCmd = &cobra.Command{
+	Use:   "verify-pvtdata",
+	Short: "Verifies the private data of a channel against the hashes in its blocks.",
+	Long:  `Verifies the private data stored by the node for a channel against the hashes of the private write sets recorded in its blocks, and lists the collections whose private data is missing or does not match. Only the private data of the collections the organization of the node is a member of is expected to be stored. Reads the ledger directly, so the node must be stopped. Requires '-c'.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return verifyPvtData()
+	},
+}
+
+func verifyPvtData() error {
+	if verifyPvtDataChannelID == "" {
+		return errors.New("Must supply channel ID")
+	}
+
+	ledgermgmt.Initialize(peer.ConfigTxProcessors)
+	defer ledgermgmt.Close()
+	lgr, err := ledgermgmt.OpenLedger(verifyPvtDataChannelID)
+	if err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("failed opening the ledger of channel %s", verifyPvtDataChannelID))
+	}
+
+	endBlock := uint64(math.MaxUint64)
+	if verifyPvtDataEndBlock >= 0 {
+		endBlock = uint64(verifyPvtDataEndBlock)
+	}
+	eligible, err := peer.PvtDataEligibility(verifyPvtDataChannelID, lgr)
+	if err != nil {
+		return err
+	}
+	return reportPvtDataVerification(lgr, eligible, verifyPvtDataStartBlock, endBlock)
+}
+
+// reportPvtDataVerification verifies the private data of the given ledger
+// and prints its discrepancies, returning an error if there are any
+func reportPvtDataVerification(lgr pvtdataverifier.Ledger, eligible pvtdataverifier.Eligibility, startBlock, endBlock uint64) error {
+	verification, err := pvtdataverifier.Verify(lgr, eligible, startBlock, endBlock)
+	if err != nil {
+		return err
+	}
+	for _, discrepancy := range verification.Discrepancies {
+		fmt.Println(describePvtDataDiscrepancy(discrepancy))
+	}
+	fmt.Printf("Verified the private data of blocks %d to %d: %d discrepancies\n",
+		verification.StartBlock, verification.EndBlock, len(verification.Discrepancies))
+	if len(verification.Discrepancies) > 0 {
+		return errors.Errorf("found %d private data discrepancies", len(verification.Discrepancies))
+	}
+	return nil
+}
+
+func describePvtDataDiscrepancy(d *pb.PvtDataDiscrepancy) string {
+	tx := fmt.Sprintf("block %d, transaction %d", d.BlockNum, d.TxNum)
+	if d.TxId != "" {
+		tx = fmt.Sprintf("%s (%s)", tx, d.TxId)
+	}
+	switch d.Type {
+	case pb.PvtDataDiscrepancy_MISSING:
+		return fmt.Sprintf("%s: missing private data of collection %s/%s with hash %s",
+			tx, d.Namespace, d.Collection, hex.EncodeToString(d.ExpectedHash))
+	case pb.PvtDataDiscrepancy_HASH_MISMATCH:
+		return fmt.Sprintf("%s: private data of collection %s/%s has hash %s instead of %s",
+			tx, d.Namespace, d.Collection, hex.EncodeToString(d.ActualHash), hex.EncodeToString(d.ExpectedHash))
+	default:
+		return fmt.Sprintf("%s: unexpected private data of collection %s/%s with hash %s",
+			tx, d.Namespace, d.Collection, hex.EncodeToString(d.ActualHash))
+	}
+}
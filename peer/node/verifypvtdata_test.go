@@ -0,0 +1,71 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"io/ioutil"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/hyperledger/fabric/common/ledger/testutil"
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/ledgermgmt"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribePvtDataDiscrepancy(t *testing.T) {
+	assert.Equal(t, "block 2, transaction 1 (tx1): missing private data of collection ns1/coll1 with hash 0102",
+		describePvtDataDiscrepancy(&pb.PvtDataDiscrepancy{BlockNum: 2, TxNum: 1, TxId: "tx1", Namespace: "ns1", Collection: "coll1", Type: pb.PvtDataDiscrepancy_MISSING, ExpectedHash: []byte{0x01, 0x02}}))
+	assert.Equal(t, "block 2, transaction 1 (tx1): private data of collection ns1/coll1 has hash 0304 instead of 0102",
+		describePvtDataDiscrepancy(&pb.PvtDataDiscrepancy{BlockNum: 2, TxNum: 1, TxId: "tx1", Namespace: "ns1", Collection: "coll1", Type: pb.PvtDataDiscrepancy_HASH_MISMATCH, ExpectedHash: []byte{0x01, 0x02}, ActualHash: []byte{0x03, 0x04}}))
+	assert.Equal(t, "block 2, transaction 5: unexpected private data of collection ns1/coll1 with hash 0304",
+		describePvtDataDiscrepancy(&pb.PvtDataDiscrepancy{BlockNum: 2, TxNum: 5, Namespace: "ns1", Collection: "coll1", Type: pb.PvtDataDiscrepancy_UNEXPECTED, ActualHash: []byte{0x03, 0x04}}))
+}
+
+func TestReportPvtDataVerification(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "verifypvtdata")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set("peer.fileSystemPath", tempDir)
+	defer viper.Set("peer.fileSystemPath", "")
+	ledgermgmt.InitializeTestEnv()
+	defer ledgermgmt.CleanupTestEnv()
+
+	eligible := func(string, string) (bool, error) { return true, nil }
+	bg, gb := testutil.NewBlockGenerator(t, "testchannel", false)
+	lgr, err := ledgermgmt.CreateLedger(gb)
+	assert.NoError(t, err)
+
+	simulator, err := lgr.NewTxSimulator(util.GenerateUUID())
+	assert.NoError(t, err)
+	simulator.SetPrivateData("ns1", "coll1", "key1", []byte("value1"))
+	simulator.Done()
+	simRes, err := simulator.GetTxSimulationResults()
+	assert.NoError(t, err)
+	pubSimResBytes, err := simRes.GetPubSimulationBytes()
+	assert.NoError(t, err)
+
+	// block 1 is committed with its private data
+	blockPvtData := map[uint64]*ledger.TxPvtData{0: {SeqInBlock: 0, WriteSet: simRes.PvtSimulationResults}}
+	assert.NoError(t, lgr.CommitWithPvtData(&ledger.BlockAndPvtData{Block: bg.NextBlock([][]byte{pubSimResBytes}), BlockPvtData: blockPvtData}))
+	assert.NoError(t, reportPvtDataVerification(lgr, eligible, 0, math.MaxUint64))
+
+	// block 2 is committed without its private data
+	assert.NoError(t, lgr.CommitWithPvtData(&ledger.BlockAndPvtData{Block: bg.NextBlock([][]byte{pubSimResBytes})}))
+	assert.EqualError(t, reportPvtDataVerification(lgr, eligible, 0, math.MaxUint64), "found 1 private data discrepancies")
+	assert.NoError(t, reportPvtDataVerification(lgr, eligible, 0, 1))
+	assert.Error(t, reportPvtDataVerification(lgr, eligible, 3, math.MaxUint64))
+}
+
+func TestVerifyPvtDataCmd(t *testing.T) {
+	verifyPvtDataChannelID = ""
+	assert.EqualError(t, verifyPvtData(), "Must supply channel ID")
+}
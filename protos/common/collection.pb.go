@@ -70,6 +70,31 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// DisseminationMode defines how the failure to disseminate the
+// private data of a collection upon endorsement is handled
+type DisseminationMode int32
+
+const (
+	// The endorsement fails
+	DisseminationMode_STRICT DisseminationMode = 0
+	// The endorsement succeeds, and the failure is only logged
+	DisseminationMode_BEST_EFFORT DisseminationMode = 1
+)
+
+var DisseminationMode_name = map[int32]string{
+	0: "STRICT",
+	1: "BEST_EFFORT",
+}
+var DisseminationMode_value = map[string]int32{
+	"STRICT":      0,
+	"BEST_EFFORT": 1,
+}
+
+func (x DisseminationMode) String() string {
+	return proto.EnumName(DisseminationMode_name, int32(x))
+}
+func (DisseminationMode) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
 // CollectionConfigPackage represents an array of CollectionConfig
 // messages; the extra struct is required because repeated oneof is
 // forbidden by the protobuf syntax
@@ -199,6 +224,17 @@ type StaticCollectionConfig struct {
 	// The maximum number of peers that private data will be sent to
 	// upon endorsement. This number has to be bigger than required_peer_count.
 	MaximumPeerCount int32 `protobuf:"varint,4,opt,name=maximum_peer_count,json=maximumPeerCount" json:"maximum_peer_count,omitempty"`
+	// Whether the endorsement fails if dissemination to at least
+	// required_peer_count peers is not achieved.
+	DisseminationMode DisseminationMode `protobuf:"varint,5,opt,name=dissemination_mode,json=disseminationMode,enum=common.DisseminationMode" json:"dissemination_mode,omitempty"`
+	// Whether only clients of the organizations that satisfy
+	// member_orgs_policy can read the private data of the collection
+	// through chaincode invocations.
+	MemberOnlyRead bool `protobuf:"varint,6,opt,name=member_only_read,json=memberOnlyRead" json:"member_only_read,omitempty"`
+	// Whether only clients of the organizations that satisfy
+	// member_orgs_policy can write the private data of the collection
+	// through chaincode invocations.
+	MemberOnlyWrite bool `protobuf:"varint,7,opt,name=member_only_write,json=memberOnlyWrite" json:"member_only_write,omitempty"`
 }
 
 func (m *StaticCollectionConfig) Reset()                    { *m = StaticCollectionConfig{} }
@@ -234,6 +270,27 @@ func (m *StaticCollectionConfig) GetMaximumPeerCount() int32 {
 	return 0
 }
 
+func (m *StaticCollectionConfig) GetDisseminationMode() DisseminationMode {
+	if m != nil {
+		return m.DisseminationMode
+	}
+	return DisseminationMode_STRICT
+}
+
+func (m *StaticCollectionConfig) GetMemberOnlyRead() bool {
+	if m != nil {
+		return m.MemberOnlyRead
+	}
+	return false
+}
+
+func (m *StaticCollectionConfig) GetMemberOnlyWrite() bool {
+	if m != nil {
+		return m.MemberOnlyWrite
+	}
+	return false
+}
+
 // Collection policy configuration. Initially, the configuration can only
 // contain a SignaturePolicy. In the future, the SignaturePolicy may be a
 // more general Policy. Instead of containing the actual policy, the
@@ -376,37 +433,45 @@ func init() {
 	proto.RegisterType((*StaticCollectionConfig)(nil), "common.StaticCollectionConfig")
 	proto.RegisterType((*CollectionPolicyConfig)(nil), "common.CollectionPolicyConfig")
 	proto.RegisterType((*CollectionCriteria)(nil), "common.CollectionCriteria")
+	proto.RegisterEnum("common.DisseminationMode", DisseminationMode_name, DisseminationMode_value)
 }
 
 func init() { proto.RegisterFile("common/collection.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 429 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x6a, 0xdb, 0x40,
-	0x10, 0x86, 0xa3, 0xc6, 0x71, 0xd0, 0xe4, 0x50, 0x77, 0x42, 0x1d, 0x51, 0x4a, 0x1a, 0x4c, 0x0f,
-	0x86, 0x16, 0xa9, 0xa4, 0x6f, 0x10, 0x53, 0x48, 0x69, 0xa0, 0x46, 0xb9, 0xe5, 0x22, 0xd6, 0xab,
-	0x89, 0xbc, 0x54, 0xda, 0x55, 0x76, 0x57, 0xc5, 0x3e, 0xf6, 0x2d, 0xfb, 0x38, 0xc1, 0xbb, 0x92,
-	0x2d, 0x1b, 0xdf, 0x3c, 0xf3, 0x7f, 0xf3, 0x7b, 0xe6, 0x5f, 0xc1, 0x15, 0x57, 0x55, 0xa5, 0x64,
-	0xc2, 0x55, 0x59, 0x12, 0xb7, 0x42, 0xc9, 0xb8, 0xd6, 0xca, 0x2a, 0x1c, 0x7a, 0xe1, 0xc3, 0xfb,
-	0x16, 0xa8, 0x55, 0x29, 0xb8, 0x20, 0xe3, 0xe5, 0xc9, 0x2f, 0xb8, 0x9a, 0x6d, 0x47, 0x66, 0x4a,
-	0x3e, 0x8b, 0x62, 0xce, 0xf8, 0x1f, 0x56, 0x10, 0x7e, 0x83, 0x21, 0x77, 0x8d, 0x28, 0xb8, 0x39,
-	0x9d, 0x5e, 0xdc, 0x46, 0xb1, 0xb7, 0x88, 0x0f, 0x07, 0xd2, 0x96, 0x9b, 0xac, 0x61, 0x74, 0xa8,
-	0xe1, 0x13, 0x44, 0xc6, 0x32, 0x2b, 0x78, 0xb6, 0x5b, 0x2d, 0xdb, 0xfa, 0x06, 0xd3, 0x8b, 0xdb,
-	0xeb, 0xce, 0xf7, 0xd1, 0x71, 0x87, 0x0e, 0xf7, 0x27, 0xe9, 0xd8, 0x1c, 0x55, 0xee, 0x42, 0x38,
-	0xaf, 0xd9, 0xba, 0x54, 0x2c, 0x9f, 0xfc, 0x0f, 0x60, 0x7c, 0x7c, 0x1e, 0x11, 0x06, 0x92, 0x55,
-	0xe4, 0xfe, 0x2d, 0x4c, 0xdd, 0x6f, 0x7c, 0x00, 0xac, 0xa8, 0x5a, 0x90, 0xce, 0x94, 0x2e, 0x4c,
-	0xe6, 0x42, 0x59, 0x47, 0x6f, 0xf6, 0xf7, 0xd9, 0x39, 0xcd, 0x9d, 0xde, 0x5e, 0x3b, 0xf2, 0x93,
-	0xbf, 0x75, 0x61, 0x7c, 0x1f, 0x63, 0xb8, 0xd4, 0xf4, 0xd2, 0x08, 0x4d, 0x79, 0x56, 0x13, 0xe9,
-	0x8c, 0xab, 0x46, 0xda, 0xe8, 0xf4, 0x26, 0x98, 0x9e, 0xa5, 0xef, 0x3a, 0x69, 0x4e, 0xa4, 0x67,
-	0x1b, 0x01, 0xbf, 0x02, 0x56, 0x6c, 0x25, 0xaa, 0xa6, 0xea, 0xe3, 0x03, 0x87, 0x8f, 0x5a, 0x65,
-	0x4b, 0x4f, 0x5e, 0x60, 0x7c, 0x7c, 0x13, 0x7c, 0x80, 0x91, 0x11, 0x85, 0x64, 0xb6, 0xd1, 0xd4,
-	0xdd, 0xe0, 0x33, 0xfd, 0xb4, 0xcd, 0xb4, 0xd3, 0xfd, 0xe0, 0x0f, 0xf9, 0x97, 0x4a, 0x55, 0xd3,
-	0xfd, 0x49, 0xfa, 0xd6, 0xec, 0x4b, 0xfd, 0x34, 0xff, 0x05, 0x80, 0xbd, 0x1c, 0xb5, 0xb0, 0xa4,
-	0x05, 0xc3, 0x08, 0xce, 0xf9, 0x92, 0x49, 0x49, 0x65, 0x1b, 0x66, 0x57, 0xe2, 0x25, 0x9c, 0xd9,
-	0x55, 0x26, 0x72, 0x17, 0x61, 0x98, 0x0e, 0xec, 0xea, 0x67, 0x8e, 0xd7, 0x00, 0xbb, 0x37, 0x77,
-	0x69, 0x84, 0x69, 0xaf, 0x83, 0x1f, 0x21, 0xdc, 0x3c, 0x86, 0xa9, 0x19, 0x27, 0x77, 0x7d, 0x98,
-	0xee, 0x1a, 0x77, 0x8f, 0xf0, 0x59, 0xe9, 0x22, 0x5e, 0xae, 0x6b, 0xd2, 0x25, 0xe5, 0x05, 0xe9,
-	0xf8, 0x99, 0x2d, 0xb4, 0xe0, 0xfe, 0xcb, 0x35, 0xed, 0x85, 0x4f, 0x5f, 0x0a, 0x61, 0x97, 0xcd,
-	0x62, 0x53, 0x26, 0x3d, 0x38, 0xf1, 0x70, 0xe2, 0xe1, 0xc4, 0xc3, 0x8b, 0xa1, 0x2b, 0xbf, 0xbf,
-	0x06, 0x00, 0x00, 0xff, 0xff, 0xa8, 0x98, 0x7f, 0x6c, 0x2f, 0x03, 0x00, 0x00,
+	// 535 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x5f, 0x6f, 0xda, 0x3c,
+	0x14, 0xc6, 0x9b, 0xfe, 0xa1, 0x6f, 0x4e, 0xa5, 0x36, 0xb8, 0x7a, 0x69, 0x36, 0x4d, 0x1d, 0x42,
+	0xbb, 0x88, 0xba, 0x29, 0x54, 0xec, 0x1b, 0xc0, 0xa8, 0xa8, 0xd6, 0xa9, 0xc8, 0x20, 0x4d, 0xea,
+	0x4d, 0x64, 0x92, 0xd3, 0x60, 0x2d, 0xb1, 0x53, 0x27, 0x6c, 0xe4, 0x72, 0xdf, 0x7a, 0x97, 0x13,
+	0x4e, 0x42, 0x52, 0xc6, 0x1d, 0x3e, 0xcf, 0xef, 0x1c, 0x8e, 0x9f, 0xc7, 0x81, 0x2b, 0x5f, 0xc6,
+	0xb1, 0x14, 0x7d, 0x5f, 0x46, 0x11, 0xfa, 0x19, 0x97, 0xc2, 0x4d, 0x94, 0xcc, 0x24, 0x69, 0x15,
+	0xc2, 0xdb, 0xff, 0x4b, 0x20, 0x91, 0x11, 0xf7, 0x39, 0xa6, 0x85, 0xdc, 0xfb, 0x0a, 0x57, 0xa3,
+	0x6d, 0xcb, 0x48, 0x8a, 0x67, 0x1e, 0x4e, 0x99, 0xff, 0x83, 0x85, 0x48, 0x6e, 0xa1, 0xe5, 0xeb,
+	0x82, 0x6d, 0x74, 0x8f, 0x9c, 0xb3, 0x81, 0xed, 0x16, 0x23, 0xdc, 0xdd, 0x06, 0x5a, 0x72, 0xbd,
+	0x1c, 0xac, 0x5d, 0x8d, 0x3c, 0x81, 0x9d, 0x66, 0x2c, 0xe3, 0xbe, 0x57, 0xaf, 0xe6, 0x6d, 0xe7,
+	0x1a, 0xce, 0xd9, 0xe0, 0xba, 0x9a, 0x3b, 0xd3, 0xdc, 0xee, 0x84, 0xc9, 0x01, 0xed, 0xa4, 0x7b,
+	0x95, 0xa1, 0x09, 0xa7, 0x09, 0xcb, 0x23, 0xc9, 0x82, 0xde, 0x9f, 0x43, 0xe8, 0xec, 0xef, 0x27,
+	0x04, 0x8e, 0x05, 0x8b, 0x51, 0xff, 0x9b, 0x49, 0xf5, 0x6f, 0xf2, 0x00, 0x24, 0xc6, 0x78, 0x81,
+	0xca, 0x93, 0x2a, 0x4c, 0x3d, 0x6d, 0x4a, 0x6e, 0x1f, 0xbe, 0xde, 0xa7, 0x9e, 0x34, 0xd5, 0x7a,
+	0x79, 0x5b, 0xab, 0xe8, 0x7c, 0x54, 0x61, 0x5a, 0xd4, 0x89, 0x0b, 0x97, 0x0a, 0x5f, 0x56, 0x5c,
+	0x61, 0xe0, 0x25, 0x88, 0xca, 0xf3, 0xe5, 0x4a, 0x64, 0xf6, 0x51, 0xd7, 0x70, 0x4e, 0x68, 0xbb,
+	0x92, 0xa6, 0x88, 0x6a, 0xb4, 0x11, 0xc8, 0x27, 0x20, 0x31, 0x5b, 0xf3, 0x78, 0x15, 0x37, 0xf1,
+	0x63, 0x8d, 0x5b, 0xa5, 0x52, 0xd3, 0x13, 0x20, 0x01, 0x4f, 0x53, 0x8c, 0xb9, 0x60, 0xda, 0xbd,
+	0x58, 0x06, 0x68, 0x9f, 0x74, 0x0d, 0xe7, 0x7c, 0xf0, 0xa6, 0xda, 0xf5, 0x4b, 0x93, 0xf8, 0x26,
+	0x03, 0xa4, 0xed, 0x60, 0xb7, 0x44, 0x1c, 0xb0, 0xaa, 0x5b, 0x8b, 0x28, 0xf7, 0x14, 0xb2, 0xc0,
+	0x6e, 0x75, 0x0d, 0xe7, 0x3f, 0x7a, 0x5e, 0xde, 0x49, 0x44, 0x39, 0x45, 0x16, 0x90, 0x1b, 0x68,
+	0x37, 0xc9, 0x5f, 0x8a, 0x67, 0x68, 0x9f, 0x6a, 0xf4, 0xa2, 0x46, 0xbf, 0x6f, 0xca, 0xbd, 0x17,
+	0xe8, 0xec, 0x77, 0x8a, 0x3c, 0x80, 0x95, 0xf2, 0x50, 0xb0, 0x6c, 0xa5, 0xb0, 0xf2, 0xb8, 0xc8,
+	0xfc, 0xfd, 0x36, 0xf3, 0x4a, 0x2f, 0x1a, 0xc7, 0xe2, 0x27, 0x46, 0x32, 0xc1, 0xc9, 0x01, 0xbd,
+	0x48, 0x5f, 0x4b, 0xcd, 0xb4, 0x7f, 0x1b, 0x40, 0x1a, 0x39, 0x6f, 0xd6, 0x50, 0x9c, 0x11, 0x1b,
+	0x4e, 0xfd, 0x25, 0x13, 0x02, 0xa3, 0x32, 0xec, 0xea, 0x48, 0x2e, 0xe1, 0x24, 0x5b, 0x7b, 0x3c,
+	0xd0, 0x11, 0x9b, 0xf4, 0x38, 0x5b, 0xdf, 0x07, 0xe4, 0x1a, 0xa0, 0x7e, 0x93, 0x3a, 0x2d, 0x93,
+	0x36, 0x2a, 0xe4, 0x1d, 0x98, 0x9b, 0xc7, 0x92, 0x26, 0xcc, 0x47, 0x9d, 0x8e, 0x49, 0xeb, 0xc2,
+	0xcd, 0x2d, 0xb4, 0xff, 0x31, 0x9d, 0x00, 0xb4, 0x66, 0x73, 0x7a, 0x3f, 0x9a, 0x5b, 0x07, 0xe4,
+	0x02, 0xce, 0x86, 0xe3, 0xd9, 0xdc, 0x1b, 0xdf, 0xdd, 0x3d, 0xd2, 0xb9, 0x65, 0x0c, 0x67, 0xf0,
+	0x41, 0xaa, 0xd0, 0x5d, 0xe6, 0x09, 0xaa, 0x08, 0x83, 0x10, 0x95, 0xfb, 0xcc, 0x16, 0x8a, 0xfb,
+	0xc5, 0xb7, 0x98, 0x96, 0x9e, 0x3c, 0x7d, 0x0c, 0x79, 0xb6, 0x5c, 0x2d, 0x36, 0xc7, 0x7e, 0x03,
+	0xee, 0x17, 0x70, 0xbf, 0x80, 0xfb, 0x05, 0xbc, 0x68, 0xe9, 0xe3, 0xe7, 0xbf, 0x03, 0x00, 0xcc,
+	0x29, 0x86, 0x19, 0x01, 0x04, 0x00, 0x00,
 }
@@ -0,0 +1,919 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: discovery/protocol.proto
+
+/*
+Package discovery is a generated protocol buffer package.
+
+It is generated from these files:
+	discovery/protocol.proto
+
+It has these top-level messages:
+	SignedRequest
+	Request
+	AuthInfo
+	Query
+	ConfigQuery
+	PeerMembershipQuery
+	ChaincodeQuery
+	Response
+	QueryResult
+	Error
+	ConfigResult
+	PeerMembershipResult
+	Peers
+	Peer
+	ChaincodeQueryResult
+	EndorsementDescriptor
+	Layout
+*/
+package discovery
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import google_protobuf "github.com/golang/protobuf/ptypes/timestamp"
+import gossip "github.com/hyperledger/fabric/protos/gossip"
+import msp "github.com/hyperledger/fabric/protos/msp"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// SignedRequest contains a serialized Request in the payload field
+// and a signature of the payload by the client identity of the request
+type SignedRequest struct {
+	Payload   []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *SignedRequest) Reset()                    { *m = SignedRequest{} }
+func (m *SignedRequest) String() string            { return proto.CompactTextString(m) }
+func (*SignedRequest) ProtoMessage()               {}
+func (*SignedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *SignedRequest) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *SignedRequest) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// Request contains the queries of a client
+type Request struct {
+	// authentication identifies the client that sent the request
+	Authentication *AuthInfo `protobuf:"bytes,1,opt,name=authentication" json:"authentication,omitempty"`
+	// queries are the queries the client sent
+	Queries []*Query `protobuf:"bytes,2,rep,name=queries" json:"queries,omitempty"`
+}
+
+func (m *Request) Reset()                    { *m = Request{} }
+func (m *Request) String() string            { return proto.CompactTextString(m) }
+func (*Request) ProtoMessage()               {}
+func (*Request) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Request) GetAuthentication() *AuthInfo {
+	if m != nil {
+		return m.Authentication
+	}
+	return nil
+}
+
+func (m *Request) GetQueries() []*Query {
+	if m != nil {
+		return m.Queries
+	}
+	return nil
+}
+
+// AuthInfo identifies the client that sent a request
+type AuthInfo struct {
+	// client_identity is the serialized identity of the client,
+	// whose signature is in the signature field of the SignedRequest
+	ClientIdentity []byte `protobuf:"bytes,1,opt,name=client_identity,json=clientIdentity,proto3" json:"client_identity,omitempty"`
+	// client_tls_cert_hash is the SHA256 hash of the TLS certificate of
+	// the client, which is required if the peer requires TLS client
+	// authentication, so that requests can't be replayed by other clients
+	ClientTlsCertHash []byte `protobuf:"bytes,2,opt,name=client_tls_cert_hash,json=clientTlsCertHash,proto3" json:"client_tls_cert_hash,omitempty"`
+	// timestamp is the time the request was created at, which must be
+	// within the authentication time window of the peer
+	Timestamp *google_protobuf.Timestamp `protobuf:"bytes,3,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *AuthInfo) Reset()                    { *m = AuthInfo{} }
+func (m *AuthInfo) String() string            { return proto.CompactTextString(m) }
+func (*AuthInfo) ProtoMessage()               {}
+func (*AuthInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *AuthInfo) GetClientIdentity() []byte {
+	if m != nil {
+		return m.ClientIdentity
+	}
+	return nil
+}
+
+func (m *AuthInfo) GetClientTlsCertHash() []byte {
+	if m != nil {
+		return m.ClientTlsCertHash
+	}
+	return nil
+}
+
+func (m *AuthInfo) GetTimestamp() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+// Query is a query about a channel
+type Query struct {
+	Channel string `protobuf:"bytes,1,opt,name=channel" json:"channel,omitempty"`
+	// Types that are valid to be assigned to Query:
+	//	*Query_ConfigQuery
+	//	*Query_PeerQuery
+	//	*Query_CcQuery
+	Query isQuery_Query `protobuf_oneof:"query"`
+}
+
+func (m *Query) Reset()                    { *m = Query{} }
+func (m *Query) String() string            { return proto.CompactTextString(m) }
+func (*Query) ProtoMessage()               {}
+func (*Query) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+type isQuery_Query interface {
+	isQuery_Query()
+}
+
+type Query_ConfigQuery struct {
+	ConfigQuery *ConfigQuery `protobuf:"bytes,2,opt,name=config_query,json=configQuery,oneof"`
+}
+type Query_PeerQuery struct {
+	PeerQuery *PeerMembershipQuery `protobuf:"bytes,3,opt,name=peer_query,json=peerQuery,oneof"`
+}
+type Query_CcQuery struct {
+	CcQuery *ChaincodeQuery `protobuf:"bytes,4,opt,name=cc_query,json=ccQuery,oneof"`
+}
+
+func (*Query_ConfigQuery) isQuery_Query() {}
+func (*Query_PeerQuery) isQuery_Query()   {}
+func (*Query_CcQuery) isQuery_Query()     {}
+
+func (m *Query) GetQuery() isQuery_Query {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *Query) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *Query) GetConfigQuery() *ConfigQuery {
+	if x, ok := m.GetQuery().(*Query_ConfigQuery); ok {
+		return x.ConfigQuery
+	}
+	return nil
+}
+
+func (m *Query) GetPeerQuery() *PeerMembershipQuery {
+	if x, ok := m.GetQuery().(*Query_PeerQuery); ok {
+		return x.PeerQuery
+	}
+	return nil
+}
+
+func (m *Query) GetCcQuery() *ChaincodeQuery {
+	if x, ok := m.GetQuery().(*Query_CcQuery); ok {
+		return x.CcQuery
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Query) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Query_OneofMarshaler, _Query_OneofUnmarshaler, _Query_OneofSizer, []interface{}{
+		(*Query_ConfigQuery)(nil),
+		(*Query_PeerQuery)(nil),
+		(*Query_CcQuery)(nil),
+	}
+}
+
+func _Query_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Query)
+	// query
+	switch x := m.Query.(type) {
+	case *Query_ConfigQuery:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ConfigQuery); err != nil {
+			return err
+		}
+	case *Query_PeerQuery:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PeerQuery); err != nil {
+			return err
+		}
+	case *Query_CcQuery:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CcQuery); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("Query.Query has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Query_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Query)
+	switch tag {
+	case 2: // query.config_query
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ConfigQuery)
+		err := b.DecodeMessage(msg)
+		m.Query = &Query_ConfigQuery{msg}
+		return true, err
+	case 3: // query.peer_query
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PeerMembershipQuery)
+		err := b.DecodeMessage(msg)
+		m.Query = &Query_PeerQuery{msg}
+		return true, err
+	case 4: // query.cc_query
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChaincodeQuery)
+		err := b.DecodeMessage(msg)
+		m.Query = &Query_CcQuery{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Query_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Query)
+	// query
+	switch x := m.Query.(type) {
+	case *Query_ConfigQuery:
+		s := proto.Size(x.ConfigQuery)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Query_PeerQuery:
+		s := proto.Size(x.PeerQuery)
+		n += proto.SizeVarint(3<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Query_CcQuery:
+		s := proto.Size(x.CcQuery)
+		n += proto.SizeVarint(4<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// ConfigQuery queries the MSPs and the orderers of a channel
+type ConfigQuery struct {
+}
+
+func (m *ConfigQuery) Reset()                    { *m = ConfigQuery{} }
+func (m *ConfigQuery) String() string            { return proto.CompactTextString(m) }
+func (*ConfigQuery) ProtoMessage()               {}
+func (*ConfigQuery) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+// PeerMembershipQuery queries the peers of a channel
+type PeerMembershipQuery struct {
+}
+
+func (m *PeerMembershipQuery) Reset()                    { *m = PeerMembershipQuery{} }
+func (m *PeerMembershipQuery) String() string            { return proto.CompactTextString(m) }
+func (*PeerMembershipQuery) ProtoMessage()               {}
+func (*PeerMembershipQuery) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+// ChaincodeQuery queries the endorsement plans of chaincodes
+type ChaincodeQuery struct {
+	Chaincodes []string `protobuf:"bytes,1,rep,name=chaincodes" json:"chaincodes,omitempty"`
+}
+
+func (m *ChaincodeQuery) Reset()                    { *m = ChaincodeQuery{} }
+func (m *ChaincodeQuery) String() string            { return proto.CompactTextString(m) }
+func (*ChaincodeQuery) ProtoMessage()               {}
+func (*ChaincodeQuery) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *ChaincodeQuery) GetChaincodes() []string {
+	if m != nil {
+		return m.Chaincodes
+	}
+	return nil
+}
+
+// Response contains the results of the queries of a Request,
+// in the order of the queries
+type Response struct {
+	Results []*QueryResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+}
+
+func (m *Response) Reset()                    { *m = Response{} }
+func (m *Response) String() string            { return proto.CompactTextString(m) }
+func (*Response) ProtoMessage()               {}
+func (*Response) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *Response) GetResults() []*QueryResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// QueryResult is the result of a query
+type QueryResult struct {
+	// Types that are valid to be assigned to Result:
+	//	*QueryResult_Error
+	//	*QueryResult_ConfigResult
+	//	*QueryResult_Members
+	//	*QueryResult_CcQueryRes
+	Result isQueryResult_Result `protobuf_oneof:"result"`
+}
+
+func (m *QueryResult) Reset()                    { *m = QueryResult{} }
+func (m *QueryResult) String() string            { return proto.CompactTextString(m) }
+func (*QueryResult) ProtoMessage()               {}
+func (*QueryResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+type isQueryResult_Result interface {
+	isQueryResult_Result()
+}
+
+type QueryResult_Error struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error,oneof"`
+}
+type QueryResult_ConfigResult struct {
+	ConfigResult *ConfigResult `protobuf:"bytes,2,opt,name=config_result,json=configResult,oneof"`
+}
+type QueryResult_Members struct {
+	Members *PeerMembershipResult `protobuf:"bytes,3,opt,name=members,oneof"`
+}
+type QueryResult_CcQueryRes struct {
+	CcQueryRes *ChaincodeQueryResult `protobuf:"bytes,4,opt,name=cc_query_res,json=ccQueryRes,oneof"`
+}
+
+func (*QueryResult_Error) isQueryResult_Result()        {}
+func (*QueryResult_ConfigResult) isQueryResult_Result() {}
+func (*QueryResult_Members) isQueryResult_Result()      {}
+func (*QueryResult_CcQueryRes) isQueryResult_Result()   {}
+
+func (m *QueryResult) GetResult() isQueryResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *QueryResult) GetError() *Error {
+	if x, ok := m.GetResult().(*QueryResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (m *QueryResult) GetConfigResult() *ConfigResult {
+	if x, ok := m.GetResult().(*QueryResult_ConfigResult); ok {
+		return x.ConfigResult
+	}
+	return nil
+}
+
+func (m *QueryResult) GetMembers() *PeerMembershipResult {
+	if x, ok := m.GetResult().(*QueryResult_Members); ok {
+		return x.Members
+	}
+	return nil
+}
+
+func (m *QueryResult) GetCcQueryRes() *ChaincodeQueryResult {
+	if x, ok := m.GetResult().(*QueryResult_CcQueryRes); ok {
+		return x.CcQueryRes
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*QueryResult) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _QueryResult_OneofMarshaler, _QueryResult_OneofUnmarshaler, _QueryResult_OneofSizer, []interface{}{
+		(*QueryResult_Error)(nil),
+		(*QueryResult_ConfigResult)(nil),
+		(*QueryResult_Members)(nil),
+		(*QueryResult_CcQueryRes)(nil),
+	}
+}
+
+func _QueryResult_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*QueryResult)
+	// result
+	switch x := m.Result.(type) {
+	case *QueryResult_Error:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Error); err != nil {
+			return err
+		}
+	case *QueryResult_ConfigResult:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ConfigResult); err != nil {
+			return err
+		}
+	case *QueryResult_Members:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Members); err != nil {
+			return err
+		}
+	case *QueryResult_CcQueryRes:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CcQueryRes); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("QueryResult.Result has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _QueryResult_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*QueryResult)
+	switch tag {
+	case 1: // result.error
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Error)
+		err := b.DecodeMessage(msg)
+		m.Result = &QueryResult_Error{msg}
+		return true, err
+	case 2: // result.config_result
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ConfigResult)
+		err := b.DecodeMessage(msg)
+		m.Result = &QueryResult_ConfigResult{msg}
+		return true, err
+	case 3: // result.members
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PeerMembershipResult)
+		err := b.DecodeMessage(msg)
+		m.Result = &QueryResult_Members{msg}
+		return true, err
+	case 4: // result.cc_query_res
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChaincodeQueryResult)
+		err := b.DecodeMessage(msg)
+		m.Result = &QueryResult_CcQueryRes{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _QueryResult_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*QueryResult)
+	// result
+	switch x := m.Result.(type) {
+	case *QueryResult_Error:
+		s := proto.Size(x.Error)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *QueryResult_ConfigResult:
+		s := proto.Size(x.ConfigResult)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *QueryResult_Members:
+		s := proto.Size(x.Members)
+		n += proto.SizeVarint(3<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *QueryResult_CcQueryRes:
+		s := proto.Size(x.CcQueryRes)
+		n += proto.SizeVarint(4<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// Error is the reason a query failed
+type Error struct {
+	Content string `protobuf:"bytes,1,opt,name=content" json:"content,omitempty"`
+}
+
+func (m *Error) Reset()                    { *m = Error{} }
+func (m *Error) String() string            { return proto.CompactTextString(m) }
+func (*Error) ProtoMessage()               {}
+func (*Error) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *Error) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+// ConfigResult contains the configuration of a channel
+type ConfigResult struct {
+	// msps are the configurations of the MSPs of the channel, by MSP ID
+	Msps map[string]*msp.MSPConfig `protobuf:"bytes,1,rep,name=msps" json:"msps,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// orderers are the endpoints of the ordering service of the channel
+	Orderers []string `protobuf:"bytes,2,rep,name=orderers" json:"orderers,omitempty"`
+}
+
+func (m *ConfigResult) Reset()                    { *m = ConfigResult{} }
+func (m *ConfigResult) String() string            { return proto.CompactTextString(m) }
+func (*ConfigResult) ProtoMessage()               {}
+func (*ConfigResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *ConfigResult) GetMsps() map[string]*msp.MSPConfig {
+	if m != nil {
+		return m.Msps
+	}
+	return nil
+}
+
+func (m *ConfigResult) GetOrderers() []string {
+	if m != nil {
+		return m.Orderers
+	}
+	return nil
+}
+
+// PeerMembershipResult contains the peers of a channel
+type PeerMembershipResult struct {
+	// peers_by_org are the peers of the channel, by MSP ID
+	PeersByOrg map[string]*Peers `protobuf:"bytes,1,rep,name=peers_by_org,json=peersByOrg" json:"peers_by_org,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *PeerMembershipResult) Reset()                    { *m = PeerMembershipResult{} }
+func (m *PeerMembershipResult) String() string            { return proto.CompactTextString(m) }
+func (*PeerMembershipResult) ProtoMessage()               {}
+func (*PeerMembershipResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *PeerMembershipResult) GetPeersByOrg() map[string]*Peers {
+	if m != nil {
+		return m.PeersByOrg
+	}
+	return nil
+}
+
+// Peers is a list of peers
+type Peers struct {
+	Peers []*Peer `protobuf:"bytes,1,rep,name=peers" json:"peers,omitempty"`
+}
+
+func (m *Peers) Reset()                    { *m = Peers{} }
+func (m *Peers) String() string            { return proto.CompactTextString(m) }
+func (*Peers) ProtoMessage()               {}
+func (*Peers) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *Peers) GetPeers() []*Peer {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+// Peer is a peer of a channel
+type Peer struct {
+	MspId    string `protobuf:"bytes,1,opt,name=msp_id,json=mspId" json:"msp_id,omitempty"`
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint" json:"endpoint,omitempty"`
+	// identity is the serialized identity of the peer
+	Identity []byte `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	// ledger_height is the height of the ledger of the channel
+	// the peer advertised
+	LedgerHeight uint64 `protobuf:"varint,4,opt,name=ledger_height,json=ledgerHeight" json:"ledger_height,omitempty"`
+	// chaincodes are the chaincodes the peer advertised it installed
+	Chaincodes []*gossip.Chaincode `protobuf:"bytes,5,rep,name=chaincodes" json:"chaincodes,omitempty"`
+}
+
+func (m *Peer) Reset()                    { *m = Peer{} }
+func (m *Peer) String() string            { return proto.CompactTextString(m) }
+func (*Peer) ProtoMessage()               {}
+func (*Peer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *Peer) GetMspId() string {
+	if m != nil {
+		return m.MspId
+	}
+	return ""
+}
+
+func (m *Peer) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *Peer) GetIdentity() []byte {
+	if m != nil {
+		return m.Identity
+	}
+	return nil
+}
+
+func (m *Peer) GetLedgerHeight() uint64 {
+	if m != nil {
+		return m.LedgerHeight
+	}
+	return 0
+}
+
+func (m *Peer) GetChaincodes() []*gossip.Chaincode {
+	if m != nil {
+		return m.Chaincodes
+	}
+	return nil
+}
+
+// ChaincodeQueryResult contains the endorsement plans of chaincodes,
+// in the order of the chaincodes of the query
+type ChaincodeQueryResult struct {
+	Content []*EndorsementDescriptor `protobuf:"bytes,1,rep,name=content" json:"content,omitempty"`
+}
+
+func (m *ChaincodeQueryResult) Reset()                    { *m = ChaincodeQueryResult{} }
+func (m *ChaincodeQueryResult) String() string            { return proto.CompactTextString(m) }
+func (*ChaincodeQueryResult) ProtoMessage()               {}
+func (*ChaincodeQueryResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *ChaincodeQueryResult) GetContent() []*EndorsementDescriptor {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+// EndorsementDescriptor contains the plans to endorse a proposal to a chaincode.
+// The peers able to endorse the proposal are grouped by the principals of the
+// endorsement policy of the chaincode they satisfy, and each layout specifies
+// the number of peers of each group whose endorsements satisfy the policy
+type EndorsementDescriptor struct {
+	Chaincode string `protobuf:"bytes,1,opt,name=chaincode" json:"chaincode,omitempty"`
+	// endorsers_by_groups are the peers that have the chaincode
+	// installed, by the name of the group they belong to
+	EndorsersByGroups map[string]*Peers `protobuf:"bytes,2,rep,name=endorsers_by_groups,json=endorsersByGroups" json:"endorsers_by_groups,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// layouts are the combinations of groups satisfying the policy
+	Layouts []*Layout `protobuf:"bytes,3,rep,name=layouts" json:"layouts,omitempty"`
+}
+
+func (m *EndorsementDescriptor) Reset()                    { *m = EndorsementDescriptor{} }
+func (m *EndorsementDescriptor) String() string            { return proto.CompactTextString(m) }
+func (*EndorsementDescriptor) ProtoMessage()               {}
+func (*EndorsementDescriptor) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *EndorsementDescriptor) GetChaincode() string {
+	if m != nil {
+		return m.Chaincode
+	}
+	return ""
+}
+
+func (m *EndorsementDescriptor) GetEndorsersByGroups() map[string]*Peers {
+	if m != nil {
+		return m.EndorsersByGroups
+	}
+	return nil
+}
+
+func (m *EndorsementDescriptor) GetLayouts() []*Layout {
+	if m != nil {
+		return m.Layouts
+	}
+	return nil
+}
+
+// Layout is a combination of groups satisfying an endorsement policy
+type Layout struct {
+	// quantities_by_group is the number of peers of each group
+	// whose endorsements are needed to satisfy the policy
+	QuantitiesByGroup map[string]uint32 `protobuf:"bytes,1,rep,name=quantities_by_group,json=quantitiesByGroup" json:"quantities_by_group,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+}
+
+func (m *Layout) Reset()                    { *m = Layout{} }
+func (m *Layout) String() string            { return proto.CompactTextString(m) }
+func (*Layout) ProtoMessage()               {}
+func (*Layout) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+func (m *Layout) GetQuantitiesByGroup() map[string]uint32 {
+	if m != nil {
+		return m.QuantitiesByGroup
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SignedRequest)(nil), "discovery.SignedRequest")
+	proto.RegisterType((*Request)(nil), "discovery.Request")
+	proto.RegisterType((*AuthInfo)(nil), "discovery.AuthInfo")
+	proto.RegisterType((*Query)(nil), "discovery.Query")
+	proto.RegisterType((*ConfigQuery)(nil), "discovery.ConfigQuery")
+	proto.RegisterType((*PeerMembershipQuery)(nil), "discovery.PeerMembershipQuery")
+	proto.RegisterType((*ChaincodeQuery)(nil), "discovery.ChaincodeQuery")
+	proto.RegisterType((*Response)(nil), "discovery.Response")
+	proto.RegisterType((*QueryResult)(nil), "discovery.QueryResult")
+	proto.RegisterType((*Error)(nil), "discovery.Error")
+	proto.RegisterType((*ConfigResult)(nil), "discovery.ConfigResult")
+	proto.RegisterType((*PeerMembershipResult)(nil), "discovery.PeerMembershipResult")
+	proto.RegisterType((*Peers)(nil), "discovery.Peers")
+	proto.RegisterType((*Peer)(nil), "discovery.Peer")
+	proto.RegisterType((*ChaincodeQueryResult)(nil), "discovery.ChaincodeQueryResult")
+	proto.RegisterType((*EndorsementDescriptor)(nil), "discovery.EndorsementDescriptor")
+	proto.RegisterType((*Layout)(nil), "discovery.Layout")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Discovery service
+
+type DiscoveryClient interface {
+	// Discover receives a signed request, and returns a response
+	// with a result for each of the queries of the request
+	Discover(ctx context.Context, in *SignedRequest, opts ...grpc.CallOption) (*Response, error)
+}
+
+type discoveryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDiscoveryClient(cc *grpc.ClientConn) DiscoveryClient {
+	return &discoveryClient{cc}
+}
+
+func (c *discoveryClient) Discover(ctx context.Context, in *SignedRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/discovery.Discovery/Discover", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Discovery service
+
+type DiscoveryServer interface {
+	// Discover receives a signed request, and returns a response
+	// with a result for each of the queries of the request
+	Discover(context.Context, *SignedRequest) (*Response, error)
+}
+
+func RegisterDiscoveryServer(s *grpc.Server, srv DiscoveryServer) {
+	s.RegisterService(&_Discovery_serviceDesc, srv)
+}
+
+func _Discovery_Discover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryServer).Discover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/discovery.Discovery/Discover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryServer).Discover(ctx, req.(*SignedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Discovery_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "discovery.Discovery",
+	HandlerType: (*DiscoveryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Discover",
+			Handler:    _Discovery_Discover_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "discovery/protocol.proto",
+}
+
+func init() { proto.RegisterFile("discovery/protocol.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 1042 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x5b, 0x6f, 0xe3, 0x44,
+	0x14, 0x6e, 0xda, 0xa6, 0x49, 0x4e, 0xd2, 0xdb, 0xf4, 0x42, 0x88, 0xd0, 0x6e, 0xd7, 0x5c, 0xb6,
+	0x02, 0xc9, 0x59, 0x8a, 0x80, 0xd5, 0x16, 0x81, 0x48, 0xbb, 0x6a, 0x2a, 0x51, 0x6d, 0xeb, 0x5d,
+	0x21, 0xc4, 0x4b, 0xe4, 0xd8, 0xa7, 0xb6, 0x55, 0xdb, 0xe3, 0xce, 0x8c, 0x57, 0xf2, 0x33, 0xff,
+	0x83, 0x17, 0x5e, 0x10, 0x12, 0x7f, 0x80, 0x5f, 0xc3, 0x4f, 0x41, 0x9e, 0x8b, 0xe3, 0xa4, 0x59,
+	0xed, 0x03, 0x6f, 0x9e, 0xef, 0x9c, 0xf3, 0x9d, 0xef, 0x5c, 0xec, 0x31, 0xf4, 0xfd, 0x88, 0x7b,
+	0xf4, 0x2d, 0xb2, 0x62, 0x98, 0x31, 0x2a, 0xa8, 0x47, 0x63, 0x5b, 0x3e, 0x90, 0x4e, 0x65, 0x19,
+	0x3c, 0x0e, 0x28, 0x0d, 0x62, 0x54, 0x1e, 0xd3, 0xfc, 0x76, 0x28, 0xa2, 0x04, 0xb9, 0x70, 0x93,
+	0x4c, 0xf9, 0x0e, 0xf6, 0x03, 0xca, 0x79, 0x94, 0x0d, 0x13, 0xe4, 0xdc, 0x0d, 0xd0, 0xa0, 0x09,
+	0xcf, 0x86, 0x09, 0xcf, 0x26, 0x1e, 0x4d, 0x6f, 0xa3, 0x40, 0xa1, 0xd6, 0x05, 0x6c, 0xbe, 0x8e,
+	0x82, 0x14, 0x7d, 0x07, 0xef, 0x73, 0xe4, 0x82, 0xf4, 0xa1, 0x95, 0xb9, 0x45, 0x4c, 0x5d, 0xbf,
+	0xdf, 0x38, 0x6a, 0x1c, 0xf7, 0x1c, 0x73, 0x24, 0x1f, 0x41, 0x87, 0x47, 0x41, 0xea, 0x8a, 0x9c,
+	0x61, 0x7f, 0x55, 0xda, 0x66, 0x80, 0xc5, 0xa0, 0x65, 0x28, 0x4e, 0x61, 0xcb, 0xcd, 0x45, 0x88,
+	0xa9, 0x88, 0x3c, 0x57, 0x44, 0x34, 0x95, 0x4c, 0xdd, 0x93, 0x3d, 0xbb, 0x2a, 0xc2, 0xfe, 0x31,
+	0x17, 0xe1, 0x65, 0x7a, 0x4b, 0x9d, 0x05, 0x57, 0xf2, 0x39, 0xb4, 0xee, 0x73, 0x64, 0x11, 0xf2,
+	0xfe, 0xea, 0xd1, 0xda, 0x71, 0xf7, 0x64, 0xa7, 0x16, 0x75, 0x93, 0x23, 0x2b, 0x1c, 0xe3, 0x60,
+	0xfd, 0xde, 0x80, 0xb6, 0x21, 0x22, 0x4f, 0x61, 0xdb, 0x8b, 0x23, 0x4c, 0xc5, 0x24, 0xf2, 0x4b,
+	0x3e, 0x51, 0xe8, 0x02, 0xb6, 0x14, 0x7c, 0xa9, 0x51, 0x32, 0x84, 0x7d, 0xed, 0x28, 0x62, 0x3e,
+	0xf1, 0x90, 0x89, 0x49, 0xe8, 0xf2, 0x50, 0x97, 0xb4, 0xab, 0x6c, 0x6f, 0x62, 0x7e, 0x86, 0x4c,
+	0x8c, 0x5d, 0x1e, 0x92, 0xe7, 0xd0, 0xa9, 0x5a, 0xdc, 0x5f, 0x93, 0xa5, 0x0c, 0x6c, 0x35, 0x04,
+	0xdb, 0x0c, 0xc1, 0x7e, 0x63, 0x3c, 0x9c, 0x99, 0xb3, 0xf5, 0x6f, 0x03, 0x9a, 0x52, 0x73, 0xd9,
+	0x56, 0x2f, 0x74, 0xd3, 0x14, 0x63, 0xa9, 0xaa, 0xe3, 0x98, 0x23, 0x39, 0x85, 0x9e, 0x9a, 0xc8,
+	0xa4, 0x2c, 0xab, 0x90, 0x32, 0xba, 0x27, 0x87, 0xb5, 0xaa, 0xcf, 0xa4, 0x59, 0xf2, 0x8c, 0x57,
+	0x9c, 0xae, 0x37, 0x3b, 0x92, 0x1f, 0x00, 0x32, 0x44, 0xa6, 0x43, 0x95, 0xb6, 0x47, 0xb5, 0xd0,
+	0x6b, 0x44, 0x76, 0x85, 0xc9, 0x14, 0x19, 0x0f, 0xa3, 0xcc, 0x50, 0x74, 0xca, 0x18, 0x45, 0xf0,
+	0x0d, 0xb4, 0x3d, 0x4f, 0x87, 0xaf, 0xcb, 0xf0, 0x0f, 0xeb, 0x99, 0x43, 0x37, 0x4a, 0x3d, 0xea,
+	0xa3, 0x89, 0x6c, 0x79, 0x9e, 0x7c, 0x1c, 0xb5, 0xa0, 0x29, 0x83, 0xac, 0x4d, 0xe8, 0xd6, 0xf4,
+	0x59, 0x07, 0xb0, 0xb7, 0x24, 0xa7, 0xf5, 0x0c, 0xb6, 0xe6, 0xb9, 0xc8, 0x23, 0x00, 0xcf, 0x20,
+	0xbc, 0xdf, 0x38, 0x5a, 0x3b, 0xee, 0x38, 0x35, 0xc4, 0xfa, 0x0e, 0xda, 0x0e, 0xf2, 0x8c, 0xa6,
+	0x1c, 0xc9, 0x33, 0x68, 0x31, 0xe4, 0x79, 0x2c, 0x94, 0xe3, 0x7c, 0x77, 0xd4, 0x4e, 0x48, 0xb3,
+	0x63, 0xdc, 0xac, 0xdf, 0x56, 0xa1, 0x5b, 0x33, 0x90, 0x63, 0x68, 0x22, 0x63, 0x94, 0xe9, 0x4d,
+	0xac, 0xef, 0xd4, 0xcb, 0x12, 0x1f, 0xaf, 0x38, 0xca, 0x81, 0x7c, 0x0f, 0x9b, 0x7a, 0x1c, 0x8a,
+	0x4b, 0xcf, 0xe3, 0x83, 0x07, 0xf3, 0x50, 0xcc, 0xe3, 0x15, 0xa7, 0xe7, 0xd5, 0xce, 0xe4, 0x14,
+	0x5a, 0x89, 0x2a, 0x5e, 0x8f, 0xe3, 0xf1, 0x3b, 0xc7, 0x51, 0x31, 0x98, 0x08, 0x72, 0x06, 0x3d,
+	0x33, 0x8d, 0x32, 0x7d, 0x7f, 0xfd, 0x01, 0xc3, 0x7c, 0x17, 0x2b, 0x06, 0xd0, 0x73, 0x71, 0x90,
+	0x8f, 0xda, 0xb0, 0xa1, 0xa4, 0x5b, 0x4f, 0xa0, 0x29, 0xab, 0x93, 0xdb, 0x47, 0x53, 0x81, 0xa9,
+	0xa8, 0xb6, 0x4f, 0x1d, 0xad, 0xbf, 0x1a, 0xd0, 0xab, 0xd7, 0x43, 0xbe, 0x86, 0xf5, 0x84, 0x67,
+	0xa6, 0xd1, 0x4f, 0xde, 0x51, 0xb6, 0x7d, 0xc5, 0x33, 0xfe, 0x32, 0x15, 0xac, 0x70, 0xa4, 0x3b,
+	0x19, 0x40, 0x9b, 0x32, 0x1f, 0x19, 0x32, 0xf5, 0xde, 0x76, 0x9c, 0xea, 0x3c, 0xb8, 0x80, 0x4e,
+	0xe5, 0x4e, 0x76, 0x60, 0xed, 0x0e, 0x0b, 0x2d, 0xa3, 0x7c, 0x24, 0x9f, 0x40, 0xf3, 0xad, 0x1b,
+	0xe7, 0xa8, 0x3b, 0xbd, 0x65, 0x27, 0x3c, 0xb3, 0xaf, 0x5e, 0x5f, 0xeb, 0x7c, 0xca, 0xf8, 0x62,
+	0xf5, 0x79, 0xc3, 0xfa, 0xa7, 0x01, 0xfb, 0xcb, 0x5a, 0x48, 0x6e, 0xa0, 0x57, 0xae, 0x34, 0x9f,
+	0x4c, 0x8b, 0x09, 0x65, 0x81, 0x16, 0x3f, 0x7c, 0x4f, 0xe7, 0x25, 0xc8, 0x47, 0xc5, 0x2b, 0x16,
+	0xa8, 0x52, 0x20, 0xab, 0x80, 0xc1, 0x2b, 0xd8, 0x5e, 0x30, 0x2f, 0x91, 0xfe, 0xd9, 0xbc, 0xf4,
+	0x9d, 0x85, 0x84, 0xbc, 0x2e, 0xde, 0x86, 0xa6, 0xc4, 0xc8, 0xa7, 0xd0, 0x94, 0x79, 0xb4, 0xca,
+	0xed, 0x85, 0x20, 0x47, 0x59, 0xad, 0xbf, 0x1b, 0xb0, 0x5e, 0x9e, 0xc9, 0x01, 0x6c, 0x94, 0x9f,
+	0xed, 0xc8, 0xd7, 0x99, 0x9b, 0x09, 0xcf, 0x2e, 0xfd, 0xb2, 0xe3, 0x98, 0xfa, 0x19, 0x8d, 0x52,
+	0xb5, 0xa3, 0x1d, 0xa7, 0x3a, 0x97, 0xb6, 0xea, 0x23, 0xb8, 0x26, 0x3f, 0x6b, 0xd5, 0x99, 0x7c,
+	0x0c, 0x9b, 0x31, 0xfa, 0x01, 0xb2, 0x49, 0x88, 0x51, 0x10, 0x0a, 0xb9, 0x64, 0xeb, 0x4e, 0x4f,
+	0x81, 0x63, 0x89, 0x91, 0x2f, 0xe7, 0xde, 0xce, 0xa6, 0x14, 0xba, 0x6b, 0xab, 0x7b, 0x65, 0xb6,
+	0x83, 0x73, 0x2f, 0xac, 0x03, 0xfb, 0xcb, 0x96, 0x93, 0xbc, 0xa8, 0xef, 0x5e, 0xc9, 0x73, 0x54,
+	0x7f, 0xf9, 0x52, 0x9f, 0x32, 0x8e, 0x09, 0xa6, 0xe2, 0x1c, 0xb9, 0xc7, 0xa2, 0x4c, 0x50, 0x36,
+	0xdb, 0xce, 0x3f, 0x56, 0xe1, 0x60, 0xa9, 0x4b, 0x79, 0x19, 0x55, 0xb9, 0x75, 0x5f, 0x66, 0x00,
+	0x09, 0x60, 0x0f, 0x55, 0x98, 0xda, 0x89, 0x80, 0xd1, 0x3c, 0x33, 0x17, 0xca, 0xb7, 0xef, 0xcb,
+	0x6f, 0xd0, 0x72, 0xf8, 0x17, 0x32, 0x52, 0xad, 0xc7, 0x2e, 0x2e, 0xe2, 0xe4, 0x0b, 0x68, 0xc5,
+	0x6e, 0x41, 0x73, 0x51, 0xbe, 0xed, 0xaa, 0x49, 0x33, 0xf2, 0x9f, 0xa4, 0xc5, 0x31, 0x1e, 0x83,
+	0x9f, 0xe1, 0x70, 0x39, 0xf3, 0xff, 0xdc, 0xac, 0x3f, 0x1b, 0xb0, 0xa1, 0x72, 0x91, 0x5f, 0x60,
+	0xef, 0x3e, 0x77, 0xcb, 0x41, 0x47, 0x38, 0xab, 0x5c, 0x37, 0xfe, 0xf8, 0x81, 0x36, 0xfb, 0xa6,
+	0x72, 0xd6, 0x82, 0x74, 0xa5, 0xf7, 0x8b, 0xf8, 0xe0, 0x1c, 0x0e, 0x97, 0x3b, 0x2f, 0x11, 0xbf,
+	0x5f, 0x17, 0xbf, 0x59, 0x93, 0x7a, 0x32, 0x86, 0xce, 0xb9, 0xd1, 0x40, 0x4e, 0xa1, 0x6d, 0x0e,
+	0xa4, 0x5f, 0xd3, 0x36, 0xf7, 0x43, 0x32, 0xa8, 0xff, 0x35, 0x98, 0x1b, 0xc1, 0x5a, 0x19, 0xdd,
+	0xc1, 0x53, 0xca, 0x02, 0x3b, 0x2c, 0x32, 0x64, 0x6a, 0x75, 0xed, 0x5b, 0x77, 0xca, 0x22, 0x4f,
+	0x5d, 0xc9, 0x7c, 0x16, 0x35, 0xda, 0xa9, 0x52, 0x5e, 0xbb, 0xde, 0x9d, 0x1b, 0xe0, 0xaf, 0x76,
+	0x10, 0x89, 0x30, 0x9f, 0xda, 0x1e, 0x4d, 0x86, 0x35, 0x86, 0xa1, 0x62, 0x50, 0x7f, 0x56, 0x7c,
+	0x58, 0x31, 0x4c, 0x37, 0x24, 0xf2, 0xd5, 0x7f, 0x03, 0x00, 0x2d, 0x49, 0x64, 0xe1, 0xa0, 0x09,
+	0x00, 0x00,
+}
@@ -14,6 +14,7 @@ It has these top-level messages:
 	GossipMessage
 	StateInfo
 	Properties
+	Chaincode
 	StateInfoSnapshot
 	StateInfoPullRequest
 	ConnEstablish
@@ -64,6 +65,32 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// CompressionAlgorithm denotes the algorithm
+// a payload of an envelope is compressed with
+type CompressionAlgorithm int32
+
+const (
+	CompressionAlgorithm_NO_COMPRESSION CompressionAlgorithm = 0
+	CompressionAlgorithm_SNAPPY         CompressionAlgorithm = 1
+	CompressionAlgorithm_GZIP           CompressionAlgorithm = 2
+)
+
+var CompressionAlgorithm_name = map[int32]string{
+	0: "NO_COMPRESSION",
+	1: "SNAPPY",
+	2: "GZIP",
+}
+var CompressionAlgorithm_value = map[string]int32{
+	"NO_COMPRESSION": 0,
+	"SNAPPY":         1,
+	"GZIP":           2,
+}
+
+func (x CompressionAlgorithm) String() string {
+	return proto.EnumName(CompressionAlgorithm_name, int32(x))
+}
+func (CompressionAlgorithm) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
 type PullMsgType int32
 
 const (
@@ -86,7 +113,7 @@ var PullMsgType_value = map[string]int32{
 func (x PullMsgType) String() string {
 	return proto.EnumName(PullMsgType_name, int32(x))
 }
-func (PullMsgType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+func (PullMsgType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
 
 type GossipMessage_Tag int32
 
@@ -129,6 +156,15 @@ type Envelope struct {
 	Payload        []byte          `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
 	Signature      []byte          `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 	SecretEnvelope *SecretEnvelope `protobuf:"bytes,3,opt,name=secret_envelope,json=secretEnvelope" json:"secret_envelope,omitempty"`
+	// compression is the algorithm the payload
+	// is compressed with. The signature is over
+	// the uncompressed payload
+	Compression CompressionAlgorithm `protobuf:"varint,4,opt,name=compression,enum=gossip.CompressionAlgorithm" json:"compression,omitempty"`
+	// batch carries envelopes that are sent to
+	// a peer together in a single message.
+	// An envelope that carries a batch has no
+	// payload and signature of its own
+	Batch []*Envelope `protobuf:"bytes,5,rep,name=batch" json:"batch,omitempty"`
 }
 
 func (m *Envelope) Reset()                    { *m = Envelope{} }
@@ -157,6 +193,20 @@ func (m *Envelope) GetSecretEnvelope() *SecretEnvelope {
 	return nil
 }
 
+func (m *Envelope) GetCompression() CompressionAlgorithm {
+	if m != nil {
+		return m.Compression
+	}
+	return CompressionAlgorithm_NO_COMPRESSION
+}
+
+func (m *Envelope) GetBatch() []*Envelope {
+	if m != nil {
+		return m.Batch
+	}
+	return nil
+}
+
 // SecretEnvelope is a marshalled Secret
 // and a signature over it.
 // The signature should be validated by the peer
@@ -1073,6 +1123,16 @@ func (m *StateInfo) GetProperties() *Properties {
 type Properties struct {
 	LedgerHeight uint64 `protobuf:"varint,1,opt,name=ledger_height,json=ledgerHeight" json:"ledger_height,omitempty"`
 	LeftChannel  bool   `protobuf:"varint,2,opt,name=left_channel,json=leftChannel" json:"left_channel,omitempty"`
+	// chaincodes installed on the peer and instantiated on the channel
+	Chaincodes []*Chaincode `protobuf:"bytes,3,rep,name=chaincodes" json:"chaincodes,omitempty"`
+	// chaincodes instantiated on the channel
+	InstantiatedChaincodes []*Chaincode `protobuf:"bytes,4,rep,name=instantiated_chaincodes,json=instantiatedChaincodes" json:"instantiated_chaincodes,omitempty"`
+	// whether the peer is a leader that pulls
+	// blocks of the channel from the ordering service
+	Leader bool `protobuf:"varint,5,opt,name=leader" json:"leader,omitempty"`
+	// whether the peer is pulling missing blocks
+	// of the channel from other peers
+	CatchingUp bool `protobuf:"varint,6,opt,name=catching_up,json=catchingUp" json:"catching_up,omitempty"`
 }
 
 func (m *Properties) Reset()                    { *m = Properties{} }
@@ -1094,6 +1154,60 @@ func (m *Properties) GetLeftChannel() bool {
 	return false
 }
 
+func (m *Properties) GetChaincodes() []*Chaincode {
+	if m != nil {
+		return m.Chaincodes
+	}
+	return nil
+}
+
+func (m *Properties) GetInstantiatedChaincodes() []*Chaincode {
+	if m != nil {
+		return m.InstantiatedChaincodes
+	}
+	return nil
+}
+
+func (m *Properties) GetLeader() bool {
+	if m != nil {
+		return m.Leader
+	}
+	return false
+}
+
+func (m *Properties) GetCatchingUp() bool {
+	if m != nil {
+		return m.CatchingUp
+	}
+	return false
+}
+
+// Chaincode represents a chaincode installed on a peer,
+// or instantiated on a channel
+type Chaincode struct {
+	Name    string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Version string `protobuf:"bytes,2,opt,name=version" json:"version,omitempty"`
+}
+
+func (m *Chaincode) Reset()                    { *m = Chaincode{} }
+func (m *Chaincode) String() string            { return proto.CompactTextString(m) }
+func (*Chaincode) ProtoMessage()               {}
+func (*Chaincode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *Chaincode) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Chaincode) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
 // StateInfoSnapshot is an aggregation of StateInfo messages
 type StateInfoSnapshot struct {
 	Elements []*Envelope `protobuf:"bytes,1,rep,name=elements" json:"elements,omitempty"`
@@ -1102,7 +1216,7 @@ type StateInfoSnapshot struct {
 func (m *StateInfoSnapshot) Reset()                    { *m = StateInfoSnapshot{} }
 func (m *StateInfoSnapshot) String() string            { return proto.CompactTextString(m) }
 func (*StateInfoSnapshot) ProtoMessage()               {}
-func (*StateInfoSnapshot) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*StateInfoSnapshot) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 func (m *StateInfoSnapshot) GetElements() []*Envelope {
 	if m != nil {
@@ -1123,7 +1237,7 @@ type StateInfoPullRequest struct {
 func (m *StateInfoPullRequest) Reset()                    { *m = StateInfoPullRequest{} }
 func (m *StateInfoPullRequest) String() string            { return proto.CompactTextString(m) }
 func (*StateInfoPullRequest) ProtoMessage()               {}
-func (*StateInfoPullRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*StateInfoPullRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 func (m *StateInfoPullRequest) GetChannel_MAC() []byte {
 	if m != nil {
@@ -1139,12 +1253,21 @@ type ConnEstablish struct {
 	PkiId       []byte `protobuf:"bytes,1,opt,name=pki_id,json=pkiId,proto3" json:"pki_id,omitempty"`
 	Identity    []byte `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
 	TlsCertHash []byte `protobuf:"bytes,3,opt,name=tls_cert_hash,json=tlsCertHash,proto3" json:"tls_cert_hash,omitempty"`
+	// compression lists the compression algorithms
+	// the peer is able to decompress payloads with
+	Compression []CompressionAlgorithm `protobuf:"varint,4,rep,packed,name=compression,enum=gossip.CompressionAlgorithm" json:"compression,omitempty"`
+	// batching is whether the peer sends batches,
+	// and is willing to receive them
+	Batching bool `protobuf:"varint,5,opt,name=batching" json:"batching,omitempty"`
+	// max_batch_size is the maximum number of envelopes
+	// a batch received by the peer may carry
+	MaxBatchSize uint32 `protobuf:"varint,6,opt,name=max_batch_size,json=maxBatchSize" json:"max_batch_size,omitempty"`
 }
 
 func (m *ConnEstablish) Reset()                    { *m = ConnEstablish{} }
 func (m *ConnEstablish) String() string            { return proto.CompactTextString(m) }
 func (*ConnEstablish) ProtoMessage()               {}
-func (*ConnEstablish) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*ConnEstablish) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *ConnEstablish) GetPkiId() []byte {
 	if m != nil {
@@ -1167,6 +1290,27 @@ func (m *ConnEstablish) GetTlsCertHash() []byte {
 	return nil
 }
 
+func (m *ConnEstablish) GetCompression() []CompressionAlgorithm {
+	if m != nil {
+		return m.Compression
+	}
+	return nil
+}
+
+func (m *ConnEstablish) GetBatching() bool {
+	if m != nil {
+		return m.Batching
+	}
+	return false
+}
+
+func (m *ConnEstablish) GetMaxBatchSize() uint32 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
 // PeerIdentity defines the identity of the peer
 // Used to make other peers learn of the identity
 // of a certain peer
@@ -1179,7 +1323,7 @@ type PeerIdentity struct {
 func (m *PeerIdentity) Reset()                    { *m = PeerIdentity{} }
 func (m *PeerIdentity) String() string            { return proto.CompactTextString(m) }
 func (*PeerIdentity) ProtoMessage()               {}
-func (*PeerIdentity) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*PeerIdentity) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
 func (m *PeerIdentity) GetPkiId() []byte {
 	if m != nil {
@@ -1213,7 +1357,7 @@ type DataRequest struct {
 func (m *DataRequest) Reset()                    { *m = DataRequest{} }
 func (m *DataRequest) String() string            { return proto.CompactTextString(m) }
 func (*DataRequest) ProtoMessage()               {}
-func (*DataRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*DataRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 func (m *DataRequest) GetNonce() uint64 {
 	if m != nil {
@@ -1247,7 +1391,7 @@ type GossipHello struct {
 func (m *GossipHello) Reset()                    { *m = GossipHello{} }
 func (m *GossipHello) String() string            { return proto.CompactTextString(m) }
 func (*GossipHello) ProtoMessage()               {}
-func (*GossipHello) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*GossipHello) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 func (m *GossipHello) GetNonce() uint64 {
 	if m != nil {
@@ -1281,7 +1425,7 @@ type DataUpdate struct {
 func (m *DataUpdate) Reset()                    { *m = DataUpdate{} }
 func (m *DataUpdate) String() string            { return proto.CompactTextString(m) }
 func (*DataUpdate) ProtoMessage()               {}
-func (*DataUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*DataUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
 func (m *DataUpdate) GetNonce() uint64 {
 	if m != nil {
@@ -1315,7 +1459,7 @@ type DataDigest struct {
 func (m *DataDigest) Reset()                    { *m = DataDigest{} }
 func (m *DataDigest) String() string            { return proto.CompactTextString(m) }
 func (*DataDigest) ProtoMessage()               {}
-func (*DataDigest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*DataDigest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 func (m *DataDigest) GetNonce() uint64 {
 	if m != nil {
@@ -1346,7 +1490,7 @@ type DataMessage struct {
 func (m *DataMessage) Reset()                    { *m = DataMessage{} }
 func (m *DataMessage) String() string            { return proto.CompactTextString(m) }
 func (*DataMessage) ProtoMessage()               {}
-func (*DataMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*DataMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 func (m *DataMessage) GetPayload() *Payload {
 	if m != nil {
@@ -1365,7 +1509,7 @@ type PrivateDataMessage struct {
 func (m *PrivateDataMessage) Reset()                    { *m = PrivateDataMessage{} }
 func (m *PrivateDataMessage) String() string            { return proto.CompactTextString(m) }
 func (*PrivateDataMessage) ProtoMessage()               {}
-func (*PrivateDataMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*PrivateDataMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 func (m *PrivateDataMessage) GetPayload() *PrivatePayload {
 	if m != nil {
@@ -1384,7 +1528,7 @@ type Payload struct {
 func (m *Payload) Reset()                    { *m = Payload{} }
 func (m *Payload) String() string            { return proto.CompactTextString(m) }
 func (*Payload) ProtoMessage()               {}
-func (*Payload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*Payload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *Payload) GetSeqNum() uint64 {
 	if m != nil {
@@ -1420,7 +1564,7 @@ type PrivatePayload struct {
 func (m *PrivatePayload) Reset()                    { *m = PrivatePayload{} }
 func (m *PrivatePayload) String() string            { return proto.CompactTextString(m) }
 func (*PrivatePayload) ProtoMessage()               {}
-func (*PrivatePayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*PrivatePayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 func (m *PrivatePayload) GetCollectionName() string {
 	if m != nil {
@@ -1461,7 +1605,7 @@ type AliveMessage struct {
 func (m *AliveMessage) Reset()                    { *m = AliveMessage{} }
 func (m *AliveMessage) String() string            { return proto.CompactTextString(m) }
 func (*AliveMessage) ProtoMessage()               {}
-func (*AliveMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*AliveMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 func (m *AliveMessage) GetMembership() *Member {
 	if m != nil {
@@ -1490,12 +1634,20 @@ type LeadershipMessage struct {
 	PkiId         []byte    `protobuf:"bytes,1,opt,name=pki_id,json=pkiId,proto3" json:"pki_id,omitempty"`
 	Timestamp     *PeerTime `protobuf:"bytes,2,opt,name=timestamp" json:"timestamp,omitempty"`
 	IsDeclaration bool      `protobuf:"varint,3,opt,name=is_declaration,json=isDeclaration" json:"is_declaration,omitempty"`
+	// priority is the leadership priority configured for the peer,
+	// peers with a higher priority are preferred as leaders
+	Priority uint32 `protobuf:"varint,4,opt,name=priority" json:"priority,omitempty"`
+	// ledger_height is the height of the peer's ledger of the channel
+	LedgerHeight uint64 `protobuf:"varint,5,opt,name=ledger_height,json=ledgerHeight" json:"ledger_height,omitempty"`
+	// deliver_unhealthy is set when the peer fails receiving
+	// blocks from the ordering service
+	DeliverUnhealthy bool `protobuf:"varint,6,opt,name=deliver_unhealthy,json=deliverUnhealthy" json:"deliver_unhealthy,omitempty"`
 }
 
 func (m *LeadershipMessage) Reset()                    { *m = LeadershipMessage{} }
 func (m *LeadershipMessage) String() string            { return proto.CompactTextString(m) }
 func (*LeadershipMessage) ProtoMessage()               {}
-func (*LeadershipMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*LeadershipMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 func (m *LeadershipMessage) GetPkiId() []byte {
 	if m != nil {
@@ -1518,6 +1670,27 @@ func (m *LeadershipMessage) GetIsDeclaration() bool {
 	return false
 }
 
+func (m *LeadershipMessage) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *LeadershipMessage) GetLedgerHeight() uint64 {
+	if m != nil {
+		return m.LedgerHeight
+	}
+	return 0
+}
+
+func (m *LeadershipMessage) GetDeliverUnhealthy() bool {
+	if m != nil {
+		return m.DeliverUnhealthy
+	}
+	return false
+}
+
 // PeerTime defines the logical time of a peer's life
 type PeerTime struct {
 	IncNum uint64 `protobuf:"varint,1,opt,name=inc_num,json=incNum" json:"inc_num,omitempty"`
@@ -1527,7 +1700,7 @@ type PeerTime struct {
 func (m *PeerTime) Reset()                    { *m = PeerTime{} }
 func (m *PeerTime) String() string            { return proto.CompactTextString(m) }
 func (*PeerTime) ProtoMessage()               {}
-func (*PeerTime) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*PeerTime) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *PeerTime) GetIncNum() uint64 {
 	if m != nil {
@@ -1553,7 +1726,7 @@ type MembershipRequest struct {
 func (m *MembershipRequest) Reset()                    { *m = MembershipRequest{} }
 func (m *MembershipRequest) String() string            { return proto.CompactTextString(m) }
 func (*MembershipRequest) ProtoMessage()               {}
-func (*MembershipRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*MembershipRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *MembershipRequest) GetSelfInformation() *Envelope {
 	if m != nil {
@@ -1578,7 +1751,7 @@ type MembershipResponse struct {
 func (m *MembershipResponse) Reset()                    { *m = MembershipResponse{} }
 func (m *MembershipResponse) String() string            { return proto.CompactTextString(m) }
 func (*MembershipResponse) ProtoMessage()               {}
-func (*MembershipResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*MembershipResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *MembershipResponse) GetAlive() []*Envelope {
 	if m != nil {
@@ -1605,7 +1778,7 @@ type Member struct {
 func (m *Member) Reset()                    { *m = Member{} }
 func (m *Member) String() string            { return proto.CompactTextString(m) }
 func (*Member) ProtoMessage()               {}
-func (*Member) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*Member) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *Member) GetEndpoint() string {
 	if m != nil {
@@ -1635,7 +1808,7 @@ type Empty struct {
 func (m *Empty) Reset()                    { *m = Empty{} }
 func (m *Empty) String() string            { return proto.CompactTextString(m) }
 func (*Empty) ProtoMessage()               {}
-func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 // RemoteStateRequest is used to ask a set of blocks
 // from a remote peer
@@ -1647,7 +1820,7 @@ type RemoteStateRequest struct {
 func (m *RemoteStateRequest) Reset()                    { *m = RemoteStateRequest{} }
 func (m *RemoteStateRequest) String() string            { return proto.CompactTextString(m) }
 func (*RemoteStateRequest) ProtoMessage()               {}
-func (*RemoteStateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*RemoteStateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func (m *RemoteStateRequest) GetStartSeqNum() uint64 {
 	if m != nil {
@@ -1672,7 +1845,7 @@ type RemoteStateResponse struct {
 func (m *RemoteStateResponse) Reset()                    { *m = RemoteStateResponse{} }
 func (m *RemoteStateResponse) String() string            { return proto.CompactTextString(m) }
 func (*RemoteStateResponse) ProtoMessage()               {}
-func (*RemoteStateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*RemoteStateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
 func (m *RemoteStateResponse) GetPayloads() []*Payload {
 	if m != nil {
@@ -1690,7 +1863,7 @@ type RemotePvtDataRequest struct {
 func (m *RemotePvtDataRequest) Reset()                    { *m = RemotePvtDataRequest{} }
 func (m *RemotePvtDataRequest) String() string            { return proto.CompactTextString(m) }
 func (*RemotePvtDataRequest) ProtoMessage()               {}
-func (*RemotePvtDataRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*RemotePvtDataRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
 func (m *RemotePvtDataRequest) GetDigests() []*PvtDataDigest {
 	if m != nil {
@@ -1711,7 +1884,7 @@ type PvtDataDigest struct {
 func (m *PvtDataDigest) Reset()                    { *m = PvtDataDigest{} }
 func (m *PvtDataDigest) String() string            { return proto.CompactTextString(m) }
 func (*PvtDataDigest) ProtoMessage()               {}
-func (*PvtDataDigest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*PvtDataDigest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
 
 func (m *PvtDataDigest) GetTxId() string {
 	if m != nil {
@@ -1757,7 +1930,7 @@ type RemotePvtDataResponse struct {
 func (m *RemotePvtDataResponse) Reset()                    { *m = RemotePvtDataResponse{} }
 func (m *RemotePvtDataResponse) String() string            { return proto.CompactTextString(m) }
 func (*RemotePvtDataResponse) ProtoMessage()               {}
-func (*RemotePvtDataResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*RemotePvtDataResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
 
 func (m *RemotePvtDataResponse) GetElements() []*PvtDataElement {
 	if m != nil {
@@ -1775,7 +1948,7 @@ type PvtDataElement struct {
 func (m *PvtDataElement) Reset()                    { *m = PvtDataElement{} }
 func (m *PvtDataElement) String() string            { return proto.CompactTextString(m) }
 func (*PvtDataElement) ProtoMessage()               {}
-func (*PvtDataElement) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*PvtDataElement) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
 
 func (m *PvtDataElement) GetDigest() *PvtDataDigest {
 	if m != nil {
@@ -1803,7 +1976,7 @@ type PvtDataPayload struct {
 func (m *PvtDataPayload) Reset()                    { *m = PvtDataPayload{} }
 func (m *PvtDataPayload) String() string            { return proto.CompactTextString(m) }
 func (*PvtDataPayload) ProtoMessage()               {}
-func (*PvtDataPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*PvtDataPayload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
 
 func (m *PvtDataPayload) GetTxSeqInBlock() uint64 {
 	if m != nil {
@@ -1826,7 +1999,7 @@ type Acknowledgement struct {
 func (m *Acknowledgement) Reset()                    { *m = Acknowledgement{} }
 func (m *Acknowledgement) String() string            { return proto.CompactTextString(m) }
 func (*Acknowledgement) ProtoMessage()               {}
-func (*Acknowledgement) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (*Acknowledgement) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
 
 func (m *Acknowledgement) GetError() string {
 	if m != nil {
@@ -1842,6 +2015,7 @@ func init() {
 	proto.RegisterType((*GossipMessage)(nil), "gossip.GossipMessage")
 	proto.RegisterType((*StateInfo)(nil), "gossip.StateInfo")
 	proto.RegisterType((*Properties)(nil), "gossip.Properties")
+	proto.RegisterType((*Chaincode)(nil), "gossip.Chaincode")
 	proto.RegisterType((*StateInfoSnapshot)(nil), "gossip.StateInfoSnapshot")
 	proto.RegisterType((*StateInfoPullRequest)(nil), "gossip.StateInfoPullRequest")
 	proto.RegisterType((*ConnEstablish)(nil), "gossip.ConnEstablish")
@@ -1869,6 +2043,7 @@ func init() {
 	proto.RegisterType((*PvtDataElement)(nil), "gossip.PvtDataElement")
 	proto.RegisterType((*PvtDataPayload)(nil), "gossip.PvtDataPayload")
 	proto.RegisterType((*Acknowledgement)(nil), "gossip.Acknowledgement")
+	proto.RegisterEnum("gossip.CompressionAlgorithm", CompressionAlgorithm_name, CompressionAlgorithm_value)
 	proto.RegisterEnum("gossip.PullMsgType", PullMsgType_name, PullMsgType_value)
 	proto.RegisterEnum("gossip.GossipMessage_Tag", GossipMessage_Tag_name, GossipMessage_Tag_value)
 }
@@ -2018,116 +2193,133 @@ var _Gossip_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("gossip/message.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1773 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdb, 0x6e, 0xe3, 0xc6,
-	0x19, 0x16, 0xad, 0x23, 0x7f, 0x1d, 0x2c, 0x8f, 0xbd, 0xbb, 0x8c, 0x93, 0xa6, 0x0e, 0xdb, 0x4d,
-	0xb6, 0x75, 0x62, 0x2f, 0x9c, 0x16, 0x0d, 0x90, 0xb6, 0x0b, 0xdb, 0x52, 0x2c, 0x21, 0x2b, 0xaf,
-	0x4b, 0x7b, 0xd1, 0xba, 0x37, 0xc4, 0x98, 0x1c, 0x53, 0xac, 0xc9, 0x21, 0xcd, 0x19, 0x6f, 0xec,
-	0xcb, 0xa2, 0x17, 0x05, 0x7a, 0xd7, 0x47, 0xe8, 0xb3, 0xf4, 0xc5, 0x8a, 0x99, 0xe1, 0x51, 0xb2,
-	0x16, 0xd8, 0x05, 0x7a, 0xa7, 0xff, 0x3c, 0xf3, 0xcd, 0x7f, 0xa2, 0x60, 0xcb, 0x8b, 0x18, 0xf3,
-	0xe3, 0xfd, 0x90, 0x30, 0x86, 0x3d, 0xb2, 0x17, 0x27, 0x11, 0x8f, 0x50, 0x4b, 0x71, 0xcd, 0x7f,
-	0x68, 0xd0, 0x19, 0xd3, 0x77, 0x24, 0x88, 0x62, 0x82, 0x0c, 0x68, 0xc7, 0xf8, 0x21, 0x88, 0xb0,
-	0x6b, 0x68, 0x3b, 0xda, 0x8b, 0x9e, 0x95, 0x91, 0xe8, 0x33, 0xd0, 0x99, 0xef, 0x51, 0xcc, 0xef,
-	0x12, 0x62, 0xac, 0x49, 0x59, 0xc1, 0x40, 0xaf, 0x60, 0x9d, 0x11, 0x27, 0x21, 0xdc, 0x26, 0xa9,
-	0x2b, 0xa3, 0xbe, 0xa3, 0xbd, 0xe8, 0x1e, 0x3c, 0xdd, 0x53, 0x61, 0xf6, 0xce, 0xa5, 0x38, 0x0b,
-	0x64, 0x0d, 0x58, 0x85, 0x36, 0x27, 0x30, 0xa8, 0x6a, 0x7c, 0xec, 0x51, 0xcc, 0x43, 0x68, 0x29,
-	0x4f, 0xe8, 0x6b, 0x18, 0xfa, 0x94, 0x93, 0x84, 0xe2, 0x60, 0x4c, 0xdd, 0x38, 0xf2, 0x29, 0x97,
-	0xae, 0xf4, 0x49, 0xcd, 0x5a, 0x92, 0x1c, 0xe9, 0xd0, 0x76, 0x22, 0xca, 0x09, 0xe5, 0xe6, 0x3f,
-	0xbb, 0xd0, 0x3f, 0x91, 0xc7, 0x9e, 0x29, 0xc8, 0xd0, 0x16, 0x34, 0x69, 0x44, 0x1d, 0x22, 0xed,
-	0x1b, 0x96, 0x22, 0xc4, 0x11, 0x9d, 0x39, 0xa6, 0x94, 0x04, 0xe9, 0x31, 0x32, 0x12, 0xed, 0x42,
-	0x9d, 0x63, 0x4f, 0x62, 0x30, 0x38, 0xf8, 0x24, 0xc3, 0xa0, 0xe2, 0x73, 0xef, 0x02, 0x7b, 0x96,
-	0xd0, 0x42, 0xdf, 0x82, 0x8e, 0x03, 0xff, 0x1d, 0xb1, 0x43, 0xe6, 0x19, 0x4d, 0x09, 0xdb, 0x56,
-	0x66, 0x72, 0x28, 0x04, 0xa9, 0xc5, 0xa4, 0x66, 0x75, 0xa4, 0xe2, 0x8c, 0x79, 0xe8, 0x37, 0xd0,
-	0x0e, 0x49, 0x68, 0x27, 0xe4, 0xd6, 0x68, 0x49, 0x93, 0x3c, 0xca, 0x8c, 0x84, 0x57, 0x24, 0x61,
-	0x73, 0x3f, 0xb6, 0xc8, 0xed, 0x1d, 0x61, 0x7c, 0x52, 0xb3, 0x5a, 0x21, 0x09, 0x2d, 0x72, 0x8b,
-	0x7e, 0x9b, 0x59, 0x31, 0xa3, 0x2d, 0xad, 0xb6, 0x1f, 0xb3, 0x62, 0x71, 0x44, 0x19, 0xc9, 0xcd,
-	0x18, 0x7a, 0x09, 0x1d, 0x17, 0x73, 0x2c, 0x0f, 0xd8, 0x91, 0x76, 0x9b, 0x99, 0xdd, 0x08, 0x73,
-	0x5c, 0x9c, 0xaf, 0x2d, 0xd4, 0xc4, 0xf1, 0x76, 0xa1, 0x39, 0x27, 0x41, 0x10, 0x19, 0x7a, 0x55,
-	0x5d, 0x41, 0x30, 0x11, 0xa2, 0x49, 0xcd, 0x52, 0x3a, 0x68, 0x3f, 0x75, 0xef, 0xfa, 0x9e, 0x01,
-	0x52, 0x1f, 0x95, 0xdd, 0x8f, 0x7c, 0x4f, 0xdd, 0x42, 0x7a, 0x1f, 0xf9, 0x5e, 0x7e, 0x1e, 0x71,
-	0xfb, 0xee, 0xf2, 0x79, 0x8a, 0x7b, 0x4b, 0x0b, 0x75, 0xf1, 0xae, 0xb4, 0xb8, 0x8b, 0x5d, 0xcc,
-	0x89, 0xd1, 0x5b, 0x8e, 0xf2, 0x56, 0x4a, 0x26, 0x35, 0x0b, 0xdc, 0x9c, 0x42, 0xcf, 0xa1, 0x49,
-	0xc2, 0x98, 0x3f, 0x18, 0x7d, 0x69, 0xd0, 0xcf, 0x0c, 0xc6, 0x82, 0x29, 0x2e, 0x20, 0xa5, 0x68,
-	0x17, 0x1a, 0x4e, 0x44, 0xa9, 0x31, 0x90, 0x5a, 0x4f, 0x32, 0xad, 0xe3, 0x88, 0xd2, 0x31, 0xe3,
-	0xf8, 0x2a, 0xf0, 0xd9, 0x7c, 0x52, 0xb3, 0xa4, 0x12, 0x3a, 0x00, 0x60, 0x1c, 0x73, 0x62, 0xfb,
-	0xf4, 0x3a, 0x32, 0xd6, 0xa5, 0xc9, 0x46, 0x5e, 0x26, 0x42, 0x32, 0xa5, 0xd7, 0x02, 0x1d, 0x9d,
-	0x65, 0x04, 0x3a, 0x82, 0x81, 0xb2, 0x61, 0x14, 0xc7, 0x6c, 0x1e, 0x71, 0x63, 0x58, 0x7d, 0xf4,
-	0xdc, 0xee, 0x3c, 0x55, 0x98, 0xd4, 0xac, 0xbe, 0x34, 0xc9, 0x18, 0x68, 0x06, 0x9b, 0x45, 0x5c,
-	0x3b, 0xbe, 0x0b, 0x02, 0x89, 0xdf, 0x86, 0x74, 0xf4, 0xd9, 0x92, 0xa3, 0xb3, 0xbb, 0x20, 0x28,
-	0x80, 0x1c, 0xb2, 0x05, 0x3e, 0x3a, 0x04, 0xe5, 0x5f, 0x38, 0x11, 0x4a, 0x06, 0xaa, 0x26, 0x94,
-	0x45, 0xc2, 0x88, 0x13, 0xe9, 0xae, 0x70, 0xd3, 0x63, 0x25, 0x1a, 0x8d, 0xb2, 0x5b, 0x25, 0x69,
-	0xca, 0x19, 0x9b, 0xd2, 0xc7, 0xa7, 0x8f, 0xfa, 0xc8, 0xb3, 0xb2, 0xcf, 0xca, 0x0c, 0x81, 0x4d,
-	0x40, 0xb0, 0xab, 0x92, 0x57, 0xa6, 0xe8, 0x56, 0x15, 0x9b, 0xd7, 0xb9, 0xb4, 0x48, 0xd4, 0x7e,
-	0x61, 0x22, 0xd2, 0xf5, 0x7b, 0xe8, 0xc7, 0x84, 0x24, 0xb6, 0xef, 0x12, 0xca, 0x7d, 0xfe, 0x60,
-	0x3c, 0xa9, 0x96, 0xe1, 0x19, 0x21, 0xc9, 0x34, 0x95, 0x89, 0x6b, 0xc4, 0x25, 0x5a, 0x14, 0x3b,
-	0x76, 0x6e, 0x8c, 0xa7, 0xd2, 0xe4, 0x59, 0x5e, 0xb9, 0xce, 0x0d, 0x8d, 0x7e, 0x0a, 0x88, 0xeb,
-	0x91, 0x90, 0x50, 0x71, 0x79, 0xa1, 0x85, 0xfe, 0x08, 0x10, 0x27, 0xfe, 0x3b, 0x85, 0x82, 0xf1,
-	0xac, 0x0a, 0xbe, 0xba, 0xef, 0xd9, 0x3b, 0x5e, 0xcd, 0xe2, 0x92, 0x05, 0x7a, 0x55, 0xb2, 0x67,
-	0x86, 0x21, 0xed, 0x7f, 0xb6, 0xc2, 0x3e, 0x47, 0xac, 0x64, 0x82, 0x5e, 0x41, 0x2f, 0xa5, 0x6c,
-	0x91, 0xe8, 0xc6, 0x27, 0xd5, 0x67, 0x3b, 0x53, 0xb2, 0x6a, 0x59, 0x77, 0xe3, 0x82, 0x6b, 0xda,
-	0x50, 0xbf, 0xc0, 0x1e, 0xea, 0x83, 0xfe, 0xf6, 0x74, 0x34, 0xfe, 0x61, 0x7a, 0x3a, 0x1e, 0x0d,
-	0x6b, 0x48, 0x87, 0xe6, 0x78, 0x76, 0x76, 0x71, 0x39, 0xd4, 0x50, 0x0f, 0x3a, 0x6f, 0xac, 0x13,
-	0xfb, 0xcd, 0xe9, 0xeb, 0xcb, 0xe1, 0x9a, 0xd0, 0x3b, 0x9e, 0x1c, 0x9e, 0x2a, 0xb2, 0x8e, 0x86,
-	0xd0, 0x93, 0xe4, 0xe1, 0xe9, 0xc8, 0x7e, 0x63, 0x9d, 0x0c, 0x1b, 0x68, 0x1d, 0xba, 0x4a, 0xc1,
-	0x92, 0x8c, 0x66, 0xb9, 0x13, 0xff, 0x57, 0x03, 0x3d, 0xcf, 0x48, 0xb4, 0x0d, 0x9d, 0x90, 0x70,
-	0x2c, 0x8f, 0xad, 0x66, 0x42, 0x4e, 0xa3, 0x3d, 0xd0, 0xb9, 0x1f, 0x12, 0xc6, 0x71, 0x18, 0xcb,
-	0x6e, 0xdc, 0x3d, 0x18, 0x96, 0x5f, 0xef, 0xc2, 0x0f, 0x89, 0x55, 0xa8, 0xa0, 0x27, 0xd0, 0x8a,
-	0x6f, 0x7c, 0xdb, 0x77, 0x65, 0x93, 0xee, 0x59, 0xcd, 0xf8, 0xc6, 0x9f, 0xba, 0xe8, 0xe7, 0xd0,
-	0x4d, 0x7b, 0xb8, 0x3d, 0x3b, 0x3c, 0x36, 0x1a, 0x52, 0x06, 0x29, 0x6b, 0x76, 0x78, 0x2c, 0xaa,
-	0x37, 0x4e, 0xa2, 0x98, 0x24, 0xdc, 0x27, 0x2c, 0xed, 0xd6, 0xa8, 0x00, 0x2f, 0x93, 0x58, 0x25,
-	0x2d, 0xf3, 0x02, 0xa0, 0x90, 0xa0, 0x5f, 0x40, 0x5f, 0x66, 0x45, 0x62, 0xcf, 0x89, 0xef, 0xcd,
-	0x79, 0x3a, 0x53, 0x7a, 0x8a, 0x39, 0x91, 0x3c, 0xf4, 0x05, 0xf4, 0x02, 0x72, 0xcd, 0xed, 0xf2,
-	0x7c, 0xe9, 0x58, 0x5d, 0xc1, 0x3b, 0x56, 0x2c, 0xf3, 0x10, 0x36, 0x96, 0xaa, 0x1e, 0x7d, 0x0d,
-	0x1d, 0x12, 0xc8, 0x84, 0x63, 0x86, 0xb6, 0x53, 0x2f, 0xa3, 0x90, 0xcf, 0xde, 0x5c, 0xc3, 0xfc,
-	0x1d, 0x6c, 0x3d, 0x56, 0xef, 0x8b, 0x28, 0x68, 0x8b, 0x28, 0x98, 0xd7, 0xd0, 0xaf, 0x34, 0xb7,
-	0x12, 0x9c, 0x5a, 0x19, 0xce, 0x6d, 0xe8, 0xe4, 0x25, 0xa5, 0x46, 0x64, 0x4e, 0x23, 0x13, 0xfa,
-	0x3c, 0x60, 0xb6, 0x43, 0x12, 0x6e, 0xcf, 0x31, 0x9b, 0xa7, 0x0f, 0xd1, 0xe5, 0x01, 0x3b, 0x26,
-	0x09, 0x9f, 0x60, 0x36, 0x37, 0xdf, 0x42, 0xaf, 0x5c, 0x7a, 0xab, 0xc2, 0x20, 0x68, 0x08, 0x37,
-	0x69, 0x08, 0xf9, 0xbb, 0x92, 0x2c, 0xf5, 0x6a, 0xb2, 0x98, 0x21, 0x74, 0x4b, 0x15, 0xb6, 0x7a,
-	0xba, 0xbb, 0x72, 0xf2, 0x30, 0x63, 0x6d, 0xa7, 0xfe, 0x42, 0xb7, 0x32, 0x12, 0xed, 0x41, 0x27,
-	0x64, 0x9e, 0xcd, 0x1f, 0xd2, 0x35, 0x67, 0x50, 0x8c, 0x1f, 0x81, 0xe2, 0x8c, 0x79, 0x17, 0x0f,
-	0x31, 0xb1, 0xda, 0xa1, 0xfa, 0x61, 0x46, 0xd0, 0x2d, 0xcd, 0xbd, 0x15, 0xe1, 0xca, 0xe7, 0x5d,
-	0x5b, 0x4a, 0xee, 0x0f, 0x0b, 0x78, 0x0f, 0x50, 0x8c, 0xb4, 0x15, 0xf1, 0x7e, 0x09, 0x8d, 0x34,
-	0xd6, 0xe3, 0x59, 0xd2, 0xf8, 0xa8, 0xc8, 0x81, 0x8a, 0xac, 0x46, 0xf6, 0xff, 0x1d, 0xd8, 0xef,
-	0xd4, 0x3b, 0x66, 0x5b, 0xda, 0xaf, 0xaa, 0x2b, 0x63, 0xf7, 0x60, 0x3d, 0xb7, 0x56, 0xec, 0x7c,
-	0x87, 0x34, 0x7f, 0x00, 0xb4, 0xdc, 0xe9, 0xd0, 0xcb, 0x45, 0x07, 0x4f, 0x17, 0xda, 0xe2, 0x92,
-	0x9f, 0x4b, 0x68, 0xa7, 0x3c, 0xf4, 0x0c, 0xda, 0x8c, 0xdc, 0xda, 0xf4, 0x2e, 0x4c, 0xaf, 0xdb,
-	0x62, 0xe4, 0xf6, 0xf4, 0x2e, 0x14, 0xd9, 0x59, 0x7a, 0x55, 0x85, 0xeb, 0x17, 0x0b, 0x5d, 0xb8,
-	0xbe, 0x53, 0x17, 0xb9, 0x5f, 0xee, 0xb3, 0xff, 0xd6, 0x60, 0x50, 0x0d, 0x8b, 0xbe, 0x82, 0x75,
-	0x27, 0x0a, 0x02, 0xe2, 0x70, 0x3f, 0xa2, 0x36, 0xc5, 0xa1, 0x42, 0x56, 0xb7, 0x06, 0x05, 0xfb,
-	0x14, 0x87, 0x44, 0xac, 0xc8, 0x42, 0xca, 0x62, 0xec, 0xa8, 0x15, 0x59, 0xb7, 0x0a, 0x06, 0xda,
-	0x84, 0x26, 0xbf, 0xcf, 0x5a, 0x9f, 0x6e, 0x35, 0xf8, 0xfd, 0xd4, 0x15, 0x6d, 0x29, 0x3b, 0x51,
-	0xf2, 0x13, 0x23, 0x3c, 0xed, 0x7d, 0xd9, 0x31, 0x2d, 0xc1, 0x33, 0xff, 0xa5, 0x41, 0xaf, 0xbc,
-	0x92, 0xa2, 0x3d, 0x80, 0x30, 0xdf, 0x1c, 0x53, 0xd0, 0x06, 0xd5, 0x9d, 0xd2, 0x2a, 0x69, 0x7c,
-	0x70, 0x9b, 0x2e, 0x37, 0x90, 0x46, 0xb5, 0x81, 0x98, 0x7f, 0xd7, 0x60, 0x63, 0x69, 0xb6, 0xaf,
-	0x6a, 0x11, 0x1f, 0x1a, 0xf8, 0x39, 0x0c, 0x7c, 0x66, 0xbb, 0xc4, 0x09, 0x70, 0x82, 0x05, 0xae,
-	0x12, 0xac, 0x8e, 0xd5, 0xf7, 0xd9, 0xa8, 0x60, 0x9a, 0xbf, 0x87, 0x4e, 0x66, 0x2d, 0x12, 0xc0,
-	0xa7, 0x4e, 0x39, 0x01, 0x7c, 0xea, 0x88, 0x04, 0x28, 0x65, 0xc6, 0x5a, 0x39, 0x33, 0xcc, 0x6b,
-	0xd8, 0x58, 0xda, 0xd6, 0xd1, 0xf7, 0x30, 0x64, 0x24, 0xb8, 0x96, 0x6b, 0x5a, 0x12, 0xaa, 0xd8,
-	0x5a, 0xf5, 0xc0, 0x79, 0x91, 0xae, 0x0b, 0xcd, 0x69, 0xa1, 0x28, 0x2a, 0x4e, 0xac, 0x1d, 0x54,
-	0x56, 0x56, 0xcf, 0x52, 0x84, 0x79, 0x05, 0x68, 0x79, 0xbf, 0x47, 0x5f, 0x42, 0x53, 0x7e, 0x4e,
-	0xac, 0x1c, 0x14, 0x4a, 0x2c, 0x3b, 0x05, 0xc1, 0xee, 0x7b, 0x3a, 0x05, 0xc1, 0xae, 0xf9, 0x67,
-	0x68, 0xa9, 0x18, 0xe2, 0xcd, 0x48, 0xe5, 0x7b, 0xcb, 0xca, 0xe9, 0xf7, 0x76, 0xb9, 0xc7, 0x47,
-	0xb2, 0xd9, 0x86, 0xa6, 0x5c, 0xb7, 0xcd, 0xbf, 0x00, 0x5a, 0x5e, 0x2a, 0xc5, 0x18, 0x61, 0x1c,
-	0x27, 0xdc, 0xae, 0x16, 0x5f, 0x57, 0x32, 0xcf, 0x55, 0x05, 0x7e, 0x0e, 0x5d, 0x42, 0x5d, 0xbb,
-	0xfa, 0x08, 0x3a, 0xa1, 0xae, 0x92, 0x9b, 0x47, 0xb0, 0xf9, 0xc8, 0xaa, 0x89, 0x76, 0xa1, 0x93,
-	0xd6, 0x79, 0x36, 0x4c, 0x97, 0x1a, 0x4a, 0xae, 0x60, 0x9e, 0xc0, 0xd6, 0x63, 0xeb, 0x1b, 0xda,
-	0x2f, 0xba, 0x9d, 0xf2, 0x91, 0x7f, 0x1e, 0xa4, 0x8a, 0xaa, 0x57, 0xe6, 0x4d, 0xd0, 0xfc, 0x8f,
-	0x06, 0xfd, 0x8a, 0xa8, 0xa8, 0x57, 0xad, 0x54, 0xaf, 0xef, 0x2f, 0xf1, 0xcf, 0x01, 0x8a, 0x96,
-	0x90, 0xd6, 0x79, 0x89, 0x83, 0x3e, 0x05, 0xfd, 0x2a, 0x88, 0x9c, 0x1b, 0x81, 0x89, 0x2c, 0xac,
-	0x86, 0xd5, 0x91, 0x8c, 0x73, 0x72, 0x8b, 0x76, 0xa0, 0x27, 0xa0, 0xf2, 0xa9, 0x2d, 0x59, 0x72,
-	0xcb, 0x69, 0x58, 0xc0, 0xc8, 0xed, 0x94, 0x1e, 0x09, 0x8e, 0xf9, 0x23, 0x3c, 0x79, 0x74, 0xd7,
-	0x44, 0x07, 0x4b, 0xfb, 0xc7, 0xd3, 0x85, 0xeb, 0x8e, 0x95, 0xb8, 0xb4, 0x85, 0x5c, 0xc2, 0xa0,
-	0x2a, 0x43, 0xdf, 0x40, 0x4b, 0xa1, 0x91, 0x26, 0xfe, 0x0a, 0xc8, 0x52, 0xa5, 0xf2, 0x5f, 0x05,
-	0x2a, 0xed, 0xf3, 0xf6, 0xfc, 0xa7, 0xdc, 0x75, 0xd6, 0x42, 0x9f, 0xc3, 0x3a, 0xbf, 0xb7, 0x2b,
-	0xd7, 0x4b, 0xf7, 0x2f, 0x7e, 0x7f, 0x9e, 0x5f, 0xb0, 0xea, 0xb2, 0xfc, 0xef, 0x83, 0xf9, 0x15,
-	0xac, 0x2f, 0xac, 0xf6, 0xa2, 0xe8, 0x48, 0x92, 0x44, 0x49, 0xfa, 0x3e, 0x8a, 0xf8, 0xf5, 0x1f,
-	0xa0, 0x5b, 0x1a, 0x5a, 0x8b, 0xfb, 0x72, 0x1f, 0xf4, 0xa3, 0xd7, 0x6f, 0x8e, 0x7f, 0xb4, 0x67,
-	0xe7, 0x27, 0x43, 0x4d, 0xac, 0xc5, 0xd3, 0xd1, 0xf8, 0xf4, 0x62, 0x7a, 0x71, 0x29, 0x39, 0x6b,
-	0x07, 0x7f, 0x83, 0x96, 0x5a, 0x1a, 0xd0, 0x77, 0xd0, 0x53, 0xbf, 0xce, 0x79, 0x42, 0x70, 0x88,
-	0x96, 0x2a, 0x70, 0x7b, 0x89, 0x63, 0xd6, 0x5e, 0x68, 0x2f, 0x35, 0xf4, 0x25, 0x34, 0xce, 0x7c,
-	0xea, 0xa1, 0xea, 0x77, 0xeb, 0x76, 0x95, 0x34, 0x6b, 0x47, 0xdf, 0xfc, 0x75, 0xd7, 0xf3, 0xf9,
-	0xfc, 0xee, 0x6a, 0xcf, 0x89, 0xc2, 0xfd, 0xf9, 0x43, 0x4c, 0x12, 0xb5, 0x8d, 0xee, 0x5f, 0xe3,
-	0xab, 0xc4, 0x77, 0xf6, 0xe5, 0x5f, 0x46, 0x6c, 0x5f, 0x99, 0x5d, 0xb5, 0x24, 0xf9, 0xed, 0xff,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xb4, 0xb4, 0x9a, 0xc5, 0x59, 0x12, 0x00, 0x00,
+	// 2043 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdd, 0x72, 0xdb, 0xc6,
+	0x15, 0x26, 0xc4, 0x1f, 0x91, 0x87, 0x3f, 0xa2, 0xd6, 0xb2, 0x8c, 0x28, 0x6e, 0xaa, 0xa2, 0x71,
+	0xe2, 0x46, 0x89, 0xe4, 0x2a, 0xed, 0x34, 0x9d, 0xb4, 0x71, 0x29, 0x4a, 0x11, 0xd9, 0x98, 0x14,
+	0x0b, 0xca, 0xd3, 0x3a, 0x37, 0x98, 0x15, 0xb0, 0x22, 0x51, 0x03, 0x0b, 0x08, 0xbb, 0x74, 0xa4,
+	0xbc, 0x40, 0x67, 0x3a, 0xbd, 0xe9, 0x23, 0xf4, 0x59, 0xfa, 0x32, 0x9d, 0xe9, 0x45, 0x5f, 0xa1,
+	0xb3, 0xbb, 0xf8, 0x15, 0x49, 0xcf, 0x38, 0x33, 0xb9, 0xc3, 0xf9, 0xdd, 0xdd, 0xb3, 0xe7, 0x7c,
+	0xe7, 0x2c, 0x60, 0x67, 0x16, 0x30, 0xe6, 0x86, 0x47, 0x3e, 0x61, 0x0c, 0xcf, 0xc8, 0x61, 0x18,
+	0x05, 0x3c, 0x40, 0x35, 0xc5, 0x35, 0xfe, 0xab, 0x41, 0xfd, 0x8c, 0xbe, 0x21, 0x5e, 0x10, 0x12,
+	0xa4, 0xc3, 0x66, 0x88, 0xef, 0xbc, 0x00, 0x3b, 0xba, 0xb6, 0xaf, 0x3d, 0x6d, 0x99, 0x09, 0x89,
+	0x1e, 0x43, 0x83, 0xb9, 0x33, 0x8a, 0xf9, 0x22, 0x22, 0xfa, 0x86, 0x94, 0x65, 0x0c, 0xf4, 0x1c,
+	0xb6, 0x18, 0xb1, 0x23, 0xc2, 0x2d, 0x12, 0xbb, 0xd2, 0xcb, 0xfb, 0xda, 0xd3, 0xe6, 0xf1, 0xee,
+	0xa1, 0x5a, 0xe6, 0x70, 0x2a, 0xc5, 0xc9, 0x42, 0x66, 0x87, 0x15, 0x68, 0xf4, 0x15, 0x34, 0xed,
+	0xc0, 0x0f, 0x23, 0xc2, 0x98, 0x1b, 0x50, 0xbd, 0xb2, 0xaf, 0x3d, 0xed, 0x1c, 0x3f, 0x4e, 0x8c,
+	0xfb, 0x99, 0xa8, 0xe7, 0xcd, 0x82, 0xc8, 0xe5, 0x73, 0xdf, 0xcc, 0x1b, 0xa0, 0x8f, 0xa0, 0x7a,
+	0x85, 0xb9, 0x3d, 0xd7, 0xab, 0xfb, 0xe5, 0xa7, 0xcd, 0xe3, 0x6e, 0x62, 0x99, 0x2e, 0xa8, 0xc4,
+	0xc6, 0x00, 0x3a, 0xc5, 0x9d, 0xfc, 0xd0, 0x23, 0x1b, 0x3d, 0xa8, 0x29, 0x4f, 0xe8, 0x53, 0xe8,
+	0xba, 0x94, 0x93, 0x88, 0x62, 0xef, 0x8c, 0x3a, 0x61, 0xe0, 0x52, 0x2e, 0x5d, 0x35, 0x06, 0x25,
+	0x73, 0x49, 0x72, 0xd2, 0x80, 0x4d, 0x3b, 0xa0, 0x9c, 0x50, 0x6e, 0xfc, 0xad, 0x09, 0xed, 0x73,
+	0xb9, 0xcf, 0x91, 0xba, 0x1a, 0xb4, 0x03, 0x55, 0x1a, 0x50, 0x9b, 0x48, 0xfb, 0x8a, 0xa9, 0x08,
+	0xb1, 0x45, 0x7b, 0x8e, 0x29, 0x25, 0x5e, 0xbc, 0x8d, 0x84, 0x44, 0x07, 0x50, 0xe6, 0x78, 0x26,
+	0x63, 0xdd, 0x39, 0x7e, 0x2f, 0x39, 0x74, 0xc1, 0xe7, 0xe1, 0x25, 0x9e, 0x99, 0x42, 0x0b, 0x7d,
+	0x0e, 0x0d, 0xec, 0xb9, 0x6f, 0x88, 0xe5, 0xb3, 0x99, 0x5e, 0x95, 0xd7, 0xb3, 0x93, 0x98, 0xf4,
+	0x84, 0x20, 0xb6, 0x18, 0x94, 0xcc, 0xba, 0x54, 0x1c, 0xb1, 0x19, 0xfa, 0x15, 0x6c, 0xfa, 0xc4,
+	0xb7, 0x22, 0x72, 0xa3, 0xd7, 0xa4, 0x49, 0xba, 0xca, 0x88, 0xf8, 0x57, 0x24, 0x62, 0x73, 0x37,
+	0x34, 0xc9, 0xcd, 0x82, 0x30, 0x3e, 0x28, 0x99, 0x35, 0x9f, 0xf8, 0x26, 0xb9, 0x41, 0xbf, 0x4e,
+	0xac, 0x98, 0xbe, 0x29, 0xad, 0xf6, 0x56, 0x59, 0xb1, 0x30, 0xa0, 0x8c, 0xa4, 0x66, 0x0c, 0x3d,
+	0x83, 0xba, 0x83, 0x39, 0x96, 0x1b, 0xac, 0x4b, 0xbb, 0x07, 0x89, 0xdd, 0x29, 0xe6, 0x38, 0xdb,
+	0xdf, 0xa6, 0x50, 0x13, 0xdb, 0x3b, 0x80, 0xea, 0x9c, 0x78, 0x5e, 0xa0, 0x37, 0x8a, 0xea, 0x2a,
+	0x04, 0x03, 0x21, 0x1a, 0x94, 0x4c, 0xa5, 0x83, 0x8e, 0x62, 0xf7, 0x8e, 0x3b, 0xd3, 0x41, 0xea,
+	0xa3, 0xbc, 0xfb, 0x53, 0x77, 0xa6, 0x4e, 0x21, 0xbd, 0x9f, 0xba, 0xb3, 0x74, 0x3f, 0xe2, 0xf4,
+	0xcd, 0xe5, 0xfd, 0x64, 0xe7, 0x96, 0x16, 0xea, 0xe0, 0x4d, 0x69, 0xb1, 0x08, 0x1d, 0xcc, 0x89,
+	0xde, 0x5a, 0x5e, 0xe5, 0xa5, 0x94, 0x0c, 0x4a, 0x26, 0x38, 0x29, 0x85, 0x9e, 0x40, 0x95, 0xf8,
+	0x21, 0xbf, 0xd3, 0xdb, 0xd2, 0xa0, 0x9d, 0xa6, 0xaf, 0x60, 0x8a, 0x03, 0x48, 0x29, 0x3a, 0x80,
+	0x8a, 0x1d, 0x50, 0xaa, 0x77, 0xa4, 0xd6, 0xc3, 0xac, 0x3c, 0x28, 0x3d, 0x63, 0x1c, 0x5f, 0x79,
+	0x2e, 0x9b, 0x0f, 0x4a, 0xa6, 0x54, 0x42, 0xc7, 0x00, 0x8c, 0x63, 0x4e, 0x2c, 0x97, 0x5e, 0x07,
+	0xfa, 0x96, 0x34, 0xd9, 0x4e, 0xcb, 0x51, 0x48, 0x86, 0xf4, 0x5a, 0x44, 0xa7, 0xc1, 0x12, 0x02,
+	0x9d, 0x40, 0x47, 0xd9, 0x30, 0x8a, 0x43, 0x36, 0x0f, 0xb8, 0xde, 0x2d, 0x5e, 0x7a, 0x6a, 0x37,
+	0x8d, 0x15, 0x06, 0x25, 0xb3, 0x2d, 0x4d, 0x12, 0x06, 0x1a, 0xc1, 0x83, 0x6c, 0x5d, 0x2b, 0x5c,
+	0x78, 0x9e, 0x8c, 0xdf, 0xb6, 0x74, 0xf4, 0x78, 0xc9, 0xd1, 0x64, 0xe1, 0x79, 0x59, 0x20, 0xbb,
+	0xec, 0x1e, 0x1f, 0xf5, 0x40, 0xf9, 0xb7, 0x22, 0xa5, 0xa4, 0xa3, 0x62, 0x42, 0x99, 0xc4, 0x0f,
+	0x38, 0x91, 0xee, 0x32, 0x37, 0x2d, 0x96, 0xa3, 0xd1, 0x69, 0x72, 0xaa, 0x28, 0x4e, 0x39, 0xfd,
+	0x81, 0xf4, 0xf1, 0xfe, 0x4a, 0x1f, 0x69, 0x56, 0xb6, 0x59, 0x9e, 0x21, 0x62, 0xe3, 0x11, 0xec,
+	0xa8, 0xe4, 0x95, 0x29, 0xba, 0x53, 0x8c, 0xcd, 0x8b, 0x54, 0x9a, 0x25, 0x6a, 0x3b, 0x33, 0x11,
+	0xe9, 0xfa, 0x25, 0xb4, 0x43, 0x42, 0x22, 0xcb, 0x75, 0x08, 0xe5, 0x2e, 0xbf, 0xd3, 0x1f, 0x16,
+	0xcb, 0x70, 0x42, 0x48, 0x34, 0x8c, 0x65, 0xe2, 0x18, 0x61, 0x8e, 0x16, 0xc5, 0x8e, 0xed, 0xd7,
+	0xfa, 0xae, 0x34, 0x79, 0x94, 0x56, 0xae, 0xfd, 0x9a, 0x06, 0xdf, 0x79, 0xc4, 0x99, 0x11, 0x9f,
+	0x50, 0x71, 0x78, 0xa1, 0x85, 0xbe, 0x02, 0x08, 0x23, 0xf7, 0x8d, 0x8a, 0x82, 0xfe, 0xa8, 0x18,
+	0x7c, 0x75, 0xde, 0xc9, 0x1b, 0x5e, 0xcc, 0xe2, 0x9c, 0x05, 0x7a, 0x9e, 0xb3, 0x67, 0xba, 0x2e,
+	0xed, 0x7f, 0xb2, 0xc6, 0x3e, 0x8d, 0x58, 0xce, 0x04, 0x3d, 0x87, 0x56, 0x4c, 0x59, 0x22, 0xd1,
+	0xf5, 0xf7, 0x8a, 0xd7, 0x36, 0x51, 0xb2, 0x62, 0x59, 0x37, 0xc3, 0x8c, 0x6b, 0x58, 0x50, 0xbe,
+	0xc4, 0x33, 0xd4, 0x86, 0xc6, 0xcb, 0xf1, 0xe9, 0xd9, 0xd7, 0xc3, 0xf1, 0xd9, 0x69, 0xb7, 0x84,
+	0x1a, 0x50, 0x3d, 0x1b, 0x4d, 0x2e, 0x5f, 0x75, 0x35, 0xd4, 0x82, 0xfa, 0x85, 0x79, 0x6e, 0x5d,
+	0x8c, 0x5f, 0xbc, 0xea, 0x6e, 0x08, 0xbd, 0xfe, 0xa0, 0x37, 0x56, 0x64, 0x19, 0x75, 0xa1, 0x25,
+	0xc9, 0xde, 0xf8, 0xd4, 0xba, 0x30, 0xcf, 0xbb, 0x15, 0xb4, 0x05, 0x4d, 0xa5, 0x60, 0x4a, 0x46,
+	0x35, 0x8f, 0xc4, 0xff, 0xd6, 0xa0, 0x91, 0x66, 0x24, 0xda, 0x83, 0xba, 0x4f, 0x38, 0x96, 0xdb,
+	0x56, 0x3d, 0x21, 0xa5, 0xd1, 0x21, 0x34, 0xb8, 0xeb, 0x13, 0xc6, 0xb1, 0x1f, 0x4a, 0x34, 0xce,
+	0x35, 0x1b, 0x71, 0x7b, 0x97, 0xae, 0x4f, 0xcc, 0x4c, 0x05, 0x3d, 0x84, 0x5a, 0xf8, 0xda, 0xb5,
+	0x5c, 0x47, 0x82, 0x74, 0xcb, 0xac, 0x86, 0xaf, 0xdd, 0xa1, 0x83, 0x7e, 0x0a, 0xcd, 0x18, 0xc3,
+	0xad, 0x51, 0xaf, 0x2f, 0xfb, 0x5d, 0xcb, 0x84, 0x98, 0x35, 0xea, 0xf5, 0x45, 0xf5, 0x86, 0x51,
+	0x10, 0x92, 0x88, 0xbb, 0x84, 0xe9, 0xd5, 0x22, 0x8e, 0x4c, 0x52, 0x89, 0x99, 0xd3, 0x32, 0xfe,
+	0xb1, 0x01, 0x90, 0x89, 0xd0, 0xcf, 0xa1, 0x2d, 0xd3, 0x22, 0xb2, 0xe6, 0xc4, 0x9d, 0xcd, 0x79,
+	0xdc, 0x54, 0x5a, 0x8a, 0x39, 0x90, 0x3c, 0xf4, 0x33, 0x68, 0x79, 0xe4, 0x9a, 0x5b, 0xf9, 0x06,
+	0x53, 0x37, 0x9b, 0x82, 0xd7, 0x57, 0x2c, 0xf4, 0x4b, 0x10, 0x1b, 0x73, 0xa9, 0x1d, 0x38, 0x84,
+	0xe9, 0xe5, 0xfd, 0x72, 0x1e, 0x48, 0xfa, 0x89, 0xc4, 0xcc, 0x29, 0xa1, 0x3f, 0xc2, 0x23, 0x97,
+	0x32, 0x8e, 0x29, 0x77, 0x31, 0x27, 0x8e, 0x95, 0xb3, 0xaf, 0xac, 0xb3, 0xdf, 0xcd, 0x5b, 0xf4,
+	0x33, 0x5f, 0xbb, 0x50, 0x53, 0x45, 0x24, 0xa3, 0x50, 0x37, 0x63, 0x4a, 0x86, 0x50, 0xf4, 0x74,
+	0x97, 0xce, 0xac, 0x45, 0x28, 0xbb, 0x53, 0xdd, 0x84, 0x84, 0xf5, 0x32, 0x34, 0x7e, 0x0b, 0x8d,
+	0xd4, 0x0d, 0x42, 0x50, 0xa1, 0xd8, 0x57, 0x8d, 0xb5, 0x61, 0xca, 0x6f, 0xd1, 0x57, 0xdf, 0x90,
+	0x48, 0x0e, 0x1c, 0x1b, 0x92, 0x9d, 0x90, 0x46, 0x0f, 0xb6, 0x97, 0x90, 0x0e, 0x7d, 0x0a, 0x75,
+	0xe2, 0xc9, 0x22, 0x63, 0xba, 0xb6, 0x66, 0xcc, 0x48, 0x35, 0x8c, 0xdf, 0xc0, 0xce, 0x2a, 0x8c,
+	0xbb, 0x7f, 0xf3, 0xda, 0xfd, 0x9b, 0x37, 0xfe, 0xa3, 0x41, 0xbb, 0x80, 0xe8, 0xb9, 0x1c, 0xd2,
+	0xf2, 0x39, 0xb4, 0x07, 0xf5, 0x14, 0x47, 0xd4, 0x5c, 0x90, 0xd2, 0xc8, 0x80, 0x36, 0xf7, 0x98,
+	0x65, 0x93, 0x88, 0x5b, 0x73, 0xcc, 0xe6, 0x71, 0xf6, 0x35, 0xb9, 0xc7, 0xfa, 0x24, 0xe2, 0x03,
+	0xcc, 0xe6, 0xcb, 0x33, 0x57, 0xf9, 0xdd, 0x66, 0xae, 0x3d, 0xa8, 0x5f, 0xc5, 0xd1, 0x8e, 0xaf,
+	0x26, 0xa5, 0xd1, 0x87, 0xd0, 0xf1, 0xf1, 0xad, 0x25, 0x69, 0x8b, 0xb9, 0xdf, 0x13, 0x79, 0x3f,
+	0x6d, 0xb3, 0xe5, 0xe3, 0xdb, 0x13, 0xc1, 0x9c, 0xba, 0xdf, 0x13, 0xe3, 0x25, 0xb4, 0xf2, 0x88,
+	0xb7, 0xee, 0xa0, 0x08, 0x2a, 0xe2, 0x20, 0xf1, 0x21, 0xe5, 0x77, 0xa1, 0x46, 0xcb, 0xc5, 0x1a,
+	0x35, 0x7c, 0x68, 0xe6, 0x80, 0x6d, 0xfd, 0x50, 0xe5, 0xc8, 0x86, 0xcf, 0xf4, 0x8d, 0xfd, 0xb2,
+	0xb8, 0xfc, 0x98, 0x44, 0x87, 0x50, 0xf7, 0xd9, 0xcc, 0xe2, 0x77, 0xf1, 0x14, 0xdb, 0xc9, 0xba,
+	0xbe, 0xb8, 0xc8, 0x11, 0x9b, 0x5d, 0xde, 0x85, 0xc4, 0xdc, 0xf4, 0xd5, 0x87, 0x11, 0x40, 0x33,
+	0x37, 0x6e, 0xac, 0x59, 0x2e, 0xbf, 0xdf, 0x8d, 0x25, 0x4c, 0x79, 0xb7, 0x05, 0x6f, 0x01, 0xb2,
+	0x49, 0x62, 0xcd, 0x7a, 0x1f, 0x42, 0x25, 0x5e, 0x6b, 0x75, 0xa2, 0x56, 0x7e, 0xd0, 0xca, 0x1e,
+	0x40, 0x36, 0x29, 0xfd, 0xe8, 0x81, 0xfd, 0x02, 0x9a, 0xb9, 0xfe, 0x80, 0x7e, 0x51, 0x9c, 0xd4,
+	0x9b, 0xc7, 0x5b, 0xa9, 0xb5, 0x62, 0xa7, 0xa3, 0xbb, 0xf1, 0x35, 0xa0, 0xe5, 0x06, 0x83, 0x9e,
+	0xdd, 0x77, 0xb0, 0x7b, 0xaf, 0x1b, 0x2d, 0xf9, 0x79, 0x05, 0x9b, 0x31, 0x0f, 0x3d, 0x82, 0x4d,
+	0x46, 0x6e, 0x2c, 0xba, 0xf0, 0xe3, 0xe3, 0xd6, 0x18, 0xb9, 0x19, 0x2f, 0x7c, 0x91, 0x9d, 0xb9,
+	0x5b, 0x95, 0xdf, 0x02, 0x55, 0x0b, 0xcd, 0x4f, 0x80, 0x66, 0xab, 0xd8, 0xde, 0xfe, 0xa9, 0x41,
+	0xa7, 0xb8, 0x2c, 0xfa, 0x18, 0xb6, 0xec, 0xc0, 0xf3, 0x88, 0xcd, 0xdd, 0x80, 0x5a, 0x39, 0xb8,
+	0xea, 0x64, 0xec, 0xb1, 0x00, 0xae, 0xc7, 0xd0, 0x10, 0x52, 0x16, 0x62, 0x9b, 0xc4, 0xd0, 0x95,
+	0x31, 0xd0, 0x03, 0xa8, 0xf2, 0xdb, 0xa4, 0xe3, 0x34, 0xcc, 0x0a, 0xbf, 0x1d, 0x3a, 0xa2, 0x19,
+	0x24, 0x3b, 0x8a, 0xbe, 0x63, 0x84, 0xc7, 0x2d, 0x27, 0xd9, 0xa6, 0x29, 0x78, 0xc6, 0xdf, 0x35,
+	0x68, 0xe5, 0x5f, 0x02, 0xe8, 0x10, 0xc0, 0x4f, 0x07, 0xf6, 0x38, 0x68, 0x9d, 0xe2, 0x28, 0x6f,
+	0xe6, 0x34, 0xde, 0xb9, 0x3b, 0xe6, 0x21, 0xac, 0x52, 0x84, 0x30, 0xe3, 0x7f, 0x1a, 0x6c, 0x2f,
+	0x8d, 0x54, 0xeb, 0x20, 0xe2, 0x5d, 0x17, 0x7e, 0x02, 0x1d, 0x97, 0x59, 0x0e, 0xb1, 0x3d, 0x1c,
+	0x61, 0x11, 0x57, 0x19, 0xac, 0xba, 0xd9, 0x76, 0xd9, 0x69, 0xc6, 0x14, 0xfb, 0x0b, 0x23, 0x37,
+	0x88, 0x92, 0xfd, 0xb5, 0xcd, 0x94, 0x5e, 0x6e, 0xaf, 0xd5, 0x15, 0xed, 0xf5, 0x00, 0xb6, 0x1d,
+	0x22, 0x22, 0x1a, 0x59, 0x0b, 0x3a, 0x27, 0xd8, 0xe3, 0xf3, 0xbb, 0xb8, 0x55, 0x75, 0x63, 0xc1,
+	0xcb, 0x84, 0x6f, 0xfc, 0x0e, 0xea, 0xc9, 0x5e, 0x45, 0xba, 0xb9, 0xd4, 0xce, 0xa7, 0x9b, 0x4b,
+	0x6d, 0x91, 0x6e, 0xb9, 0x3c, 0xdc, 0xc8, 0xe7, 0xa1, 0x71, 0x0d, 0xdb, 0x4b, 0x4f, 0x32, 0xf4,
+	0x25, 0x74, 0x19, 0xf1, 0xae, 0xe5, 0x2c, 0x1e, 0xf9, 0xea, 0xa4, 0xda, 0xbe, 0xb6, 0x12, 0x12,
+	0xb6, 0x84, 0xe6, 0x30, 0x53, 0x14, 0xf5, 0x2d, 0x66, 0x4b, 0x2a, 0xeb, 0xb8, 0x65, 0x2a, 0xc2,
+	0xb8, 0x02, 0xb4, 0xfc, 0x88, 0x13, 0x0f, 0x70, 0xf9, 0x66, 0x5c, 0xdb, 0x19, 0x95, 0x58, 0xe2,
+	0x12, 0xc1, 0xce, 0x5b, 0x70, 0x89, 0x60, 0xc7, 0xf8, 0x33, 0xd4, 0xd4, 0x1a, 0xe2, 0x06, 0x48,
+	0xe1, 0x51, 0x6d, 0xa6, 0xf4, 0x5b, 0x31, 0x75, 0xf5, 0xdc, 0x65, 0x6c, 0x42, 0x55, 0xbe, 0xa9,
+	0x8c, 0xbf, 0x00, 0x5a, 0x7e, 0x39, 0x88, 0xb6, 0xc9, 0x38, 0x8e, 0xb8, 0x55, 0x2c, 0xf5, 0xa6,
+	0x64, 0x4e, 0x55, 0xbd, 0x7f, 0x00, 0x4d, 0x42, 0x1d, 0xab, 0x78, 0x09, 0x0d, 0x42, 0x1d, 0x25,
+	0x37, 0x4e, 0xe0, 0xc1, 0x8a, 0xf7, 0x04, 0x3a, 0x80, 0x7a, 0x8c, 0x2a, 0xc9, 0xf4, 0xb0, 0x04,
+	0x5f, 0xa9, 0x82, 0x71, 0x0e, 0x3b, 0xab, 0x66, 0x74, 0x74, 0x94, 0x61, 0xab, 0xf2, 0x91, 0xbe,
+	0x01, 0x63, 0x45, 0x85, 0xcc, 0x29, 0xe4, 0x1a, 0xff, 0xd2, 0xa0, 0x5d, 0x10, 0x65, 0xe8, 0xa0,
+	0xe5, 0xd0, 0xe1, 0xed, 0x80, 0xf2, 0x01, 0x40, 0x06, 0x40, 0x31, 0xaa, 0xe4, 0x38, 0xe8, 0x7d,
+	0x68, 0x5c, 0x79, 0x81, 0xfd, 0x5a, 0xc4, 0x44, 0x96, 0x49, 0xc5, 0xac, 0x4b, 0xc6, 0x94, 0xdc,
+	0xa0, 0x7d, 0x68, 0x89, 0x50, 0xb9, 0xd4, 0x92, 0xac, 0xb8, 0x4a, 0x80, 0x91, 0x9b, 0x21, 0x3d,
+	0x11, 0x1c, 0xe3, 0x1b, 0x78, 0xb8, 0xf2, 0x41, 0x81, 0x8e, 0x97, 0x06, 0xae, 0xdd, 0x7b, 0xc7,
+	0x3d, 0x53, 0xe2, 0xdc, 0xd8, 0xf5, 0x0a, 0x3a, 0x45, 0x19, 0xfa, 0x0c, 0x6a, 0x2a, 0x1a, 0x71,
+	0xe2, 0xaf, 0x09, 0x59, 0xac, 0x94, 0xff, 0x1f, 0xa4, 0xd2, 0x3e, 0x21, 0x8d, 0x3f, 0xa5, 0xae,
+	0x13, 0xc0, 0x7e, 0x02, 0x5b, 0xfc, 0xd6, 0x2a, 0x1c, 0x2f, 0x9e, 0xb1, 0xf9, 0xed, 0x34, 0x3d,
+	0x60, 0xd1, 0x65, 0xfe, 0x17, 0x93, 0xf1, 0x31, 0x6c, 0xdd, 0x7b, 0xbf, 0x89, 0xa2, 0x23, 0x51,
+	0x14, 0x44, 0xf1, 0xfd, 0x28, 0xe2, 0x93, 0x3f, 0xc0, 0xce, 0xaa, 0x81, 0x0c, 0x21, 0xe8, 0x8c,
+	0x2f, 0xac, 0xfe, 0xc5, 0x68, 0x62, 0x9e, 0x4d, 0xa7, 0xc3, 0x8b, 0x71, 0xb7, 0x84, 0x00, 0x6a,
+	0xd3, 0x71, 0x6f, 0x32, 0x11, 0x6f, 0xa4, 0x3a, 0x54, 0xce, 0xbf, 0x1d, 0x4e, 0xba, 0x1b, 0x9f,
+	0xfc, 0x1e, 0x9a, 0xb9, 0x26, 0x7b, 0xff, 0x59, 0xd5, 0x86, 0xc6, 0xc9, 0x8b, 0x8b, 0xfe, 0x37,
+	0xd6, 0x68, 0x7a, 0xde, 0xd5, 0xc4, 0xeb, 0x69, 0x78, 0x7a, 0x36, 0xbe, 0x1c, 0x5e, 0xbe, 0x92,
+	0x9c, 0x8d, 0xe3, 0xbf, 0x42, 0x4d, 0x0d, 0x39, 0xe8, 0x0b, 0x68, 0xa9, 0xaf, 0x29, 0x8f, 0x08,
+	0xf6, 0xd1, 0x52, 0x0d, 0xef, 0x2d, 0x71, 0x8c, 0xd2, 0x53, 0xed, 0x99, 0x86, 0x3e, 0x82, 0xca,
+	0x44, 0x0c, 0x87, 0xc5, 0xdf, 0x1b, 0x7b, 0x45, 0xd2, 0x28, 0x9d, 0x7c, 0xf6, 0xed, 0xc1, 0xcc,
+	0xe5, 0xf3, 0xc5, 0xd5, 0xa1, 0x1d, 0xf8, 0x47, 0xf3, 0xbb, 0x90, 0x44, 0x0a, 0x54, 0x8f, 0xae,
+	0xf1, 0x55, 0xe4, 0xda, 0x47, 0xf2, 0x0f, 0x26, 0x3b, 0x52, 0x66, 0x57, 0x35, 0x49, 0x7e, 0xfe,
+	0xff, 0x01, 0x00, 0x06, 0x2f, 0x4b, 0xdd, 0xe8, 0x14, 0x00, 0x00,
 }
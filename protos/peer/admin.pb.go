@@ -23,16 +23,36 @@ It has these top-level messages:
 	ServerStatus
 	LogLevelRequest
 	LogLevelResponse
+	HandlerInfo
+	Handlers
+	HotKeysRequest
+	HotKey
+	HotKeys
+	DivergenceRequest
+	KeyObservation
+	DivergentKey
+	DivergenceReport
+	GossipPeer
+	GossipMembership
+	GossipChannelsRequest
+	GossipChannel
+	GossipChannels
+	GossipConnection
+	GossipConnections
 	ChaincodeID
 	ChaincodeInput
 	ChaincodeSpec
 	ChaincodeDeploymentSpec
 	ChaincodeInvocationSpec
 	ChaincodeEvent
+	ChaincodeEvents
 	ChaincodeMessage
 	GetState
 	PutState
 	DelState
+	GetStateMultiple
+	GetStateMultipleResult
+	PutStateMultiple
 	GetStateByRange
 	GetQueryResult
 	GetHistoryForKey
@@ -61,6 +81,8 @@ It has these top-level messages:
 	ChaincodeHeaderExtension
 	ChaincodeProposalPayload
 	ChaincodeAction
+	ChaincodeCall
+	ChaincodeCalls
 	ProposalResponse
 	Response
 	ProposalResponsePayload
@@ -69,6 +91,8 @@ It has these top-level messages:
 	ChaincodeInfo
 	ChannelQueryResponse
 	ChannelInfo
+	PvtDataVerification
+	PvtDataDiscrepancy
 	APIResource
 	ChaincodeIdentifier
 	ChaincodeValidation
@@ -82,6 +106,9 @@ It has these top-level messages:
 	TransactionAction
 	ChaincodeActionPayload
 	ChaincodeEndorsedAction
+	MVCCConflict
+	KeyVersion
+	MVCCConflicts
 */
 package peer
 
@@ -139,6 +166,30 @@ func (x ServerStatus_StatusCode) String() string {
 }
 func (ServerStatus_StatusCode) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 0} }
 
+type DivergentKey_Access int32
+
+const (
+	DivergentKey_READ        DivergentKey_Access = 0
+	DivergentKey_WRITE       DivergentKey_Access = 1
+	DivergentKey_RANGE_QUERY DivergentKey_Access = 2
+)
+
+var DivergentKey_Access_name = map[int32]string{
+	0: "READ",
+	1: "WRITE",
+	2: "RANGE_QUERY",
+}
+var DivergentKey_Access_value = map[string]int32{
+	"READ":        0,
+	"WRITE":       1,
+	"RANGE_QUERY": 2,
+}
+
+func (x DivergentKey_Access) String() string {
+	return proto.EnumName(DivergentKey_Access_name, int32(x))
+}
+func (DivergentKey_Access) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{10, 0} }
+
 type ServerStatus struct {
 	Status ServerStatus_StatusCode `protobuf:"varint,1,opt,name=status,enum=protos.ServerStatus_StatusCode" json:"status,omitempty"`
 }
@@ -203,11 +254,698 @@ func (m *LogLevelResponse) GetLogLevel() string {
 	return ""
 }
 
+// HandlerInfo describes a handler loaded by the peer, as
+// defined in the peer.handlers section of core.yaml
+type HandlerInfo struct {
+	// type is the type of the handler: auth, decoration,
+	// endorsement or validation
+	Type string `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
+	// key is the name endorsement and validation
+	// handlers are registered under
+	Key     string `protobuf:"bytes,2,opt,name=key" json:"key,omitempty"`
+	Name    string `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	Library string `protobuf:"bytes,4,opt,name=library" json:"library,omitempty"`
+	// config holds the configuration parameters of the
+	// handler, in JSON, with their values redacted when
+	// returned by GetHandlers
+	Config string `protobuf:"bytes,5,opt,name=config" json:"config,omitempty"`
+}
+
+func (m *HandlerInfo) Reset()                    { *m = HandlerInfo{} }
+func (m *HandlerInfo) String() string            { return proto.CompactTextString(m) }
+func (*HandlerInfo) ProtoMessage()               {}
+func (*HandlerInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *HandlerInfo) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *HandlerInfo) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *HandlerInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *HandlerInfo) GetLibrary() string {
+	if m != nil {
+		return m.Library
+	}
+	return ""
+}
+
+func (m *HandlerInfo) GetConfig() string {
+	if m != nil {
+		return m.Config
+	}
+	return ""
+}
+
+type Handlers struct {
+	Handlers []*HandlerInfo `protobuf:"bytes,1,rep,name=handlers" json:"handlers,omitempty"`
+}
+
+func (m *Handlers) Reset()                    { *m = Handlers{} }
+func (m *Handlers) String() string            { return proto.CompactTextString(m) }
+func (*Handlers) ProtoMessage()               {}
+func (*Handlers) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *Handlers) GetHandlers() []*HandlerInfo {
+	if m != nil {
+		return m.Handlers
+	}
+	return nil
+}
+
+type HotKeysRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId" json:"channel_id,omitempty"`
+	// namespace restricts the keys to those of a single
+	// chaincode, if set
+	Namespace string `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
+}
+
+func (m *HotKeysRequest) Reset()                    { *m = HotKeysRequest{} }
+func (m *HotKeysRequest) String() string            { return proto.CompactTextString(m) }
+func (*HotKeysRequest) ProtoMessage()               {}
+func (*HotKeysRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *HotKeysRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *HotKeysRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+// HotKey is a key that caused the invalidation of transactions
+// because of MVCC or phantom read conflicts. Public keys are
+// identified by the key, private keys by the collection and
+// the hash of the key, and range queries by their start and
+// end keys.
+type HotKey struct {
+	Namespace  string `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
+	Collection string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+	Key        string `protobuf:"bytes,3,opt,name=key" json:"key,omitempty"`
+	KeyHash    []byte `protobuf:"bytes,4,opt,name=key_hash,json=keyHash,proto3" json:"key_hash,omitempty"`
+	StartKey   string `protobuf:"bytes,5,opt,name=start_key,json=startKey" json:"start_key,omitempty"`
+	EndKey     string `protobuf:"bytes,6,opt,name=end_key,json=endKey" json:"end_key,omitempty"`
+	// conflicts is an estimate of the number of conflicts of
+	// the key, which exceeds the actual number by at most
+	// max_error
+	Conflicts uint64 `protobuf:"varint,7,opt,name=conflicts" json:"conflicts,omitempty"`
+	MaxError  uint64 `protobuf:"varint,8,opt,name=max_error,json=maxError" json:"max_error,omitempty"`
+}
+
+func (m *HotKey) Reset()                    { *m = HotKey{} }
+func (m *HotKey) String() string            { return proto.CompactTextString(m) }
+func (*HotKey) ProtoMessage()               {}
+func (*HotKey) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *HotKey) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *HotKey) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *HotKey) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *HotKey) GetKeyHash() []byte {
+	if m != nil {
+		return m.KeyHash
+	}
+	return nil
+}
+
+func (m *HotKey) GetStartKey() string {
+	if m != nil {
+		return m.StartKey
+	}
+	return ""
+}
+
+func (m *HotKey) GetEndKey() string {
+	if m != nil {
+		return m.EndKey
+	}
+	return ""
+}
+
+func (m *HotKey) GetConflicts() uint64 {
+	if m != nil {
+		return m.Conflicts
+	}
+	return 0
+}
+
+func (m *HotKey) GetMaxError() uint64 {
+	if m != nil {
+		return m.MaxError
+	}
+	return 0
+}
+
+// HotKeys holds, for each namespace, the most conflicted keys
+// first
+type HotKeys struct {
+	HotKeys []*HotKey `protobuf:"bytes,1,rep,name=hot_keys,json=hotKeys" json:"hot_keys,omitempty"`
+}
+
+func (m *HotKeys) Reset()                    { *m = HotKeys{} }
+func (m *HotKeys) String() string            { return proto.CompactTextString(m) }
+func (*HotKeys) ProtoMessage()               {}
+func (*HotKeys) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *HotKeys) GetHotKeys() []*HotKey {
+	if m != nil {
+		return m.HotKeys
+	}
+	return nil
+}
+
+type DivergenceRequest struct {
+	SignedProposal *SignedProposal `protobuf:"bytes,1,opt,name=signed_proposal,json=signedProposal" json:"signed_proposal,omitempty"`
+	// proposal_responses are the responses of the endorsers
+	// to the proposal
+	ProposalResponses []*ProposalResponse `protobuf:"bytes,2,rep,name=proposal_responses,json=proposalResponses" json:"proposal_responses,omitempty"`
+	// resimulate requests that the proposal be simulated by
+	// the peer as well, without being endorsed
+	Resimulate bool `protobuf:"varint,3,opt,name=resimulate" json:"resimulate,omitempty"`
+	// block_height is the height of the ledger the proposal
+	// must be simulated at, if set
+	BlockHeight uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight" json:"block_height,omitempty"`
+}
+
+func (m *DivergenceRequest) Reset()                    { *m = DivergenceRequest{} }
+func (m *DivergenceRequest) String() string            { return proto.CompactTextString(m) }
+func (*DivergenceRequest) ProtoMessage()               {}
+func (*DivergenceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *DivergenceRequest) GetSignedProposal() *SignedProposal {
+	if m != nil {
+		return m.SignedProposal
+	}
+	return nil
+}
+
+func (m *DivergenceRequest) GetProposalResponses() []*ProposalResponse {
+	if m != nil {
+		return m.ProposalResponses
+	}
+	return nil
+}
+
+func (m *DivergenceRequest) GetResimulate() bool {
+	if m != nil {
+		return m.Resimulate
+	}
+	return false
+}
+
+func (m *DivergenceRequest) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// KeyObservation is what a simulation of a proposal read or
+// wrote for a key. Private keys are observed through the
+// hashes of their values.
+type KeyObservation struct {
+	// present is unset if the simulation didn't read or
+	// write the key
+	Present bool `protobuf:"varint,1,opt,name=present" json:"present,omitempty"`
+	// version is the version of the key that was read,
+	// unset if the key didn't exist
+	Version *KeyVersion `protobuf:"bytes,2,opt,name=version" json:"version,omitempty"`
+	// value is the value written, the hash of the value
+	// for private keys, or the marshaled RangeQueryInfo
+	// for range queries
+	Value    []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	IsDelete bool   `protobuf:"varint,4,opt,name=is_delete,json=isDelete" json:"is_delete,omitempty"`
+}
+
+func (m *KeyObservation) Reset()                    { *m = KeyObservation{} }
+func (m *KeyObservation) String() string            { return proto.CompactTextString(m) }
+func (*KeyObservation) ProtoMessage()               {}
+func (*KeyObservation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *KeyObservation) GetPresent() bool {
+	if m != nil {
+		return m.Present
+	}
+	return false
+}
+
+func (m *KeyObservation) GetVersion() *KeyVersion {
+	if m != nil {
+		return m.Version
+	}
+	return nil
+}
+
+func (m *KeyObservation) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *KeyObservation) GetIsDelete() bool {
+	if m != nil {
+		return m.IsDelete
+	}
+	return false
+}
+
+// DivergentKey is a key that simulations of a proposal
+// didn't read or write alike. Public keys are identified by
+// the key, private keys by the collection and the hash of the
+// key, and range queries by their start and end keys.
+type DivergentKey struct {
+	Namespace  string              `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
+	Collection string              `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+	Key        string              `protobuf:"bytes,3,opt,name=key" json:"key,omitempty"`
+	KeyHash    []byte              `protobuf:"bytes,4,opt,name=key_hash,json=keyHash,proto3" json:"key_hash,omitempty"`
+	StartKey   string              `protobuf:"bytes,5,opt,name=start_key,json=startKey" json:"start_key,omitempty"`
+	EndKey     string              `protobuf:"bytes,6,opt,name=end_key,json=endKey" json:"end_key,omitempty"`
+	Access     DivergentKey_Access `protobuf:"varint,7,opt,name=access,enum=protos.DivergentKey_Access" json:"access,omitempty"`
+	// observations holds the observation of the key by each
+	// of the endorsers of the report, in the same order
+	Observations []*KeyObservation `protobuf:"bytes,8,rep,name=observations" json:"observations,omitempty"`
+}
+
+func (m *DivergentKey) Reset()                    { *m = DivergentKey{} }
+func (m *DivergentKey) String() string            { return proto.CompactTextString(m) }
+func (*DivergentKey) ProtoMessage()               {}
+func (*DivergentKey) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *DivergentKey) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *DivergentKey) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *DivergentKey) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *DivergentKey) GetKeyHash() []byte {
+	if m != nil {
+		return m.KeyHash
+	}
+	return nil
+}
+
+func (m *DivergentKey) GetStartKey() string {
+	if m != nil {
+		return m.StartKey
+	}
+	return ""
+}
+
+func (m *DivergentKey) GetEndKey() string {
+	if m != nil {
+		return m.EndKey
+	}
+	return ""
+}
+
+func (m *DivergentKey) GetAccess() DivergentKey_Access {
+	if m != nil {
+		return m.Access
+	}
+	return DivergentKey_READ
+}
+
+func (m *DivergentKey) GetObservations() []*KeyObservation {
+	if m != nil {
+		return m.Observations
+	}
+	return nil
+}
+
+// DivergenceReport describes how the results of the
+// simulations of a proposal differ
+type DivergenceReport struct {
+	// endorsers identifies the simulations compared, i.e., the
+	// MSP IDs of the endorsers in the order of the responses,
+	// followed by "local" for the simulation of the peer
+	Endorsers []string `protobuf:"bytes,1,rep,name=endorsers" json:"endorsers,omitempty"`
+	// block_height is the height of the ledger the peer
+	// simulated the proposal at, if it did
+	BlockHeight   uint64          `protobuf:"varint,2,opt,name=block_height,json=blockHeight" json:"block_height,omitempty"`
+	DivergentKeys []*DivergentKey `protobuf:"bytes,3,rep,name=divergent_keys,json=divergentKeys" json:"divergent_keys,omitempty"`
+	// responses_diverge is set if the chaincode responses,
+	// i.e., their status, message and payload, differ
+	ResponsesDiverge bool `protobuf:"varint,4,opt,name=responses_diverge,json=responsesDiverge" json:"responses_diverge,omitempty"`
+	// events_diverge is set if the chaincode events differ
+	EventsDiverge bool `protobuf:"varint,5,opt,name=events_diverge,json=eventsDiverge" json:"events_diverge,omitempty"`
+}
+
+func (m *DivergenceReport) Reset()                    { *m = DivergenceReport{} }
+func (m *DivergenceReport) String() string            { return proto.CompactTextString(m) }
+func (*DivergenceReport) ProtoMessage()               {}
+func (*DivergenceReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *DivergenceReport) GetEndorsers() []string {
+	if m != nil {
+		return m.Endorsers
+	}
+	return nil
+}
+
+func (m *DivergenceReport) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *DivergenceReport) GetDivergentKeys() []*DivergentKey {
+	if m != nil {
+		return m.DivergentKeys
+	}
+	return nil
+}
+
+func (m *DivergenceReport) GetResponsesDiverge() bool {
+	if m != nil {
+		return m.ResponsesDiverge
+	}
+	return false
+}
+
+func (m *DivergenceReport) GetEventsDiverge() bool {
+	if m != nil {
+		return m.EventsDiverge
+	}
+	return false
+}
+
+// GossipPeer is a remote peer known to the gossip component
+type GossipPeer struct {
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint" json:"endpoint,omitempty"`
+	// internal_endpoint is only set for the peers of
+	// the organization of the local MSP
+	InternalEndpoint string `protobuf:"bytes,2,opt,name=internal_endpoint,json=internalEndpoint" json:"internal_endpoint,omitempty"`
+	PkiId            []byte `protobuf:"bytes,3,opt,name=pki_id,json=pkiId,proto3" json:"pki_id,omitempty"`
+	// mspid is unset if the identity of the peer is unknown
+	Mspid string `protobuf:"bytes,4,opt,name=mspid" json:"mspid,omitempty"`
+	// ledger_height and leader are set for the peers of a
+	// channel, as published by them
+	LedgerHeight uint64 `protobuf:"varint,5,opt,name=ledger_height,json=ledgerHeight" json:"ledger_height,omitempty"`
+	Leader       bool   `protobuf:"varint,6,opt,name=leader" json:"leader,omitempty"`
+}
+
+func (m *GossipPeer) Reset()                    { *m = GossipPeer{} }
+func (m *GossipPeer) String() string            { return proto.CompactTextString(m) }
+func (*GossipPeer) ProtoMessage()               {}
+func (*GossipPeer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *GossipPeer) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *GossipPeer) GetInternalEndpoint() string {
+	if m != nil {
+		return m.InternalEndpoint
+	}
+	return ""
+}
+
+func (m *GossipPeer) GetPkiId() []byte {
+	if m != nil {
+		return m.PkiId
+	}
+	return nil
+}
+
+func (m *GossipPeer) GetMspid() string {
+	if m != nil {
+		return m.Mspid
+	}
+	return ""
+}
+
+func (m *GossipPeer) GetLedgerHeight() uint64 {
+	if m != nil {
+		return m.LedgerHeight
+	}
+	return 0
+}
+
+func (m *GossipPeer) GetLeader() bool {
+	if m != nil {
+		return m.Leader
+	}
+	return false
+}
+
+type GossipMembership struct {
+	Alive []*GossipPeer `protobuf:"bytes,1,rep,name=alive" json:"alive,omitempty"`
+	Dead  []*GossipPeer `protobuf:"bytes,2,rep,name=dead" json:"dead,omitempty"`
+	// identity_digests are the PKI-IDs of the peers whose
+	// identities are available to other peers through pull
+	IdentityDigests [][]byte `protobuf:"bytes,3,rep,name=identity_digests,json=identityDigests,proto3" json:"identity_digests,omitempty"`
+}
+
+func (m *GossipMembership) Reset()                    { *m = GossipMembership{} }
+func (m *GossipMembership) String() string            { return proto.CompactTextString(m) }
+func (*GossipMembership) ProtoMessage()               {}
+func (*GossipMembership) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *GossipMembership) GetAlive() []*GossipPeer {
+	if m != nil {
+		return m.Alive
+	}
+	return nil
+}
+
+func (m *GossipMembership) GetDead() []*GossipPeer {
+	if m != nil {
+		return m.Dead
+	}
+	return nil
+}
+
+func (m *GossipMembership) GetIdentityDigests() [][]byte {
+	if m != nil {
+		return m.IdentityDigests
+	}
+	return nil
+}
+
+type GossipChannelsRequest struct {
+	// channel_id restricts the channels to a single one,
+	// if set
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId" json:"channel_id,omitempty"`
+}
+
+func (m *GossipChannelsRequest) Reset()                    { *m = GossipChannelsRequest{} }
+func (m *GossipChannelsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GossipChannelsRequest) ProtoMessage()               {}
+func (*GossipChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *GossipChannelsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// GossipChannel is a channel as seen by the gossip component
+type GossipChannel struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId" json:"channel_id,omitempty"`
+	// peers are the alive remote peers of the channel
+	Peers []*GossipPeer `protobuf:"bytes,2,rep,name=peers" json:"peers,omitempty"`
+	// leader is set if the peer itself pulls the blocks of
+	// the channel from the ordering service
+	Leader bool `protobuf:"varint,3,opt,name=leader" json:"leader,omitempty"`
+	// block_digests are the sequence numbers of the blocks
+	// available to other peers through pull
+	BlockDigests []uint64 `protobuf:"varint,4,rep,packed,name=block_digests,json=blockDigests" json:"block_digests,omitempty"`
+}
+
+func (m *GossipChannel) Reset()                    { *m = GossipChannel{} }
+func (m *GossipChannel) String() string            { return proto.CompactTextString(m) }
+func (*GossipChannel) ProtoMessage()               {}
+func (*GossipChannel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *GossipChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *GossipChannel) GetPeers() []*GossipPeer {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+func (m *GossipChannel) GetLeader() bool {
+	if m != nil {
+		return m.Leader
+	}
+	return false
+}
+
+func (m *GossipChannel) GetBlockDigests() []uint64 {
+	if m != nil {
+		return m.BlockDigests
+	}
+	return nil
+}
+
+type GossipChannels struct {
+	Channels []*GossipChannel `protobuf:"bytes,1,rep,name=channels" json:"channels,omitempty"`
+}
+
+func (m *GossipChannels) Reset()                    { *m = GossipChannels{} }
+func (m *GossipChannels) String() string            { return proto.CompactTextString(m) }
+func (*GossipChannels) ProtoMessage()               {}
+func (*GossipChannels) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+func (m *GossipChannels) GetChannels() []*GossipChannel {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+type GossipConnection struct {
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint" json:"endpoint,omitempty"`
+	PkiId    []byte `protobuf:"bytes,2,opt,name=pki_id,json=pkiId,proto3" json:"pki_id,omitempty"`
+	// outbound is set if the peer initiated the connection
+	Outbound      bool   `protobuf:"varint,3,opt,name=outbound" json:"outbound,omitempty"`
+	BytesSent     uint64 `protobuf:"varint,4,opt,name=bytes_sent,json=bytesSent" json:"bytes_sent,omitempty"`
+	BytesReceived uint64 `protobuf:"varint,5,opt,name=bytes_received,json=bytesReceived" json:"bytes_received,omitempty"`
+}
+
+func (m *GossipConnection) Reset()                    { *m = GossipConnection{} }
+func (m *GossipConnection) String() string            { return proto.CompactTextString(m) }
+func (*GossipConnection) ProtoMessage()               {}
+func (*GossipConnection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+func (m *GossipConnection) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *GossipConnection) GetPkiId() []byte {
+	if m != nil {
+		return m.PkiId
+	}
+	return nil
+}
+
+func (m *GossipConnection) GetOutbound() bool {
+	if m != nil {
+		return m.Outbound
+	}
+	return false
+}
+
+func (m *GossipConnection) GetBytesSent() uint64 {
+	if m != nil {
+		return m.BytesSent
+	}
+	return 0
+}
+
+func (m *GossipConnection) GetBytesReceived() uint64 {
+	if m != nil {
+		return m.BytesReceived
+	}
+	return 0
+}
+
+type GossipConnections struct {
+	Connections []*GossipConnection `protobuf:"bytes,1,rep,name=connections" json:"connections,omitempty"`
+}
+
+func (m *GossipConnections) Reset()                    { *m = GossipConnections{} }
+func (m *GossipConnections) String() string            { return proto.CompactTextString(m) }
+func (*GossipConnections) ProtoMessage()               {}
+func (*GossipConnections) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *GossipConnections) GetConnections() []*GossipConnection {
+	if m != nil {
+		return m.Connections
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ServerStatus)(nil), "protos.ServerStatus")
 	proto.RegisterType((*LogLevelRequest)(nil), "protos.LogLevelRequest")
 	proto.RegisterType((*LogLevelResponse)(nil), "protos.LogLevelResponse")
+	proto.RegisterType((*HandlerInfo)(nil), "protos.HandlerInfo")
+	proto.RegisterType((*Handlers)(nil), "protos.Handlers")
+	proto.RegisterType((*HotKeysRequest)(nil), "protos.HotKeysRequest")
+	proto.RegisterType((*HotKey)(nil), "protos.HotKey")
+	proto.RegisterType((*HotKeys)(nil), "protos.HotKeys")
+	proto.RegisterType((*DivergenceRequest)(nil), "protos.DivergenceRequest")
+	proto.RegisterType((*KeyObservation)(nil), "protos.KeyObservation")
+	proto.RegisterType((*DivergentKey)(nil), "protos.DivergentKey")
+	proto.RegisterType((*DivergenceReport)(nil), "protos.DivergenceReport")
+	proto.RegisterType((*GossipPeer)(nil), "protos.GossipPeer")
+	proto.RegisterType((*GossipMembership)(nil), "protos.GossipMembership")
+	proto.RegisterType((*GossipChannelsRequest)(nil), "protos.GossipChannelsRequest")
+	proto.RegisterType((*GossipChannel)(nil), "protos.GossipChannel")
+	proto.RegisterType((*GossipChannels)(nil), "protos.GossipChannels")
+	proto.RegisterType((*GossipConnection)(nil), "protos.GossipConnection")
+	proto.RegisterType((*GossipConnections)(nil), "protos.GossipConnections")
 	proto.RegisterEnum("protos.ServerStatus_StatusCode", ServerStatus_StatusCode_name, ServerStatus_StatusCode_value)
+	proto.RegisterEnum("protos.DivergentKey_Access", DivergentKey_Access_name, DivergentKey_Access_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -227,6 +965,22 @@ type AdminClient interface {
 	GetModuleLogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*LogLevelResponse, error)
 	SetModuleLogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*LogLevelResponse, error)
 	RevertLogLevels(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// Return the handlers the peer loaded, along with their configuration.
+	GetHandlers(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*Handlers, error)
+	// Return the keys of a channel with the most MVCC and phantom read conflicts.
+	GetHotKeys(ctx context.Context, in *HotKeysRequest, opts ...grpc.CallOption) (*HotKeys, error)
+	// Diff the read-write sets of the responses of endorsers to a proposal,
+	// optionally along with those of a local simulation of the proposal.
+	CheckEndorsementDivergence(ctx context.Context, in *DivergenceRequest, opts ...grpc.CallOption) (*DivergenceReport, error)
+	// Return the alive and dead members of the gossip membership, and the
+	// peers whose identities are available to other peers through pull.
+	GetGossipMembership(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*GossipMembership, error)
+	// Return the peers, the leadership and the blocks available to other
+	// peers through pull of a channel, or of all the channels the peer joined.
+	GetGossipChannels(ctx context.Context, in *GossipChannelsRequest, opts ...grpc.CallOption) (*GossipChannels, error)
+	// Return the gossip connections to remote peers, along with the bytes
+	// sent and received over them.
+	GetGossipConnections(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*GossipConnections, error)
 }
 
 type adminClient struct {
@@ -282,6 +1036,60 @@ func (c *adminClient) RevertLogLevels(ctx context.Context, in *google_protobuf.E
 	return out, nil
 }
 
+func (c *adminClient) GetHandlers(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*Handlers, error) {
+	out := new(Handlers)
+	err := grpc.Invoke(ctx, "/protos.Admin/GetHandlers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetHotKeys(ctx context.Context, in *HotKeysRequest, opts ...grpc.CallOption) (*HotKeys, error) {
+	out := new(HotKeys)
+	err := grpc.Invoke(ctx, "/protos.Admin/GetHotKeys", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) CheckEndorsementDivergence(ctx context.Context, in *DivergenceRequest, opts ...grpc.CallOption) (*DivergenceReport, error) {
+	out := new(DivergenceReport)
+	err := grpc.Invoke(ctx, "/protos.Admin/CheckEndorsementDivergence", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetGossipMembership(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*GossipMembership, error) {
+	out := new(GossipMembership)
+	err := grpc.Invoke(ctx, "/protos.Admin/GetGossipMembership", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetGossipChannels(ctx context.Context, in *GossipChannelsRequest, opts ...grpc.CallOption) (*GossipChannels, error) {
+	out := new(GossipChannels)
+	err := grpc.Invoke(ctx, "/protos.Admin/GetGossipChannels", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetGossipConnections(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*GossipConnections, error) {
+	out := new(GossipConnections)
+	err := grpc.Invoke(ctx, "/protos.Admin/GetGossipConnections", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Admin service
 
 type AdminServer interface {
@@ -291,6 +1099,22 @@ type AdminServer interface {
 	GetModuleLogLevel(context.Context, *LogLevelRequest) (*LogLevelResponse, error)
 	SetModuleLogLevel(context.Context, *LogLevelRequest) (*LogLevelResponse, error)
 	RevertLogLevels(context.Context, *google_protobuf.Empty) (*google_protobuf.Empty, error)
+	// Return the handlers the peer loaded, along with their configuration.
+	GetHandlers(context.Context, *google_protobuf.Empty) (*Handlers, error)
+	// Return the keys of a channel with the most MVCC and phantom read conflicts.
+	GetHotKeys(context.Context, *HotKeysRequest) (*HotKeys, error)
+	// Diff the read-write sets of the responses of endorsers to a proposal,
+	// optionally along with those of a local simulation of the proposal.
+	CheckEndorsementDivergence(context.Context, *DivergenceRequest) (*DivergenceReport, error)
+	// Return the alive and dead members of the gossip membership, and the
+	// peers whose identities are available to other peers through pull.
+	GetGossipMembership(context.Context, *google_protobuf.Empty) (*GossipMembership, error)
+	// Return the peers, the leadership and the blocks available to other
+	// peers through pull of a channel, or of all the channels the peer joined.
+	GetGossipChannels(context.Context, *GossipChannelsRequest) (*GossipChannels, error)
+	// Return the gossip connections to remote peers, along with the bytes
+	// sent and received over them.
+	GetGossipConnections(context.Context, *google_protobuf.Empty) (*GossipConnections, error)
 }
 
 func RegisterAdminServer(s *grpc.Server, srv AdminServer) {
@@ -387,6 +1211,114 @@ func _Admin_RevertLogLevels_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_GetHandlers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetHandlers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.Admin/GetHandlers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetHandlers(ctx, req.(*google_protobuf.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetHotKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HotKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetHotKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.Admin/GetHotKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetHotKeys(ctx, req.(*HotKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_CheckEndorsementDivergence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DivergenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CheckEndorsementDivergence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.Admin/CheckEndorsementDivergence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CheckEndorsementDivergence(ctx, req.(*DivergenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetGossipMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetGossipMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.Admin/GetGossipMembership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetGossipMembership(ctx, req.(*google_protobuf.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetGossipChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GossipChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetGossipChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.Admin/GetGossipChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetGossipChannels(ctx, req.(*GossipChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetGossipConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetGossipConnections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.Admin/GetGossipConnections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetGossipConnections(ctx, req.(*google_protobuf.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Admin_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "protos.Admin",
 	HandlerType: (*AdminServer)(nil),
@@ -411,6 +1343,30 @@ var _Admin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RevertLogLevels",
 			Handler:    _Admin_RevertLogLevels_Handler,
 		},
+		{
+			MethodName: "GetHandlers",
+			Handler:    _Admin_GetHandlers_Handler,
+		},
+		{
+			MethodName: "GetHotKeys",
+			Handler:    _Admin_GetHotKeys_Handler,
+		},
+		{
+			MethodName: "CheckEndorsementDivergence",
+			Handler:    _Admin_CheckEndorsementDivergence_Handler,
+		},
+		{
+			MethodName: "GetGossipMembership",
+			Handler:    _Admin_GetGossipMembership_Handler,
+		},
+		{
+			MethodName: "GetGossipChannels",
+			Handler:    _Admin_GetGossipChannels_Handler,
+		},
+		{
+			MethodName: "GetGossipConnections",
+			Handler:    _Admin_GetGossipConnections_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "peer/admin.proto",
@@ -419,32 +1375,98 @@ var _Admin_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("peer/admin.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 418 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x9b, 0x42, 0x0b, 0x79, 0x1b, 0xcc, 0x58, 0x08, 0xaa, 0x4e, 0x08, 0x94, 0x13, 0x5c,
-	0x1c, 0x69, 0x1c, 0x38, 0x20, 0x0e, 0xdd, 0x12, 0x06, 0x62, 0x4b, 0x23, 0x67, 0x15, 0x02, 0x09,
-	0x4d, 0x49, 0xf3, 0xe6, 0x55, 0x38, 0x73, 0xb0, 0x9d, 0x4a, 0xfb, 0x3a, 0x7c, 0x2e, 0x3e, 0x0c,
-	0x4a, 0xdc, 0x68, 0x13, 0xb0, 0x03, 0x82, 0x93, 0xf3, 0xde, 0xfb, 0xff, 0xff, 0x71, 0x7e, 0xb1,
-	0x81, 0xd4, 0x88, 0x3a, 0xcc, 0xcb, 0x6a, 0x75, 0xc1, 0x6a, 0xad, 0xac, 0xa2, 0xe3, 0x6e, 0x31,
-	0xd3, 0x5d, 0xa1, 0x94, 0x90, 0x18, 0x76, 0x65, 0xd1, 0x9c, 0x85, 0x58, 0xd5, 0xf6, 0xd2, 0x89,
-	0x82, 0xef, 0x1e, 0x6c, 0x67, 0xa8, 0xd7, 0xa8, 0x33, 0x9b, 0xdb, 0xc6, 0xd0, 0x57, 0x30, 0x36,
-	0xdd, 0xd3, 0xc4, 0x7b, 0xe6, 0x3d, 0xbf, 0xbf, 0xf7, 0xd4, 0x09, 0x0d, 0xbb, 0xae, 0x62, 0x6e,
-	0x39, 0x50, 0x25, 0xf2, 0x8d, 0x3c, 0xf8, 0x04, 0x70, 0xd5, 0xa5, 0xf7, 0xc0, 0x5f, 0x24, 0x51,
-	0xfc, 0xf6, 0x7d, 0x12, 0x47, 0x64, 0x40, 0xb7, 0xe0, 0x4e, 0x76, 0x32, 0xe3, 0x27, 0x71, 0x44,
-	0x3c, 0x57, 0xcc, 0xd3, 0x34, 0x8e, 0xc8, 0x90, 0x02, 0x8c, 0xd3, 0xd9, 0x22, 0x8b, 0x23, 0x72,
-	0x8b, 0xfa, 0x30, 0x8a, 0x39, 0x9f, 0x73, 0x72, 0xbb, 0xd5, 0x2c, 0x92, 0x0f, 0xc9, 0xfc, 0x63,
-	0x42, 0x46, 0xc1, 0x31, 0xec, 0x1c, 0x29, 0x71, 0x84, 0x6b, 0x94, 0x1c, 0xbf, 0x35, 0x68, 0x2c,
-	0x7d, 0x02, 0x20, 0x95, 0x38, 0xad, 0x54, 0xd9, 0x48, 0xec, 0xb6, 0xea, 0x73, 0x5f, 0x2a, 0x71,
-	0xdc, 0x35, 0xe8, 0x2e, 0xb4, 0xc5, 0xa9, 0x6c, 0x2d, 0x93, 0x61, 0x37, 0xbd, 0x2b, 0x37, 0x11,
-	0x41, 0x02, 0xe4, 0x2a, 0xce, 0xd4, 0xea, 0xc2, 0xe0, 0xbf, 0xe4, 0xed, 0xfd, 0x18, 0xc2, 0x68,
-	0xd6, 0x82, 0xa7, 0xaf, 0xc1, 0x3f, 0x44, 0xbb, 0x21, 0xf9, 0x88, 0x39, 0xf0, 0xac, 0x07, 0xcf,
-	0xe2, 0x16, 0xfc, 0xf4, 0xe1, 0x9f, 0x88, 0x06, 0x03, 0xfa, 0x06, 0xb6, 0x32, 0x9b, 0x6b, 0xeb,
-	0xda, 0x7f, 0x6d, 0x7f, 0x07, 0x0f, 0x0e, 0xd1, 0xba, 0xfd, 0xf6, 0x9f, 0x47, 0x1f, 0xf7, 0xe2,
-	0x5f, 0xf8, 0x4d, 0x27, 0xbf, 0x0f, 0x1c, 0x09, 0x97, 0x94, 0xfd, 0x9f, 0xa4, 0x03, 0xd8, 0xe1,
-	0xb8, 0x46, 0x6d, 0xfb, 0xd9, 0xcd, 0x54, 0x6e, 0xe8, 0x07, 0x83, 0xfd, 0x2f, 0x10, 0x28, 0x2d,
-	0xd8, 0xf9, 0x65, 0x8d, 0x5a, 0x62, 0x29, 0x50, 0xb3, 0xb3, 0xbc, 0xd0, 0xab, 0x65, 0xff, 0xe2,
-	0xf6, 0xe4, 0xef, 0x6f, 0x77, 0x7f, 0x20, 0xcd, 0x97, 0x5f, 0x73, 0x81, 0x9f, 0x5f, 0x88, 0x95,
-	0x3d, 0x6f, 0x0a, 0xb6, 0x54, 0x55, 0x78, 0xcd, 0x18, 0x3a, 0xa3, 0xbb, 0x0a, 0x26, 0x6c, 0x8d,
-	0x85, 0xbb, 0x26, 0x2f, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x6e, 0xe6, 0xef, 0xb7, 0x41, 0x03,
-	0x00, 0x00,
+	// 1477 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xdd, 0x6e, 0xdb, 0x46,
+	0x16, 0xd6, 0xbf, 0xa8, 0x23, 0x59, 0xa6, 0x27, 0x8e, 0xc3, 0x28, 0xc9, 0xae, 0x97, 0x8b, 0x2c,
+	0x1c, 0xec, 0x42, 0xc6, 0x3a, 0x8b, 0x0d, 0x76, 0x83, 0xa2, 0x70, 0x2c, 0xd5, 0x36, 0x1c, 0xff,
+	0x74, 0x14, 0x37, 0x48, 0x81, 0x42, 0xa0, 0xc8, 0x63, 0x89, 0x10, 0x45, 0xb2, 0x33, 0x94, 0x10,
+	0x3d, 0x43, 0x2e, 0x7a, 0xd5, 0x17, 0xe8, 0x4d, 0x1f, 0xa3, 0xaf, 0xd0, 0x47, 0xe8, 0x45, 0x1f,
+	0xa4, 0x98, 0x1f, 0xea, 0xcf, 0x56, 0x7f, 0xd0, 0xde, 0xf4, 0x4a, 0x3c, 0xdf, 0xf9, 0xce, 0xe1,
+	0x9c, 0x6f, 0xce, 0x1c, 0x8e, 0xc0, 0x8c, 0x11, 0xd9, 0xbe, 0xe3, 0x8d, 0xfc, 0xb0, 0x19, 0xb3,
+	0x28, 0x89, 0x48, 0x49, 0xfe, 0xf0, 0xc6, 0xa3, 0x7e, 0x14, 0xf5, 0x03, 0xdc, 0x97, 0x66, 0x6f,
+	0x7c, 0xb3, 0x8f, 0xa3, 0x38, 0x99, 0x2a, 0x52, 0xe3, 0x9e, 0x0c, 0x8b, 0x59, 0x14, 0x47, 0xdc,
+	0x09, 0x34, 0xf8, 0x78, 0x09, 0xec, 0x32, 0xe4, 0x71, 0x14, 0x72, 0xd4, 0xde, 0x1d, 0xe9, 0x4d,
+	0x98, 0x13, 0x72, 0xc7, 0x4d, 0xfc, 0x48, 0xbf, 0xcf, 0xfe, 0x26, 0x0b, 0xb5, 0x0e, 0xb2, 0x09,
+	0xb2, 0x4e, 0xe2, 0x24, 0x63, 0x4e, 0x5e, 0x40, 0x89, 0xcb, 0x27, 0x2b, 0xbb, 0x9b, 0xdd, 0xab,
+	0x1f, 0xfc, 0x55, 0x11, 0x79, 0x73, 0x91, 0xd5, 0x54, 0x3f, 0x47, 0x91, 0x87, 0x54, 0xd3, 0xed,
+	0x77, 0x00, 0x73, 0x94, 0x6c, 0x40, 0xe5, 0xfa, 0xa2, 0xd5, 0xfe, 0xe4, 0xf4, 0xa2, 0xdd, 0x32,
+	0x33, 0xa4, 0x0a, 0xe5, 0xce, 0x9b, 0x43, 0xfa, 0xa6, 0xdd, 0x32, 0xb3, 0xca, 0xb8, 0xbc, 0xba,
+	0x6a, 0xb7, 0xcc, 0x1c, 0x01, 0x28, 0x5d, 0x1d, 0x5e, 0x77, 0xda, 0x2d, 0x33, 0x4f, 0x2a, 0x50,
+	0x6c, 0x53, 0x7a, 0x49, 0xcd, 0x82, 0xe0, 0x5c, 0x5f, 0x9c, 0x5d, 0x5c, 0xbe, 0xbd, 0x30, 0x8b,
+	0xf6, 0x39, 0x6c, 0xbe, 0x8e, 0xfa, 0xaf, 0x71, 0x82, 0x01, 0xc5, 0x2f, 0xc7, 0xc8, 0x13, 0xf2,
+	0x04, 0x20, 0x88, 0xfa, 0xdd, 0x51, 0xe4, 0x8d, 0x03, 0x94, 0x4b, 0xad, 0xd0, 0x4a, 0x10, 0xf5,
+	0xcf, 0x25, 0x40, 0x1e, 0x81, 0x30, 0xba, 0x81, 0x08, 0xb1, 0x72, 0xd2, 0x6b, 0x04, 0x3a, 0x85,
+	0x7d, 0x01, 0xe6, 0x3c, 0x9d, 0x52, 0xe9, 0x77, 0xe5, 0x9b, 0x42, 0xf5, 0xc4, 0x09, 0xbd, 0x00,
+	0xd9, 0x69, 0x78, 0x13, 0x11, 0x02, 0x85, 0x64, 0x1a, 0xa7, 0x49, 0xe4, 0x33, 0x31, 0x21, 0x3f,
+	0xc4, 0xa9, 0x8e, 0x14, 0x8f, 0x82, 0x15, 0x3a, 0x23, 0xb4, 0xf2, 0x8a, 0x25, 0x9e, 0x89, 0x05,
+	0xe5, 0xc0, 0xef, 0x31, 0x87, 0x4d, 0xad, 0x82, 0x84, 0x53, 0x93, 0xec, 0x40, 0xc9, 0x8d, 0xc2,
+	0x1b, 0xbf, 0x6f, 0x15, 0xa5, 0x43, 0x5b, 0xf6, 0x4b, 0x30, 0xf4, 0xab, 0x39, 0xd9, 0x07, 0x63,
+	0xa0, 0x9f, 0xad, 0xec, 0x6e, 0x7e, 0xaf, 0x7a, 0x70, 0x2f, 0xdd, 0xbb, 0x85, 0xe5, 0xd1, 0x19,
+	0xc9, 0x3e, 0x87, 0xfa, 0x49, 0x94, 0x9c, 0xe1, 0x94, 0x2f, 0xa8, 0xea, 0x0e, 0x9c, 0x30, 0xc4,
+	0xa0, 0xeb, 0x7b, 0xa9, 0x0a, 0x1a, 0x39, 0xf5, 0xc8, 0x63, 0xa8, 0x88, 0x75, 0xf2, 0xd8, 0x71,
+	0x51, 0xd7, 0x32, 0x07, 0xec, 0x1f, 0xb3, 0x50, 0x52, 0xf9, 0x96, 0x89, 0xd9, 0x15, 0x22, 0xf9,
+	0x0b, 0x80, 0x1b, 0x05, 0x01, 0xca, 0x3e, 0xd4, 0x79, 0x16, 0x90, 0x54, 0xac, 0xfc, 0x5c, 0xac,
+	0x87, 0x60, 0x0c, 0x71, 0xda, 0x1d, 0x38, 0x7c, 0x20, 0x95, 0xa9, 0xd1, 0xf2, 0x10, 0xa7, 0x27,
+	0x0e, 0x1f, 0x88, 0x9d, 0xe1, 0x89, 0xc3, 0x92, 0xae, 0x08, 0x51, 0xe2, 0x18, 0x12, 0x10, 0xeb,
+	0x78, 0x00, 0x65, 0x0c, 0x3d, 0xe9, 0x2a, 0x29, 0xdd, 0x30, 0xf4, 0xf4, 0x02, 0x85, 0x82, 0x81,
+	0xef, 0x26, 0xdc, 0x2a, 0xef, 0x66, 0xf7, 0x0a, 0x74, 0x0e, 0x88, 0x9c, 0x23, 0xe7, 0x7d, 0x17,
+	0x19, 0x8b, 0x98, 0x65, 0x48, 0xaf, 0x31, 0x72, 0xde, 0xb7, 0x85, 0x6d, 0xff, 0x07, 0xca, 0x5a,
+	0x35, 0xf2, 0x0c, 0x8c, 0x41, 0x24, 0xdf, 0x9c, 0x2a, 0x5e, 0x9f, 0x29, 0x2e, 0x29, 0xb4, 0x3c,
+	0x50, 0x54, 0xfb, 0x87, 0x2c, 0x6c, 0xb5, 0xfc, 0x09, 0xb2, 0x3e, 0x86, 0x2e, 0xa6, 0x7a, 0x7f,
+	0x0c, 0x9b, 0xdc, 0xef, 0x87, 0xe8, 0x75, 0xd3, 0x73, 0x2b, 0xd5, 0xaa, 0x1e, 0xec, 0xcc, 0x4e,
+	0x9d, 0x74, 0x5f, 0x69, 0x2f, 0xad, 0xf3, 0x25, 0x9b, 0x1c, 0x03, 0xb9, 0x75, 0xe2, 0xb9, 0x95,
+	0x93, 0x6b, 0xb1, 0xd2, 0x1c, 0xb3, 0x68, 0x4d, 0xa0, 0x5b, 0xf1, 0x0a, 0xc2, 0xc5, 0x9e, 0x30,
+	0xe4, 0xfe, 0x68, 0x1c, 0x38, 0x89, 0x6a, 0x4a, 0x83, 0x2e, 0x20, 0xe4, 0x6f, 0x50, 0xeb, 0x05,
+	0x91, 0x3b, 0xec, 0x0e, 0xd0, 0xef, 0x0f, 0x12, 0xb9, 0x0b, 0x05, 0x5a, 0x95, 0xd8, 0x89, 0x84,
+	0xec, 0x0f, 0x59, 0xa8, 0x9f, 0xe1, 0xf4, 0xb2, 0xc7, 0x91, 0x4d, 0x1c, 0xb9, 0x93, 0x16, 0x94,
+	0x63, 0x86, 0x1c, 0xc3, 0x44, 0xd6, 0x65, 0xd0, 0xd4, 0x24, 0xff, 0x82, 0xf2, 0x04, 0x19, 0x4f,
+	0x1b, 0xa0, 0x7a, 0x40, 0xd2, 0xd5, 0x9e, 0xe1, 0xf4, 0x33, 0xe5, 0xa1, 0x29, 0x85, 0x6c, 0x43,
+	0x71, 0xe2, 0x04, 0x63, 0xb5, 0xb0, 0x1a, 0x55, 0x86, 0xd8, 0x26, 0x9f, 0x77, 0x3d, 0x0c, 0x30,
+	0x41, 0xb9, 0x20, 0x83, 0x1a, 0x3e, 0x6f, 0x49, 0xdb, 0xfe, 0x3e, 0x07, 0xb5, 0x54, 0xf0, 0x3f,
+	0x47, 0x4f, 0x3e, 0x87, 0x92, 0xe3, 0xba, 0xc8, 0x55, 0x43, 0xd6, 0x0f, 0x1e, 0xa5, 0x8a, 0x2c,
+	0x96, 0xd1, 0x3c, 0x94, 0x14, 0xaa, 0xa9, 0xe4, 0xff, 0x50, 0x8b, 0xe6, 0x82, 0x73, 0xcb, 0xd8,
+	0xcd, 0x2f, 0xb6, 0xcf, 0xf2, 0x7e, 0xd0, 0x25, 0xae, 0xdd, 0x84, 0x92, 0xca, 0x46, 0x0c, 0x28,
+	0xd0, 0xf6, 0xa1, 0x18, 0xd4, 0x15, 0x28, 0xbe, 0xa5, 0xa7, 0x6f, 0xda, 0x66, 0x96, 0x6c, 0x42,
+	0x95, 0x1e, 0x5e, 0x1c, 0xb7, 0xbb, 0x9f, 0x5e, 0xb7, 0xe9, 0x3b, 0x33, 0x27, 0x0e, 0xb8, 0xb9,
+	0xd8, 0xc3, 0x71, 0xc4, 0x12, 0x21, 0x2b, 0x86, 0x5e, 0xc4, 0x78, 0x3a, 0x76, 0x2a, 0x74, 0x0e,
+	0xdc, 0x6a, 0x9b, 0xdc, 0xad, 0xb6, 0x21, 0x2f, 0xa1, 0xee, 0xa5, 0x05, 0xaa, 0xa3, 0x94, 0x97,
+	0x35, 0x6c, 0xdf, 0x55, 0x3e, 0xdd, 0xf0, 0x16, 0x2c, 0x4e, 0xfe, 0x09, 0x5b, 0xb3, 0xb6, 0xef,
+	0x6a, 0x97, 0x6e, 0x05, 0x73, 0xe6, 0xd0, 0x09, 0xc8, 0x53, 0xa8, 0xe3, 0x04, 0xc3, 0x64, 0xce,
+	0x2c, 0x4a, 0xe6, 0x86, 0x42, 0x35, 0xcd, 0xfe, 0x2e, 0x0b, 0x70, 0x1c, 0x71, 0xee, 0xc7, 0x57,
+	0x88, 0x8c, 0x34, 0xc0, 0xc0, 0xd0, 0x8b, 0x23, 0x5f, 0x37, 0x71, 0x85, 0xce, 0x6c, 0xf1, 0x7a,
+	0x3f, 0x4c, 0x90, 0x85, 0x4e, 0xd0, 0x9d, 0x91, 0x54, 0xf3, 0x98, 0xa9, 0xa3, 0x9d, 0x92, 0xef,
+	0x43, 0x29, 0x1e, 0xfa, 0x62, 0xb0, 0xea, 0x2e, 0x8e, 0x87, 0xfe, 0xa9, 0x27, 0x7a, 0x7b, 0xc4,
+	0x63, 0xdf, 0xd3, 0x23, 0x5f, 0x19, 0xe4, 0xef, 0xb0, 0x11, 0xa0, 0xd7, 0x47, 0x96, 0x2a, 0x57,
+	0x94, 0xca, 0xd5, 0x14, 0xa8, 0xa5, 0xdb, 0x81, 0x52, 0x80, 0x8e, 0x87, 0x4c, 0x76, 0x92, 0x41,
+	0xb5, 0x65, 0x7f, 0x95, 0x05, 0x53, 0x55, 0x70, 0x8e, 0xa3, 0x1e, 0x32, 0x3e, 0xf0, 0x63, 0xb2,
+	0x07, 0x45, 0x27, 0xf0, 0x27, 0xa8, 0x27, 0xd5, 0xec, 0xbc, 0xcd, 0x4b, 0xa5, 0x8a, 0x40, 0xfe,
+	0x01, 0x05, 0x0f, 0x1d, 0xcf, 0xca, 0xad, 0x25, 0x4a, 0x3f, 0x79, 0x06, 0xa6, 0xef, 0x61, 0x98,
+	0xf8, 0xc9, 0xb4, 0xeb, 0xf9, 0x7d, 0xe4, 0x89, 0xda, 0xbb, 0x1a, 0xdd, 0x4c, 0xf1, 0x96, 0x82,
+	0xed, 0xff, 0xc2, 0x7d, 0x15, 0x7e, 0xa4, 0x3e, 0x26, 0xbf, 0xf2, 0x8b, 0x63, 0x7f, 0x9d, 0x85,
+	0x8d, 0xa5, 0xc0, 0x5f, 0x08, 0x10, 0x55, 0xc6, 0x28, 0x5a, 0x71, 0xfd, 0xe2, 0x15, 0x61, 0x41,
+	0xbc, 0xfc, 0xa2, 0x78, 0x42, 0x79, 0xd5, 0xb2, 0x69, 0x49, 0x85, 0xdd, 0xbc, 0x50, 0x5e, 0x82,
+	0x69, 0x3d, 0x47, 0x50, 0x5f, 0xae, 0x87, 0xfc, 0x1b, 0x0c, 0xbd, 0x8a, 0xf4, 0x5b, 0x70, 0x7f,
+	0xf9, 0xdd, 0x9a, 0x49, 0x67, 0x34, 0xfb, 0xdb, 0xd9, 0x36, 0x1d, 0x45, 0x61, 0xa8, 0x07, 0xcd,
+	0xcf, 0xb5, 0xdb, 0xbc, 0x83, 0x72, 0x8b, 0x1d, 0xd4, 0x00, 0x23, 0x1a, 0x27, 0xbd, 0x68, 0x1c,
+	0x7a, 0xba, 0x96, 0x99, 0x2d, 0xe4, 0xea, 0x4d, 0x13, 0xe4, 0x5d, 0x39, 0x84, 0xd5, 0xd4, 0xae,
+	0x48, 0xa4, 0x23, 0xc6, 0xf0, 0x53, 0xa8, 0x2b, 0x37, 0x43, 0x17, 0xfd, 0x09, 0x7a, 0xba, 0xcf,
+	0x36, 0x24, 0x4a, 0x35, 0x68, 0x5f, 0xc2, 0xd6, 0xea, 0x42, 0xc5, 0xe8, 0xa9, 0xba, 0x73, 0xd3,
+	0xca, 0x2e, 0x7f, 0x74, 0x56, 0xf9, 0x74, 0x91, 0x7c, 0xf0, 0xa1, 0x04, 0xc5, 0x43, 0x71, 0xed,
+	0x25, 0x2f, 0xa1, 0x72, 0x8c, 0x89, 0xbe, 0x7c, 0xee, 0x34, 0xd5, 0xb5, 0xb7, 0x99, 0x5e, 0x7b,
+	0x9b, 0x6d, 0x71, 0xed, 0x6d, 0x6c, 0xdf, 0x75, 0x09, 0xb5, 0x33, 0xe4, 0x23, 0xa8, 0x76, 0xc4,
+	0x5c, 0x55, 0xf0, 0x6f, 0x0e, 0x3f, 0x81, 0xad, 0x63, 0x4c, 0xd4, 0x15, 0x2f, 0xbd, 0x11, 0x92,
+	0x07, 0x29, 0x79, 0xe5, 0xca, 0xd9, 0xb0, 0x6e, 0x3b, 0xd4, 0x80, 0x51, 0x99, 0x3a, 0x7f, 0x4c,
+	0xa6, 0x23, 0xd8, 0xa4, 0x38, 0x41, 0x96, 0xa4, 0xbe, 0xf5, 0xaa, 0xac, 0xc1, 0xed, 0x0c, 0xf9,
+	0x1f, 0x54, 0x8f, 0x31, 0x99, 0xdd, 0x0c, 0xd7, 0x25, 0x30, 0x57, 0xee, 0x87, 0x42, 0x93, 0x17,
+	0x00, 0x22, 0x54, 0xdf, 0x70, 0x76, 0x96, 0xef, 0x33, 0xe9, 0xb1, 0x6d, 0x6c, 0xae, 0xe0, 0x76,
+	0x86, 0x74, 0xa0, 0x71, 0x34, 0x40, 0x77, 0xd8, 0x56, 0xc3, 0x7f, 0x84, 0x61, 0x32, 0xff, 0x58,
+	0x90, 0x87, 0xab, 0xd3, 0xdc, 0xc5, 0x5b, 0x6a, 0xac, 0x7e, 0x5b, 0xec, 0x0c, 0x39, 0x85, 0x7b,
+	0xc7, 0x98, 0xdc, 0x9a, 0x65, 0xeb, 0x0a, 0x5a, 0xe9, 0xbe, 0x79, 0x84, 0x9d, 0x21, 0xaf, 0xe5,
+	0x66, 0xaf, 0x9c, 0xda, 0x27, 0x77, 0x9e, 0xd1, 0x59, 0x99, 0x3b, 0x77, 0xbb, 0xed, 0x0c, 0x39,
+	0x83, 0xed, 0x79, 0xb6, 0x85, 0x43, 0xb1, 0x6e, 0x65, 0x0f, 0xd7, 0x9d, 0x0b, 0x6e, 0x67, 0x5e,
+	0x7d, 0x01, 0x76, 0xc4, 0xfa, 0xcd, 0xc1, 0x34, 0x46, 0xa6, 0x06, 0x7c, 0xf3, 0xc6, 0xe9, 0x31,
+	0xdf, 0x4d, 0x83, 0x62, 0x44, 0xf6, 0xaa, 0x26, 0x0f, 0xcc, 0x95, 0xe3, 0x0e, 0x9d, 0x3e, 0x7e,
+	0xfe, 0xac, 0xef, 0x27, 0x83, 0x71, 0xaf, 0xe9, 0x46, 0xa3, 0xfd, 0x85, 0xc0, 0x7d, 0x15, 0xa8,
+	0xfe, 0x37, 0xf2, 0x7d, 0x11, 0xd8, 0x53, 0xff, 0x29, 0x9f, 0xff, 0x34, 0x00, 0xeb, 0x87, 0x66,
+	0x0c, 0x6e, 0x0e, 0x00, 0x00,
 }
@@ -40,6 +40,8 @@ const (
 	ChaincodeMessage_QUERY_STATE_CLOSE   ChaincodeMessage_Type = 17
 	ChaincodeMessage_KEEPALIVE           ChaincodeMessage_Type = 18
 	ChaincodeMessage_GET_HISTORY_FOR_KEY ChaincodeMessage_Type = 19
+	ChaincodeMessage_GET_STATE_MULTIPLE  ChaincodeMessage_Type = 20
+	ChaincodeMessage_PUT_STATE_MULTIPLE  ChaincodeMessage_Type = 21
 )
 
 var ChaincodeMessage_Type_name = map[int32]string{
@@ -62,6 +64,8 @@ var ChaincodeMessage_Type_name = map[int32]string{
 	17: "QUERY_STATE_CLOSE",
 	18: "KEEPALIVE",
 	19: "GET_HISTORY_FOR_KEY",
+	20: "GET_STATE_MULTIPLE",
+	21: "PUT_STATE_MULTIPLE",
 }
 var ChaincodeMessage_Type_value = map[string]int32{
 	"UNDEFINED":           0,
@@ -83,6 +87,8 @@ var ChaincodeMessage_Type_value = map[string]int32{
 	"QUERY_STATE_CLOSE":   17,
 	"KEEPALIVE":           18,
 	"GET_HISTORY_FOR_KEY": 19,
+	"GET_STATE_MULTIPLE":  20,
+	"PUT_STATE_MULTIPLE":  21,
 }
 
 func (x ChaincodeMessage_Type) String() string {
@@ -102,6 +108,10 @@ type ChaincodeMessage struct {
 	ChaincodeEvent *ChaincodeEvent `protobuf:"bytes,6,opt,name=chaincode_event,json=chaincodeEvent" json:"chaincode_event,omitempty"`
 	// channel id
 	ChannelId string `protobuf:"bytes,7,opt,name=channel_id,json=channelId" json:"channel_id,omitempty"`
+	// all the events emitted by chaincode, in the order in which they were
+	// set; chaincode_event contains the last of them. Used only with Init
+	// or Invoke.
+	ChaincodeEvents []*ChaincodeEvent `protobuf:"bytes,8,rep,name=chaincode_events,json=chaincodeEvents" json:"chaincode_events,omitempty"`
 }
 
 func (m *ChaincodeMessage) Reset()                    { *m = ChaincodeMessage{} }
@@ -158,6 +168,13 @@ func (m *ChaincodeMessage) GetChannelId() string {
 	return ""
 }
 
+func (m *ChaincodeMessage) GetChaincodeEvents() []*ChaincodeEvent {
+	if m != nil {
+		return m.ChaincodeEvents
+	}
+	return nil
+}
+
 type GetState struct {
 	Key        string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
 	Collection string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
@@ -238,6 +255,73 @@ func (m *DelState) GetCollection() string {
 	return ""
 }
 
+// GetStateMultiple is the payload of a GET_STATE_MULTIPLE message. The
+// values are returned in a GetStateMultipleResult in the same order as keys.
+type GetStateMultiple struct {
+	Keys       []string `protobuf:"bytes,1,rep,name=keys" json:"keys,omitempty"`
+	Collection string   `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+}
+
+func (m *GetStateMultiple) Reset()                    { *m = GetStateMultiple{} }
+func (m *GetStateMultiple) String() string            { return proto.CompactTextString(m) }
+func (*GetStateMultiple) ProtoMessage()               {}
+func (*GetStateMultiple) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{4} }
+
+func (m *GetStateMultiple) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *GetStateMultiple) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+type GetStateMultipleResult struct {
+	Values [][]byte `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (m *GetStateMultipleResult) Reset()                    { *m = GetStateMultipleResult{} }
+func (m *GetStateMultipleResult) String() string            { return proto.CompactTextString(m) }
+func (*GetStateMultipleResult) ProtoMessage()               {}
+func (*GetStateMultipleResult) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{5} }
+
+func (m *GetStateMultipleResult) GetValues() [][]byte {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+// PutStateMultiple is the payload of a PUT_STATE_MULTIPLE message.
+type PutStateMultiple struct {
+	Kvs        map[string][]byte `protobuf:"bytes,1,rep,name=kvs" json:"kvs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Collection string            `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+}
+
+func (m *PutStateMultiple) Reset()                    { *m = PutStateMultiple{} }
+func (m *PutStateMultiple) String() string            { return proto.CompactTextString(m) }
+func (*PutStateMultiple) ProtoMessage()               {}
+func (*PutStateMultiple) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{6} }
+
+func (m *PutStateMultiple) GetKvs() map[string][]byte {
+	if m != nil {
+		return m.Kvs
+	}
+	return nil
+}
+
+func (m *PutStateMultiple) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
 type GetStateByRange struct {
 	StartKey   string `protobuf:"bytes,1,opt,name=startKey" json:"startKey,omitempty"`
 	EndKey     string `protobuf:"bytes,2,opt,name=endKey" json:"endKey,omitempty"`
@@ -247,7 +331,7 @@ type GetStateByRange struct {
 func (m *GetStateByRange) Reset()                    { *m = GetStateByRange{} }
 func (m *GetStateByRange) String() string            { return proto.CompactTextString(m) }
 func (*GetStateByRange) ProtoMessage()               {}
-func (*GetStateByRange) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{4} }
+func (*GetStateByRange) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{7} }
 
 func (m *GetStateByRange) GetStartKey() string {
 	if m != nil {
@@ -278,7 +362,7 @@ type GetQueryResult struct {
 func (m *GetQueryResult) Reset()                    { *m = GetQueryResult{} }
 func (m *GetQueryResult) String() string            { return proto.CompactTextString(m) }
 func (*GetQueryResult) ProtoMessage()               {}
-func (*GetQueryResult) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{5} }
+func (*GetQueryResult) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{8} }
 
 func (m *GetQueryResult) GetQuery() string {
 	if m != nil {
@@ -301,7 +385,7 @@ type GetHistoryForKey struct {
 func (m *GetHistoryForKey) Reset()                    { *m = GetHistoryForKey{} }
 func (m *GetHistoryForKey) String() string            { return proto.CompactTextString(m) }
 func (*GetHistoryForKey) ProtoMessage()               {}
-func (*GetHistoryForKey) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{6} }
+func (*GetHistoryForKey) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{9} }
 
 func (m *GetHistoryForKey) GetKey() string {
 	if m != nil {
@@ -317,7 +401,7 @@ type QueryStateNext struct {
 func (m *QueryStateNext) Reset()                    { *m = QueryStateNext{} }
 func (m *QueryStateNext) String() string            { return proto.CompactTextString(m) }
 func (*QueryStateNext) ProtoMessage()               {}
-func (*QueryStateNext) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{7} }
+func (*QueryStateNext) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{10} }
 
 func (m *QueryStateNext) GetId() string {
 	if m != nil {
@@ -333,7 +417,7 @@ type QueryStateClose struct {
 func (m *QueryStateClose) Reset()                    { *m = QueryStateClose{} }
 func (m *QueryStateClose) String() string            { return proto.CompactTextString(m) }
 func (*QueryStateClose) ProtoMessage()               {}
-func (*QueryStateClose) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{8} }
+func (*QueryStateClose) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{11} }
 
 func (m *QueryStateClose) GetId() string {
 	if m != nil {
@@ -349,7 +433,7 @@ type QueryResultBytes struct {
 func (m *QueryResultBytes) Reset()                    { *m = QueryResultBytes{} }
 func (m *QueryResultBytes) String() string            { return proto.CompactTextString(m) }
 func (*QueryResultBytes) ProtoMessage()               {}
-func (*QueryResultBytes) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{9} }
+func (*QueryResultBytes) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{12} }
 
 func (m *QueryResultBytes) GetResultBytes() []byte {
 	if m != nil {
@@ -367,7 +451,7 @@ type QueryResponse struct {
 func (m *QueryResponse) Reset()                    { *m = QueryResponse{} }
 func (m *QueryResponse) String() string            { return proto.CompactTextString(m) }
 func (*QueryResponse) ProtoMessage()               {}
-func (*QueryResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{10} }
+func (*QueryResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{13} }
 
 func (m *QueryResponse) GetResults() []*QueryResultBytes {
 	if m != nil {
@@ -395,6 +479,9 @@ func init() {
 	proto.RegisterType((*GetState)(nil), "protos.GetState")
 	proto.RegisterType((*PutState)(nil), "protos.PutState")
 	proto.RegisterType((*DelState)(nil), "protos.DelState")
+	proto.RegisterType((*GetStateMultiple)(nil), "protos.GetStateMultiple")
+	proto.RegisterType((*GetStateMultipleResult)(nil), "protos.GetStateMultipleResult")
+	proto.RegisterType((*PutStateMultiple)(nil), "protos.PutStateMultiple")
 	proto.RegisterType((*GetStateByRange)(nil), "protos.GetStateByRange")
 	proto.RegisterType((*GetQueryResult)(nil), "protos.GetQueryResult")
 	proto.RegisterType((*GetHistoryForKey)(nil), "protos.GetHistoryForKey")
@@ -512,57 +599,64 @@ var _ChaincodeSupport_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("peer/chaincode_shim.proto", fileDescriptor3) }
 
 var fileDescriptor3 = []byte{
-	// 825 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x51, 0x6f, 0xe2, 0x46,
-	0x10, 0xc7, 0x8f, 0x00, 0xc1, 0x0c, 0x09, 0xec, 0x6d, 0xae, 0xa9, 0x0f, 0xe9, 0x5a, 0x8a, 0xfa,
-	0x40, 0x5f, 0xa0, 0xa5, 0x7d, 0xe8, 0xc3, 0x49, 0x15, 0xc1, 0x1b, 0x62, 0x85, 0xd8, 0xdc, 0xda,
-	0x39, 0x1d, 0x7d, 0xb1, 0x1c, 0xbc, 0x67, 0xac, 0x1a, 0xaf, 0x6b, 0x2f, 0xa7, 0xf3, 0x67, 0xe8,
-	0x07, 0xeb, 0xd7, 0xaa, 0xd6, 0xc6, 0x84, 0x23, 0x8a, 0x4e, 0xea, 0x13, 0xfe, 0xcf, 0xfc, 0x66,
-	0xe6, 0x3f, 0xd6, 0xb2, 0x86, 0xd7, 0x31, 0x63, 0xc9, 0x68, 0xb5, 0x76, 0x83, 0x68, 0xc5, 0x3d,
-	0xe6, 0xa4, 0xeb, 0x60, 0x33, 0x8c, 0x13, 0x2e, 0x38, 0x3e, 0xcd, 0x7f, 0xd2, 0x6e, 0xf7, 0x08,
-	0x61, 0x9f, 0x58, 0x24, 0x0a, 0xa6, 0x7b, 0x91, 0xe7, 0xe2, 0x84, 0xc7, 0x3c, 0x75, 0xc3, 0x5d,
-	0xf0, 0x7b, 0x9f, 0x73, 0x3f, 0x64, 0xa3, 0x5c, 0x3d, 0x6c, 0x3f, 0x8e, 0x44, 0xb0, 0x61, 0xa9,
-	0x70, 0x37, 0x71, 0x01, 0xf4, 0xff, 0xa9, 0x03, 0x9a, 0x96, 0xfd, 0xee, 0x58, 0x9a, 0xba, 0x3e,
-	0xc3, 0xbf, 0x40, 0x4d, 0x64, 0x31, 0x53, 0x2b, 0xbd, 0xca, 0xa0, 0x3d, 0x7e, 0x53, 0xa0, 0xe9,
-	0xf0, 0x98, 0x1b, 0xda, 0x59, 0xcc, 0x68, 0x8e, 0xe2, 0xdf, 0xa1, 0xb9, 0x6f, 0xad, 0x9e, 0xf4,
-	0x2a, 0x83, 0xd6, 0xb8, 0x3b, 0x2c, 0x86, 0x0f, 0xcb, 0xe1, 0x43, 0xbb, 0x24, 0xe8, 0x23, 0x8c,
-	0x55, 0x68, 0xc4, 0x6e, 0x16, 0x72, 0xd7, 0x53, 0xab, 0xbd, 0xca, 0xe0, 0x8c, 0x96, 0x12, 0x63,
-	0xa8, 0x89, 0xcf, 0x81, 0xa7, 0xd6, 0x7a, 0x95, 0x41, 0x93, 0xe6, 0xcf, 0x78, 0x0c, 0x4a, 0xb9,
-	0xa2, 0x5a, 0xcf, 0xc7, 0x5c, 0x96, 0xf6, 0xac, 0xc0, 0x8f, 0x98, 0xb7, 0xd8, 0x65, 0xe9, 0x9e,
-	0xc3, 0x7f, 0x40, 0xe7, 0xe8, 0x95, 0xa9, 0xa7, 0x5f, 0x96, 0xee, 0x37, 0x23, 0x32, 0x4b, 0xdb,
-	0xab, 0x2f, 0x34, 0x7e, 0x03, 0xb0, 0x5a, 0xbb, 0x51, 0xc4, 0x42, 0x27, 0xf0, 0xd4, 0x46, 0x6e,
-	0xa7, 0xb9, 0x8b, 0xe8, 0x5e, 0xff, 0xdf, 0x13, 0xa8, 0xc9, 0x57, 0x81, 0xcf, 0xa1, 0x79, 0x6f,
-	0x68, 0xe4, 0x5a, 0x37, 0x88, 0x86, 0x5e, 0xe0, 0x33, 0x50, 0x28, 0x99, 0xe9, 0x96, 0x4d, 0x28,
-	0xaa, 0xe0, 0x36, 0x40, 0xa9, 0x88, 0x86, 0x4e, 0xb0, 0x02, 0x35, 0xdd, 0xd0, 0x6d, 0x54, 0xc5,
-	0x4d, 0xa8, 0x53, 0x32, 0xd1, 0x96, 0xa8, 0x86, 0x3b, 0xd0, 0xb2, 0xe9, 0xc4, 0xb0, 0x26, 0x53,
-	0x5b, 0x37, 0x0d, 0x54, 0x97, 0x2d, 0xa7, 0xe6, 0xdd, 0x62, 0x4e, 0x6c, 0xa2, 0xa1, 0x53, 0x89,
-	0x12, 0x4a, 0x4d, 0x8a, 0x1a, 0x32, 0x33, 0x23, 0xb6, 0x63, 0xd9, 0x13, 0x9b, 0x20, 0x45, 0xca,
-	0xc5, 0x7d, 0x29, 0x9b, 0x52, 0x6a, 0x64, 0xbe, 0x93, 0x80, 0x5f, 0x01, 0xd2, 0x8d, 0xf7, 0xe6,
-	0x2d, 0x71, 0xa6, 0x37, 0x13, 0xdd, 0x98, 0x9a, 0x1a, 0x41, 0xad, 0xc2, 0xa0, 0xb5, 0x30, 0x0d,
-	0x8b, 0xa0, 0x73, 0x7c, 0x09, 0x78, 0xdf, 0xd0, 0xb9, 0x5a, 0x3a, 0x74, 0x62, 0xcc, 0x08, 0x6a,
-	0xcb, 0x5a, 0x19, 0x7f, 0x77, 0x4f, 0xe8, 0xd2, 0xa1, 0xc4, 0xba, 0x9f, 0xdb, 0xa8, 0x23, 0xa3,
-	0x45, 0xa4, 0xe0, 0x0d, 0xf2, 0xc1, 0x46, 0x08, 0x7f, 0x03, 0x2f, 0x0f, 0xa3, 0xd3, 0xb9, 0x69,
-	0x11, 0xf4, 0x52, 0xba, 0xb9, 0x25, 0x64, 0x31, 0x99, 0xeb, 0xef, 0x09, 0xc2, 0xf8, 0x5b, 0xb8,
-	0x90, 0x1d, 0x6f, 0x74, 0xcb, 0x36, 0xe9, 0xd2, 0xb9, 0x36, 0xa9, 0x73, 0x4b, 0x96, 0xe8, 0xa2,
-	0xff, 0x16, 0x94, 0x19, 0x13, 0x96, 0x70, 0x05, 0xc3, 0x08, 0xaa, 0x7f, 0xb1, 0x2c, 0x3f, 0x83,
-	0x4d, 0x2a, 0x1f, 0xf1, 0x77, 0x00, 0x2b, 0x1e, 0x86, 0x6c, 0x25, 0x02, 0x1e, 0xe5, 0x87, 0xac,
-	0x49, 0x0f, 0x22, 0x7d, 0x0a, 0xca, 0x62, 0xfb, 0x6c, 0xf5, 0x2b, 0xa8, 0x7f, 0x72, 0xc3, 0x2d,
-	0xcb, 0x0b, 0xcf, 0x68, 0x21, 0x8e, 0x7a, 0x56, 0x9f, 0xf4, 0x7c, 0x0b, 0x8a, 0xc6, 0xc2, 0xff,
-	0xeb, 0x88, 0x41, 0xa7, 0xdc, 0xe7, 0x2a, 0xa3, 0x6e, 0xe4, 0x33, 0xdc, 0x05, 0x25, 0x15, 0x6e,
-	0x22, 0x6e, 0xf7, 0x9d, 0xf6, 0x1a, 0x5f, 0xc2, 0x29, 0x8b, 0x3c, 0x99, 0x29, 0x5a, 0xed, 0xd4,
-	0x57, 0x4d, 0x5e, 0x43, 0x7b, 0xc6, 0xc4, 0xbb, 0x2d, 0x4b, 0x32, 0xca, 0xd2, 0x6d, 0x28, 0xe4,
-	0xb2, 0x7f, 0x4b, 0xb9, 0x1b, 0x51, 0x88, 0xaf, 0xda, 0xfd, 0x11, 0xd0, 0x8c, 0x89, 0x9b, 0x20,
-	0x15, 0x3c, 0xc9, 0xae, 0x79, 0x22, 0x67, 0x3f, 0x59, 0xba, 0xdf, 0x83, 0x76, 0x3e, 0x2a, 0x5f,
-	0xcb, 0x60, 0x9f, 0x05, 0x6e, 0xc3, 0x49, 0xe0, 0xed, 0x90, 0x93, 0xc0, 0xeb, 0xff, 0x00, 0x9d,
-	0x47, 0x62, 0x1a, 0xf2, 0x94, 0x3d, 0x41, 0x7e, 0x03, 0x74, 0xe0, 0xf7, 0x2a, 0x13, 0x2c, 0xc5,
-	0x3d, 0x68, 0x25, 0x8f, 0x32, 0x87, 0xcf, 0xe8, 0x61, 0xa8, 0x1f, 0xc1, 0x79, 0x59, 0x15, 0xf3,
-	0x28, 0x65, 0x78, 0x0c, 0x8d, 0x22, 0x2f, 0xf1, 0xea, 0xa0, 0x35, 0x56, 0xcb, 0xbf, 0xf4, 0x71,
-	0x77, 0x5a, 0x82, 0xf8, 0x35, 0x28, 0x6b, 0x37, 0x75, 0x36, 0x3c, 0x29, 0xce, 0x82, 0x42, 0x1b,
-	0x6b, 0x37, 0xbd, 0xe3, 0x49, 0xe9, 0xb2, 0x5a, 0xba, 0x1c, 0x7f, 0x38, 0xb8, 0x1c, 0xad, 0x6d,
-	0x1c, 0xf3, 0x44, 0x60, 0x0d, 0x14, 0xca, 0xfc, 0x20, 0x15, 0x2c, 0xc1, 0xea, 0x73, 0x57, 0x63,
-	0xf7, 0xd9, 0x4c, 0xff, 0xc5, 0xa0, 0xf2, 0x73, 0xe5, 0xca, 0x84, 0x3e, 0x4f, 0xfc, 0xe1, 0x3a,
-	0x8b, 0x59, 0x12, 0x32, 0xcf, 0x67, 0xc9, 0xf0, 0xa3, 0xfb, 0x90, 0x04, 0xab, 0xb2, 0x4e, 0xde,
-	0xe6, 0x7f, 0xfe, 0xe4, 0x07, 0x62, 0xbd, 0x7d, 0x18, 0xae, 0xf8, 0x66, 0x74, 0x80, 0x8e, 0x0a,
-	0xb4, 0xb8, 0xd5, 0xd3, 0x91, 0x44, 0x1f, 0x8a, 0x4f, 0xc4, 0xaf, 0xff, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0x18, 0x6a, 0x79, 0xe7, 0x46, 0x06, 0x00, 0x00,
+	// 941 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x0d, 0xf5, 0x61, 0x53, 0x63, 0x47, 0xda, 0xac, 0x1d, 0x97, 0x11, 0x90, 0x56, 0x21, 0x7a,
+	0x70, 0x2f, 0x52, 0xaa, 0x14, 0x45, 0x50, 0x04, 0x28, 0x64, 0x69, 0x6d, 0x13, 0x92, 0x29, 0x65,
+	0x49, 0x05, 0x71, 0x2f, 0x04, 0x2d, 0x6e, 0x24, 0xc2, 0x34, 0xc9, 0x72, 0x57, 0x46, 0xf8, 0x6b,
+	0xfa, 0x33, 0x7b, 0x2b, 0x8a, 0x25, 0x45, 0x59, 0x96, 0xeb, 0xba, 0xe8, 0x49, 0x7c, 0x33, 0x6f,
+	0xde, 0xbc, 0xd9, 0xd1, 0x62, 0xe1, 0x55, 0xcc, 0x58, 0xd2, 0x99, 0x2d, 0x5c, 0x3f, 0x9c, 0x45,
+	0x1e, 0x73, 0xf8, 0xc2, 0xbf, 0x69, 0xc7, 0x49, 0x24, 0x22, 0xbc, 0x93, 0xfd, 0xf0, 0x66, 0x73,
+	0x8b, 0xc2, 0x6e, 0x59, 0x28, 0x72, 0x4e, 0xf3, 0x20, 0xcb, 0xc5, 0x49, 0x14, 0x47, 0xdc, 0x0d,
+	0x56, 0xc1, 0xef, 0xe6, 0x51, 0x34, 0x0f, 0x58, 0x27, 0x43, 0x57, 0xcb, 0x2f, 0x1d, 0xe1, 0xdf,
+	0x30, 0x2e, 0xdc, 0x9b, 0x38, 0x27, 0xe8, 0x7f, 0x55, 0x01, 0xf5, 0x0b, 0xbd, 0x0b, 0xc6, 0xb9,
+	0x3b, 0x67, 0xf8, 0x47, 0xa8, 0x88, 0x34, 0x66, 0x9a, 0xd2, 0x52, 0x8e, 0xeb, 0xdd, 0xd7, 0x39,
+	0x95, 0xb7, 0xb7, 0x79, 0x6d, 0x3b, 0x8d, 0x19, 0xcd, 0xa8, 0xf8, 0x3d, 0xd4, 0xd6, 0xd2, 0x5a,
+	0xa9, 0xa5, 0x1c, 0xef, 0x75, 0x9b, 0xed, 0xbc, 0x79, 0xbb, 0x68, 0xde, 0xb6, 0x0b, 0x06, 0xbd,
+	0x23, 0x63, 0x0d, 0x76, 0x63, 0x37, 0x0d, 0x22, 0xd7, 0xd3, 0xca, 0x2d, 0xe5, 0x78, 0x9f, 0x16,
+	0x10, 0x63, 0xa8, 0x88, 0xaf, 0xbe, 0xa7, 0x55, 0x5a, 0xca, 0x71, 0x8d, 0x66, 0xdf, 0xb8, 0x0b,
+	0x6a, 0x31, 0xa2, 0x56, 0xcd, 0xda, 0x1c, 0x15, 0xf6, 0x2c, 0x7f, 0x1e, 0x32, 0x6f, 0xb2, 0xca,
+	0xd2, 0x35, 0x0f, 0xff, 0x0a, 0x8d, 0xad, 0x23, 0xd3, 0x76, 0xee, 0x97, 0xae, 0x27, 0x23, 0x32,
+	0x4b, 0xeb, 0xb3, 0x7b, 0x18, 0xbf, 0x06, 0x98, 0x2d, 0xdc, 0x30, 0x64, 0x81, 0xe3, 0x7b, 0xda,
+	0x6e, 0x66, 0xa7, 0xb6, 0x8a, 0x18, 0x1e, 0xee, 0x01, 0xda, 0xd2, 0xe7, 0x9a, 0xda, 0x2a, 0xff,
+	0x4b, 0x83, 0xc6, 0xfd, 0x06, 0x5c, 0xff, 0xb3, 0x04, 0x15, 0x79, 0x9a, 0xf8, 0x39, 0xd4, 0xa6,
+	0xe6, 0x80, 0x9c, 0x1a, 0x26, 0x19, 0xa0, 0x67, 0x78, 0x1f, 0x54, 0x4a, 0xce, 0x0c, 0xcb, 0x26,
+	0x14, 0x29, 0xb8, 0x0e, 0x50, 0x20, 0x32, 0x40, 0x25, 0xac, 0x42, 0xc5, 0x30, 0x0d, 0x1b, 0x95,
+	0x71, 0x0d, 0xaa, 0x94, 0xf4, 0x06, 0x97, 0xa8, 0x82, 0x1b, 0xb0, 0x67, 0xd3, 0x9e, 0x69, 0xf5,
+	0xfa, 0xb6, 0x31, 0x36, 0x51, 0x55, 0x4a, 0xf6, 0xc7, 0x17, 0x93, 0x11, 0xb1, 0xc9, 0x00, 0xed,
+	0x48, 0x2a, 0xa1, 0x74, 0x4c, 0xd1, 0xae, 0xcc, 0x9c, 0x11, 0xdb, 0xb1, 0xec, 0x9e, 0x4d, 0x90,
+	0x2a, 0xe1, 0x64, 0x5a, 0xc0, 0x9a, 0x84, 0x03, 0x32, 0x5a, 0x41, 0xc0, 0x87, 0x80, 0x0c, 0xf3,
+	0xd3, 0x78, 0x48, 0x9c, 0xfe, 0x79, 0xcf, 0x30, 0xfb, 0xe3, 0x01, 0x41, 0x7b, 0xb9, 0x41, 0x6b,
+	0x32, 0x36, 0x2d, 0x82, 0x9e, 0xe3, 0x23, 0xc0, 0x6b, 0x41, 0xe7, 0xe4, 0xd2, 0xa1, 0x3d, 0xf3,
+	0x8c, 0xa0, 0xba, 0xac, 0x95, 0xf1, 0x8f, 0x53, 0x42, 0x2f, 0x1d, 0x4a, 0xac, 0xe9, 0xc8, 0x46,
+	0x0d, 0x19, 0xcd, 0x23, 0x39, 0xdf, 0x24, 0x9f, 0x6d, 0x84, 0xf0, 0x4b, 0x78, 0xb1, 0x19, 0xed,
+	0x8f, 0xc6, 0x16, 0x41, 0x2f, 0xa4, 0x9b, 0x21, 0x21, 0x93, 0xde, 0xc8, 0xf8, 0x44, 0x10, 0xc6,
+	0xdf, 0xc0, 0x81, 0x54, 0x3c, 0x37, 0x2c, 0x7b, 0x4c, 0x2f, 0x9d, 0xd3, 0x31, 0x75, 0x86, 0xe4,
+	0x12, 0x1d, 0xdc, 0xb7, 0x70, 0x31, 0x1d, 0xd9, 0xc6, 0x64, 0x44, 0xd0, 0xa1, 0x8c, 0x4f, 0xa6,
+	0x0f, 0xe2, 0x2f, 0xf5, 0x0f, 0xa0, 0x9e, 0x31, 0x61, 0x09, 0x57, 0x30, 0x8c, 0xa0, 0x7c, 0xcd,
+	0xd2, 0xec, 0x6f, 0x5f, 0xa3, 0xf2, 0x13, 0x7f, 0x0b, 0x30, 0x8b, 0x82, 0x80, 0xcd, 0x84, 0x1f,
+	0x85, 0xd9, 0xff, 0xba, 0x46, 0x37, 0x22, 0x3a, 0x05, 0x75, 0xb2, 0x7c, 0xb4, 0xfa, 0x10, 0xaa,
+	0xb7, 0x6e, 0xb0, 0x64, 0x59, 0xe1, 0x3e, 0xcd, 0xc1, 0x96, 0x66, 0xf9, 0x81, 0xe6, 0x07, 0x50,
+	0x07, 0x2c, 0xf8, 0xbf, 0x8e, 0x4e, 0x01, 0x15, 0xf3, 0x5c, 0x2c, 0x03, 0xe1, 0xc7, 0x01, 0x93,
+	0x17, 0xe9, 0x9a, 0xa5, 0x5c, 0x53, 0x5a, 0x65, 0x79, 0x91, 0xe4, 0xf7, 0x93, 0x3a, 0x6f, 0xe1,
+	0x68, 0x5b, 0x87, 0x32, 0xbe, 0x0c, 0x04, 0x3e, 0x82, 0x9d, 0x6c, 0x90, 0x5c, 0x6f, 0x9f, 0xae,
+	0x90, 0xfe, 0x87, 0x02, 0x68, 0xb2, 0xbc, 0x5f, 0x82, 0xdf, 0x41, 0xf9, 0xfa, 0x36, 0x67, 0xee,
+	0x75, 0xdf, 0x14, 0xd7, 0x61, 0x9b, 0xd6, 0x1e, 0xde, 0x72, 0x12, 0x8a, 0x24, 0xa5, 0x92, 0xfd,
+	0x94, 0xb7, 0xe6, 0xcf, 0xa0, 0x16, 0x05, 0xff, 0xf5, 0xd4, 0x7f, 0x29, 0xbd, 0x57, 0x74, 0x06,
+	0x8d, 0x62, 0xa6, 0x93, 0x94, 0xba, 0xe1, 0x9c, 0xe1, 0x26, 0xa8, 0x5c, 0xb8, 0x89, 0x18, 0xae,
+	0x35, 0xd6, 0x58, 0x0e, 0xca, 0x42, 0x4f, 0x66, 0x72, 0x0b, 0x2b, 0xf4, 0xe4, 0x02, 0x4f, 0xa1,
+	0x7e, 0xc6, 0xc4, 0xc7, 0x25, 0x4b, 0xd2, 0xd5, 0x91, 0x1d, 0x42, 0xf5, 0x77, 0x09, 0x57, 0x2d,
+	0x72, 0xf0, 0xe4, 0x0a, 0xbe, 0xcf, 0x56, 0x79, 0xee, 0x73, 0x11, 0x25, 0xe9, 0x69, 0x94, 0x0c,
+	0xd9, 0x3f, 0x8c, 0xab, 0xb7, 0xa0, 0x9e, 0xb5, 0xca, 0xc6, 0x32, 0xd9, 0x57, 0x81, 0xeb, 0x50,
+	0xf2, 0xbd, 0x15, 0xa5, 0xe4, 0x7b, 0xfa, 0x1b, 0x68, 0xdc, 0x31, 0xfa, 0x41, 0xc4, 0xd9, 0x03,
+	0xca, 0x4f, 0x80, 0x36, 0xfc, 0x9e, 0xa4, 0x82, 0x71, 0xdc, 0x82, 0xbd, 0xe4, 0x0e, 0x66, 0xe4,
+	0x7d, 0xba, 0x19, 0xd2, 0x43, 0x78, 0x5e, 0x54, 0xc5, 0x51, 0xc8, 0x19, 0xee, 0xc2, 0x6e, 0x9e,
+	0x2f, 0x36, 0xae, 0x15, 0x1b, 0xdf, 0x56, 0xa7, 0x05, 0x11, 0xbf, 0x02, 0x75, 0xe1, 0x72, 0xe7,
+	0x26, 0x4a, 0xf2, 0x8d, 0xa9, 0x74, 0x77, 0xe1, 0xf2, 0x8b, 0x28, 0x29, 0x5c, 0x96, 0x0b, 0x97,
+	0xdd, 0xcf, 0x1b, 0x6f, 0x95, 0xb5, 0x8c, 0xe3, 0x28, 0x11, 0x78, 0x00, 0x2a, 0x65, 0x73, 0x9f,
+	0x0b, 0x96, 0x60, 0xed, 0xb1, 0x97, 0xaa, 0xf9, 0x68, 0x46, 0x7f, 0x76, 0xac, 0xbc, 0x55, 0x4e,
+	0xc6, 0xa0, 0x47, 0xc9, 0xbc, 0xbd, 0x48, 0x63, 0x96, 0x04, 0xcc, 0x9b, 0xb3, 0xa4, 0xfd, 0xc5,
+	0xbd, 0x4a, 0xfc, 0x59, 0x51, 0x27, 0x1f, 0xd7, 0xdf, 0x7e, 0x98, 0xfb, 0x62, 0xb1, 0xbc, 0x6a,
+	0xcf, 0xa2, 0x9b, 0xce, 0x06, 0xb5, 0x93, 0x53, 0xf3, 0x47, 0x96, 0x77, 0x24, 0xf5, 0x2a, 0x7f,
+	0xb1, 0xdf, 0xfd, 0x3d, 0x00, 0xe1, 0x47, 0x1f, 0x80, 0xd5, 0x07, 0x00, 0x00,
 }
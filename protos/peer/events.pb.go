@@ -419,7 +419,11 @@ func (m *FilteredTransactionActions) GetChaincodeActions() []*FilteredChaincodeA
 // FilteredChaincodeAction is a minimal set of information about an action within a
 // transaction.
 type FilteredChaincodeAction struct {
+	// the last event emitted by the chaincode
 	ChaincodeEvent *ChaincodeEvent `protobuf:"bytes,1,opt,name=chaincode_event,json=chaincodeEvent" json:"chaincode_event,omitempty"`
+	// all the events emitted by the chaincode, in the order in which they
+	// were set
+	ChaincodeEvents []*ChaincodeEvent `protobuf:"bytes,2,rep,name=chaincode_events,json=chaincodeEvents" json:"chaincode_events,omitempty"`
 }
 
 func (m *FilteredChaincodeAction) Reset()                    { *m = FilteredChaincodeAction{} }
@@ -434,6 +438,13 @@ func (m *FilteredChaincodeAction) GetChaincodeEvent() *ChaincodeEvent {
 	return nil
 }
 
+func (m *FilteredChaincodeAction) GetChaincodeEvents() []*ChaincodeEvent {
+	if m != nil {
+		return m.ChaincodeEvents
+	}
+	return nil
+}
+
 // SignedEvent is used for any communication between consumer and producer
 type SignedEvent struct {
 	// Signature over the event bytes
@@ -1179,69 +1190,69 @@ var _Deliver_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("peer/events.proto", fileDescriptor5) }
 
 var fileDescriptor5 = []byte{
-	// 1009 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdd, 0x72, 0xdb, 0x44,
-	0x14, 0xb6, 0x62, 0xc7, 0xb1, 0x8e, 0xe3, 0xd4, 0xd9, 0xb4, 0xa9, 0xc6, 0x05, 0x5a, 0xc4, 0xc0,
-	0x04, 0x2e, 0xec, 0x60, 0x3a, 0x0c, 0xd3, 0x0b, 0x98, 0xf8, 0x27, 0xc8, 0x34, 0x4d, 0x32, 0x1b,
-	0x87, 0x8b, 0x5e, 0xa0, 0x59, 0xcb, 0x6b, 0x59, 0xad, 0x2c, 0x79, 0x76, 0xd7, 0x99, 0xe4, 0x11,
-	0x78, 0x03, 0xde, 0x80, 0x19, 0x5e, 0x85, 0x17, 0xe2, 0x92, 0xd1, 0x6a, 0x57, 0x52, 0x6c, 0xda,
-	0x21, 0x57, 0xf6, 0xf9, 0xfb, 0xf6, 0xfc, 0x7c, 0x67, 0x57, 0xb0, 0xbf, 0xa4, 0x94, 0x75, 0xe8,
-	0x0d, 0x8d, 0x04, 0x6f, 0x2f, 0x59, 0x2c, 0x62, 0x54, 0x95, 0x3f, 0xbc, 0x75, 0xe0, 0xc5, 0x8b,
-	0x45, 0x1c, 0x75, 0xd2, 0x9f, 0xd4, 0xd8, 0x7a, 0xee, 0xc7, 0xb1, 0x1f, 0xd2, 0x8e, 0x94, 0x26,
-	0xab, 0x59, 0x47, 0x04, 0x0b, 0xca, 0x05, 0x59, 0x2c, 0x95, 0x43, 0x4b, 0x02, 0x7a, 0x73, 0x12,
-	0x44, 0x5e, 0x3c, 0xa5, 0xae, 0x84, 0x56, 0xb6, 0x43, 0x69, 0x13, 0x8c, 0x44, 0x9c, 0x78, 0x22,
-	0xd0, 0xa0, 0xf6, 0x25, 0xec, 0xf6, 0x75, 0x00, 0xa6, 0x3e, 0xfa, 0x1c, 0x76, 0x73, 0x80, 0x60,
-	0x6a, 0x19, 0x2f, 0x8c, 0x23, 0x13, 0xd7, 0x33, 0xdd, 0x68, 0x8a, 0x3e, 0x05, 0x90, 0xc8, 0x6e,
-	0x44, 0x16, 0xd4, 0xda, 0x92, 0x0e, 0xa6, 0xd4, 0x9c, 0x93, 0x05, 0xb5, 0xff, 0x34, 0xa0, 0x36,
-	0x8a, 0x04, 0x65, 0x94, 0x0b, 0x74, 0xac, 0x7d, 0xc5, 0xdd, 0x92, 0x4a, 0xb0, 0xbd, 0xee, 0x7e,
-	0x7a, 0x34, 0x6f, 0x0f, 0x13, 0xcb, 0xf8, 0x6e, 0x49, 0x55, 0x78, 0xf2, 0x17, 0x0d, 0x00, 0xe5,
-	0x09, 0x30, 0xea, 0xbb, 0x41, 0x34, 0x8b, 0xe5, 0x29, 0xf5, 0xee, 0x63, 0x1d, 0x59, 0x4c, 0xd9,
-	0x29, 0xe1, 0xa6, 0x57, 0x90, 0x47, 0xd1, 0x2c, 0x46, 0x16, 0xec, 0x48, 0xdd, 0x68, 0x60, 0x95,
-	0x65, 0x82, 0x5a, 0xec, 0x99, 0xb0, 0xa3, 0x9c, 0xec, 0x97, 0x50, 0xc3, 0xd4, 0x0f, 0xb8, 0xa0,
-	0x0c, 0x1d, 0x41, 0x35, 0x9d, 0x84, 0x65, 0xbc, 0x28, 0x1f, 0xd5, 0xbb, 0x4d, 0x7d, 0x94, 0x2e,
-	0x05, 0x2b, 0xbb, 0xfd, 0x06, 0x4c, 0x4c, 0xdf, 0x51, 0xd9, 0x44, 0xf4, 0x05, 0x6c, 0x89, 0x5b,
-	0x59, 0x57, 0xbd, 0x7b, 0xa0, 0x43, 0xc6, 0x79, 0x97, 0xf1, 0x96, 0xb8, 0x45, 0xcf, 0xc0, 0xa4,
-	0x8c, 0xc5, 0xcc, 0x5d, 0x70, 0x5f, 0xf5, 0xab, 0x26, 0x15, 0x6f, 0xb8, 0x6f, 0x7f, 0x0f, 0x70,
-	0x1d, 0xb1, 0x87, 0xa7, 0xf1, 0x87, 0x01, 0x8d, 0xd3, 0x20, 0x4c, 0xb4, 0xd3, 0x5e, 0x18, 0x7b,
-	0xef, 0x93, 0xb9, 0x78, 0x73, 0x12, 0x45, 0x34, 0xcc, 0x07, 0x67, 0x2a, 0xcd, 0x68, 0x8a, 0x0e,
-	0xa1, 0x1a, 0xad, 0x16, 0x13, 0xca, 0x64, 0x0a, 0x15, 0xac, 0x24, 0x74, 0x09, 0x4f, 0x66, 0x0a,
-	0xc7, 0x2d, 0xf0, 0x83, 0x5b, 0x15, 0x99, 0xc1, 0x33, 0x9d, 0x81, 0x3e, 0xac, 0x58, 0xdd, 0xe3,
-	0xd9, 0xa6, 0x92, 0xdb, 0xff, 0x18, 0x70, 0xf0, 0x1f, 0xde, 0x08, 0x41, 0x45, 0xdc, 0x66, 0xa9,
-	0xc9, 0xff, 0xe8, 0x2b, 0xa8, 0x48, 0x6a, 0x6c, 0x49, 0x6a, 0xa0, 0xb6, 0x62, 0xbc, 0x43, 0xc9,
-	0x94, 0x32, 0xc9, 0x0d, 0x69, 0x47, 0xa7, 0x80, 0xc4, 0xad, 0x7b, 0x43, 0xc2, 0x60, 0x4a, 0x12,
-	0x30, 0x37, 0x99, 0xb6, 0x9c, 0xed, 0x5e, 0xd7, 0xca, 0x1a, 0x7f, 0xfb, 0x6b, 0xe6, 0xd0, 0x4f,
-	0xd8, 0xd0, 0x14, 0x6b, 0x1a, 0x74, 0x0d, 0x07, 0x85, 0x22, 0xdd, 0xbc, 0xd6, 0x64, 0x82, 0xf6,
-	0x47, 0x6a, 0x3d, 0x49, 0x3d, 0x9d, 0x12, 0x46, 0x62, 0x43, 0xdb, 0xab, 0x42, 0x65, 0x40, 0x04,
-	0xb1, 0xdf, 0x41, 0xeb, 0xc3, 0xb1, 0xe8, 0x0c, 0xf6, 0x73, 0x6e, 0xeb, 0xa3, 0xd3, 0x41, 0x3f,
-	0x5f, 0x3f, 0x3a, 0xa3, 0x78, 0x1a, 0x5c, 0xe0, 0xb8, 0x42, 0xb3, 0xdf, 0xc2, 0xd3, 0x0f, 0x38,
-	0xa3, 0x9f, 0xe0, 0xd1, 0xda, 0x35, 0xa0, 0x38, 0x7a, 0xb8, 0xb1, 0x41, 0x72, 0x09, 0xf1, 0x9e,
-	0x77, 0x4f, 0xb6, 0x5f, 0x43, 0xfd, 0x2a, 0xf0, 0x23, 0x3a, 0x95, 0x22, 0xfa, 0x04, 0x4c, 0x1e,
-	0xf8, 0x11, 0x11, 0x2b, 0x96, 0x6e, 0xf1, 0x2e, 0xce, 0x15, 0xe8, 0x33, 0xb5, 0xe4, 0xbd, 0x3b,
-	0x41, 0xb9, 0x9c, 0xe4, 0x2e, 0x2e, 0x68, 0xec, 0xbf, 0xcb, 0xb0, 0x9d, 0xe2, 0xb4, 0xa1, 0xa6,
-	0xa9, 0xae, 0x12, 0xca, 0x08, 0xae, 0x37, 0xd1, 0x29, 0xe1, 0xcc, 0x07, 0x7d, 0x09, 0xdb, 0x93,
-	0x84, 0xdb, 0x6a, 0xff, 0x1b, 0x9a, 0x1e, 0x92, 0xf0, 0x4e, 0x09, 0xa7, 0x56, 0x74, 0xb2, 0x59,
-	0x6e, 0xf9, 0x63, 0xe5, 0x3a, 0xa5, 0xf5, 0x82, 0xd1, 0xb7, 0x60, 0x32, 0xbd, 0xd5, 0x8a, 0x0d,
-	0xfb, 0x79, 0x6a, 0xca, 0xe0, 0x94, 0x70, 0xee, 0x85, 0x5e, 0x02, 0xac, 0xb2, 0xcd, 0xb5, 0xb6,
-	0x65, 0x0c, 0xd2, 0x31, 0xf9, 0x4e, 0x3b, 0x25, 0x5c, 0xf0, 0x43, 0x3f, 0xc2, 0x5e, 0xb6, 0x6e,
-	0x69, 0x6d, 0x3b, 0x32, 0xf2, 0xc9, 0x3a, 0x01, 0x74, 0x8d, 0x8d, 0xd9, 0xbd, 0x2d, 0x4f, 0x6e,
-	0x36, 0x46, 0x89, 0x88, 0x99, 0x55, 0x95, 0x9d, 0xd6, 0x22, 0xfa, 0x01, 0xcc, 0xec, 0x45, 0xb0,
-	0x6a, 0x12, 0xb4, 0xd5, 0x4e, 0xdf, 0x8c, 0xb6, 0x7e, 0x33, 0xda, 0x63, 0xed, 0x81, 0x73, 0x67,
-	0x64, 0x43, 0x43, 0x84, 0xdc, 0xf5, 0x28, 0x13, 0xee, 0x9c, 0xf0, 0xb9, 0x65, 0x4a, 0xe4, 0xba,
-	0x08, 0x79, 0x9f, 0x32, 0xe1, 0x10, 0x3e, 0xef, 0xed, 0xa8, 0x19, 0xda, 0x7f, 0x19, 0xf0, 0x68,
-	0x40, 0xc3, 0xe0, 0x86, 0x32, 0x4c, 0xf9, 0x32, 0x8e, 0x38, 0x4d, 0xae, 0x2d, 0x2e, 0x88, 0x58,
-	0x71, 0x75, 0xc5, 0xef, 0xe9, 0x41, 0x5d, 0x49, 0xad, 0x53, 0xc2, 0xca, 0xfe, 0x7f, 0x27, 0xba,
-	0xd9, 0xa5, 0xf2, 0x43, 0xba, 0x94, 0xec, 0x63, 0x72, 0x79, 0x7c, 0x73, 0x0d, 0x66, 0xf6, 0xca,
-	0xa0, 0x5d, 0xa8, 0xe1, 0xe1, 0xcf, 0xa3, 0xab, 0xf1, 0x10, 0x37, 0x4b, 0xc8, 0x84, 0xed, 0xde,
-	0xd9, 0x45, 0xff, 0x75, 0xd3, 0x40, 0x0d, 0x30, 0xfb, 0xce, 0xc9, 0xe8, 0xbc, 0x7f, 0x31, 0x18,
-	0x36, 0xb7, 0x12, 0x11, 0x0f, 0x7f, 0x19, 0xf6, 0xc7, 0xa3, 0x8b, 0xf3, 0x66, 0x19, 0xed, 0x43,
-	0xe3, 0x74, 0x74, 0x36, 0x1e, 0xe2, 0xe1, 0x20, 0x0d, 0xa8, 0x74, 0x5f, 0x41, 0x55, 0xc2, 0x72,
-	0x74, 0x0c, 0x95, 0xfe, 0x9c, 0x08, 0x94, 0x5d, 0xfe, 0x85, 0xb5, 0x69, 0x35, 0xee, 0xbd, 0x74,
-	0x76, 0xe9, 0xc8, 0x38, 0x36, 0xba, 0xbf, 0x1b, 0xb0, 0xa3, 0xfa, 0x87, 0x5e, 0xe5, 0x7f, 0x9b,
-	0xba, 0x13, 0xc3, 0xe8, 0x86, 0x86, 0xf1, 0x92, 0xb6, 0x9e, 0xea, 0xe8, 0xb5, 0x6e, 0xa7, 0x38,
-	0xa8, 0x97, 0x8d, 0x41, 0xf7, 0xe2, 0xc1, 0x18, 0xbd, 0xdf, 0xc0, 0x8e, 0x99, 0xdf, 0x9e, 0xdf,
-	0x2d, 0x29, 0x0b, 0xe9, 0xd4, 0xa7, 0xac, 0x3d, 0x23, 0x13, 0x16, 0x78, 0x3a, 0x2c, 0xf9, 0x6a,
-	0xe8, 0x35, 0xd2, 0x5a, 0x2f, 0x89, 0xf7, 0x9e, 0xf8, 0xf4, 0xed, 0xd7, 0x7e, 0x20, 0xe6, 0xab,
-	0x49, 0x72, 0x56, 0xa7, 0x10, 0xd9, 0x49, 0x23, 0xd3, 0xcf, 0x13, 0xde, 0x49, 0x22, 0x27, 0xe9,
-	0xf7, 0xcc, 0x77, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x7f, 0xfc, 0x9e, 0x06, 0xeb, 0x08, 0x00,
-	0x00,
+	// 1022 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x93, 0x34, 0x8d, 0x5f, 0x9a, 0x6e, 0x3a, 0xdd, 0xed, 0x5a, 0x59, 0x60, 0x8b, 0x11,
+	0xa8, 0x70, 0x48, 0x4a, 0x58, 0x21, 0xb4, 0x07, 0x50, 0xf3, 0xa7, 0x38, 0x6c, 0xb7, 0xad, 0xa6,
+	0x29, 0x07, 0x0e, 0x44, 0x13, 0x67, 0xe2, 0x78, 0xd7, 0xb1, 0xa3, 0x99, 0x49, 0xd5, 0x7e, 0x04,
+	0xbe, 0x01, 0x17, 0xce, 0x48, 0x7c, 0x15, 0xbe, 0x10, 0x47, 0xe4, 0xf1, 0x8c, 0xed, 0x26, 0x6c,
+	0x45, 0x4f, 0xf1, 0xbc, 0xf7, 0x7e, 0xbf, 0x79, 0xff, 0x27, 0xb0, 0xb7, 0xa4, 0x94, 0xb5, 0xe9,
+	0x0d, 0x0d, 0x05, 0x6f, 0x2d, 0x59, 0x24, 0x22, 0x54, 0x91, 0x3f, 0xbc, 0xb9, 0xef, 0x46, 0x8b,
+	0x45, 0x14, 0xb6, 0x93, 0x9f, 0x44, 0xd9, 0x7c, 0xe9, 0x45, 0x91, 0x17, 0xd0, 0xb6, 0x3c, 0x4d,
+	0x56, 0xb3, 0xb6, 0xf0, 0x17, 0x94, 0x0b, 0xb2, 0x58, 0x2a, 0x83, 0xa6, 0x24, 0x74, 0xe7, 0xc4,
+	0x0f, 0xdd, 0x68, 0x4a, 0xc7, 0x92, 0x5a, 0xe9, 0x0e, 0xa4, 0x4e, 0x30, 0x12, 0x72, 0xe2, 0x0a,
+	0x5f, 0x93, 0xda, 0x97, 0xb0, 0xd3, 0xd3, 0x00, 0x4c, 0x3d, 0xf4, 0x29, 0xec, 0x64, 0x04, 0xfe,
+	0xd4, 0x32, 0x0e, 0x8d, 0x23, 0x13, 0xd7, 0x52, 0xd9, 0x70, 0x8a, 0x3e, 0x06, 0x90, 0xcc, 0xe3,
+	0x90, 0x2c, 0xa8, 0x55, 0x94, 0x06, 0xa6, 0x94, 0x9c, 0x93, 0x05, 0xb5, 0xff, 0x34, 0xa0, 0x3a,
+	0x0c, 0x05, 0x65, 0x94, 0x0b, 0x74, 0xac, 0x6d, 0xc5, 0xdd, 0x92, 0x4a, 0xb2, 0xdd, 0xce, 0x5e,
+	0x72, 0x35, 0x6f, 0x0d, 0x62, 0xcd, 0xe8, 0x6e, 0x49, 0x15, 0x3c, 0xfe, 0x44, 0x7d, 0x40, 0x99,
+	0x03, 0x8c, 0x7a, 0x63, 0x3f, 0x9c, 0x45, 0xf2, 0x96, 0x5a, 0xe7, 0xa9, 0x46, 0xe6, 0x5d, 0x76,
+	0x0a, 0xb8, 0xe1, 0xe6, 0xce, 0xc3, 0x70, 0x16, 0x21, 0x0b, 0xb6, 0xa5, 0x6c, 0xd8, 0xb7, 0x4a,
+	0xd2, 0x41, 0x7d, 0xec, 0x9a, 0xb0, 0xad, 0x8c, 0xec, 0x57, 0x50, 0xc5, 0xd4, 0xf3, 0xb9, 0xa0,
+	0x0c, 0x1d, 0x41, 0x25, 0xa9, 0x84, 0x65, 0x1c, 0x96, 0x8e, 0x6a, 0x9d, 0x86, 0xbe, 0x4a, 0x87,
+	0x82, 0x95, 0xde, 0x7e, 0x0b, 0x26, 0xa6, 0xef, 0xa8, 0x4c, 0x22, 0xfa, 0x0c, 0x8a, 0xe2, 0x56,
+	0xc6, 0x55, 0xeb, 0xec, 0x6b, 0xc8, 0x28, 0xcb, 0x32, 0x2e, 0x8a, 0x5b, 0xf4, 0x02, 0x4c, 0xca,
+	0x58, 0xc4, 0xc6, 0x0b, 0xee, 0xa9, 0x7c, 0x55, 0xa5, 0xe0, 0x2d, 0xf7, 0xec, 0x6f, 0x01, 0xae,
+	0x43, 0xf6, 0x78, 0x37, 0x7e, 0x37, 0xa0, 0x7e, 0xea, 0x07, 0xb1, 0x74, 0xda, 0x0d, 0x22, 0xf7,
+	0x7d, 0x5c, 0x17, 0x77, 0x4e, 0xc2, 0x90, 0x06, 0x59, 0xe1, 0x4c, 0x25, 0x19, 0x4e, 0xd1, 0x01,
+	0x54, 0xc2, 0xd5, 0x62, 0x42, 0x99, 0x74, 0xa1, 0x8c, 0xd5, 0x09, 0x5d, 0xc2, 0xb3, 0x99, 0xe2,
+	0x19, 0xe7, 0xfa, 0x83, 0x5b, 0x65, 0xe9, 0xc1, 0x0b, 0xed, 0x81, 0xbe, 0x2c, 0x1f, 0xdd, 0xd3,
+	0xd9, 0xa6, 0x90, 0xdb, 0xff, 0x18, 0xb0, 0xff, 0x1f, 0xd6, 0x08, 0x41, 0x59, 0xdc, 0xa6, 0xae,
+	0xc9, 0x6f, 0xf4, 0x05, 0x94, 0x65, 0x6b, 0x14, 0x65, 0x6b, 0xa0, 0x96, 0xea, 0x78, 0x87, 0x92,
+	0x29, 0x65, 0xb2, 0x37, 0xa4, 0x1e, 0x9d, 0x02, 0x12, 0xb7, 0xe3, 0x1b, 0x12, 0xf8, 0x53, 0x12,
+	0x93, 0x8d, 0xe3, 0x6a, 0xcb, 0xda, 0xee, 0x76, 0xac, 0x34, 0xf1, 0xb7, 0x3f, 0xa7, 0x06, 0xbd,
+	0xb8, 0x1b, 0x1a, 0x62, 0x4d, 0x82, 0xae, 0x61, 0x3f, 0x17, 0xe4, 0x38, 0x8b, 0x35, 0xae, 0xa0,
+	0xfd, 0x40, 0xac, 0x27, 0x89, 0xa5, 0x53, 0xc0, 0x48, 0x6c, 0x48, 0xbb, 0x15, 0x28, 0xf7, 0x89,
+	0x20, 0xf6, 0x3b, 0x68, 0x7e, 0x18, 0x8b, 0xce, 0x60, 0x2f, 0xeb, 0x6d, 0x7d, 0x75, 0x52, 0xe8,
+	0x97, 0xeb, 0x57, 0xa7, 0x2d, 0x9e, 0x80, 0x73, 0x3d, 0xae, 0xd8, 0xec, 0x3f, 0x0c, 0x78, 0xfe,
+	0x01, 0x6b, 0xf4, 0x03, 0x3c, 0x59, 0xdb, 0x03, 0xaa, 0x49, 0x0f, 0x36, 0x46, 0x48, 0x4e, 0x21,
+	0xde, 0x75, 0xef, 0x9d, 0xd1, 0x09, 0x34, 0xd6, 0x08, 0xb8, 0x55, 0x3c, 0x2c, 0x3d, 0xc0, 0xf0,
+	0xe4, 0x3e, 0x03, 0xb7, 0xdf, 0x40, 0xed, 0xca, 0xf7, 0x42, 0x3a, 0x4d, 0x18, 0x3f, 0x02, 0x93,
+	0xfb, 0x5e, 0x48, 0xc4, 0x8a, 0x25, 0x9b, 0x60, 0x07, 0x67, 0x02, 0xf4, 0x89, 0x5a, 0x14, 0xdd,
+	0x3b, 0x41, 0xb9, 0xec, 0x86, 0x1d, 0x9c, 0x93, 0xd8, 0x7f, 0x97, 0x60, 0x2b, 0xe1, 0x69, 0x41,
+	0x55, 0x8f, 0x8b, 0x8a, 0x29, 0x1d, 0x12, 0x3d, 0xcd, 0x4e, 0x01, 0xa7, 0x36, 0xe8, 0x73, 0xd8,
+	0x9a, 0xc4, 0xf3, 0xa1, 0x76, 0x48, 0x5d, 0xb7, 0x98, 0x1c, 0x1a, 0xa7, 0x80, 0x13, 0x2d, 0x3a,
+	0xd9, 0xcc, 0x58, 0xe9, 0xa1, 0x8c, 0x39, 0x85, 0x8d, 0x9c, 0x7d, 0x0d, 0x26, 0xd3, 0x9b, 0x41,
+	0x75, 0xd4, 0x5e, 0xe6, 0x9a, 0x52, 0x38, 0x05, 0x9c, 0x59, 0xa1, 0x57, 0x00, 0xab, 0x74, 0xfa,
+	0xad, 0x2d, 0x89, 0x41, 0x1a, 0x93, 0xed, 0x05, 0xa7, 0x80, 0x73, 0x76, 0xe8, 0x7b, 0xd8, 0x4d,
+	0x47, 0x36, 0x89, 0x6d, 0x5b, 0x22, 0x9f, 0xad, 0x37, 0x91, 0x8e, 0xb1, 0x3e, 0xcb, 0x0b, 0xe4,
+	0x76, 0x64, 0x94, 0x88, 0x88, 0x59, 0x15, 0x99, 0x69, 0x7d, 0x44, 0xdf, 0x81, 0x99, 0xbe, 0x2a,
+	0x56, 0x55, 0x92, 0x36, 0x5b, 0xc9, 0xbb, 0xd3, 0xd2, 0xef, 0x4e, 0x6b, 0xa4, 0x2d, 0x70, 0x66,
+	0x8c, 0x6c, 0xa8, 0x8b, 0x80, 0x8f, 0x5d, 0xca, 0xc4, 0x78, 0x4e, 0xf8, 0xdc, 0x32, 0x25, 0x73,
+	0x4d, 0x04, 0xbc, 0x47, 0x99, 0x70, 0x08, 0x9f, 0x77, 0xb7, 0x55, 0x0d, 0xed, 0xbf, 0x0c, 0x78,
+	0xd2, 0xa7, 0x81, 0x7f, 0x43, 0x19, 0xa6, 0x7c, 0x19, 0x85, 0x9c, 0xc6, 0xab, 0x8f, 0x0b, 0x22,
+	0x56, 0x5c, 0x3d, 0x13, 0xbb, 0xba, 0x50, 0x57, 0x52, 0xea, 0x14, 0xb0, 0xd2, 0xff, 0xdf, 0x8a,
+	0x6e, 0x66, 0xa9, 0xf4, 0x98, 0x2c, 0xc5, 0x33, 0x1d, 0x2f, 0xa0, 0xaf, 0xae, 0xc1, 0x4c, 0x5f,
+	0x2a, 0xb4, 0x03, 0x55, 0x3c, 0xf8, 0x71, 0x78, 0x35, 0x1a, 0xe0, 0x46, 0x01, 0x99, 0xb0, 0xd5,
+	0x3d, 0xbb, 0xe8, 0xbd, 0x69, 0x18, 0xa8, 0x0e, 0x66, 0xcf, 0x39, 0x19, 0x9e, 0xf7, 0x2e, 0xfa,
+	0x83, 0x46, 0x31, 0x3e, 0xe2, 0xc1, 0x4f, 0x83, 0xde, 0x68, 0x78, 0x71, 0xde, 0x28, 0xa1, 0x3d,
+	0xa8, 0x9f, 0x0e, 0xcf, 0x46, 0x03, 0x3c, 0xe8, 0x27, 0x80, 0x72, 0xe7, 0x35, 0x54, 0x92, 0x41,
+	0x41, 0xc7, 0x50, 0xee, 0xcd, 0x89, 0x40, 0xe9, 0x03, 0x92, 0x1b, 0x9b, 0x66, 0xfd, 0xde, 0x6b,
+	0x69, 0x17, 0x8e, 0x8c, 0x63, 0xa3, 0xf3, 0x9b, 0x01, 0xdb, 0x2a, 0x7f, 0xe8, 0x75, 0xf6, 0xd9,
+	0xd0, 0x99, 0x18, 0x84, 0x37, 0x34, 0x88, 0x96, 0xb4, 0xf9, 0x5c, 0xa3, 0xd7, 0xb2, 0x9d, 0xf0,
+	0xa0, 0x6e, 0x5a, 0x06, 0x9d, 0x8b, 0x47, 0x73, 0x74, 0x7f, 0x05, 0x3b, 0x62, 0x5e, 0x6b, 0x7e,
+	0xb7, 0xa4, 0x2c, 0xa0, 0x53, 0x8f, 0xb2, 0xd6, 0x8c, 0x4c, 0x98, 0xef, 0x6a, 0xd8, 0x92, 0x52,
+	0xd6, 0xad, 0x27, 0xb1, 0x5e, 0x12, 0xf7, 0x3d, 0xf1, 0xe8, 0x2f, 0x5f, 0x7a, 0xbe, 0x98, 0xaf,
+	0x26, 0xf1, 0x5d, 0xed, 0x1c, 0xb2, 0x9d, 0x20, 0x93, 0xbf, 0x38, 0xbc, 0x1d, 0x23, 0x27, 0xc9,
+	0x7f, 0xa2, 0x6f, 0xfe, 0x1d, 0x00, 0xb0, 0xe2, 0x29, 0x6e, 0x2f, 0x09, 0x00, 0x00,
 }
@@ -139,6 +139,18 @@ type ChaincodeHeaderExtension struct {
 	PayloadVisibility []byte `protobuf:"bytes,1,opt,name=payload_visibility,json=payloadVisibility,proto3" json:"payload_visibility,omitempty"`
 	// The ID of the chaincode to target.
 	ChaincodeId *ChaincodeID `protobuf:"bytes,2,opt,name=chaincode_id,json=chaincodeId" json:"chaincode_id,omitempty"`
+	// The ReadOnly field marks proposals that are not meant to be submitted
+	// as transactions, such as queries. Endorsers simulate them against a
+	// read-only view of the ledger, fail them if the chaincode attempts to
+	// write to it, and may answer them out of a cache of the responses to
+	// identical proposals.
+	ReadOnly bool `protobuf:"varint,3,opt,name=read_only,json=readOnly" json:"read_only,omitempty"`
+	// The BlockHeight field requests that a read-only proposal be simulated
+	// against the state of the ledger as of the given height, i.e., after the
+	// commit of the blocks numbered below it, rather than against its latest
+	// state. Endorsers reconstruct that state from the history of the keys,
+	// so private data cannot be read at a past height.
+	BlockHeight uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight" json:"block_height,omitempty"`
 }
 
 func (m *ChaincodeHeaderExtension) Reset()                    { *m = ChaincodeHeaderExtension{} }
@@ -160,6 +172,20 @@ func (m *ChaincodeHeaderExtension) GetChaincodeId() *ChaincodeID {
 	return nil
 }
 
+func (m *ChaincodeHeaderExtension) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return false
+}
+
+func (m *ChaincodeHeaderExtension) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
 // ChaincodeProposalPayload is the Proposal's payload message to be used when
 // the Header's type is CHAINCODE.  It contains the arguments for this
 // invocation.
@@ -211,6 +237,15 @@ type ChaincodeAction struct {
 	// Adding ChaincodeID to keep version opens up the possibility of multiple
 	// ChaincodeAction per transaction.
 	ChaincodeId *ChaincodeID `protobuf:"bytes,4,opt,name=chaincode_id,json=chaincodeId" json:"chaincode_id,omitempty"`
+	// This field contains the chaincodes invoked by the chaincode executing
+	// this invocation (chaincode-to-chaincode calls), in the order in which
+	// they were invoked. Committers may use it to enforce the endorsement
+	// policies of the callees and to verify cross-channel reads.
+	Calls []*ChaincodeCall `protobuf:"bytes,5,rep,name=calls" json:"calls,omitempty"`
+	// This field contains all the events generated by the chaincode executing
+	// this invocation, in the order in which they were set. For compatibility
+	// with existing consumers, the events field contains the last of them.
+	ChaincodeEvents []*ChaincodeEvent `protobuf:"bytes,6,rep,name=chaincode_events,json=chaincodeEvents" json:"chaincode_events,omitempty"`
 }
 
 func (m *ChaincodeAction) Reset()                    { *m = ChaincodeAction{} }
@@ -246,45 +281,136 @@ func (m *ChaincodeAction) GetChaincodeId() *ChaincodeID {
 	return nil
 }
 
+func (m *ChaincodeAction) GetCalls() []*ChaincodeCall {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
+func (m *ChaincodeAction) GetChaincodeEvents() []*ChaincodeEvent {
+	if m != nil {
+		return m.ChaincodeEvents
+	}
+	return nil
+}
+
+// ChaincodeCall records a chaincode-to-chaincode invocation performed
+// while simulating a proposal.
+type ChaincodeCall struct {
+	// The ChaincodeID (name and version) of the invoked chaincode.
+	ChaincodeId *ChaincodeID `protobuf:"bytes,1,opt,name=chaincode_id,json=chaincodeId" json:"chaincode_id,omitempty"`
+	// The channel on which the invoked chaincode was executed.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId" json:"channel_id,omitempty"`
+	// For cross-channel calls, the height of the ledger of channel_id
+	// at the time the invocation was simulated.
+	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight" json:"block_height,omitempty"`
+	// For cross-channel calls, the marshaled TxReadWriteSet containing
+	// the reads performed by the invoked chaincode. Writes to other channels
+	// are never committed and are therefore not recorded.
+	Results []byte `protobuf:"bytes,4,opt,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *ChaincodeCall) Reset()                    { *m = ChaincodeCall{} }
+func (m *ChaincodeCall) String() string            { return proto.CompactTextString(m) }
+func (*ChaincodeCall) ProtoMessage()               {}
+func (*ChaincodeCall) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{5} }
+
+func (m *ChaincodeCall) GetChaincodeId() *ChaincodeID {
+	if m != nil {
+		return m.ChaincodeId
+	}
+	return nil
+}
+
+func (m *ChaincodeCall) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChaincodeCall) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ChaincodeCall) GetResults() []byte {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// ChaincodeCalls is the list of chaincode-to-chaincode invocations
+// performed while simulating a proposal, as handed over to ESCC.
+type ChaincodeCalls struct {
+	Calls []*ChaincodeCall `protobuf:"bytes,1,rep,name=calls" json:"calls,omitempty"`
+}
+
+func (m *ChaincodeCalls) Reset()                    { *m = ChaincodeCalls{} }
+func (m *ChaincodeCalls) String() string            { return proto.CompactTextString(m) }
+func (*ChaincodeCalls) ProtoMessage()               {}
+func (*ChaincodeCalls) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{6} }
+
+func (m *ChaincodeCalls) GetCalls() []*ChaincodeCall {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SignedProposal)(nil), "protos.SignedProposal")
 	proto.RegisterType((*Proposal)(nil), "protos.Proposal")
 	proto.RegisterType((*ChaincodeHeaderExtension)(nil), "protos.ChaincodeHeaderExtension")
 	proto.RegisterType((*ChaincodeProposalPayload)(nil), "protos.ChaincodeProposalPayload")
 	proto.RegisterType((*ChaincodeAction)(nil), "protos.ChaincodeAction")
+	proto.RegisterType((*ChaincodeCall)(nil), "protos.ChaincodeCall")
+	proto.RegisterType((*ChaincodeCalls)(nil), "protos.ChaincodeCalls")
 }
 
 func init() { proto.RegisterFile("peer/proposal.proto", fileDescriptor7) }
 
 var fileDescriptor7 = []byte{
-	// 452 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4d, 0x6f, 0xd3, 0x4c,
-	0x10, 0x96, 0x93, 0xf7, 0xed, 0xc7, 0x24, 0xf4, 0x63, 0x5b, 0x21, 0x2b, 0xea, 0xa1, 0xb2, 0x84,
-	0x54, 0x24, 0xb0, 0xa5, 0x20, 0x21, 0xc4, 0x05, 0x11, 0xa8, 0x44, 0x0f, 0x48, 0x95, 0x81, 0x1e,
-	0x7a, 0x09, 0x6b, 0x7b, 0x70, 0x56, 0x35, 0xbb, 0xab, 0xdd, 0x75, 0x84, 0x8f, 0xfc, 0x1c, 0x7e,
-	0x0a, 0xff, 0x0a, 0xd9, 0xfb, 0xd1, 0x94, 0x5c, 0x38, 0x25, 0x33, 0xf3, 0x3c, 0xcf, 0xcc, 0x3c,
-	0xb3, 0x86, 0x13, 0x89, 0xa8, 0x32, 0xa9, 0x84, 0x14, 0x9a, 0x36, 0xa9, 0x54, 0xc2, 0x08, 0xb2,
-	0x33, 0xfc, 0xe8, 0xd9, 0xe9, 0x50, 0x2c, 0x57, 0x94, 0xf1, 0x52, 0x54, 0x68, 0xab, 0xb3, 0xb3,
-	0x07, 0x94, 0xa5, 0x42, 0x2d, 0x05, 0xd7, 0xae, 0x9a, 0x7c, 0x81, 0x83, 0x4f, 0xac, 0xe6, 0x58,
-	0x5d, 0x3b, 0x00, 0x79, 0x02, 0x07, 0x01, 0x5c, 0x74, 0x06, 0x75, 0x1c, 0x9d, 0x47, 0x17, 0xd3,
-	0xfc, 0x91, 0xcf, 0x2e, 0xfa, 0x24, 0x39, 0x83, 0x7d, 0xcd, 0x6a, 0x4e, 0x4d, 0xab, 0x30, 0x1e,
-	0x0d, 0x88, 0xfb, 0x44, 0x72, 0x0b, 0x7b, 0x41, 0xf0, 0x31, 0xec, 0xac, 0x90, 0x56, 0xa8, 0x9c,
-	0x90, 0x8b, 0x48, 0x0c, 0xbb, 0x92, 0x76, 0x8d, 0xa0, 0x95, 0xe3, 0xfb, 0xb0, 0xd7, 0xc6, 0x1f,
-	0x06, 0xb9, 0x66, 0x82, 0xc7, 0x63, 0xab, 0x1d, 0x12, 0xc9, 0xcf, 0x08, 0xe2, 0x77, 0x7e, 0xc9,
-	0x0f, 0x83, 0xd6, 0xa5, 0x2f, 0x92, 0xe7, 0x40, 0x9c, 0xca, 0x72, 0xcd, 0x34, 0x2b, 0x58, 0xc3,
-	0x4c, 0xe7, 0x1a, 0x1f, 0xbb, 0xca, 0x4d, 0x28, 0x90, 0x97, 0x30, 0x0d, 0x7e, 0x2d, 0x99, 0x1d,
-	0x64, 0x32, 0x3f, 0xb1, 0xe6, 0xe8, 0x34, 0xb4, 0xb9, 0x7a, 0x9f, 0x4f, 0x02, 0xf0, 0xaa, 0x4a,
-	0x7e, 0x6f, 0xce, 0xe0, 0x37, 0xbd, 0x76, 0xe3, 0x9f, 0xc2, 0xff, 0x8c, 0xcb, 0xd6, 0xb8, 0xb6,
-	0x36, 0x20, 0x37, 0x30, 0xfd, 0xac, 0x28, 0xd7, 0x0c, 0xb9, 0xf9, 0x48, 0x65, 0x3c, 0x3a, 0x1f,
-	0x5f, 0x4c, 0xe6, 0xf3, 0xad, 0x56, 0x7f, 0xa9, 0xa5, 0x9b, 0xa4, 0x4b, 0x6e, 0x54, 0x97, 0x3f,
-	0xd0, 0x99, 0xbd, 0x81, 0xe3, 0x2d, 0x08, 0x39, 0x82, 0xf1, 0x1d, 0xda, 0xbd, 0xf7, 0xf3, 0xfe,
-	0x6f, 0x3f, 0xd4, 0x9a, 0x36, 0xad, 0xbf, 0x95, 0x0d, 0x5e, 0x8f, 0x5e, 0x45, 0xc9, 0xaf, 0x08,
-	0x0e, 0x43, 0xf7, 0xb7, 0xa5, 0xe9, 0x6d, 0x8c, 0x61, 0x57, 0xa1, 0x6e, 0x1b, 0xe3, 0xaf, 0xef,
-	0xc3, 0xfe, 0x9a, 0xb8, 0x46, 0x6e, 0xb4, 0x13, 0x72, 0x11, 0x79, 0x06, 0x7b, 0xfe, 0x69, 0x0d,
-	0x27, 0x9b, 0xcc, 0x8f, 0xfc, 0x6a, 0xb9, 0xcb, 0xe7, 0x01, 0xb1, 0xe5, 0xfb, 0x7f, 0xff, 0xe6,
-	0xfb, 0xe2, 0x2b, 0x24, 0x42, 0xd5, 0xe9, 0xaa, 0x93, 0xa8, 0x1a, 0xac, 0x6a, 0x54, 0xe9, 0x37,
-	0x5a, 0x28, 0x56, 0x7a, 0x66, 0xff, 0xd8, 0x17, 0x87, 0xf7, 0x1e, 0x96, 0x77, 0xb4, 0xc6, 0xdb,
-	0xa7, 0x35, 0x33, 0xab, 0xb6, 0x48, 0x4b, 0xf1, 0x3d, 0xdb, 0xe0, 0x66, 0x96, 0x9b, 0x59, 0x6e,
-	0xd6, 0x73, 0x0b, 0xfb, 0x31, 0xbd, 0xf8, 0x13, 0x00, 0x00, 0xff, 0xff, 0x12, 0x75, 0xb6, 0xaf,
-	0x6a, 0x03, 0x00, 0x00,
+	// 592 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x5f, 0x6f, 0xd3, 0x3e,
+	0x14, 0x55, 0xda, 0x6e, 0x6b, 0x6f, 0xbb, 0x7f, 0xde, 0x7e, 0x53, 0xd4, 0xdf, 0x90, 0x46, 0x24,
+	0xa4, 0x21, 0x20, 0x95, 0x8a, 0x84, 0x10, 0x12, 0x42, 0xdb, 0x98, 0xb4, 0x3d, 0x20, 0xa6, 0x00,
+	0x7b, 0xd8, 0x4b, 0x71, 0x12, 0x93, 0x58, 0x35, 0x76, 0x64, 0xbb, 0x15, 0xf9, 0x28, 0x3c, 0xf3,
+	0x49, 0x78, 0xe4, 0x5b, 0xa1, 0x24, 0x76, 0xda, 0x2c, 0x0f, 0xec, 0xa9, 0xbd, 0xe7, 0xde, 0x73,
+	0x7c, 0x7d, 0xef, 0x89, 0xe1, 0x20, 0x23, 0x44, 0x4e, 0x32, 0x29, 0x32, 0xa1, 0x30, 0xf3, 0x33,
+	0x29, 0xb4, 0x40, 0x9b, 0xe5, 0x8f, 0x1a, 0x1f, 0x96, 0xc9, 0x28, 0xc5, 0x94, 0x47, 0x22, 0x26,
+	0x55, 0x76, 0x3c, 0x6e, 0xa2, 0x33, 0xb2, 0x24, 0x5c, 0x9b, 0xdc, 0x71, 0x43, 0x6e, 0x26, 0x89,
+	0xca, 0x04, 0x57, 0x86, 0xe9, 0x7d, 0x81, 0x9d, 0x4f, 0x34, 0xe1, 0x24, 0xbe, 0x31, 0x05, 0xe8,
+	0x09, 0xec, 0xd4, 0xc5, 0x61, 0xae, 0x89, 0x72, 0x9d, 0x13, 0xe7, 0x74, 0x14, 0x6c, 0x5b, 0xf4,
+	0xbc, 0x00, 0xd1, 0x31, 0x0c, 0x14, 0x4d, 0x38, 0xd6, 0x0b, 0x49, 0xdc, 0x4e, 0x59, 0xb1, 0x02,
+	0xbc, 0x3b, 0xe8, 0xd7, 0x82, 0x47, 0xb0, 0x99, 0x12, 0x1c, 0x13, 0x69, 0x84, 0x4c, 0x84, 0x5c,
+	0xd8, 0xca, 0x70, 0xce, 0x04, 0x8e, 0x0d, 0xdf, 0x86, 0x85, 0x36, 0xf9, 0xa1, 0x09, 0x57, 0x54,
+	0x70, 0xb7, 0x5b, 0x69, 0xd7, 0x80, 0xf7, 0xdb, 0x01, 0xf7, 0xc2, 0x5e, 0xf5, 0xaa, 0xd4, 0xba,
+	0xb4, 0x49, 0xf4, 0x02, 0x90, 0x51, 0x99, 0x2d, 0xa9, 0xa2, 0x21, 0x65, 0x54, 0xe7, 0xe6, 0xe0,
+	0x7d, 0x93, 0xb9, 0xad, 0x13, 0xe8, 0x15, 0x8c, 0x56, 0x53, 0xa3, 0x55, 0x23, 0xc3, 0xe9, 0x41,
+	0x35, 0x1c, 0xe5, 0xd7, 0xc7, 0x5c, 0xbf, 0x0f, 0x86, 0x75, 0xe1, 0x75, 0x8c, 0xfe, 0x87, 0x81,
+	0x24, 0x38, 0x9e, 0x09, 0xce, 0xf2, 0xb2, 0xc3, 0x7e, 0xd0, 0x2f, 0x80, 0x8f, 0x9c, 0xe5, 0xe8,
+	0x31, 0x8c, 0x42, 0x26, 0xa2, 0xf9, 0x2c, 0x25, 0x34, 0x49, 0xb5, 0xdb, 0x3b, 0x71, 0x4e, 0x7b,
+	0xc1, 0xb0, 0xc4, 0xae, 0x4a, 0xc8, 0xfb, 0xb3, 0x7e, 0x07, 0x3b, 0xa9, 0x1b, 0x73, 0xfd, 0x43,
+	0xd8, 0xa0, 0x3c, 0x5b, 0x68, 0xd3, 0x76, 0x15, 0xa0, 0x5b, 0x18, 0x7d, 0x96, 0x98, 0x2b, 0x4a,
+	0xb8, 0xfe, 0x80, 0x33, 0xb7, 0x73, 0xd2, 0x3d, 0x1d, 0x4e, 0xa7, 0xad, 0x56, 0xef, 0xa9, 0xf9,
+	0xeb, 0xa4, 0x4b, 0xae, 0x65, 0x1e, 0x34, 0x74, 0xc6, 0xef, 0x60, 0xbf, 0x55, 0x82, 0xf6, 0xa0,
+	0x3b, 0x27, 0xd5, 0xdc, 0x06, 0x41, 0xf1, 0xb7, 0x68, 0x6a, 0x89, 0xd9, 0xc2, 0xee, 0xba, 0x0a,
+	0xde, 0x74, 0x5e, 0x3b, 0xde, 0xcf, 0x0e, 0xec, 0xd6, 0xa7, 0x9f, 0x45, 0xba, 0x58, 0x83, 0x0b,
+	0x5b, 0x92, 0xa8, 0x05, 0xd3, 0xd6, 0x3d, 0x36, 0x2c, 0xdc, 0x50, 0xba, 0x53, 0x19, 0x21, 0x13,
+	0xa1, 0xe7, 0xd0, 0xb7, 0xd6, 0x2c, 0x07, 0x3a, 0x9c, 0xee, 0xd9, 0xab, 0x05, 0x06, 0x0f, 0xea,
+	0x8a, 0xd6, 0xde, 0x7a, 0x0f, 0xdc, 0xdb, 0x33, 0xd8, 0x88, 0x30, 0x63, 0xca, 0xdd, 0x28, 0xa7,
+	0xf7, 0x5f, 0x8b, 0x70, 0x81, 0x19, 0x0b, 0xaa, 0x1a, 0x74, 0x06, 0x7b, 0xf7, 0x3e, 0x29, 0xe5,
+	0x6e, 0x96, 0xbc, 0xa3, 0x16, 0xef, 0xb2, 0x48, 0x07, 0xbb, 0x51, 0x23, 0x56, 0xde, 0x2f, 0x07,
+	0xb6, 0x1b, 0xda, 0xad, 0xce, 0x9d, 0x07, 0x76, 0xfe, 0x08, 0x20, 0x4a, 0x31, 0xe7, 0x84, 0x59,
+	0x9f, 0x0e, 0x82, 0x81, 0x41, 0xae, 0xe3, 0x96, 0xe7, 0xba, 0x2d, 0xcf, 0xad, 0xef, 0xa4, 0xd7,
+	0xd8, 0x89, 0xf7, 0x16, 0x76, 0x1a, 0x4d, 0xaa, 0xd5, 0x9c, 0x9c, 0x7f, 0xcf, 0xe9, 0xfc, 0x2b,
+	0x78, 0x42, 0x26, 0x7e, 0x9a, 0x67, 0x44, 0x32, 0x12, 0x27, 0x44, 0xfa, 0xdf, 0x70, 0x28, 0x69,
+	0x64, 0x59, 0xc5, 0x0b, 0x74, 0xbe, 0xbb, 0x32, 0x66, 0x34, 0xc7, 0x09, 0xb9, 0x7b, 0x9a, 0x50,
+	0x9d, 0x2e, 0x42, 0x3f, 0x12, 0xdf, 0x27, 0x6b, 0xdc, 0x49, 0xc5, 0x9d, 0x54, 0xdc, 0x49, 0xc1,
+	0x0d, 0xab, 0xd7, 0xef, 0xe5, 0xdf, 0x01, 0x00, 0xb9, 0xa0, 0xd3, 0xe4, 0x1b, 0x05, 0x00, 0x00,
 }
@@ -12,6 +12,33 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+type PvtDataDiscrepancy_Type int32
+
+const (
+	// the block records a hash, but the peer has no private data
+	PvtDataDiscrepancy_MISSING PvtDataDiscrepancy_Type = 0
+	// the private data of the peer does not hash to the recorded hash
+	PvtDataDiscrepancy_HASH_MISMATCH PvtDataDiscrepancy_Type = 1
+	// the peer has private data, but the block records no hash for it
+	PvtDataDiscrepancy_UNEXPECTED PvtDataDiscrepancy_Type = 2
+)
+
+var PvtDataDiscrepancy_Type_name = map[int32]string{
+	0: "MISSING",
+	1: "HASH_MISMATCH",
+	2: "UNEXPECTED",
+}
+var PvtDataDiscrepancy_Type_value = map[string]int32{
+	"MISSING":       0,
+	"HASH_MISMATCH": 1,
+	"UNEXPECTED":    2,
+}
+
+func (x PvtDataDiscrepancy_Type) String() string {
+	return proto.EnumName(PvtDataDiscrepancy_Type_name, int32(x))
+}
+func (PvtDataDiscrepancy_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor9, []int{5, 0} }
+
 // ChaincodeQueryResponse returns information about each chaincode that pertains
 // to a query in lscc.go, such as GetChaincodes (returns all chaincodes
 // instantiated on a channel), and GetInstalledChaincodes (returns all chaincodes
@@ -146,34 +173,165 @@ func (m *ChannelInfo) GetChannelId() string {
 	return ""
 }
 
+// PvtDataVerification is returned by the VerifyPvtData function of qscc and
+// reports where the private data stored by a peer does not match the hashes
+// of the private write sets recorded in the blocks of the channel
+type PvtDataVerification struct {
+	// the first and last blocks verified
+	StartBlock    uint64                `protobuf:"varint,1,opt,name=start_block,json=startBlock" json:"start_block,omitempty"`
+	EndBlock      uint64                `protobuf:"varint,2,opt,name=end_block,json=endBlock" json:"end_block,omitempty"`
+	Discrepancies []*PvtDataDiscrepancy `protobuf:"bytes,3,rep,name=discrepancies" json:"discrepancies,omitempty"`
+}
+
+func (m *PvtDataVerification) Reset()                    { *m = PvtDataVerification{} }
+func (m *PvtDataVerification) String() string            { return proto.CompactTextString(m) }
+func (*PvtDataVerification) ProtoMessage()               {}
+func (*PvtDataVerification) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{4} }
+
+func (m *PvtDataVerification) GetStartBlock() uint64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+func (m *PvtDataVerification) GetEndBlock() uint64 {
+	if m != nil {
+		return m.EndBlock
+	}
+	return 0
+}
+
+func (m *PvtDataVerification) GetDiscrepancies() []*PvtDataDiscrepancy {
+	if m != nil {
+		return m.Discrepancies
+	}
+	return nil
+}
+
+// PvtDataDiscrepancy describes the private data of a collection written by
+// a transaction that does not match the hash recorded in the block
+type PvtDataDiscrepancy struct {
+	BlockNum   uint64                  `protobuf:"varint,1,opt,name=block_num,json=blockNum" json:"block_num,omitempty"`
+	TxNum      uint64                  `protobuf:"varint,2,opt,name=tx_num,json=txNum" json:"tx_num,omitempty"`
+	TxId       string                  `protobuf:"bytes,3,opt,name=tx_id,json=txId" json:"tx_id,omitempty"`
+	Namespace  string                  `protobuf:"bytes,4,opt,name=namespace" json:"namespace,omitempty"`
+	Collection string                  `protobuf:"bytes,5,opt,name=collection" json:"collection,omitempty"`
+	Type       PvtDataDiscrepancy_Type `protobuf:"varint,6,opt,name=type,enum=protos.PvtDataDiscrepancy_Type" json:"type,omitempty"`
+	// the hash recorded in the block, if any
+	ExpectedHash []byte `protobuf:"bytes,7,opt,name=expected_hash,json=expectedHash,proto3" json:"expected_hash,omitempty"`
+	// the hash of the private data of the peer, if any
+	ActualHash []byte `protobuf:"bytes,8,opt,name=actual_hash,json=actualHash,proto3" json:"actual_hash,omitempty"`
+}
+
+func (m *PvtDataDiscrepancy) Reset()                    { *m = PvtDataDiscrepancy{} }
+func (m *PvtDataDiscrepancy) String() string            { return proto.CompactTextString(m) }
+func (*PvtDataDiscrepancy) ProtoMessage()               {}
+func (*PvtDataDiscrepancy) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{5} }
+
+func (m *PvtDataDiscrepancy) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *PvtDataDiscrepancy) GetTxNum() uint64 {
+	if m != nil {
+		return m.TxNum
+	}
+	return 0
+}
+
+func (m *PvtDataDiscrepancy) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *PvtDataDiscrepancy) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *PvtDataDiscrepancy) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *PvtDataDiscrepancy) GetType() PvtDataDiscrepancy_Type {
+	if m != nil {
+		return m.Type
+	}
+	return PvtDataDiscrepancy_MISSING
+}
+
+func (m *PvtDataDiscrepancy) GetExpectedHash() []byte {
+	if m != nil {
+		return m.ExpectedHash
+	}
+	return nil
+}
+
+func (m *PvtDataDiscrepancy) GetActualHash() []byte {
+	if m != nil {
+		return m.ActualHash
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ChaincodeQueryResponse)(nil), "protos.ChaincodeQueryResponse")
 	proto.RegisterType((*ChaincodeInfo)(nil), "protos.ChaincodeInfo")
 	proto.RegisterType((*ChannelQueryResponse)(nil), "protos.ChannelQueryResponse")
 	proto.RegisterType((*ChannelInfo)(nil), "protos.ChannelInfo")
+	proto.RegisterType((*PvtDataVerification)(nil), "protos.PvtDataVerification")
+	proto.RegisterType((*PvtDataDiscrepancy)(nil), "protos.PvtDataDiscrepancy")
+	proto.RegisterEnum("protos.PvtDataDiscrepancy_Type", PvtDataDiscrepancy_Type_name, PvtDataDiscrepancy_Type_value)
 }
 
 func init() { proto.RegisterFile("peer/query.proto", fileDescriptor9) }
 
 var fileDescriptor9 = []byte{
-	// 296 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xdf, 0x4a, 0xc3, 0x30,
-	0x14, 0xc6, 0xe9, 0xfe, 0xba, 0x33, 0x15, 0x89, 0x53, 0x72, 0x23, 0x8c, 0x5e, 0x4d, 0x90, 0x16,
-	0x14, 0x5f, 0xc0, 0x5d, 0xc8, 0xae, 0x86, 0xbd, 0xf4, 0x46, 0xba, 0xe4, 0x6c, 0x0d, 0x6c, 0x49,
-	0x4c, 0xba, 0xc1, 0x9e, 0xc6, 0x57, 0x95, 0x93, 0xac, 0xa3, 0xbb, 0xea, 0x39, 0xbf, 0xef, 0x17,
-	0xca, 0x97, 0xc0, 0x9d, 0x45, 0x74, 0xf9, 0xef, 0x1e, 0xdd, 0x31, 0xb3, 0xce, 0xd4, 0x86, 0x0d,
-	0xc2, 0xc7, 0xa7, 0x4b, 0x78, 0x9c, 0x57, 0xa5, 0xd2, 0xc2, 0x48, 0xfc, 0xa2, 0xbc, 0x40, 0x6f,
-	0x8d, 0xf6, 0xc8, 0xde, 0x01, 0x44, 0x93, 0x78, 0x9e, 0x4c, 0xbb, 0xb3, 0xf1, 0xeb, 0x43, 0x3c,
-	0xed, 0xb3, 0xf3, 0x99, 0x85, 0x5e, 0x9b, 0xa2, 0x25, 0xa6, 0x7f, 0x09, 0xdc, 0x5c, 0xa4, 0x8c,
-	0x41, 0x4f, 0x97, 0x3b, 0xe4, 0xc9, 0x34, 0x99, 0x8d, 0x8a, 0x30, 0x33, 0x0e, 0xc3, 0x03, 0x3a,
-	0xaf, 0x8c, 0xe6, 0x9d, 0x80, 0x9b, 0x95, 0x6c, 0x5b, 0xd6, 0x15, 0xef, 0x46, 0x9b, 0x66, 0x36,
-	0x81, 0xbe, 0xd2, 0x76, 0x5f, 0xf3, 0x5e, 0x80, 0x71, 0x21, 0x13, 0xbd, 0x10, 0xbc, 0x1f, 0x4d,
-	0x9a, 0x89, 0x1d, 0x88, 0x0d, 0x22, 0xa3, 0x99, 0xdd, 0x42, 0x47, 0x49, 0x3e, 0x9c, 0x26, 0xb3,
-	0xeb, 0xa2, 0xa3, 0x64, 0xfa, 0x09, 0x93, 0x79, 0x55, 0x6a, 0x8d, 0xdb, 0xcb, 0xc2, 0x39, 0x5c,
-	0x89, 0xc8, 0x9b, 0xba, 0xf7, 0xad, 0xba, 0xc4, 0x43, 0xd9, 0xb3, 0x94, 0xbe, 0xc0, 0xb8, 0x15,
-	0xb0, 0xa7, 0x70, 0x61, 0xb4, 0xfe, 0x28, 0x79, 0x6a, 0x3b, 0x3a, 0x91, 0x85, 0xfc, 0x58, 0x42,
-	0x6a, 0xdc, 0x26, 0xab, 0x8e, 0x16, 0xdd, 0x16, 0xe5, 0x06, 0x5d, 0xb6, 0x2e, 0x57, 0x4e, 0x89,
-	0xe6, 0x27, 0xf4, 0x46, 0xdf, 0xcf, 0x1b, 0x55, 0x57, 0xfb, 0x55, 0x26, 0xcc, 0x2e, 0x6f, 0xa9,
-	0x79, 0x54, 0xf3, 0xa8, 0xe6, 0xa4, 0xae, 0xe2, 0x13, 0xbe, 0xfd, 0x07, 0x00, 0x00, 0xff, 0xff,
-	0x79, 0x94, 0xb3, 0xbd, 0xdd, 0x01, 0x00, 0x00,
+	// 555 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0xc7, 0x49, 0xd6, 0x6e, 0xed, 0xe9, 0x3a, 0x15, 0x6f, 0x43, 0x11, 0x5f, 0x9b, 0xc2, 0xcd,
+	0x90, 0x50, 0x22, 0x6d, 0x82, 0x6b, 0xb6, 0x6e, 0x5a, 0x73, 0xb1, 0x0f, 0xd2, 0x81, 0x10, 0x37,
+	0x95, 0xeb, 0x9c, 0x2d, 0x16, 0xa9, 0x13, 0x62, 0x67, 0x6a, 0x1f, 0x84, 0x6b, 0xde, 0x88, 0x67,
+	0x42, 0xb6, 0x93, 0xd2, 0x0a, 0x71, 0x55, 0xfb, 0x77, 0x7e, 0xae, 0xcf, 0xdf, 0x47, 0x81, 0x41,
+	0x81, 0x58, 0x86, 0x3f, 0x2a, 0x2c, 0x17, 0x41, 0x51, 0xe6, 0x2a, 0x27, 0x9b, 0xe6, 0x47, 0xfa,
+	0x37, 0xf0, 0x6c, 0x98, 0x52, 0x2e, 0x58, 0x9e, 0xe0, 0x27, 0x5d, 0x8f, 0x51, 0x16, 0xb9, 0x90,
+	0x48, 0xde, 0x03, 0xb0, 0xa6, 0x22, 0x3d, 0xe7, 0x70, 0xe3, 0xa8, 0x77, 0xbc, 0x6f, 0x4f, 0xcb,
+	0x60, 0x79, 0x26, 0x12, 0xf7, 0x79, 0xbc, 0x22, 0xfa, 0xbf, 0x1c, 0xe8, 0xaf, 0x55, 0x09, 0x81,
+	0x96, 0xa0, 0x33, 0xf4, 0x9c, 0x43, 0xe7, 0xa8, 0x1b, 0x9b, 0x35, 0xf1, 0x60, 0xeb, 0x11, 0x4b,
+	0xc9, 0x73, 0xe1, 0xb9, 0x06, 0x37, 0x5b, 0x6d, 0x17, 0x54, 0xa5, 0xde, 0x86, 0xb5, 0xf5, 0x9a,
+	0xec, 0x41, 0x9b, 0x8b, 0xa2, 0x52, 0x5e, 0xcb, 0x40, 0xbb, 0xd1, 0x26, 0x4a, 0xc6, 0xbc, 0xb6,
+	0x35, 0xf5, 0x5a, 0xb3, 0x47, 0xcd, 0x36, 0x2d, 0xd3, 0x6b, 0xb2, 0x03, 0x2e, 0x4f, 0xbc, 0xad,
+	0x43, 0xe7, 0x68, 0x3b, 0x76, 0x79, 0xe2, 0x5f, 0xc2, 0xde, 0x30, 0xa5, 0x42, 0x60, 0xb6, 0x1e,
+	0x38, 0x84, 0x0e, 0xb3, 0xbc, 0x89, 0xbb, 0xbb, 0x12, 0x57, 0x73, 0x13, 0x76, 0x29, 0xf9, 0xef,
+	0xa0, 0xb7, 0x52, 0x20, 0xaf, 0xcc, 0x83, 0xe9, 0xed, 0x84, 0x27, 0x75, 0xda, 0x6e, 0x4d, 0xa2,
+	0xc4, 0xff, 0xe9, 0xc0, 0xee, 0xed, 0xa3, 0x3a, 0xa7, 0x8a, 0x7e, 0xc1, 0x92, 0xdf, 0x73, 0x46,
+	0x95, 0x0e, 0x7c, 0x00, 0x3d, 0xa9, 0x68, 0xa9, 0x26, 0xd3, 0x2c, 0x67, 0xdf, 0xcd, 0xb9, 0x56,
+	0x0c, 0x06, 0x9d, 0x69, 0x42, 0x5e, 0x40, 0x17, 0x45, 0x52, 0x97, 0x5d, 0x53, 0xee, 0xa0, 0x48,
+	0x6c, 0xf1, 0x23, 0xf4, 0x13, 0x2e, 0x59, 0x89, 0x05, 0x15, 0x8c, 0xa3, 0xf4, 0x36, 0x4c, 0xe7,
+	0xcf, 0x9b, 0xce, 0xeb, 0x1b, 0xcf, 0x97, 0xce, 0x22, 0x5e, 0x3f, 0xe0, 0xff, 0x76, 0x81, 0xfc,
+	0x6b, 0xe9, 0x5b, 0xcd, 0x8d, 0x13, 0x51, 0xcd, 0xea, 0xa6, 0x3a, 0x06, 0x5c, 0x57, 0x33, 0xb2,
+	0x0f, 0x9b, 0x6a, 0x6e, 0x2a, 0xb6, 0x9f, 0xb6, 0x9a, 0x6b, 0xbc, 0x0b, 0x6d, 0x35, 0xd7, 0xe1,
+	0xeb, 0xe1, 0xa9, 0x79, 0x94, 0x90, 0x97, 0xd0, 0xd5, 0x23, 0x97, 0x05, 0x65, 0x58, 0x0f, 0xf0,
+	0x2f, 0x20, 0xaf, 0x01, 0x58, 0x9e, 0x65, 0xc8, 0xf4, 0x5b, 0xd4, 0xa3, 0x5c, 0x21, 0xe4, 0x04,
+	0x5a, 0x6a, 0x51, 0xa0, 0x19, 0xe8, 0xce, 0xf1, 0xc1, 0xff, 0x63, 0x05, 0x77, 0x8b, 0x02, 0x63,
+	0x23, 0x93, 0x37, 0xd0, 0xc7, 0x79, 0x81, 0x4c, 0x61, 0x32, 0x49, 0xa9, 0x4c, 0xeb, 0xe1, 0x6f,
+	0x37, 0x70, 0x44, 0x65, 0xaa, 0xdf, 0x9d, 0x32, 0x55, 0xd1, 0xcc, 0x2a, 0x1d, 0xa3, 0x80, 0x45,
+	0x5a, 0xf0, 0x3f, 0x40, 0x4b, 0xff, 0x27, 0xe9, 0xc1, 0xd6, 0x55, 0x34, 0x1e, 0x47, 0xd7, 0x97,
+	0x83, 0x27, 0xe4, 0x29, 0xf4, 0x47, 0xa7, 0xe3, 0xd1, 0xe4, 0x2a, 0x1a, 0x5f, 0x9d, 0xde, 0x0d,
+	0x47, 0x03, 0x87, 0xec, 0x00, 0x7c, 0xbe, 0xbe, 0xf8, 0x7a, 0x7b, 0x31, 0xbc, 0xbb, 0x38, 0x1f,
+	0xb8, 0x67, 0x37, 0xe0, 0xe7, 0xe5, 0x43, 0x90, 0x2e, 0x0a, 0x2c, 0x33, 0x4c, 0x1e, 0xb0, 0x0c,
+	0xee, 0xe9, 0xb4, 0xe4, 0xac, 0x69, 0x5e, 0x7f, 0x8c, 0xdf, 0xde, 0x3e, 0x70, 0x95, 0x56, 0xd3,
+	0x80, 0xe5, 0xb3, 0x70, 0x45, 0x0d, 0xad, 0x1a, 0x5a, 0x35, 0xd4, 0xea, 0xd4, 0x7e, 0xab, 0x27,
+	0x7f, 0x06, 0x00, 0xb9, 0x57, 0xb4, 0x2c, 0xc6, 0x03, 0x00, 0x00,
 }
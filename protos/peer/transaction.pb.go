@@ -42,6 +42,7 @@ const (
 	TxValidationCode_BAD_RWSET                    TxValidationCode = 22
 	TxValidationCode_ILLEGAL_WRITESET             TxValidationCode = 23
 	TxValidationCode_INVALID_WRITESET             TxValidationCode = 24
+	TxValidationCode_INVALID_CROSS_CHANNEL_READ   TxValidationCode = 25
 	TxValidationCode_INVALID_OTHER_REASON         TxValidationCode = 255
 )
 
@@ -71,6 +72,7 @@ var TxValidationCode_name = map[int32]string{
 	22:  "BAD_RWSET",
 	23:  "ILLEGAL_WRITESET",
 	24:  "INVALID_WRITESET",
+	25:  "INVALID_CROSS_CHANNEL_READ",
 	255: "INVALID_OTHER_REASON",
 }
 var TxValidationCode_value = map[string]int32{
@@ -99,6 +101,7 @@ var TxValidationCode_value = map[string]int32{
 	"BAD_RWSET":                    22,
 	"ILLEGAL_WRITESET":             23,
 	"INVALID_WRITESET":             24,
+	"INVALID_CROSS_CHANNEL_READ":   25,
 	"INVALID_OTHER_REASON":         255,
 }
 
@@ -298,6 +301,166 @@ func (m *ChaincodeEndorsedAction) GetEndorsements() []*Endorsement {
 	return nil
 }
 
+// MVCCConflict describes the read that caused a transaction to be marked
+// with MVCC_READ_CONFLICT or PHANTOM_READ_CONFLICT during its validation
+type MVCCConflict struct {
+	TxId           string           `protobuf:"bytes,1,opt,name=tx_id,json=txId" json:"tx_id,omitempty"`
+	BlockNum       uint64           `protobuf:"varint,2,opt,name=block_num,json=blockNum" json:"block_num,omitempty"`
+	TxNum          uint64           `protobuf:"varint,3,opt,name=tx_num,json=txNum" json:"tx_num,omitempty"`
+	ValidationCode TxValidationCode `protobuf:"varint,4,opt,name=validation_code,json=validationCode,enum=protos.TxValidationCode" json:"validation_code,omitempty"`
+	// The namespace, i.e., the chaincode, of the read
+	Namespace string `protobuf:"bytes,5,opt,name=namespace" json:"namespace,omitempty"`
+	// The collection of the read, if the read is of private data
+	Collection string `protobuf:"bytes,6,opt,name=collection" json:"collection,omitempty"`
+	// The key that was read, for reads of public data
+	Key string `protobuf:"bytes,7,opt,name=key" json:"key,omitempty"`
+	// The hash of the key that was read, for reads of private data
+	KeyHash []byte `protobuf:"bytes,8,opt,name=key_hash,json=keyHash,proto3" json:"key_hash,omitempty"`
+	// The version of the key that was read during the simulation of the
+	// transaction, unset if the key didn't exist
+	ReadVersion *KeyVersion `protobuf:"bytes,9,opt,name=read_version,json=readVersion" json:"read_version,omitempty"`
+	// The version of the key at the time of the validation, i.e., the version
+	// written by the last committed transaction or by a preceding transaction
+	// of the same block, unset if the key doesn't exist
+	CommittedVersion *KeyVersion `protobuf:"bytes,10,opt,name=committed_version,json=committedVersion" json:"committed_version,omitempty"`
+	// The range of keys, for phantom reads, i.e., when the results of a range
+	// query have changed since the simulation of the transaction
+	StartKey string `protobuf:"bytes,11,opt,name=start_key,json=startKey" json:"start_key,omitempty"`
+	EndKey   string `protobuf:"bytes,12,opt,name=end_key,json=endKey" json:"end_key,omitempty"`
+}
+
+func (m *MVCCConflict) Reset()                    { *m = MVCCConflict{} }
+func (m *MVCCConflict) String() string            { return proto.CompactTextString(m) }
+func (*MVCCConflict) ProtoMessage()               {}
+func (*MVCCConflict) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{6} }
+
+func (m *MVCCConflict) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *MVCCConflict) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *MVCCConflict) GetTxNum() uint64 {
+	if m != nil {
+		return m.TxNum
+	}
+	return 0
+}
+
+func (m *MVCCConflict) GetValidationCode() TxValidationCode {
+	if m != nil {
+		return m.ValidationCode
+	}
+	return TxValidationCode_VALID
+}
+
+func (m *MVCCConflict) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *MVCCConflict) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *MVCCConflict) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *MVCCConflict) GetKeyHash() []byte {
+	if m != nil {
+		return m.KeyHash
+	}
+	return nil
+}
+
+func (m *MVCCConflict) GetReadVersion() *KeyVersion {
+	if m != nil {
+		return m.ReadVersion
+	}
+	return nil
+}
+
+func (m *MVCCConflict) GetCommittedVersion() *KeyVersion {
+	if m != nil {
+		return m.CommittedVersion
+	}
+	return nil
+}
+
+func (m *MVCCConflict) GetStartKey() string {
+	if m != nil {
+		return m.StartKey
+	}
+	return ""
+}
+
+func (m *MVCCConflict) GetEndKey() string {
+	if m != nil {
+		return m.EndKey
+	}
+	return ""
+}
+
+// KeyVersion is the height of the transaction that last wrote a key
+type KeyVersion struct {
+	BlockNum uint64 `protobuf:"varint,1,opt,name=block_num,json=blockNum" json:"block_num,omitempty"`
+	TxNum    uint64 `protobuf:"varint,2,opt,name=tx_num,json=txNum" json:"tx_num,omitempty"`
+}
+
+func (m *KeyVersion) Reset()                    { *m = KeyVersion{} }
+func (m *KeyVersion) String() string            { return proto.CompactTextString(m) }
+func (*KeyVersion) ProtoMessage()               {}
+func (*KeyVersion) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{7} }
+
+func (m *KeyVersion) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *KeyVersion) GetTxNum() uint64 {
+	if m != nil {
+		return m.TxNum
+	}
+	return 0
+}
+
+// MVCCConflicts is returned by the GetMVCCConflicts function of the
+// query system chaincode, with the most recent conflicts last
+type MVCCConflicts struct {
+	Conflicts []*MVCCConflict `protobuf:"bytes,1,rep,name=conflicts" json:"conflicts,omitempty"`
+}
+
+func (m *MVCCConflicts) Reset()                    { *m = MVCCConflicts{} }
+func (m *MVCCConflicts) String() string            { return proto.CompactTextString(m) }
+func (*MVCCConflicts) ProtoMessage()               {}
+func (*MVCCConflicts) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{8} }
+
+func (m *MVCCConflicts) GetConflicts() []*MVCCConflict {
+	if m != nil {
+		return m.Conflicts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SignedTransaction)(nil), "protos.SignedTransaction")
 	proto.RegisterType((*ProcessedTransaction)(nil), "protos.ProcessedTransaction")
@@ -305,64 +468,83 @@ func init() {
 	proto.RegisterType((*TransactionAction)(nil), "protos.TransactionAction")
 	proto.RegisterType((*ChaincodeActionPayload)(nil), "protos.ChaincodeActionPayload")
 	proto.RegisterType((*ChaincodeEndorsedAction)(nil), "protos.ChaincodeEndorsedAction")
+	proto.RegisterType((*MVCCConflict)(nil), "protos.MVCCConflict")
+	proto.RegisterType((*KeyVersion)(nil), "protos.KeyVersion")
+	proto.RegisterType((*MVCCConflicts)(nil), "protos.MVCCConflicts")
 	proto.RegisterEnum("protos.TxValidationCode", TxValidationCode_name, TxValidationCode_value)
 }
 
 func init() { proto.RegisterFile("peer/transaction.proto", fileDescriptor12) }
 
 var fileDescriptor12 = []byte{
-	// 837 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x51, 0x6f, 0xea, 0x36,
-	0x14, 0x1e, 0xf7, 0xae, 0xed, 0x6a, 0x7a, 0x5b, 0x63, 0x28, 0xa5, 0xa8, 0xda, 0xbd, 0xe2, 0x61,
-	0xba, 0xdb, 0x24, 0x90, 0x7a, 0x1f, 0x26, 0x4d, 0x7b, 0x31, 0x89, 0x5b, 0xa2, 0x05, 0x3b, 0x72,
-	0x0c, 0xa5, 0x7b, 0x98, 0x15, 0x88, 0x2f, 0x45, 0x83, 0x24, 0x4a, 0xd2, 0xab, 0xf5, 0x6d, 0xda,
-	0x0f, 0xd8, 0x7e, 0xf2, 0x26, 0xc7, 0x09, 0xd0, 0xde, 0xed, 0x85, 0xe0, 0xef, 0x7c, 0x3e, 0xdf,
-	0x77, 0xce, 0xb1, 0x0e, 0x68, 0x27, 0x4a, 0xa5, 0x83, 0x3c, 0x0d, 0xa2, 0x2c, 0x58, 0xe4, 0xab,
-	0x38, 0xea, 0x27, 0x69, 0x9c, 0xc7, 0xe8, 0xb0, 0xf8, 0x64, 0xdd, 0xb7, 0xcb, 0x38, 0x5e, 0xae,
-	0xd5, 0xa0, 0x38, 0xce, 0x1f, 0x3f, 0x0e, 0xf2, 0xd5, 0x46, 0x65, 0x79, 0xb0, 0x49, 0x0c, 0xb1,
-	0x7b, 0x55, 0x24, 0x48, 0xd2, 0x38, 0x89, 0xb3, 0x60, 0x2d, 0x53, 0x95, 0x25, 0x71, 0x94, 0xa9,
-	0x32, 0xda, 0x5c, 0xc4, 0x9b, 0x4d, 0x1c, 0x0d, 0xcc, 0xc7, 0x80, 0xbd, 0x5f, 0x41, 0xc3, 0x5f,
-	0x2d, 0x23, 0x15, 0x8a, 0x9d, 0x2c, 0xfa, 0x1e, 0x34, 0xf6, 0x5c, 0xc8, 0xf9, 0x53, 0xae, 0xb2,
-	0x4e, 0xed, 0x5d, 0xed, 0xfd, 0x09, 0x87, 0x7b, 0x81, 0xa1, 0xc6, 0xd1, 0x15, 0x38, 0xce, 0x56,
-	0xcb, 0x28, 0xc8, 0x1f, 0x53, 0xd5, 0x79, 0x55, 0x90, 0x76, 0x40, 0xef, 0xcf, 0x1a, 0x68, 0x79,
-	0x69, 0xbc, 0x50, 0x59, 0xf6, 0x5c, 0x63, 0x08, 0x9a, 0x7b, 0xa9, 0x48, 0xf4, 0x49, 0xad, 0xe3,
-	0x44, 0x15, 0x2a, 0xf5, 0x6b, 0xd8, 0x2f, 0x4d, 0x56, 0x38, 0xff, 0x2f, 0x32, 0xfa, 0x06, 0x9c,
-	0x7e, 0x0a, 0xd6, 0xab, 0x30, 0xd0, 0xa8, 0x15, 0x87, 0x46, 0xff, 0x80, 0xbf, 0x40, 0x7b, 0x43,
-	0x50, 0xdf, 0x97, 0xfe, 0x00, 0x8e, 0xcc, 0x3f, 0x5d, 0xd4, 0xeb, 0xf7, 0xf5, 0xeb, 0x4b, 0xd3,
-	0x8c, 0xac, 0xbf, 0xc7, 0xc2, 0xc5, 0x2f, 0xaf, 0x98, 0x3d, 0x02, 0x1a, 0x9f, 0x45, 0x51, 0x1b,
-	0x1c, 0x3e, 0xa8, 0x20, 0x54, 0x69, 0xd9, 0x9d, 0xf2, 0x84, 0x3a, 0xe0, 0x28, 0x09, 0x9e, 0xd6,
-	0x71, 0x10, 0x96, 0x1d, 0xa9, 0x8e, 0xbd, 0xbf, 0x6b, 0xa0, 0x6d, 0x3d, 0x04, 0xab, 0x68, 0x11,
-	0x87, 0xca, 0x64, 0xf1, 0x4c, 0x08, 0xfd, 0x04, 0xba, 0x8b, 0x2a, 0x22, 0xb7, 0x43, 0xac, 0xf2,
-	0x18, 0x81, 0xce, 0x96, 0xe1, 0x95, 0x84, 0xea, 0xf6, 0x0f, 0xe0, 0xd0, 0x58, 0x2b, 0x14, 0xeb,
-	0xd7, 0x6f, 0xab, 0x9a, 0xb6, 0x6a, 0x24, 0x0a, 0xe3, 0x34, 0x53, 0x61, 0x59, 0x59, 0x49, 0xef,
-	0xfd, 0x55, 0x03, 0x17, 0xff, 0xc3, 0x41, 0x3f, 0x82, 0xcb, 0xcf, 0x5e, 0xd3, 0x0b, 0x47, 0x17,
-	0x15, 0x81, 0x97, 0xf1, 0x9d, 0xa1, 0x13, 0x65, 0xb2, 0x6d, 0x54, 0x94, 0x67, 0x9d, 0x57, 0x45,
-	0xab, 0x9b, 0x95, 0x2d, 0xb2, 0x8b, 0xf1, 0x67, 0xc4, 0xef, 0xfe, 0x38, 0x00, 0x50, 0xfc, 0x3e,
-	0x7d, 0x36, 0x42, 0x74, 0x0c, 0x0e, 0xa6, 0xd8, 0x75, 0x6c, 0xf8, 0x05, 0x82, 0xe0, 0x84, 0x3a,
-	0xae, 0x24, 0x74, 0x4a, 0x5c, 0xe6, 0x11, 0x58, 0x43, 0x67, 0xa0, 0x3e, 0xc4, 0xb6, 0xf4, 0xf0,
-	0xbd, 0xcb, 0xb0, 0x0d, 0x5f, 0xa1, 0x73, 0xd0, 0xd0, 0x80, 0xc5, 0xc6, 0x63, 0x46, 0xe5, 0x88,
-	0x60, 0x9b, 0x70, 0xf8, 0x1a, 0x5d, 0x82, 0xf3, 0x02, 0xe6, 0x04, 0x0b, 0xc6, 0xa5, 0xef, 0xdc,
-	0x52, 0x2c, 0x26, 0x9c, 0xc0, 0x2f, 0xd1, 0x3b, 0x70, 0xe5, 0xd0, 0x42, 0x41, 0x12, 0x6a, 0x33,
-	0xee, 0x13, 0x2e, 0x05, 0xc7, 0xd4, 0xc7, 0x96, 0x70, 0x18, 0x85, 0x07, 0xe8, 0x6b, 0xd0, 0xad,
-	0x18, 0x16, 0xa3, 0x37, 0xce, 0xed, 0xb3, 0xf8, 0x21, 0xea, 0x82, 0xf6, 0x84, 0xfa, 0x13, 0xcf,
-	0x63, 0x5c, 0x10, 0x5b, 0x8a, 0xd9, 0xd6, 0xcf, 0x51, 0xe5, 0xc7, 0xe3, 0xcc, 0x63, 0x3e, 0x76,
-	0xa5, 0x98, 0x39, 0x36, 0xfc, 0x0a, 0x21, 0x70, 0x6a, 0x4f, 0x3c, 0xd7, 0xb1, 0xb0, 0x20, 0x06,
-	0x3b, 0xd6, 0x32, 0xa5, 0x81, 0x31, 0xa1, 0x42, 0x7a, 0xcc, 0x75, 0xac, 0x7b, 0x79, 0x83, 0x1d,
-	0x57, 0x1b, 0x05, 0xa8, 0x0d, 0xd0, 0x78, 0x6a, 0x59, 0x92, 0x13, 0x6c, 0x8c, 0xb8, 0x8e, 0x25,
-	0x60, 0x5d, 0xd7, 0xe6, 0x8d, 0x30, 0x15, 0x6c, 0xfc, 0x22, 0x74, 0x82, 0x9a, 0xe0, 0x6c, 0x42,
-	0x7f, 0xa6, 0xec, 0x8e, 0x6a, 0x57, 0xe2, 0xde, 0x23, 0xf0, 0x8d, 0xb6, 0x2b, 0x30, 0xbf, 0x25,
-	0x42, 0x5a, 0x23, 0xec, 0x50, 0x49, 0x99, 0x90, 0x37, 0x6c, 0x42, 0x6d, 0x78, 0x8a, 0x5a, 0x00,
-	0x8e, 0x31, 0xf7, 0x47, 0x85, 0x53, 0x49, 0x38, 0x67, 0x1c, 0x9e, 0x55, 0x7d, 0x17, 0xb3, 0xb2,
-	0x64, 0xa8, 0xcb, 0x22, 0x33, 0xcf, 0xe1, 0xc4, 0x36, 0x49, 0x2c, 0x66, 0x13, 0xd8, 0xd0, 0x25,
-	0x6c, 0x8f, 0x72, 0x4a, 0xb8, 0xef, 0x30, 0xba, 0xf3, 0x83, 0x50, 0x07, 0xb4, 0x74, 0x37, 0xcc,
-	0x58, 0x24, 0x99, 0x09, 0x42, 0x35, 0x05, 0x36, 0x75, 0x71, 0xc5, 0x80, 0x46, 0x98, 0x52, 0xe2,
-	0x56, 0x83, 0x6b, 0x55, 0x37, 0x38, 0xf1, 0x3d, 0x46, 0x7d, 0xb2, 0xed, 0xec, 0x39, 0x7a, 0x03,
-	0x8e, 0x8b, 0xc8, 0x9d, 0x4f, 0x04, 0x6c, 0x6b, 0xe7, 0x8e, 0xeb, 0x92, 0x5b, 0xec, 0xca, 0x3b,
-	0xee, 0x08, 0xa2, 0xd1, 0x8b, 0x02, 0x2d, 0x47, 0xb7, 0x45, 0x3b, 0xe8, 0x12, 0xb4, 0x2a, 0x94,
-	0x89, 0x11, 0xe1, 0xba, 0x6f, 0x3e, 0xa3, 0xf0, 0x9f, 0xda, 0x70, 0x01, 0x7a, 0x71, 0xba, 0xec,
-	0x3f, 0x3c, 0x25, 0x2a, 0x5d, 0xab, 0x70, 0xa9, 0xd2, 0xfe, 0xc7, 0x60, 0x9e, 0xae, 0x16, 0xd5,
-	0xeb, 0xd5, 0x8b, 0x76, 0x88, 0xf6, 0x16, 0x82, 0x17, 0x2c, 0x7e, 0x0b, 0x96, 0xea, 0x97, 0x6f,
-	0x97, 0xab, 0xfc, 0xe1, 0x71, 0xae, 0xf7, 0xd7, 0x60, 0xef, 0xfa, 0xc0, 0x5c, 0x37, 0xab, 0x3b,
-	0x1b, 0xe8, 0xeb, 0x73, 0xb3, 0xd6, 0x3f, 0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x40, 0xca, 0xda,
-	0xb0, 0xf7, 0x05, 0x00, 0x00,
+	// 1104 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x56, 0xdb, 0x6e, 0xdb, 0xc6,
+	0x16, 0x3d, 0xb2, 0x2d, 0xd9, 0xda, 0x52, 0xec, 0xf1, 0xf8, 0x46, 0x3b, 0x41, 0x12, 0xe8, 0xe1,
+	0x20, 0x6d, 0x01, 0x0b, 0x70, 0x50, 0x14, 0x28, 0x0a, 0xb4, 0x34, 0x35, 0xb1, 0x08, 0x53, 0x43,
+	0x62, 0x48, 0x29, 0x4e, 0x1f, 0x3a, 0xa0, 0xc8, 0x89, 0x24, 0x58, 0x22, 0x05, 0x92, 0x0e, 0xac,
+	0xd7, 0x7e, 0x40, 0xfb, 0x0b, 0xfd, 0x87, 0x7e, 0x60, 0x8b, 0x19, 0x92, 0xba, 0x38, 0xf5, 0x8b,
+	0xa4, 0x59, 0x6b, 0xcd, 0xde, 0x6b, 0x5f, 0x04, 0x12, 0x4e, 0xe7, 0x42, 0x24, 0xed, 0x2c, 0xf1,
+	0xa3, 0xd4, 0x0f, 0xb2, 0x49, 0x1c, 0x5d, 0xce, 0x93, 0x38, 0x8b, 0x71, 0x4d, 0x7d, 0xa5, 0x17,
+	0x6f, 0x46, 0x71, 0x3c, 0x9a, 0x8a, 0xb6, 0x3a, 0x0e, 0x1f, 0x3e, 0xb7, 0xb3, 0xc9, 0x4c, 0xa4,
+	0x99, 0x3f, 0x9b, 0xe7, 0xc2, 0x8b, 0x57, 0x2a, 0xc0, 0x3c, 0x89, 0xe7, 0x71, 0xea, 0x4f, 0x79,
+	0x22, 0xd2, 0x79, 0x1c, 0xa5, 0xa2, 0x60, 0x8f, 0x82, 0x78, 0x36, 0x8b, 0xa3, 0x76, 0xfe, 0x95,
+	0x83, 0xad, 0xdf, 0xe0, 0xd0, 0x9d, 0x8c, 0x22, 0x11, 0x7a, 0xab, 0xb4, 0xf8, 0x3b, 0x38, 0x5c,
+	0x73, 0xc1, 0x87, 0x8b, 0x4c, 0xa4, 0x5a, 0xe5, 0x6d, 0xe5, 0x5d, 0x93, 0xa1, 0x35, 0xe2, 0x5a,
+	0xe2, 0xf8, 0x15, 0xd4, 0xd3, 0xc9, 0x28, 0xf2, 0xb3, 0x87, 0x44, 0x68, 0x5b, 0x4a, 0xb4, 0x02,
+	0x5a, 0xbf, 0x57, 0xe0, 0xd8, 0x49, 0xe2, 0x40, 0xa4, 0xe9, 0x66, 0x8e, 0x6b, 0x38, 0x5a, 0x0b,
+	0x45, 0xa2, 0x2f, 0x62, 0x1a, 0xcf, 0x85, 0xca, 0xd2, 0xb8, 0x42, 0x97, 0x85, 0xc9, 0x12, 0x67,
+	0xff, 0x25, 0xc6, 0xff, 0x87, 0xfd, 0x2f, 0xfe, 0x74, 0x12, 0xfa, 0x12, 0x35, 0xe2, 0x30, 0xcf,
+	0x5f, 0x65, 0x4f, 0xd0, 0xd6, 0x35, 0x34, 0xd6, 0x53, 0xbf, 0x87, 0xdd, 0xfc, 0x97, 0x2c, 0x6a,
+	0xfb, 0x5d, 0xe3, 0xea, 0x3c, 0x6f, 0x46, 0x7a, 0xb9, 0xa6, 0xd2, 0xd5, 0x27, 0x2b, 0x95, 0x2d,
+	0x02, 0x87, 0x5f, 0xb1, 0xf8, 0x14, 0x6a, 0x63, 0xe1, 0x87, 0x22, 0x29, 0xba, 0x53, 0x9c, 0xb0,
+	0x06, 0xbb, 0x73, 0x7f, 0x31, 0x8d, 0xfd, 0xb0, 0xe8, 0x48, 0x79, 0x6c, 0xfd, 0x59, 0x81, 0x53,
+	0x63, 0xec, 0x4f, 0xa2, 0x20, 0x0e, 0x45, 0x1e, 0xc5, 0xc9, 0x29, 0xfc, 0x13, 0x5c, 0x04, 0x25,
+	0xc3, 0x97, 0x43, 0x2c, 0xe3, 0xe4, 0x09, 0xb4, 0xa5, 0xc2, 0x29, 0x04, 0xe5, 0xed, 0x1f, 0xa0,
+	0x96, 0x5b, 0x53, 0x19, 0x1b, 0x57, 0x6f, 0xca, 0x9a, 0x96, 0xd9, 0x48, 0x14, 0xc6, 0x49, 0x2a,
+	0xc2, 0xa2, 0xb2, 0x42, 0xde, 0xfa, 0xa3, 0x02, 0x67, 0xcf, 0x68, 0xf0, 0x8f, 0x70, 0xfe, 0xd5,
+	0x36, 0x3d, 0x71, 0x74, 0x56, 0x0a, 0x58, 0xc1, 0xaf, 0x0c, 0x35, 0x45, 0x1e, 0x6d, 0x26, 0xa2,
+	0x2c, 0xd5, 0xb6, 0x54, 0xab, 0x8f, 0x4a, 0x5b, 0x64, 0xc5, 0xb1, 0x0d, 0x61, 0xeb, 0xef, 0x6d,
+	0x68, 0xf6, 0x06, 0x86, 0x61, 0xc4, 0xd1, 0xe7, 0xe9, 0x24, 0xc8, 0xf0, 0x11, 0x54, 0xb3, 0x47,
+	0x3e, 0xc9, 0x33, 0xd6, 0xd9, 0x4e, 0xf6, 0x68, 0x86, 0xf8, 0x25, 0xd4, 0x87, 0xd3, 0x38, 0xb8,
+	0xe7, 0xd1, 0xc3, 0x4c, 0x95, 0xbc, 0xc3, 0xf6, 0x14, 0x40, 0x1f, 0x66, 0xf8, 0x04, 0x6a, 0xd9,
+	0xa3, 0x62, 0xb6, 0x15, 0x53, 0xcd, 0x1e, 0x25, 0xac, 0xc3, 0xc1, 0x6a, 0x33, 0xb8, 0xac, 0x57,
+	0xdb, 0x79, 0x5b, 0x79, 0xb7, 0x7f, 0xa5, 0x2d, 0x17, 0xe0, 0x71, 0xb0, 0xb1, 0x3a, 0x4f, 0x57,
+	0x49, 0x6e, 0x7b, 0xe4, 0xcf, 0x44, 0x3a, 0xf7, 0x03, 0xa1, 0x55, 0x95, 0x9f, 0x15, 0x80, 0x5f,
+	0x03, 0x04, 0xf1, 0x74, 0x2a, 0xf2, 0x41, 0xd4, 0x14, 0xbd, 0x86, 0x60, 0x04, 0xdb, 0xf7, 0x62,
+	0xa1, 0xed, 0x2a, 0x42, 0xfe, 0xc4, 0xe7, 0xb0, 0x77, 0x2f, 0x16, 0x7c, 0xec, 0xa7, 0x63, 0x6d,
+	0x2f, 0x5f, 0x95, 0x7b, 0xb1, 0xe8, 0xfa, 0xe9, 0x18, 0x7f, 0x0f, 0xcd, 0x44, 0xf8, 0x21, 0xff,
+	0x22, 0x92, 0x54, 0x86, 0xab, 0xab, 0xb9, 0xe2, 0xd2, 0xea, 0xad, 0x58, 0x0c, 0x72, 0x86, 0x35,
+	0xa4, 0xae, 0x38, 0xe0, 0x9f, 0xe1, 0x50, 0xfe, 0x79, 0x26, 0x59, 0x26, 0x56, 0x77, 0xe1, 0xd9,
+	0xbb, 0x68, 0x29, 0x2e, 0x03, 0xbc, 0x84, 0x7a, 0x9a, 0xf9, 0x49, 0xc6, 0xa5, 0xd5, 0x86, 0xb2,
+	0xba, 0xa7, 0x80, 0x5b, 0xb1, 0xc0, 0x67, 0xb0, 0x2b, 0xa2, 0x50, 0x51, 0x4d, 0x45, 0xd5, 0x44,
+	0x14, 0xde, 0x8a, 0x45, 0xeb, 0x17, 0x80, 0x55, 0xd4, 0xcd, 0xe9, 0x54, 0x9e, 0x9d, 0xce, 0xd6,
+	0xda, 0x74, 0x5a, 0x06, 0xbc, 0x58, 0x1f, 0x7b, 0x8a, 0xaf, 0xa0, 0x1e, 0x94, 0x87, 0xe2, 0x9f,
+	0x7a, 0x5c, 0x56, 0xb0, 0xae, 0x64, 0x2b, 0xd9, 0xb7, 0x7f, 0x55, 0x01, 0x3d, 0x1d, 0x22, 0xae,
+	0x43, 0x75, 0xa0, 0x5b, 0x66, 0x07, 0xfd, 0x0f, 0x23, 0x68, 0x52, 0xd3, 0xe2, 0x84, 0x0e, 0x88,
+	0x65, 0x3b, 0x04, 0x55, 0xf0, 0x01, 0x34, 0xae, 0xf5, 0x0e, 0x77, 0xf4, 0x4f, 0x96, 0xad, 0x77,
+	0xd0, 0x16, 0x3e, 0x81, 0x43, 0x09, 0x18, 0x76, 0xaf, 0x67, 0x53, 0xde, 0x25, 0x7a, 0x87, 0x30,
+	0xb4, 0x8d, 0xcf, 0xe1, 0x44, 0xc1, 0x8c, 0xe8, 0x9e, 0xcd, 0xb8, 0x6b, 0xde, 0x50, 0xdd, 0xeb,
+	0x33, 0x82, 0x76, 0xf0, 0x5b, 0x78, 0x65, 0x52, 0x95, 0x81, 0x13, 0xda, 0xb1, 0x99, 0x4b, 0x18,
+	0xf7, 0x98, 0x4e, 0x5d, 0xdd, 0xf0, 0x4c, 0x9b, 0xa2, 0x2a, 0x7e, 0x0d, 0x17, 0xa5, 0xc2, 0xb0,
+	0xe9, 0x07, 0xf3, 0x66, 0x83, 0xaf, 0xe1, 0x0b, 0x38, 0xed, 0x53, 0xb7, 0xef, 0x38, 0x36, 0xf3,
+	0x48, 0x87, 0x7b, 0x77, 0x4b, 0x3f, 0xbb, 0xa5, 0x1f, 0x87, 0xd9, 0x8e, 0xed, 0xea, 0x16, 0xf7,
+	0xee, 0xcc, 0x0e, 0xda, 0xc3, 0x18, 0xf6, 0x3b, 0x7d, 0xc7, 0x32, 0x0d, 0xdd, 0x23, 0x39, 0x56,
+	0x97, 0x69, 0x0a, 0x03, 0x3d, 0x42, 0x3d, 0xee, 0xd8, 0x96, 0x69, 0x7c, 0xe2, 0x1f, 0x74, 0xd3,
+	0x92, 0x46, 0x01, 0x9f, 0x02, 0x96, 0x8d, 0xe3, 0x8c, 0xe8, 0xb9, 0x11, 0xcb, 0x34, 0x3c, 0xd4,
+	0x90, 0xb5, 0x39, 0x5d, 0x9d, 0x7a, 0x76, 0xef, 0x09, 0xd5, 0xc4, 0x47, 0x70, 0xd0, 0xa7, 0xb7,
+	0xd4, 0xfe, 0x48, 0xa5, 0x2b, 0xef, 0x93, 0x43, 0xd0, 0x0b, 0x69, 0xd7, 0xd3, 0xd9, 0x0d, 0xf1,
+	0xb8, 0xd1, 0xd5, 0x4d, 0xca, 0xa9, 0xed, 0xf1, 0x0f, 0x76, 0x9f, 0x76, 0xd0, 0x3e, 0x3e, 0x06,
+	0xd4, 0xd3, 0x99, 0xdb, 0x55, 0x4e, 0x39, 0x61, 0xcc, 0x66, 0xe8, 0xa0, 0xec, 0xbb, 0x77, 0x57,
+	0x94, 0x8c, 0x64, 0x59, 0xe4, 0xce, 0x31, 0x19, 0xe9, 0xe4, 0x41, 0x0c, 0xbb, 0x43, 0xd0, 0xa1,
+	0x2c, 0x61, 0x79, 0xe4, 0x03, 0xc2, 0x5c, 0xd3, 0xa6, 0x2b, 0x3f, 0x18, 0x6b, 0x70, 0x2c, 0xbb,
+	0x91, 0x8f, 0x85, 0x93, 0x3b, 0x8f, 0x50, 0x29, 0x41, 0x47, 0xb2, 0x38, 0x35, 0xa0, 0xae, 0x4e,
+	0x29, 0xb1, 0xca, 0xc1, 0x1d, 0x97, 0x37, 0x18, 0x71, 0x1d, 0x9b, 0xba, 0x64, 0xd9, 0xd9, 0x13,
+	0xfc, 0x02, 0xea, 0x8a, 0xf9, 0xe8, 0x12, 0x0f, 0x9d, 0x4a, 0xe7, 0xa6, 0x65, 0x91, 0x1b, 0xdd,
+	0xe2, 0x1f, 0x99, 0xe9, 0x11, 0x89, 0x9e, 0x29, 0xb4, 0x18, 0xdd, 0x12, 0xd5, 0x36, 0x06, 0xca,
+	0x6c, 0xd7, 0x5d, 0xa6, 0x95, 0xfd, 0x43, 0xe7, 0xf8, 0x1c, 0x8e, 0x4b, 0xde, 0xf6, 0xba, 0x84,
+	0x49, 0xdc, 0xb5, 0x29, 0xfa, 0xa7, 0x72, 0x1d, 0x40, 0x2b, 0x4e, 0x46, 0x97, 0xe3, 0xc5, 0x5c,
+	0x24, 0x53, 0x11, 0x8e, 0x44, 0x72, 0xf9, 0xd9, 0x1f, 0x26, 0x93, 0xa0, 0xdc, 0x6d, 0xf9, 0x14,
+	0xbf, 0xc6, 0x6b, 0x4f, 0x1b, 0xc7, 0x0f, 0xee, 0xfd, 0x91, 0xf8, 0xf5, 0x9b, 0xd1, 0x24, 0x1b,
+	0x3f, 0x0c, 0xe5, 0xc3, 0xb1, 0xbd, 0x76, 0xbd, 0x9d, 0x5f, 0xcf, 0xdf, 0x0b, 0xd2, 0xb6, 0xbc,
+	0x3e, 0xcc, 0xdf, 0x19, 0xde, 0xff, 0x3b, 0x00, 0x9e, 0xf9, 0xaf, 0x37, 0x54, 0x08, 0x00, 0x00,
 }
@@ -304,6 +304,28 @@ func UnmarshalChaincodeID(bytes []byte) (*pb.ChaincodeID, error) {
 	return ccid, nil
 }
 
+// UnmarshalChaincodeCalls returns a ChaincodeCalls from bytes
+func UnmarshalChaincodeCalls(bytes []byte) (*pb.ChaincodeCalls, error) {
+	calls := &pb.ChaincodeCalls{}
+	err := proto.Unmarshal(bytes, calls)
+	if err != nil {
+		return nil, fmt.Errorf("UnmarshalChaincodeCalls failed, err %s", err)
+	}
+
+	return calls, nil
+}
+
+// UnmarshalChaincodeEvents returns a ChaincodeEvents from bytes
+func UnmarshalChaincodeEvents(bytes []byte) (*pb.ChaincodeEvents, error) {
+	events := &pb.ChaincodeEvents{}
+	err := proto.Unmarshal(bytes, events)
+	if err != nil {
+		return nil, fmt.Errorf("UnmarshalChaincodeEvents failed, err %s", err)
+	}
+
+	return events, nil
+}
+
 // IsConfigBlock validates whenever given block contains configuration
 // update transaction
 func IsConfigBlock(block *cb.Block) bool {
@@ -186,6 +186,23 @@ func GetChaincodeEvents(eBytes []byte) (*peer.ChaincodeEvent, error) {
 	return chaincodeEvent, err
 }
 
+// GetChaincodeActionEvents returns all the events of a ChaincodeAction: the
+// events generated by chaincodes that set more than one event are stored
+// in its ChaincodeEvents, while its Events only contains the last of them
+func GetChaincodeActionEvents(cAct *peer.ChaincodeAction) ([]*peer.ChaincodeEvent, error) {
+	if len(cAct.ChaincodeEvents) > 0 {
+		return cAct.ChaincodeEvents, nil
+	}
+	if len(cAct.Events) == 0 {
+		return nil, nil
+	}
+	ccEvent, err := GetChaincodeEvents(cAct.Events)
+	if err != nil {
+		return nil, err
+	}
+	return []*peer.ChaincodeEvent{ccEvent}, nil
+}
+
 // GetProposalResponsePayload gets the proposal response payload
 func GetProposalResponsePayload(prpBytes []byte) (*peer.ProposalResponsePayload, error) {
 	prp := &peer.ProposalResponsePayload{}
@@ -301,9 +318,66 @@ func CreateChaincodeProposalWithTxIDNonceAndTransient(txid string, typ common.He
 	return &peer.Proposal{Header: hdrBytes, Payload: ccPropPayloadBytes}, txid, nil
 }
 
+// MarkProposalReadOnly marks the given chaincode proposal as read-only,
+// i.e., as not meant to be submitted as a transaction
+func MarkProposalReadOnly(prop *peer.Proposal) error {
+	return updateChaincodeHeaderExtension(prop, func(ccHdrExt *peer.ChaincodeHeaderExtension) {
+		ccHdrExt.ReadOnly = true
+	})
+}
+
+// SetProposalBlockHeight marks the given chaincode proposal as read-only, and
+// requests that it be simulated against the state of the ledger as of the given
+// height, rather than against its latest state
+func SetProposalBlockHeight(prop *peer.Proposal, height uint64) error {
+	return updateChaincodeHeaderExtension(prop, func(ccHdrExt *peer.ChaincodeHeaderExtension) {
+		ccHdrExt.ReadOnly = true
+		ccHdrExt.BlockHeight = height
+	})
+}
+
+// updateChaincodeHeaderExtension applies the given update to the chaincode
+// header extension of the given proposal
+func updateChaincodeHeaderExtension(prop *peer.Proposal, update func(*peer.ChaincodeHeaderExtension)) error {
+	hdr, err := GetHeader(prop.Header)
+	if err != nil {
+		return err
+	}
+	chdr, err := UnmarshalChannelHeader(hdr.ChannelHeader)
+	if err != nil {
+		return err
+	}
+	ccHdrExt, err := GetChaincodeHeaderExtension(hdr)
+	if err != nil {
+		return fmt.Errorf("error unmarshaling chaincode header extension: %s", err)
+	}
+
+	update(ccHdrExt)
+	if chdr.Extension, err = proto.Marshal(ccHdrExt); err != nil {
+		return err
+	}
+	if hdr.ChannelHeader, err = proto.Marshal(chdr); err != nil {
+		return err
+	}
+	prop.Header, err = proto.Marshal(hdr)
+	return err
+}
+
 // GetBytesProposalResponsePayload gets proposal response payload
 func GetBytesProposalResponsePayload(hash []byte, response *peer.Response, result []byte, event []byte, ccid *peer.ChaincodeID) ([]byte, error) {
-	cAct := &peer.ChaincodeAction{Events: event, Results: result, Response: response, ChaincodeId: ccid}
+	return GetBytesProposalResponsePayloadWithCalls(hash, response, result, event, ccid, nil)
+}
+
+// GetBytesProposalResponsePayloadWithCalls gets proposal response payload
+// whose ChaincodeAction records the supplied chaincode-to-chaincode calls
+func GetBytesProposalResponsePayloadWithCalls(hash []byte, response *peer.Response, result []byte, event []byte, ccid *peer.ChaincodeID, calls []*peer.ChaincodeCall) ([]byte, error) {
+	cAct := &peer.ChaincodeAction{Events: event, Results: result, Response: response, ChaincodeId: ccid, Calls: calls}
+	return GetBytesProposalResponsePayloadForAction(hash, cAct)
+}
+
+// GetBytesProposalResponsePayloadForAction gets proposal response payload
+// whose extension is the supplied ChaincodeAction
+func GetBytesProposalResponsePayloadForAction(hash []byte, cAct *peer.ChaincodeAction) ([]byte, error) {
 	cActBytes, err := proto.Marshal(cAct)
 	if err != nil {
 		return nil, err
@@ -332,6 +406,13 @@ func GetBytesChaincodeEvent(event *peer.ChaincodeEvent) ([]byte, error) {
 	return eventBytes, err
 }
 
+// GetBytesChaincodeEvents gets the bytes of a ChaincodeEvents message
+// wrapping the supplied events
+func GetBytesChaincodeEvents(events []*peer.ChaincodeEvent) ([]byte, error) {
+	eventsBytes, err := proto.Marshal(&peer.ChaincodeEvents{Events: events})
+	return eventsBytes, err
+}
+
 // GetBytesChaincodeActionPayload get the bytes of ChaincodeActionPayload from the message
 func GetBytesChaincodeActionPayload(cap *peer.ChaincodeActionPayload) ([]byte, error) {
 	capBytes, err := proto.Marshal(cap)
@@ -635,3 +635,74 @@ func TestMain(m *testing.M) {
 
 	os.Exit(m.Run())
 }
+
+func TestGetChaincodeActionEvents(t *testing.T) {
+	// no events
+	events, err := utils.GetChaincodeActionEvents(&pb.ChaincodeAction{})
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+
+	// a single event
+	event := &pb.ChaincodeEvent{ChaincodeId: "ccid", EventName: "first"}
+	events, err = utils.GetChaincodeActionEvents(&pb.ChaincodeAction{Events: utils.MarshalOrPanic(event)})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.True(t, proto.Equal(event, events[0]))
+
+	// multiple events
+	ccEvents := []*pb.ChaincodeEvent{event, {ChaincodeId: "ccid", EventName: "second"}}
+	events, err = utils.GetChaincodeActionEvents(&pb.ChaincodeAction{Events: utils.MarshalOrPanic(ccEvents[1]), ChaincodeEvents: ccEvents})
+	assert.NoError(t, err)
+	assert.Equal(t, ccEvents, events)
+
+	// bad event bytes
+	_, err = utils.GetChaincodeActionEvents(&pb.ChaincodeAction{Events: []byte("barf")})
+	assert.Error(t, err)
+
+	eventsBytes, err := utils.GetBytesChaincodeEvents(ccEvents)
+	assert.NoError(t, err)
+	unmarshaled, err := utils.UnmarshalChaincodeEvents(eventsBytes)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(&pb.ChaincodeEvents{Events: ccEvents}, unmarshaled))
+	_, err = utils.UnmarshalChaincodeEvents([]byte("barf"))
+	assert.Error(t, err)
+}
+
+func TestMarkProposalReadOnly(t *testing.T) {
+	cis := &pb.ChaincodeInvocationSpec{ChaincodeSpec: &pb.ChaincodeSpec{ChaincodeId: &pb.ChaincodeID{Name: "mycc"}}}
+	prop, txid, err := utils.CreateChaincodeProposal(common.HeaderType_ENDORSER_TRANSACTION, "mychannel", cis, []byte("creator"))
+	assert.NoError(t, err)
+
+	err = utils.MarkProposalReadOnly(prop)
+	assert.NoError(t, err)
+	hdr, err := utils.GetHeader(prop.Header)
+	assert.NoError(t, err)
+	chdr, err := utils.UnmarshalChannelHeader(hdr.ChannelHeader)
+	assert.NoError(t, err)
+	assert.Equal(t, txid, chdr.TxId)
+	hdrExt, err := utils.GetChaincodeHeaderExtension(hdr)
+	assert.NoError(t, err)
+	assert.True(t, hdrExt.ReadOnly)
+	assert.Equal(t, "mycc", hdrExt.ChaincodeId.Name)
+
+	err = utils.MarkProposalReadOnly(&pb.Proposal{Header: []byte("bad header")})
+	assert.Error(t, err)
+}
+
+func TestSetProposalBlockHeight(t *testing.T) {
+	cis := &pb.ChaincodeInvocationSpec{ChaincodeSpec: &pb.ChaincodeSpec{ChaincodeId: &pb.ChaincodeID{Name: "mycc"}}}
+	prop, _, err := utils.CreateChaincodeProposal(common.HeaderType_ENDORSER_TRANSACTION, "mychannel", cis, []byte("creator"))
+	assert.NoError(t, err)
+
+	err = utils.SetProposalBlockHeight(prop, 10)
+	assert.NoError(t, err)
+	hdr, err := utils.GetHeader(prop.Header)
+	assert.NoError(t, err)
+	hdrExt, err := utils.GetChaincodeHeaderExtension(hdr)
+	assert.NoError(t, err)
+	assert.True(t, hdrExt.ReadOnly)
+	assert.Equal(t, uint64(10), hdrExt.BlockHeight)
+
+	err = utils.SetProposalBlockHeight(&pb.Proposal{Header: []byte("bad header")}, 10)
+	assert.Error(t, err)
+}
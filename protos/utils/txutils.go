@@ -225,6 +225,20 @@ func CreateSignedTx(proposal *peer.Proposal, signer msp.SigningIdentity, resps .
 
 // CreateProposalResponse creates a proposal response.
 func CreateProposalResponse(hdrbytes []byte, payl []byte, response *peer.Response, results []byte, events []byte, ccid *peer.ChaincodeID, visibility []byte, signingEndorser msp.SigningIdentity) (*peer.ProposalResponse, error) {
+	return CreateProposalResponseWithCalls(hdrbytes, payl, response, results, events, ccid, nil, visibility, signingEndorser)
+}
+
+// CreateProposalResponseWithCalls creates a proposal response whose ChaincodeAction
+// also carries the chaincode-to-chaincode calls performed while simulating the proposal
+func CreateProposalResponseWithCalls(hdrbytes []byte, payl []byte, response *peer.Response, results []byte, events []byte, ccid *peer.ChaincodeID, calls []*peer.ChaincodeCall, visibility []byte, signingEndorser msp.SigningIdentity) (*peer.ProposalResponse, error) {
+	cAct := &peer.ChaincodeAction{Events: events, Results: results, Response: response, ChaincodeId: ccid, Calls: calls}
+	return CreateProposalResponseForAction(hdrbytes, payl, cAct, visibility, signingEndorser)
+}
+
+// CreateProposalResponseForAction creates a proposal response for the supplied
+// ChaincodeAction, which may also carry the chaincode-to-chaincode calls and all
+// the events produced while simulating the proposal
+func CreateProposalResponseForAction(hdrbytes []byte, payl []byte, cAct *peer.ChaincodeAction, visibility []byte, signingEndorser msp.SigningIdentity) (*peer.ProposalResponse, error) {
 	hdr, err := GetHeader(hdrbytes)
 	if err != nil {
 		return nil, err
@@ -237,7 +251,7 @@ func CreateProposalResponse(hdrbytes []byte, payl []byte, response *peer.Respons
 	}
 
 	// get the bytes of the proposal response payload - we need to sign them
-	prpBytes, err := GetBytesProposalResponsePayload(pHashBytes, response, results, events, ccid)
+	prpBytes, err := GetBytesProposalResponsePayloadForAction(pHashBytes, cAct)
 	if err != nil {
 		return nil, errors.New("Failure while marshaling the ProposalResponsePayload")
 	}